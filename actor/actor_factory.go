@@ -0,0 +1,42 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ActorFactory creates an actor from the given arguments.
+//
+// Factories are registered by name on every node of the cluster, using WithActorFactory or RegisterFactory,
+// and used to spawn actors that need real constructor parameters, locally with SpawnFromFactory or remotely
+// with a remote.SpawnRequest naming the factory. The arguments are a protocol buffers message sent along
+// with the spawn request. They are nil when the actor is spawned without arguments.
+//
+// The factory name and the arguments are kept by the cluster so that a relocated actor is created again
+// with the same factory and arguments on its new node.
+type ActorFactory func(ctx context.Context, args proto.Message) (Actor, error)
@@ -25,6 +25,11 @@
 package actor
 
 import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
 	"github.com/tochemey/goakt/v3/address"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/registry"
@@ -45,6 +50,10 @@ import (
 //
 //   - IsSingleton: The actor is a singleton
 //     if the actor is a singleton then the actor is created once in the cluster
+//
+// An ActorRef is location-transparent: Tell, Ask, Watch and Stop route the call
+// to the local actor or over remoting to the node hosting the actor.
+// The resolved location is cached and resolved again when the actor relocates.
 type ActorRef struct { //nolint:revive
 	// name defines the actor Name
 	name string
@@ -55,6 +64,35 @@ type ActorRef struct { //nolint:revive
 	// isSingleton defines if the actor is a singleton
 	isSingleton bool
 	relocatable bool
+	// system is the actor system used to reach the actor
+	system ActorSystem
+	// location caches the resolved location of the actor
+	location *actorLocation
+}
+
+// actorLocation is the resolved location of an actor.
+// It is shared by the copies of an ActorRef.
+type actorLocation struct {
+	mu      sync.RWMutex
+	pid     *PID
+	address *address.Address
+}
+
+// get returns the local PID, when the actor is local, and the address of the actor
+func (l *actorLocation) get() (*PID, *address.Address) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.pid, l.address
+}
+
+// set updates the location and returns true when it has changed
+func (l *actorLocation) set(pid *PID, addr *address.Address) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	changed := l.pid != pid || !l.address.Equals(addr)
+	l.pid = pid
+	l.address = addr
+	return changed
 }
 
 // Name represents the actor given name
@@ -69,6 +107,10 @@ func (x ActorRef) Kind() string {
 
 // Address represents the actor address
 func (x ActorRef) Address() *address.Address {
+	if x.location != nil {
+		_, addr := x.location.get()
+		return addr
+	}
 	return x.address
 }
 
@@ -89,16 +131,130 @@ func (x ActorRef) IsRelocatable() bool {
 
 // Equals is a convenient method to compare two ActorRef
 func (x ActorRef) Equals(actor ActorRef) bool {
-	return x.address.Equals(actor.address)
+	return x.Address().Equals(actor.Address())
+}
+
+// Tell sends an asynchronous message to the actor wherever it is located
+func (x ActorRef) Tell(ctx context.Context, message any) error {
+	return x.route(ctx, func(pid *PID, addr *address.Address) error {
+		if pid != nil {
+			return Tell(ctx, pid, message)
+		}
+		return x.system.getRemoting().RemoteTell(ctx, address.NoSender(), addr, message)
+	})
+}
+
+// Ask sends a synchronous message to the actor wherever it is located and expect a response.
+// This block until a response is received or timed out.
+func (x ActorRef) Ask(ctx context.Context, message any, timeout time.Duration) (response any, err error) {
+	err = x.route(ctx, func(pid *PID, addr *address.Address) error {
+		var err error
+		if pid != nil {
+			response, err = Ask(ctx, pid, message, timeout)
+			return err
+		}
+		response, err = x.system.getRemoting().RemoteAsk(ctx, address.NoSender(), addr, message, timeout)
+		return err
+	})
+	return response, err
+}
+
+// Watch makes the given watcher watch the actor wherever it is located.
+// The watcher receives a Terminated message when the actor stops.
+func (x ActorRef) Watch(ctx context.Context, watcher *PID) error {
+	return x.route(ctx, func(pid *PID, addr *address.Address) error {
+		if pid != nil {
+			if !pid.IsRunning() {
+				return ErrDead
+			}
+			watcher.Watch(pid)
+			return nil
+		}
+		return watcher.RemoteWatch(ctx, addr)
+	})
+}
+
+// Stop stops the actor wherever it is located
+func (x ActorRef) Stop(ctx context.Context) error {
+	return x.route(ctx, func(pid *PID, addr *address.Address) error {
+		if pid != nil {
+			return pid.Shutdown(ctx)
+		}
+		return x.system.getRemoting().RemoteStop(ctx, addr.GetHost(), int(addr.GetPort()), addr.GetName())
+	})
+}
+
+// route calls the given function with the cached location of the actor.
+// On failure, the location is resolved again and the call is retried once when the actor has relocated.
+func (x ActorRef) route(ctx context.Context, fn func(pid *PID, addr *address.Address) error) error {
+	if x.system == nil || x.location == nil {
+		return ErrUndefinedActor
+	}
+
+	pid, addr := x.location.get()
+	if pid != nil && !pid.IsRunning() {
+		// the local actor is gone, it may have been relocated
+		if _, err := x.resolve(ctx); err != nil {
+			return err
+		}
+		pid, addr = x.location.get()
+	}
+
+	if pid == nil && x.system.getRemoting() == nil {
+		return ErrRemotingDisabled
+	}
+
+	err := fn(pid, addr)
+	if err == nil || !x.shouldResolve(err) {
+		return err
+	}
+
+	changed, rerr := x.resolve(ctx)
+	if rerr != nil || !changed {
+		return err
+	}
+
+	pid, addr = x.location.get()
+	return fn(pid, addr)
+}
+
+// resolve looks up the actor location and returns true when it has changed
+func (x ActorRef) resolve(ctx context.Context) (bool, error) {
+	actorRef, err := x.system.ActorOf(ctx, x.name)
+	if err != nil {
+		return false, err
+	}
+	pid, addr := actorRef.location.get()
+	return x.location.set(pid, addr), nil
+}
+
+// shouldResolve returns true when the given error may be caused by the actor relocation.
+// Timeouts are not retried to avoid delivering the same request twice.
+func (x ActorRef) shouldResolve(err error) bool {
+	return !errors.Is(err, ErrRequestTimeout) &&
+		!errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded)
+}
+
+// localPID returns the PID of the actor when it is located on the local node
+func (x ActorRef) localPID() *PID {
+	if x.location == nil {
+		return nil
+	}
+	pid, _ := x.location.get()
+	return pid
 }
 
-func fromActorRef(actorRef *internalpb.Actor) ActorRef {
+func fromActorRef(system ActorSystem, actorRef *internalpb.Actor) ActorRef {
+	addr := address.From(actorRef.GetAddress())
 	return ActorRef{
 		name:        actorRef.GetAddress().GetName(),
 		kind:        actorRef.GetType(),
-		address:     address.From(actorRef.GetAddress()),
+		address:     addr,
 		isSingleton: actorRef.GetIsSingleton(),
 		relocatable: actorRef.GetRelocatable(),
+		system:      system,
+		location:    &actorLocation{address: addr},
 	}
 }
 
@@ -109,5 +265,7 @@ func fromPID(pid *PID) ActorRef {
 		address:     pid.Address(),
 		isSingleton: pid.IsSingleton(),
 		relocatable: pid.IsRelocatable(),
+		system:      pid.ActorSystem(),
+		location:    &actorLocation{pid: pid, address: pid.Address()},
 	}
 }
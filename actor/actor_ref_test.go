@@ -25,25 +25,32 @@
 package actor
 
 import (
+	"context"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
 	"github.com/tochemey/goakt/v3/internal/registry"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
 )
 
 func TestActorRef(t *testing.T) {
 	t.Run("With Equals", func(t *testing.T) {
 		addr := address.New("name", "system", "host", 1234)
-		actorRef := fromActorRef(&internalpb.Actor{
+		actorRef := fromActorRef(nil, &internalpb.Actor{
 			Address: addr.Address,
 			Type:    "kind",
 		})
 
-		newActorRef := fromActorRef(&internalpb.Actor{
+		newActorRef := fromActorRef(nil, &internalpb.Actor{
 			Address: addr.Address,
 			Type:    "kind",
 		})
@@ -65,4 +72,128 @@ func TestActorRef(t *testing.T) {
 		require.Equal(t, "name", actorRef.Name())
 		require.Equal(t, registry.Name(actor), actorRef.Kind())
 	})
+	t.Run("With local actor", func(t *testing.T) {
+		ctx := context.TODO()
+		sys, err := NewActorSystem("test", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pid, err := sys.Spawn(ctx, "actor", NewMockActor())
+		require.NoError(t, err)
+
+		watcher := NewMockWatcher()
+		watcherPID, err := sys.Spawn(ctx, "watcher", watcher)
+		require.NoError(t, err)
+
+		actorRef, err := sys.ActorOf(ctx, "actor")
+		require.NoError(t, err)
+		require.True(t, pid.Address().Equals(actorRef.Address()))
+
+		require.NoError(t, actorRef.Tell(ctx, new(testpb.TestSend)))
+
+		reply, err := actorRef.Ask(ctx, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		require.Equal(t, "received message", reply.(*testpb.Reply).GetContent())
+
+		require.NoError(t, actorRef.Watch(ctx, watcherPID))
+		require.NoError(t, actorRef.Stop(ctx))
+
+		select {
+		case terminated := <-watcher.terminated:
+			assert.Equal(t, pid.ID(), terminated.GetActorId())
+		case <-time.After(5 * time.Second):
+			t.Fatal("terminated message not received")
+		}
+
+		err = actorRef.Tell(ctx, new(testpb.TestSend))
+		require.ErrorIs(t, err, ErrActorNotFound)
+
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With remote actor", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		node2, sd2 := testCluster(t, srv.Addr().String())
+
+		pid, err := node2.Spawn(ctx, "actor", NewMockActor())
+		require.NoError(t, err)
+
+		watcher := NewMockWatcher()
+		watcherPID, err := node1.Spawn(ctx, "watcher", watcher)
+		require.NoError(t, err)
+
+		pause.For(time.Second)
+
+		actorRef, err := node1.ActorOf(ctx, "actor")
+		require.NoError(t, err)
+		require.Nil(t, actorRef.localPID())
+		require.True(t, pid.Address().Equals(actorRef.Address()))
+
+		require.NoError(t, actorRef.Tell(ctx, new(testpb.TestSend)))
+
+		reply, err := actorRef.Ask(ctx, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		require.Equal(t, "received message", reply.(*testpb.Reply).GetContent())
+
+		require.NoError(t, actorRef.Watch(ctx, watcherPID))
+		require.NoError(t, actorRef.Stop(ctx))
+
+		select {
+		case terminated := <-watcher.terminated:
+			assert.Equal(t, pid.ID(), terminated.GetActorId())
+			assert.Equal(t, goaktpb.TerminationReason_TERMINATION_REASON_STOPPED, terminated.GetReason())
+		case <-time.After(5 * time.Second):
+			t.Fatal("terminated message not received")
+		}
+
+		require.NoError(t, node1.Stop(ctx))
+		require.NoError(t, node2.Stop(ctx))
+		assert.NoError(t, sd1.Close())
+		assert.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
+	t.Run("With relocated actor", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		node2, sd2 := testCluster(t, srv.Addr().String())
+
+		_, err := node2.Spawn(ctx, "actor", NewMockActor())
+		require.NoError(t, err)
+
+		pause.For(time.Second)
+
+		actorRef, err := node1.ActorOf(ctx, "actor")
+		require.NoError(t, err)
+		require.Nil(t, actorRef.localPID())
+
+		// take down node2 for the actor to be relocated to node1
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+
+		require.Eventually(t, func() bool {
+			_, err := actorRef.Ask(ctx, new(testpb.TestReply), time.Second)
+			return err == nil
+		}, time.Minute, time.Second)
+
+		// the reference now points to the relocated actor
+		require.NotNil(t, actorRef.localPID())
+		require.Equal(t, node1.Host(), actorRef.Address().GetHost())
+		require.EqualValues(t, node1.Port(), actorRef.Address().GetPort())
+
+		require.NoError(t, node1.Stop(ctx))
+		assert.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With undefined reference", func(t *testing.T) {
+		ctx := context.TODO()
+		actorRef := ActorRef{}
+		require.ErrorIs(t, actorRef.Tell(ctx, new(testpb.TestSend)), ErrUndefinedActor)
+		_, err := actorRef.Ask(ctx, new(testpb.TestReply), time.Second)
+		require.ErrorIs(t, err, ErrUndefinedActor)
+		require.ErrorIs(t, actorRef.Stop(ctx), ErrUndefinedActor)
+	})
 }
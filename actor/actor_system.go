@@ -36,6 +36,7 @@ import (
 	"os/signal"
 	"regexp"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,33 +48,39 @@ import (
 	"github.com/flowchartsman/retry"
 	"github.com/google/uuid"
 	"go.akshayshah.org/connectproto"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	"go.uber.org/atomic"
 	"go.uber.org/multierr"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/tochemey/goakt/v3/address"
 	"github.com/tochemey/goakt/v3/discovery"
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/goaktpb"
 	"github.com/tochemey/goakt/v3/hash"
+	"github.com/tochemey/goakt/v3/internal/chunking"
 	"github.com/tochemey/goakt/v3/internal/cluster"
 	"github.com/tochemey/goakt/v3/internal/collection"
+	"github.com/tochemey/goakt/v3/internal/compression"
 	"github.com/tochemey/goakt/v3/internal/errorschain"
 	"github.com/tochemey/goakt/v3/internal/eventstream"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/internalpb/internalpbconnect"
 	"github.com/tochemey/goakt/v3/internal/network"
 	"github.com/tochemey/goakt/v3/internal/registry"
+	"github.com/tochemey/goakt/v3/internal/serialization"
 	"github.com/tochemey/goakt/v3/internal/ticker"
 	"github.com/tochemey/goakt/v3/internal/validation"
 	"github.com/tochemey/goakt/v3/internal/workerpool"
 	"github.com/tochemey/goakt/v3/log"
 	"github.com/tochemey/goakt/v3/memory"
+	"github.com/tochemey/goakt/v3/persistence"
 	"github.com/tochemey/goakt/v3/remote"
 )
 
@@ -109,6 +116,19 @@ type ActorSystem interface { //nolint:revive
 	// Stop stops the actor system and does not terminate the program.
 	// One needs to explicitly call os.Exit to terminate the program.
 	Stop(ctx context.Context) error
+	// Drain gracefully removes the node from the cluster and stops the actor system.
+	//
+	// The node is first announced as leaving so that no new actor is placed on it. Its relocatable actors
+	// and its grains are then moved one by one to the other cluster members, and only then does the node leave
+	// the cluster membership. Actors implementing HandoffActor pass their state along to their new incarnation.
+	// Singleton actors and actors with relocation disabled are handled as when the node stops.
+	//
+	// When the hand-off fails, the node is no longer announced as leaving and keeps running: the actor that could
+	// not be handed off is restored on the node with its state, and the actors already handed off stay where they are.
+	// Drain can then be called again.
+	//
+	// It returns ErrClusterDisabled when the actor system is not in cluster mode.
+	Drain(ctx context.Context) error
 	// Spawn creates and starts a new actor in the local actor system.
 	//
 	// The actor will be registered under the given `name`, allowing other actors
@@ -143,6 +163,10 @@ type ActorSystem interface { //nolint:revive
 	//   - RoundRobin: Distributes actors evenly across available nodes.
 	//   - Random: Choose a node at random.
 	//   - Local: Ensures that the actor is created on the local node.
+	//   - LeastLoad: Choose the node hosting the fewest actors.
+	//   - MemoryAware: Choose the node with the most free memory.
+	//
+	// A user-defined PlacementStrategy can also be set with WithPlacementStrategy.
 	//
 	// In non-cluster mode, the actor is created on the local actor system
 	// just like with the standard `Spawn` function.
@@ -171,6 +195,10 @@ type ActorSystem interface { //nolint:revive
 	//
 	// Note: The created actor used the default mailbox set during the creation of the actor system.
 	SpawnOn(ctx context.Context, name string, actor Actor, opts ...SpawnOption) error
+	// SpawnFromFactory creates an actor with the factory registered under the given name and the given arguments.
+	// The factory and its arguments are kept with the actor so that it is created again with them when relocated
+	// in cluster mode.
+	SpawnFromFactory(ctx context.Context, name, factory string, args proto.Message, opts ...SpawnOption) (*PID, error)
 	// SpawnFromFunc creates an actor with the given receive function. One can set the PreStart and PostStop lifecycle hooks
 	// in the given optional options
 	SpawnFromFunc(ctx context.Context, receiveFunc ReceiveFunc, opts ...FuncOption) (*PID, error)
@@ -202,7 +230,29 @@ type ActorSystem interface { //nolint:revive
 	// The cluster singleton is automatically started on the oldest node in the cluster.
 	// If the oldest node leaves the cluster, the singleton is restarted on the new oldest node.
 	// This is useful for managing shared resources or coordinating tasks that should be handled by a single actor.
-	SpawnSingleton(ctx context.Context, name string, actor Actor) error
+	//
+	// When the singleton actor requires a role, set with WithRole, it is started on the oldest node having that role instead.
+	//
+	// Two instances of a singleton actor never run at once: the node hosting the singleton actor holds a cluster lease
+	// that it renews while the singleton actor is running.
+	//
+	// Every node calling SpawnSingleton gets a proxy to the singleton actor, returned by SpawnSingletonProxy, once the
+	// singleton actor has been created, including when it has already been created by another node. To route messages to the singleton actor
+	// from every node, call SpawnSingleton on every node.
+	SpawnSingleton(ctx context.Context, name string, actor Actor, opts ...SpawnOption) error
+	// SpawnSingletonProxy creates on the given node a proxy to the named singleton actor.
+	//
+	// The proxy routes the messages it receives to the singleton actor wherever it runs in the cluster, keeping their original sender.
+	// While the singleton actor cannot be reached, e.g. when it is moving to a new node after a leader change, the messages are
+	// buffered and delivered in order once the singleton actor is reachable again. When the buffer is full, the incoming
+	// messages are sent to the deadletter.
+	//
+	// The messages awaiting a reply, e.g. sent with Ask, are relayed to the singleton actor and its response is passed along
+	// to the sender. They are not buffered: they are sent to the deadletter with ErrSingletonUnreachable when the singleton
+	// actor cannot be reached.
+	// Spawning a proxy that already exists, e.g. created by SpawnSingleton, returns the existing proxy. Hence the proxy
+	// must be spawned before calling SpawnSingleton on the given node for the given options to apply.
+	SpawnSingletonProxy(ctx context.Context, name string, opts ...SingletonProxyOption) (*PID, error)
 	// Kill stops a given actor in the system
 	Kill(ctx context.Context, name string) error
 	// ReSpawn recreates a given actor in the system
@@ -223,9 +273,10 @@ type ActorSystem interface { //nolint:revive
 	RemoteActor(ctx context.Context, actorName string) (addr *address.Address, err error)
 	// ActorOf retrieves an existing actor within the local system or across the cluster if clustering is enabled.
 	//
-	// If the actor is found locally, its PID is returned. If the actor resides on a remote host, its address is returned.
+	// The returned ActorRef is location-transparent: messages sent through it are delivered locally
+	// when the actor lives on this node, or over remoting when it resides on a remote host.
 	// If the actor is not found, an error of type "actor not found" is returned.
-	ActorOf(ctx context.Context, actorName string) (addr *address.Address, pid *PID, err error)
+	ActorOf(ctx context.Context, actorName string) (ActorRef, error)
 	// ActorExists checks whether an actor with the given name exists in the system,
 	// either locally, or on another node in the cluster if clustering is enabled.
 	ActorExists(ctx context.Context, actorName string) (exists bool, err error)
@@ -245,7 +296,7 @@ type ActorSystem interface { //nolint:revive
 	//
 	// Parameters:
 	//	  - ctx: The context for managing cancellation and deadlines.
-	//   - message: The proto.Message to be sent.
+	//   - message: The message to be sent.
 	//   - pid: The PID of the actor that will receive the message.
 	//   - delay: The duration to wait before delivering the message.
 	//   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -256,7 +307,7 @@ type ActorSystem interface { //nolint:revive
 	// Note:
 	//   - It's strongly recommended to set a unique reference ID using WithReference if you intend to cancel, pause, or resume the message later.
 	//   - If no reference is set, an automatic one will be generated, which may not be easily retrievable.
-	ScheduleOnce(ctx context.Context, message proto.Message, pid *PID, delay time.Duration, opts ...ScheduleOption) error
+	ScheduleOnce(ctx context.Context, message any, pid *PID, delay time.Duration, opts ...ScheduleOption) error
 	// Schedule schedules a recurring message to be delivered to the specified actor (PID) at a fixed interval.
 	//
 	// This function sets up a message to be sent repeatedly to the target actor, with each delivery occurring
@@ -264,7 +315,7 @@ type ActorSystem interface { //nolint:revive
 	//
 	// Parameters:
 	//	  - ctx: The context for managing cancellation and deadlines.
-	//   - message: The proto.Message to be delivered at regular intervals.
+	//   - message: The message to be delivered at regular intervals.
 	//   - pid: The PID of the actor that will receive the message.
 	//   - interval: The time duration between each delivery of the message.
 	//   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -276,7 +327,7 @@ type ActorSystem interface { //nolint:revive
 	//   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 	//   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for later operations.
 	//   - This function does not provide built-in delivery guarantees such as at-least-once or exactly-once semantics; ensure idempotency where needed.
-	Schedule(ctx context.Context, message proto.Message, pid *PID, interval time.Duration, opts ...ScheduleOption) error
+	Schedule(ctx context.Context, message any, pid *PID, interval time.Duration, opts ...ScheduleOption) error
 	// RemoteScheduleOnce schedules a one-time delivery of a message to a remote actor after a specified delay.
 	//
 	// This method schedules a message to be sent to an actor located at a remote address once the given interval has elapsed.
@@ -284,7 +335,7 @@ type ActorSystem interface { //nolint:revive
 	//
 	// Parameters:
 	//	  - ctx: The context for managing cancellation and deadlines.
-	//   - message: The proto.Message to be delivered.
+	//   - message: The message to be delivered.
 	//   - receiver: The address.Address of the remote actor that will receive the message.
 	//   - delay: The time duration to wait before delivering the message.
 	//   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -296,7 +347,7 @@ type ActorSystem interface { //nolint:revive
 	//   - Remoting must be enabled in the actor system for this function to work.
 	//   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the message later.
 	//   - If no reference is set, an automatic one will be generated internally, which may not be retrievable.
-	RemoteScheduleOnce(ctx context.Context, message proto.Message, receiver *address.Address, delay time.Duration, opts ...ScheduleOption) error
+	RemoteScheduleOnce(ctx context.Context, message any, receiver *address.Address, delay time.Duration, opts ...ScheduleOption) error
 	// RemoteSchedule schedules a recurring message to be sent to a remote actor at a specified interval.
 	//
 	// This method sends the given message repeatedly to the remote actor located at the specified address,
@@ -305,7 +356,7 @@ type ActorSystem interface { //nolint:revive
 	//
 	// Parameters:
 	//	  - ctx: The context for managing cancellation and deadlines.
-	//   - message: The proto.Message to be delivered periodically.
+	//   - message: The message to be delivered periodically.
 	//   - receiver: The address.Address of the remote actor that will receive the message.
 	//   - interval: The time duration between each message delivery.
 	//   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -317,7 +368,7 @@ type ActorSystem interface { //nolint:revive
 	//   - Remoting must be enabled in the actor system for this method to function correctly.
 	//   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 	//   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for later operations.
-	RemoteSchedule(ctx context.Context, message proto.Message, receiver *address.Address, interval time.Duration, opts ...ScheduleOption) error
+	RemoteSchedule(ctx context.Context, message any, receiver *address.Address, interval time.Duration, opts ...ScheduleOption) error
 	// ScheduleWithCron schedules a message to be delivered to the specified actor (PID) using a cron expression.
 	//
 	// This method enables flexible time-based scheduling using standard cron syntax, allowing you to specify complex recurring schedules.
@@ -325,7 +376,7 @@ type ActorSystem interface { //nolint:revive
 	//
 	// Parameters:
 	//	  - ctx: The context for managing cancellation and deadlines.
-	//   - message: The proto.Message to be delivered.
+	//   - message: The message to be delivered.
 	//   - pid: The PID of the actor that will receive the message.
 	//   - cronExpression: A standard cron-formatted string (e.g., "0 */5 * * * *") representing the schedule.
 	//   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -337,7 +388,7 @@ type ActorSystem interface { //nolint:revive
 	//   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 	//   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for future operations.
 	//   - The cron expression must follow the format supported by the scheduler (typically 6 or 5 fields depending on implementation).
-	ScheduleWithCron(ctx context.Context, message proto.Message, pid *PID, cronExpression string, opts ...ScheduleOption) error
+	ScheduleWithCron(ctx context.Context, message any, pid *PID, cronExpression string, opts ...ScheduleOption) error
 	// RemoteScheduleWithCron schedules a message to be sent to a remote actor according to a cron expression.
 	//
 	// This method allows scheduling messages to remote actors using flexible cron-based timing,
@@ -346,7 +397,7 @@ type ActorSystem interface { //nolint:revive
 	//
 	// Parameters:
 	//	  - ctx: The context for managing cancellation and deadlines.
-	//   - message: The proto.Message to be delivered according to the cron schedule.
+	//   - message: The message to be delivered according to the cron schedule.
 	//   - receiver: The address.Address of the remote actor that will receive the message.
 	//   - cronExpression: A standard cron-formatted string defining the schedule (e.g., "0 0 * * *").
 	//   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -359,7 +410,7 @@ type ActorSystem interface { //nolint:revive
 	//   - It's strongly recommended to set a unique reference ID using WithReference if you intend to cancel, pause, or resume the scheduled message.
 	//   - If no reference is set, an automatic one will be generated internally and may not be easily retrievable.
 	//   - The cron expression must conform to the scheduler’s supported format (usually 5 or 6 fields).
-	RemoteScheduleWithCron(ctx context.Context, message proto.Message, receiver *address.Address, cronExpression string, opts ...ScheduleOption) error
+	RemoteScheduleWithCron(ctx context.Context, message any, receiver *address.Address, cronExpression string, opts ...ScheduleOption) error
 	// CancelSchedule cancels a previously scheduled message intended for delivery to a target actor (PID).
 	//
 	// It attempts to locate and cancel the scheduled task associated with the specified message reference.
@@ -400,6 +451,10 @@ type ActorSystem interface { //nolint:revive
 	Register(ctx context.Context, actor Actor) error
 	// Deregister removes a registered actor from the registry
 	Deregister(ctx context.Context, actor Actor) error
+	// RegisterFactory registers an actor factory under the given name. This is necessary when creating an actor
+	// with real constructor arguments, locally with SpawnFromFactory or remotely with a remote.SpawnRequest naming the factory.
+	// In cluster mode the factory must be registered on every node so that the actors it creates can be relocated.
+	RegisterFactory(ctx context.Context, name string, factory ActorFactory) error
 	// Logger returns the logger sets when creating the actor system
 	Logger() log.Logger
 	// Host returns the actor system node host address
@@ -494,37 +549,37 @@ type ActorSystem interface { //nolint:revive
 	// AskGrain sends a synchronous request message to a Grain (virtual actor) identified by the given identity.
 	//
 	// This method locates or activates the target Grain (locally or in the cluster), sends the provided
-	// protobuf message, and waits for a response or error. The request will block until a response is received,
+	// message, and waits for a response or error. The request will block until a response is received,
 	// the context is canceled, or the timeout elapses.
 	//
 	// Parameters:
 	//   - ctx: Context for cancellation and timeout control.
 	//   - identity: The unique identity of the Grain.
-	//   - message: The protobuf message to send to the Grain.
+	//   - message: The message to send to the Grain.
 	//   - timeout: The maximum duration to wait for a response.
 	//
 	// Returns:
 	//   - response: The response message from the Grain, if successful.
 	//   - error: An error if the request fails, times out, or the system is not started.
-	AskGrain(ctx context.Context, identity *GrainIdentity, message proto.Message, timeout time.Duration) (response proto.Message, err error)
+	AskGrain(ctx context.Context, identity *GrainIdentity, message any, timeout time.Duration) (response any, err error)
 	// TellGrain sends an asynchronous message to a Grain (virtual actor) identified by the given identity.
 	//
 	// This method locates or activates the target Grain (locally or in the cluster) and delivers the provided
-	// protobuf message without waiting for a response. Use this for fire-and-forget scenarios where no reply is expected.
+	// message without waiting for a response. Use this for fire-and-forget scenarios where no reply is expected.
 	//
 	// Parameters:
 	//   - ctx: Context for cancellation and timeout control.
 	//   - identity: The unique identity of the Grain.
-	//   - message: The protobuf message to send to the Grain.
+	//   - message: The message to send to the Grain.
 	//
 	// Returns:
 	//   - error: An error if the message could not be delivered or the system is not started.
-	TellGrain(ctx context.Context, identity *GrainIdentity, message proto.Message) error
+	TellGrain(ctx context.Context, identity *GrainIdentity, message any) error
 	// handleRemoteAsk handles a synchronous message to another actor and expect a response.
 	// This block until a response is received or timed out.
-	handleRemoteAsk(ctx context.Context, to *PID, message proto.Message, timeout time.Duration) (response proto.Message, err error)
+	handleRemoteAsk(ctx context.Context, to *PID, message any, timeout time.Duration) (response any, err error)
 	// handleRemoteTell handles an asynchronous message to an actor
-	handleRemoteTell(ctx context.Context, to *PID, message proto.Message) error
+	handleRemoteTell(ctx context.Context, to *PID, message any) error
 	// putActorOnCluster sets actor in the actor system actors registry
 	putActorOnCluster(actor *PID) error
 	// getPeerStateFromStore returns the peer state from the cluster store
@@ -550,9 +605,23 @@ type ActorSystem interface { //nolint:revive
 	// internally used
 	findRoutee(routeeName string) (*PID, bool)
 	isShuttingDown() bool
+	isReadOnly() bool
+	placementPeers(ctx context.Context) ([]*cluster.Peer, error)
+	hasRole(role string) bool
 	getRemoting() *Remoting
 	getGrains() *collection.Map[GrainIdentity, *grainPID]
+	getDurableStateStore() persistence.DurableStateStore
+	getDeliveryStore() persistence.DeliveryStore
+	getSerializers() *serialization.Registry
+	getRememberedGrainStore() persistence.RememberedGrainStore
+	getCircuitBreakers() *circuitBreakers
+	getRemoteWatches() *remoteWatches
+	remoteWatchNode(ctx context.Context, addr *address.Address) string
+	getMetricsRecorder() *metricsRecorder
 	recreateGrain(ctx context.Context, props *internalpb.Grain) error
+	tellGrainOn(ctx context.Context, grain *internalpb.Grain, message any) error
+	askGrainOn(ctx context.Context, grain *internalpb.Grain, message any, timeout time.Duration) (any, error)
+	releaseSingletonLease(ctx context.Context, name string)
 }
 
 // ActorSystem represent a collection of actors on a given node
@@ -606,6 +675,9 @@ type actorSystem struct {
 
 	stopGC chan registry.Unit
 
+	// ends the remoting streams opened by the remote nodes when remoting shuts down
+	remoteStreamsStopSig chan registry.Unit
+
 	// specifies the events stream
 	eventsStream eventstream.Stream
 
@@ -637,17 +709,50 @@ type actorSystem struct {
 	actorsCounter      *atomic.Uint64
 	deadlettersCounter *atomic.Uint64
 
-	clientTLS         *tls.Config
-	serverTLS         *tls.Config
-	pubsubEnabled     atomic.Bool
-	workerPool        *workerpool.WorkerPool
-	relocationEnabled atomic.Bool
-	extensions        *collection.Map[string, extension.Extension]
+	clientTLS           *tls.Config
+	serverTLS           *tls.Config
+	certificateProvider CertificateProvider
+	serializers         *serialization.Registry
+	pubsubEnabled       atomic.Bool
+	workerPool          *workerpool.WorkerPool
+	relocationEnabled   atomic.Bool
+	extensions          *collection.Map[string, extension.Extension]
 
 	spawnOnNext  *atomic.Uint32
 	shuttingDown *atomic.Bool
 	grainsQueue  chan *internalpb.Grain
 	grains       *collection.Map[GrainIdentity, *grainPID]
+
+	grainsReactivationQueue chan registry.Unit
+
+	singletonLeases *collection.Map[string, *cluster.Lease]
+
+	journalStore      persistence.JournalStore
+	snapshotStore     persistence.SnapshotStore
+	durableStateStore persistence.DurableStateStore
+	deliveryStore     persistence.DeliveryStore
+
+	circuitBreakerDefaults *circuitBreakerConfig
+	circuitBreakerConfigs  map[string]*circuitBreakerConfig
+	circuitBreakers        *circuitBreakers
+
+	// tracks the actors watched on remote nodes
+	remoteWatches *remoteWatches
+
+	// holds the chunks of the large messages received and sent
+	receivedChunks *chunking.Assembler
+	sentChunks     *chunking.Store
+
+	// tracks the cluster membership observed by the split brain resolver
+	splitBrain *splitBrainState
+	// states whether the node is on the losing side of a network partition
+	readOnly atomic.Bool
+	// states whether the node is handing off its actors before leaving the cluster
+	draining atomic.Bool
+
+	meterProvider metric.MeterProvider
+	metrics       *metricsRecorder
+	propagator    propagation.TextMapPropagator
 }
 
 var (
@@ -668,36 +773,39 @@ func NewActorSystem(name string, opts ...Option) (ActorSystem, error) {
 	}
 
 	system := &actorSystem{
-		actorsQueue:         make(chan *internalpb.Actor, 10),
-		name:                name,
-		logger:              log.New(log.ErrorLevel, os.Stderr),
-		actorInitMaxRetries: DefaultInitMaxRetries,
-		locker:              sync.Mutex{},
-		shutdownTimeout:     DefaultShutdownTimeout,
-		stopGC:              make(chan registry.Unit, 1),
-		eventsStream:        eventstream.New(),
-		partitionHasher:     hash.DefaultHasher(),
-		actorInitTimeout:    DefaultInitTimeout,
-		eventsQueue:         make(chan *cluster.Event, 1),
-		registry:            registry.NewRegistry(),
-		clusterSyncStopSig:  make(chan registry.Unit, 1),
-		remoteConfig:        remote.DefaultConfig(),
-		actors:              newTree(),
-		startedAt:           atomic.NewInt64(0),
-		rebalancing:         atomic.NewBool(false),
-		shutdownHooks:       make([]ShutdownHook, 0),
-		rebalancedNodes:     goset.NewSet[string](),
-		rebalanceLocker:     &sync.Mutex{},
-		actorsCounter:       atomic.NewUint64(0),
-		deadlettersCounter:  atomic.NewUint64(0),
-		topicActor:          nil,
-		extensions:          collection.NewMap[string, extension.Extension](),
-		spawnOnNext:         atomic.NewUint32(0),
-		shuttingDown:        atomic.NewBool(false),
-		started:             atomic.NewBool(false),
-		starting:            atomic.NewBool(false),
-		grainsQueue:         make(chan *internalpb.Grain, 10),
-		grains:              collection.NewMap[GrainIdentity, *grainPID](),
+		actorsQueue:             make(chan *internalpb.Actor, 10),
+		name:                    name,
+		logger:                  log.New(log.ErrorLevel, os.Stderr),
+		actorInitMaxRetries:     DefaultInitMaxRetries,
+		locker:                  sync.Mutex{},
+		shutdownTimeout:         DefaultShutdownTimeout,
+		stopGC:                  make(chan registry.Unit, 1),
+		eventsStream:            eventstream.New(),
+		partitionHasher:         hash.DefaultHasher(),
+		actorInitTimeout:        DefaultInitTimeout,
+		eventsQueue:             make(chan *cluster.Event, 1),
+		registry:                registry.NewRegistry(),
+		clusterSyncStopSig:      make(chan registry.Unit, 1),
+		remoteConfig:            remote.DefaultConfig(),
+		actors:                  newTree(),
+		startedAt:               atomic.NewInt64(0),
+		rebalancing:             atomic.NewBool(false),
+		shutdownHooks:           make([]ShutdownHook, 0),
+		rebalancedNodes:         goset.NewSet[string](),
+		rebalanceLocker:         &sync.Mutex{},
+		actorsCounter:           atomic.NewUint64(0),
+		deadlettersCounter:      atomic.NewUint64(0),
+		topicActor:              nil,
+		extensions:              collection.NewMap[string, extension.Extension](),
+		spawnOnNext:             atomic.NewUint32(0),
+		shuttingDown:            atomic.NewBool(false),
+		started:                 atomic.NewBool(false),
+		starting:                atomic.NewBool(false),
+		grainsQueue:             make(chan *internalpb.Grain, 10),
+		grainsReactivationQueue: make(chan registry.Unit, 1),
+		grains:                  collection.NewMap[GrainIdentity, *grainPID](),
+		singletonLeases:         collection.NewMap[string, *cluster.Lease](),
+		remoteWatches:           newRemoteWatches(),
 	}
 
 	system.relocationEnabled.Store(true)
@@ -707,12 +815,19 @@ func NewActorSystem(name string, opts ...Option) (ActorSystem, error) {
 	system.pubsubEnabled.Store(false)
 
 	system.reflection = newReflection(system.registry)
-
 	// apply the various options
 	for _, opt := range opts {
 		opt.Apply(system)
 	}
 
+	// set the circuit breakers
+	system.circuitBreakers = newCircuitBreakers(system.circuitBreakerDefaults, system.circuitBreakerConfigs,
+		func(event *goaktpb.CircuitBreakerStateChanged) {
+			if system.eventsStream != nil {
+				system.eventsStream.Publish(eventsTopic, event)
+			}
+		})
+
 	// set the worker pool
 	system.workerPool = workerpool.New(
 		workerpool.WithPoolSize(300),
@@ -731,6 +846,13 @@ func NewActorSystem(name string, opts ...Option) (ActorSystem, error) {
 		return nil, err
 	}
 
+	if err := system.remoteConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	// set the serializers used to exchange messages with the other nodes
+	system.serializers = serialization.NewRegistry(system.remoteConfig.Serializers())
+
 	// we need to make sure the cluster kinds are defined
 	if system.clusterEnabled.Load() {
 		if err := system.clusterConfig.Validate(); err != nil {
@@ -743,6 +865,9 @@ func NewActorSystem(name string, opts ...Option) (ActorSystem, error) {
 		return nil, ErrInvalidTLSConfiguration
 	}
 
+	// fetch the certificates from the certificate provider when set
+	system.ensureCertificateProvider()
+
 	// append the right protocols to the TLS settings
 	system.ensureTLSProtos()
 
@@ -820,6 +945,8 @@ func (x *actorSystem) Start(ctx context.Context) error {
 	if err := errorschain.
 		New(errorschain.ReturnFirst()).
 		AddErrorFn(x.workerPool.Start).
+		AddErrorFn(func() error { return x.connectPersistenceStores(ctx) }).
+		AddErrorFn(x.enableMetrics).
 		AddErrorFn(func() error { return x.enableRemoting(ctx) }).
 		AddErrorFn(func() error { return x.enableClustering(ctx) }).
 		AddErrorFn(func() error { return x.spawnRootGuardian(ctx) }).
@@ -844,6 +971,7 @@ func (x *actorSystem) Start(ctx context.Context) error {
 	x.started.Store(true)
 	x.starting.Store(false)
 	x.startedAt.Store(time.Now().Unix())
+	x.triggerGrainsReactivation()
 	x.logger.Infof("%s actor system successfully started..:)", x.name)
 	return nil
 }
@@ -941,6 +1069,20 @@ func (x *actorSystem) Register(_ context.Context, actor Actor) error {
 	return nil
 }
 
+// RegisterFactory registers an actor factory under the given name
+func (x *actorSystem) RegisterFactory(_ context.Context, name string, factory ActorFactory) error {
+	if !x.started.Load() {
+		return ErrActorSystemNotStarted
+	}
+
+	if factory == nil {
+		return ErrInvalidInstance
+	}
+
+	x.reflection.RegisterFactory(name, factory)
+	return nil
+}
+
 // Schedule schedules a recurring message to be delivered to the specified actor (PID) at a fixed interval.
 //
 // This function sets up a message to be sent repeatedly to the target actor, with each delivery occurring
@@ -948,7 +1090,7 @@ func (x *actorSystem) Register(_ context.Context, actor Actor) error {
 //
 // Parameters:
 //   - ctx: The context for managing cancellation and deadlines.
-//   - message: The proto.Message to be delivered at regular intervals.
+//   - message: The message to be delivered at regular intervals.
 //   - pid: The PID of the actor that will receive the message.
 //   - interval: The time duration between each delivery of the message.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -960,8 +1102,8 @@ func (x *actorSystem) Register(_ context.Context, actor Actor) error {
 //   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 //   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for later operations.
 //   - This function does not provide built-in delivery guarantees such as at-least-once or exactly-once semantics; ensure idempotency where needed.
-func (x *actorSystem) Schedule(_ context.Context, message proto.Message, pid *PID, interval time.Duration, opts ...ScheduleOption) error {
-	return x.scheduler.Schedule(message, pid, interval, opts...)
+func (x *actorSystem) Schedule(ctx context.Context, message any, pid *PID, interval time.Duration, opts ...ScheduleOption) error {
+	return x.scheduler.Schedule(ctx, message, pid, interval, opts...)
 }
 
 // RemoteSchedule schedules a recurring message to be sent to a remote actor at a specified interval.
@@ -972,7 +1114,7 @@ func (x *actorSystem) Schedule(_ context.Context, message proto.Message, pid *PI
 //
 // Parameters:
 //   - ctx: The context for managing cancellation and deadlines.
-//   - message: The proto.Message to be delivered periodically.
+//   - message: The message to be delivered periodically.
 //   - to: The address.Address of the remote actor that will receive the message.
 //   - interval: The time duration between each message delivery.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -984,8 +1126,8 @@ func (x *actorSystem) Schedule(_ context.Context, message proto.Message, pid *PI
 //   - Remoting must be enabled in the actor system for this method to function correctly.
 //   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 //   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for later operations.
-func (x *actorSystem) RemoteSchedule(_ context.Context, message proto.Message, receiver *address.Address, interval time.Duration, opts ...ScheduleOption) error {
-	return x.scheduler.RemoteSchedule(message, receiver, interval, opts...)
+func (x *actorSystem) RemoteSchedule(ctx context.Context, message any, receiver *address.Address, interval time.Duration, opts ...ScheduleOption) error {
+	return x.scheduler.RemoteSchedule(ctx, message, receiver, interval, opts...)
 }
 
 // ScheduleOnce schedules a one-time delivery of a message to the specified actor (PID) after a given delay.
@@ -995,7 +1137,7 @@ func (x *actorSystem) RemoteSchedule(_ context.Context, message proto.Message, r
 //
 // Parameters:
 //   - ctx: The context for managing cancellation and deadlines.
-//   - message: The proto.Message to be sent.
+//   - message: The message to be sent.
 //   - pid: The PID of the actor that will receive the message.
 //   - delay: The duration to wait before delivering the message.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -1006,8 +1148,8 @@ func (x *actorSystem) RemoteSchedule(_ context.Context, message proto.Message, r
 // Note:
 //   - It's strongly recommended to set a unique reference ID using WithReference if you intend to cancel, pause, or resume the message later.
 //   - If no reference is set, an automatic one will be generated, which may not be easily retrievable.
-func (x *actorSystem) ScheduleOnce(_ context.Context, message proto.Message, pid *PID, interval time.Duration, opts ...ScheduleOption) error {
-	return x.scheduler.ScheduleOnce(message, pid, interval, opts...)
+func (x *actorSystem) ScheduleOnce(ctx context.Context, message any, pid *PID, interval time.Duration, opts ...ScheduleOption) error {
+	return x.scheduler.ScheduleOnce(ctx, message, pid, interval, opts...)
 }
 
 // RemoteScheduleOnce schedules a one-time delivery of a message to a remote actor after a specified delay.
@@ -1017,7 +1159,7 @@ func (x *actorSystem) ScheduleOnce(_ context.Context, message proto.Message, pid
 //
 // Parameters:
 //   - ctx: The context for managing cancellation and deadlines.
-//   - message: The proto.Message to be delivered.
+//   - message: The message to be delivered.
 //   - to: The address.Address of the remote actor that will receive the message.
 //   - delay: The time duration to wait before delivering the message.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -1029,8 +1171,8 @@ func (x *actorSystem) ScheduleOnce(_ context.Context, message proto.Message, pid
 //   - Remoting must be enabled in the actor system for this function to work.
 //   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the message later.
 //   - If no reference is set, an automatic one will be generated internally, which may not be retrievable.
-func (x *actorSystem) RemoteScheduleOnce(_ context.Context, message proto.Message, receiver *address.Address, interval time.Duration, opts ...ScheduleOption) error {
-	return x.scheduler.RemoteScheduleOnce(message, receiver, interval, opts...)
+func (x *actorSystem) RemoteScheduleOnce(ctx context.Context, message any, receiver *address.Address, interval time.Duration, opts ...ScheduleOption) error {
+	return x.scheduler.RemoteScheduleOnce(ctx, message, receiver, interval, opts...)
 }
 
 // ScheduleWithCron schedules a message to be delivered to the specified actor (PID) using a cron expression.
@@ -1040,7 +1182,7 @@ func (x *actorSystem) RemoteScheduleOnce(_ context.Context, message proto.Messag
 //
 // Parameters:
 //   - ctx: The context for managing cancellation and deadlines.
-//   - message: The proto.Message to be delivered.
+//   - message: The message to be delivered.
 //   - pid: The PID of the actor that will receive the message.
 //   - cronExpression: A standard cron-formatted string (e.g., "0 */5 * * * *") representing the schedule.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -1052,8 +1194,8 @@ func (x *actorSystem) RemoteScheduleOnce(_ context.Context, message proto.Messag
 //   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 //   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for future operations.
 //   - The cron expression must follow the format supported by the scheduler (typically 6 or 5 fields depending on implementation).
-func (x *actorSystem) ScheduleWithCron(_ context.Context, message proto.Message, pid *PID, cronExpression string, opts ...ScheduleOption) error {
-	return x.scheduler.ScheduleWithCron(message, pid, cronExpression, opts...)
+func (x *actorSystem) ScheduleWithCron(ctx context.Context, message any, pid *PID, cronExpression string, opts ...ScheduleOption) error {
+	return x.scheduler.ScheduleWithCron(ctx, message, pid, cronExpression, opts...)
 }
 
 // RemoteScheduleWithCron schedules a message to be sent to a remote actor according to a cron expression.
@@ -1064,7 +1206,7 @@ func (x *actorSystem) ScheduleWithCron(_ context.Context, message proto.Message,
 //
 // Parameters:
 //   - ctx: The context for managing cancellation and deadlines.
-//   - message: The proto.Message to be delivered according to the cron schedule.
+//   - message: The message to be delivered according to the cron schedule.
 //   - to: The address.Address of the remote actor that will receive the message.
 //   - cronExpression: A standard cron-formatted string defining the schedule (e.g., "0 0 * * *").
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -1077,8 +1219,8 @@ func (x *actorSystem) ScheduleWithCron(_ context.Context, message proto.Message,
 //   - It's strongly recommended to set a unique reference ID using WithReference if you intend to cancel, pause, or resume the scheduled message.
 //   - If no reference is set, an automatic one will be generated internally and may not be easily retrievable.
 //   - The cron expression must conform to the scheduler’s supported format (usually 5 or 6 fields).
-func (x *actorSystem) RemoteScheduleWithCron(_ context.Context, message proto.Message, receiver *address.Address, cronExpression string, opts ...ScheduleOption) error {
-	return x.scheduler.RemoteScheduleWithCron(message, receiver, cronExpression, opts...)
+func (x *actorSystem) RemoteScheduleWithCron(ctx context.Context, message any, receiver *address.Address, cronExpression string, opts ...ScheduleOption) error {
+	return x.scheduler.RemoteScheduleWithCron(ctx, message, receiver, cronExpression, opts...)
 }
 
 // CancelSchedule cancels a previously scheduled message intended for delivery to a target actor.
@@ -1164,6 +1306,12 @@ func (x *actorSystem) InCluster() bool {
 		x.cluster != nil
 }
 
+// hasRole states whether the actor system can host actors and grains requiring the given role.
+// Every node has the empty role and a node outside a cluster has all the roles
+func (x *actorSystem) hasRole(role string) bool {
+	return role == "" || !x.InCluster() || slices.Contains(x.clusterConfig.Roles(), role)
+}
+
 // NumActors returns the total number of active actors on a given running node.
 // This does not account for the total number of actors in the cluster
 func (x *actorSystem) NumActors() uint64 {
@@ -1200,17 +1348,28 @@ func (x *actorSystem) Spawn(ctx context.Context, name string, actor Actor, opts
 		return nil, ErrActorSystemNotStarted
 	}
 
-	// check some preconditions
-	if err := x.checkSpawnPreconditions(ctx, name, actor, false); err != nil {
+	spawnConfig := newSpawnConfig(opts...)
+
+	// check some preconditions. An actor moved from a draining node
+	// is still registered in the cluster until it is created here
+	if err := x.checkSpawnPreconditions(ctx, name, actor, false); err != nil &&
+		(!spawnConfig.handedOff || !errors.Is(err, ErrActorAlreadyExists)) {
 		return nil, err
 	}
 
+	// an actor requiring a role can only be hosted by a node having that role
+	if !x.hasRole(spawnConfig.role) {
+		return nil, ErrRoleNotFound
+	}
+
 	actorAddress := x.actorAddress(name)
 	pidNode, exist := x.actors.node(actorAddress.String())
 	if exist {
 		pid := pidNode.value()
 		if pid.IsRunning() {
-			return pid, nil
+			// in cluster mode the running actor has passed the preconditions only when its record
+			// is missing, e.g. when it was held by a node that left the cluster, hence it is registered again
+			return pid, x.putActorOnCluster(pid)
 		}
 	}
 
@@ -1270,6 +1429,10 @@ func (x *actorSystem) SpawnNamedFromFunc(ctx context.Context, name string, recei
 //   - RoundRobin: Distributes actors evenly across available nodes.
 //   - Random: Choose a node at random.
 //   - Local: Ensures that the actor is created on the local node.
+//   - LeastLoad: Choose the node hosting the fewest actors.
+//   - MemoryAware: Choose the node with the most free memory.
+//
+// A user-defined PlacementStrategy can also be set with WithPlacementStrategy.
 //
 // In non-cluster mode, the actor is created on the local actor system
 // just like with the standard `Spawn` function.
@@ -1307,19 +1470,35 @@ func (x *actorSystem) SpawnOn(ctx context.Context, name string, actor Actor, opt
 	}
 
 	config := newSpawnConfig(opts...)
-	if !x.InCluster() || config.placement == Local {
+	if !x.InCluster() || (config.placement == Local && config.placementStrategy == nil) {
 		_, err := x.Spawn(ctx, name, actor, opts...)
 		return err
 	}
 
-	peers, err := x.cluster.Peers(ctx)
+	peers, err := x.placementPeers(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to fetch cluster nodes: %w", err)
 	}
 
+	// only the nodes having the role of the actor can host it
+	if config.role != "" {
+		peers = collection.Filter(peers, func(peer *cluster.Peer) bool {
+			return peer.HasRole(config.role)
+		})
+
+		if len(peers) == 0 && !x.hasRole(config.role) {
+			return ErrRoleNotFound
+		}
+	}
+
 	var peer *cluster.Peer
 
-	if len(peers) > 1 {
+	// the load-aware placements choose among the peers and the local node
+	if strategy := config.strategy(); strategy != nil {
+		if peer, err = x.place(ctx, strategy, peers, config.role); err != nil {
+			return fmt.Errorf("failed to place actor=(%s): %w", name, err)
+		}
+	} else if len(peers) > 1 || (len(peers) == 1 && !x.hasRole(config.role)) {
 		switch config.placement {
 		case Random:
 			peer = peers[rand.IntN(len(peers))] //nolint:gosec
@@ -1346,9 +1525,30 @@ func (x *actorSystem) SpawnOn(ctx context.Context, name string, actor Actor, opt
 		PassivationStrategy: config.passivationStrategy,
 		Dependencies:        config.dependencies,
 		EnableStashing:      config.enableStash,
+		SnapshotInterval:    config.snapshotInterval,
+		Role:                config.role,
 	})
 }
 
+// SpawnFromFactory creates an actor with the factory registered under the given name and the given arguments.
+func (x *actorSystem) SpawnFromFactory(ctx context.Context, name, factory string, args proto.Message, opts ...SpawnOption) (*PID, error) {
+	if !x.started.Load() {
+		return nil, ErrActorSystemNotStarted
+	}
+
+	packed, err := marshalFactoryArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := x.reflection.NewActorFromFactory(ctx, factory, packed)
+	if err != nil {
+		return nil, err
+	}
+
+	return x.Spawn(ctx, name, actor, append(opts, withFactory(factory, packed))...)
+}
+
 // SpawnFromFunc creates an actor with the given receive function.
 func (x *actorSystem) SpawnFromFunc(ctx context.Context, receiveFunc ReceiveFunc, opts ...FuncOption) (*PID, error) {
 	return x.SpawnNamedFromFunc(ctx, uuid.NewString(), receiveFunc, opts...)
@@ -1390,7 +1590,16 @@ func (x *actorSystem) SpawnRouter(ctx context.Context, poolSize int, routeesKind
 // The cluster singleton is automatically started on the oldest node in the cluster.
 // When the oldest node leaves the cluster unexpectedly, the singleton is restarted on the new oldest node.
 // This is useful for managing shared resources or coordinating tasks that should be handled by a single actor.
-func (x *actorSystem) SpawnSingleton(ctx context.Context, name string, actor Actor) error {
+//
+// When the singleton actor requires a role, set with WithRole, it is started on the oldest node having that role instead.
+//
+// Two instances of a singleton actor never run at once: the node hosting the singleton actor holds a cluster lease
+// that it renews while the singleton actor is running.
+//
+// Every node calling SpawnSingleton gets a proxy to the singleton actor, returned by SpawnSingletonProxy, once the
+// singleton actor has been created, including when it has already been created by another node. To route messages to the singleton actor
+// from every node, call SpawnSingleton on every node.
+func (x *actorSystem) SpawnSingleton(ctx context.Context, name string, actor Actor, opts ...SpawnOption) error {
 	if !x.started.Load() {
 		return ErrActorSystemNotStarted
 	}
@@ -1399,11 +1608,42 @@ func (x *actorSystem) SpawnSingleton(ctx context.Context, name string, actor Act
 		return ErrClusterDisabled
 	}
 
+	if x.readOnly.Load() {
+		return ErrActorSystemReadOnly
+	}
+
+	err := x.spawnSingleton(ctx, name, actor, opts...)
+
+	// every node creating the singleton actor gets a proxy routing to it wherever it runs,
+	// even when the singleton actor has already been created by another node
+	if !isReservedName(name) && (err == nil || errors.Is(err, ErrSingletonAlreadyExists)) {
+		if _, proxyErr := x.spawnSingletonProxy(ctx, name); proxyErr != nil && err == nil {
+			return proxyErr
+		}
+	}
+	return err
+}
+
+// spawnSingleton creates the singleton actor on the oldest node of the cluster,
+// or on the oldest node having the role of the singleton actor when set
+func (x *actorSystem) spawnSingleton(ctx context.Context, name string, actor Actor, opts ...SpawnOption) error {
 	cl := x.getCluster()
+	config := newSpawnConfig(opts...)
 
-	// only create the singleton actor on the oldest node in the cluster
-	if !cl.IsLeader(ctx) {
-		return x.spawnSingletonOnLeader(ctx, cl, name, actor)
+	switch {
+	case config.role != "":
+		// only create the singleton actor on the oldest node in the cluster having its role
+		oldest, err := x.oldestWithRole(ctx, config.role)
+		if err != nil {
+			return err
+		}
+
+		if oldest.PeerAddress() != x.clusterNode.PeersAddress() {
+			return x.spawnSingletonOn(ctx, oldest, name, actor, config)
+		}
+	case !cl.IsLeader(ctx):
+		// only create the singleton actor on the oldest node in the cluster
+		return x.spawnSingletonOnLeader(ctx, cl, name, actor, config)
 	}
 
 	// check some preconditions
@@ -1411,9 +1651,17 @@ func (x *actorSystem) SpawnSingleton(ctx context.Context, name string, actor Act
 		return err
 	}
 
-	pid, err := x.configPID(ctx, name, actor,
+	// the lease guarantees that two instances of the singleton actor never run at once
+	// while the cluster membership changes
+	lease, err := x.acquireSingletonLease(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	spawnOpts := []SpawnOption{
 		WithLongLived(),
 		withSingleton(),
+		WithRole(config.role),
 		WithSupervisor(
 			NewSupervisor(
 				WithStrategy(OneForOneStrategy),
@@ -1421,8 +1669,16 @@ func (x *actorSystem) SpawnSingleton(ctx context.Context, name string, actor Act
 				WithDirective(&InternalError{}, StopDirective),
 				WithDirective(&runtime.PanicNilError{}, StopDirective),
 			),
-		))
+		),
+	}
+
+	if config.factory != "" {
+		spawnOpts = append(spawnOpts, withFactory(config.factory, config.factoryArgs))
+	}
+
+	pid, err := x.configPID(ctx, name, actor, spawnOpts...)
 	if err != nil {
+		x.releaseSingletonLease(ctx, name)
 		return err
 	}
 
@@ -1430,9 +1686,39 @@ func (x *actorSystem) SpawnSingleton(ctx context.Context, name string, actor Act
 	// add the given actor to the tree and supervise it
 	_ = x.actors.addNode(x.singletonManager, pid)
 	x.actors.addWatcher(pid, x.deathWatch)
+	go x.holdSingletonLease(pid, lease)
 	return x.putActorOnCluster(pid)
 }
 
+// SpawnSingletonProxy creates on the given node a proxy to the named singleton actor.
+//
+// The proxy routes the messages it receives to the singleton actor wherever it runs in the cluster, keeping their original sender.
+// While the singleton actor cannot be reached, e.g. when it is moving to a new node after a leader change, the messages are
+// buffered and delivered in order once the singleton actor is reachable again. When the buffer is full, the incoming
+// messages are sent to the deadletter.
+//
+// The messages awaiting a reply, e.g. sent with Ask, are relayed to the singleton actor and its response is passed along
+// to the sender. They are not buffered: they are sent to the deadletter with ErrSingletonUnreachable when the singleton
+// actor cannot be reached.
+// Spawning a proxy that already exists, e.g. created by SpawnSingleton, returns the existing proxy. Hence the proxy
+// must be spawned before calling SpawnSingleton on the given node for the given options to apply.
+func (x *actorSystem) SpawnSingletonProxy(ctx context.Context, name string, opts ...SingletonProxyOption) (*PID, error) {
+	if !x.started.Load() {
+		return nil, ErrActorSystemNotStarted
+	}
+
+	if !x.InCluster() {
+		return nil, ErrClusterDisabled
+	}
+
+	// a singleton actor cannot have a reserved name
+	if isReservedName(name) {
+		return nil, NewErrReservedName(name)
+	}
+
+	return x.spawnSingletonProxy(ctx, name, opts...)
+}
+
 // Kill stops a given actor in the system
 func (x *actorSystem) Kill(ctx context.Context, name string) error {
 	if !x.started.Load() {
@@ -1526,7 +1812,7 @@ func (x *actorSystem) ActorRefs(ctx context.Context, timeout time.Duration) []Ac
 	if x.InCluster() {
 		if actors, err := x.getCluster().Actors(ctx, timeout); err == nil {
 			for _, actor := range actors {
-				actorRef := fromActorRef(actor)
+				actorRef := fromActorRef(x, actor)
 				if _, ok := uniques[actorRef.Address().String()]; !ok {
 					actorRefs = append(actorRefs, actorRef)
 				}
@@ -1550,28 +1836,28 @@ func (x *actorSystem) PeerAddress() string {
 
 // ActorOf retrieves an existing actor within the local system or across the cluster if clustering is enabled.
 //
-// If the actor is found locally, its PID is returned. If the actor resides on a remote host, its address is returned.
+// The returned ActorRef is location-transparent: messages sent through it are delivered locally
+// when the actor lives on this node, or over remoting when it resides on a remote host.
 // If the actor is not found, an error of type "actor not found" is returned.
-func (x *actorSystem) ActorOf(ctx context.Context, actorName string) (addr *address.Address, pid *PID, err error) {
+func (x *actorSystem) ActorOf(ctx context.Context, actorName string) (ActorRef, error) {
 	x.locker.Lock()
 
 	if !x.started.Load() {
 		x.locker.Unlock()
-		return nil, nil, ErrActorSystemNotStarted
+		return ActorRef{}, ErrActorSystemNotStarted
 	}
 
 	// user should not query system actors
 	if isReservedName(actorName) {
 		x.locker.Unlock()
-		return nil, nil, NewErrActorNotFound(actorName)
+		return ActorRef{}, NewErrActorNotFound(actorName)
 	}
 
 	// first check whether the actor exist locally
 	actorAddress := x.actorAddress(actorName)
 	if pidnode, ok := x.actors.node(actorAddress.String()); ok {
 		x.locker.Unlock()
-		pid := pidnode.value()
-		return pid.Address(), pid, nil
+		return fromPID(pidnode.value()), nil
 	}
 
 	// check in the cluster
@@ -1581,25 +1867,25 @@ func (x *actorSystem) ActorOf(ctx context.Context, actorName string) (addr *addr
 			if errors.Is(err, cluster.ErrActorNotFound) {
 				x.logger.Infof("actor=%s not found", actorName)
 				x.locker.Unlock()
-				return nil, nil, NewErrActorNotFound(actorName)
+				return ActorRef{}, NewErrActorNotFound(actorName)
 			}
 
 			x.locker.Unlock()
-			return nil, nil, fmt.Errorf("failed to fetch remote actor=%s: %w", actorName, err)
+			return ActorRef{}, fmt.Errorf("failed to fetch remote actor=%s: %w", actorName, err)
 		}
 
 		x.locker.Unlock()
-		return address.From(actor.GetAddress()), nil, nil
+		return fromActorRef(x, actor), nil
 	}
 
 	if x.remotingEnabled.Load() {
 		x.locker.Unlock()
-		return nil, nil, ErrMethodCallNotAllowed
+		return ActorRef{}, ErrMethodCallNotAllowed
 	}
 
 	x.logger.Infof("actor=%s not found", actorName)
 	x.locker.Unlock()
-	return nil, nil, NewErrActorNotFound(actorName)
+	return ActorRef{}, NewErrActorNotFound(actorName)
 }
 
 // ActorExists checks whether an actor with the given name exists in the system,
@@ -1750,7 +2036,7 @@ func (x *actorSystem) RemoteAsk(ctx context.Context, request *connect.Request[in
 		timeout = req.GetTimeout().AsDuration()
 	}
 
-	responses := make([]*anypb.Any, 0, len(req.GetRemoteMessages()))
+	responses := make([]*internalpb.Payload, 0, len(req.GetRemoteMessages()))
 	for _, message := range req.GetRemoteMessages() {
 		receiver := message.GetReceiver()
 
@@ -1767,19 +2053,37 @@ func (x *actorSystem) RemoteAsk(ctx context.Context, request *connect.Request[in
 			return nil, err
 		}
 
+		if err := x.assembleChunks(message.GetPayload()); err != nil {
+			err := NewErrInvalidRemoteMessage(err)
+			logger.Error(err.Error())
+			return nil, err
+		}
+
 		pid := pidNode.value()
-		reply, err := x.handleRemoteAsk(ctx, pid, message, timeout)
+		msgCtx := contextWithMetadataValues(extractContext(ctx, x.propagator, message.GetHeaders()), withPeerIdentity(ctx, message.GetMetadata()))
+		reply, err := x.handleRemoteAsk(msgCtx, pid, message, timeout)
 		if err != nil {
 			err := NewErrRemoteSendFailure(err)
 			logger.Error(err.Error())
 			return nil, err
 		}
 
-		marshaled, _ := anypb.New(reply)
+		marshaled, err := x.serializers.Serialize(reply)
+		if err != nil {
+			err := NewErrInvalidRemoteMessage(err)
+			logger.Error(err.Error())
+			return nil, err
+		}
+
+		if err := x.storeChunks(marshaled); err != nil {
+			logger.Error(err.Error())
+			return nil, connect.NewError(connect.CodeResourceExhausted, err)
+		}
+
 		responses = append(responses, marshaled)
 	}
 
-	return connect.NewResponse(&internalpb.RemoteAskResponse{Messages: responses}), nil
+	return connect.NewResponse(&internalpb.RemoteAskResponse{Payloads: responses}), nil
 }
 
 // RemoteTell is used to send a message to an actor remotely by another actor
@@ -1792,24 +2096,109 @@ func (x *actorSystem) RemoteTell(ctx context.Context, request *connect.Request[i
 
 	req := request.Msg
 	for _, message := range req.GetRemoteMessages() {
-		receiver := message.GetReceiver()
-		addr := address.From(receiver)
-		pidNode, exist := x.actors.node(addr.String())
-		if !exist {
-			err := NewErrAddressNotFound(addr.String())
+		if err := x.remoteTell(ctx, message); err != nil {
 			logger.Error(err)
 			return nil, err
 		}
+	}
 
-		pid := pidNode.value()
-		if err := x.handleRemoteTell(ctx, pid, message); err != nil {
-			err := NewErrRemoteSendFailure(err)
-			logger.Error(err)
-			return nil, err
+	return connect.NewResponse(new(internalpb.RemoteTellResponse)), nil
+}
+
+// RemoteStreamTell handles the stream of messages sent by a remote node without expecting any reply.
+// Batches are processed in the order they are received and a credit is granted back for every processed batch.
+// Delivery failures are logged and do not end the stream.
+func (x *actorSystem) RemoteStreamTell(ctx context.Context, stream *connect.BidiStream[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse]) error {
+	logger := x.logger
+
+	if !x.remotingEnabled.Load() {
+		return connect.NewError(connect.CodeFailedPrecondition, ErrRemotingDisabled)
+	}
+
+	// grant the initial window
+	if err := stream.Send(&internalpb.RemoteStreamTellResponse{Credits: int32(x.remoteConfig.StreamWindow())}); err != nil { // nolint
+		return err
+	}
+
+	done := make(chan registry.Unit)
+	defer close(done)
+
+	// the batches are received in the background so that the stream ends when remoting shuts down
+	requests := make(chan *internalpb.RemoteStreamTellRequest)
+	errc := make(chan error, 1)
+	go func() {
+		for {
+			request, err := stream.Receive()
+			if err != nil {
+				errc <- err
+				return
+			}
+
+			select {
+			case requests <- request:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case request := <-requests:
+			for _, message := range request.GetRemoteMessages() {
+				if err := x.remoteTell(ctx, message); err != nil {
+					logger.Error(err)
+				}
+			}
+
+			if err := stream.Send(&internalpb.RemoteStreamTellResponse{Credits: 1}); err != nil {
+				return err
+			}
+		case err := <-errc:
+			if isStreamEnd(err) {
+				return nil
+			}
+			return err
+		case <-x.remoteStreamsStopSig:
+			return connect.NewError(connect.CodeUnavailable, ErrRemotingDisabled)
 		}
 	}
+}
 
-	return connect.NewResponse(new(internalpb.RemoteTellResponse)), nil
+// RemoteWatch registers a remote actor as a watcher of a local actor.
+// The watcher receives a Terminated message when the local actor stops.
+func (x *actorSystem) RemoteWatch(ctx context.Context, request *connect.Request[internalpb.RemoteWatchRequest]) (*connect.Response[internalpb.RemoteWatchResponse], error) {
+	if !x.remotingEnabled.Load() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrRemotingDisabled)
+	}
+
+	watcher := address.From(request.Msg.GetWatcher())
+	watchee := address.From(request.Msg.GetWatchee())
+	pidNode, exist := x.actors.node(watchee.String())
+	if !exist || !pidNode.value().IsRunning() {
+		return nil, connect.NewError(connect.CodeNotFound, NewErrActorNotFound(watchee.String()))
+	}
+
+	pidNode.value().remoteWatchers.Set(watcher.String(), &remoteWatcher{
+		address: watcher,
+		node:    x.remoteWatchNode(ctx, watcher),
+	})
+	return connect.NewResponse(new(internalpb.RemoteWatchResponse)), nil
+}
+
+// RemoteUnWatch removes a remote actor from the watchers of a local actor
+func (x *actorSystem) RemoteUnWatch(_ context.Context, request *connect.Request[internalpb.RemoteUnWatchRequest]) (*connect.Response[internalpb.RemoteUnWatchResponse], error) {
+	if !x.remotingEnabled.Load() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrRemotingDisabled)
+	}
+
+	watcher := address.From(request.Msg.GetWatcher())
+	watchee := address.From(request.Msg.GetWatchee())
+	if pidNode, exist := x.actors.node(watchee.String()); exist {
+		pidNode.value().remoteWatchers.Delete(watcher.String())
+	}
+
+	return connect.NewResponse(new(internalpb.RemoteUnWatchResponse)), nil
 }
 
 // RemoteReSpawn is used the handle the re-creation of an actor from a remote host or from an api call
@@ -1903,7 +2292,7 @@ func (x *actorSystem) RemoteSpawn(ctx context.Context, request *connect.Request[
 		return nil, connect.NewError(connect.CodeFailedPrecondition, NewErrActorNotFound(msg.GetActorName()))
 	}
 
-	actor, err := x.reflection.NewActor(msg.GetActorType())
+	actor, err := x.remoteSpawnActor(ctx, msg)
 	if err != nil {
 		logger.Errorf(
 			"failed to create actor=[(%s) of type (%s)] on [host=%s, port=%d]: reason: (%v)",
@@ -1914,14 +2303,30 @@ func (x *actorSystem) RemoteSpawn(ctx context.Context, request *connect.Request[
 			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrTypeNotRegistered)
 		}
 
+		if errors.Is(err, ErrFactoryNotRegistered) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrFactoryNotRegistered)
+		}
+
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	if msg.GetIsSingleton() {
-		if err := x.SpawnSingleton(ctx, msg.GetActorName(), actor); err != nil {
+		singletonOpts := []SpawnOption{WithRole(msg.GetRole())}
+		if msg.GetFactory() != "" {
+			singletonOpts = append(singletonOpts, withFactory(msg.GetFactory(), msg.GetArgs()))
+		}
+
+		// the proxy to the singleton actor is created by the requesting node
+		if err := x.spawnSingleton(ctx, msg.GetActorName(), actor, singletonOpts...); err != nil {
 			logger.Errorf("failed to create actor=(%s) on [host=%s, port=%d]: reason: (%v)", msg.GetActorName(), msg.GetHost(), msg.GetPort(), err)
+			if errors.Is(err, ErrSingletonAlreadyExists) {
+				return nil, connect.NewError(connect.CodeAlreadyExists, err)
+			}
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
+
+		logger.Infof("singleton actor=(%s) successfully created on [host=%s, port=%d]", msg.GetActorName(), msg.GetHost(), msg.GetPort())
+		return connect.NewResponse(new(internalpb.RemoteSpawnResponse)), nil
 	}
 
 	opts := []SpawnOption{
@@ -1936,6 +2341,22 @@ func (x *actorSystem) RemoteSpawn(ctx context.Context, request *connect.Request[
 		opts = append(opts, WithStashing())
 	}
 
+	if msg.GetSnapshotInterval() > 0 {
+		opts = append(opts, WithSnapshotInterval(msg.GetSnapshotInterval()))
+	}
+
+	if msg.GetFactory() != "" {
+		opts = append(opts, withFactory(msg.GetFactory(), msg.GetArgs()))
+	}
+
+	if msg.GetHandOff() {
+		opts = append(opts, withHandOff(msg.GetHandOffState()))
+	}
+
+	if msg.GetRole() != "" {
+		opts = append(opts, WithRole(msg.GetRole()))
+	}
+
 	// set the dependencies if any
 	if len(msg.GetDependencies()) > 0 {
 		dependencies, err := x.reflection.NewDependencies(msg.GetDependencies()...)
@@ -1955,6 +2376,15 @@ func (x *actorSystem) RemoteSpawn(ctx context.Context, request *connect.Request[
 	return connect.NewResponse(new(internalpb.RemoteSpawnResponse)), nil
 }
 
+// remoteSpawnActor creates the actor of a remote spawn request,
+// with the factory named by the request when set, otherwise from the actor type
+func (x *actorSystem) remoteSpawnActor(ctx context.Context, msg *internalpb.RemoteSpawnRequest) (Actor, error) {
+	if msg.GetFactory() != "" {
+		return x.reflection.NewActorFromFactory(ctx, msg.GetFactory(), msg.GetArgs())
+	}
+	return x.reflection.NewActor(msg.GetActorType())
+}
+
 // RemoteReinstate handles the remoteReinstate call
 func (x *actorSystem) RemoteReinstate(_ context.Context, request *connect.Request[internalpb.RemoteReinstateRequest]) (*connect.Response[internalpb.RemoteReinstateResponse], error) {
 	logger := x.logger
@@ -2002,13 +2432,7 @@ func (x *actorSystem) GetNodeMetric(_ context.Context, request *connect.Request[
 		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidHost)
 	}
 
-	actorCount := x.actors.count()
-	return connect.NewResponse(
-		&internalpb.GetNodeMetricResponse{
-			NodeRemoteAddress: remoteAddr,
-			ActorsCount:       uint64(actorCount),
-		},
-	), nil
+	return connect.NewResponse(x.nodeMetric()), nil
 }
 
 // GetKinds returns the cluster kinds
@@ -2033,6 +2457,36 @@ func (x *actorSystem) GetKinds(_ context.Context, request *connect.Request[inter
 	return connect.NewResponse(&internalpb.GetKindsResponse{Kinds: kinds}), nil
 }
 
+// DrainNode hands off the actors and grains of the node to the other cluster members
+// and then stops the actor system
+func (x *actorSystem) DrainNode(ctx context.Context, request *connect.Request[internalpb.DrainNodeRequest]) (*connect.Response[internalpb.DrainNodeResponse], error) {
+	if !x.clusterEnabled.Load() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrClusterDisabled)
+	}
+
+	req := request.Msg
+	remoteAddr := fmt.Sprintf("%s:%d", x.remoteConfig.BindAddr(), x.remoteConfig.BindPort())
+
+	// routine check
+	if remoteAddr != req.GetNodeAddress() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidHost)
+	}
+
+	if err := x.handOff(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	// the actor system is stopped once the response is sent since
+	// stopping it shuts down the server handling this request
+	go func() {
+		if err := x.Stop(context.Background()); err != nil {
+			x.logger.Errorf("%s failed to stop after draining: %v", x.name, err)
+		}
+	}()
+
+	return connect.NewResponse(new(internalpb.DrainNodeResponse)), nil
+}
+
 // TopicActor returns the topic actor, a system-managed actor responsible for handling
 // publish-subscribe (pub-sub) functionality within the actor system.
 //
@@ -2117,12 +2571,37 @@ func (x *actorSystem) Inject(dependencies ...extension.Dependency) error {
 
 // handleRemoteAsk handles a synchronous message to another actor and expect a response.
 // This block until a response is received or timed out.
-func (x *actorSystem) handleRemoteAsk(ctx context.Context, to *PID, message proto.Message, timeout time.Duration) (response proto.Message, err error) {
+func (x *actorSystem) handleRemoteAsk(ctx context.Context, to *PID, message any, timeout time.Duration) (response any, err error) {
 	return Ask(ctx, to, message, timeout)
 }
 
+// remoteTell delivers the given message received from a remote node to its receiver
+func (x *actorSystem) remoteTell(ctx context.Context, message *internalpb.RemoteMessage) error {
+	addr := address.From(message.GetReceiver())
+	pidNode, exist := x.actors.node(addr.String())
+	if !exist {
+		return NewErrAddressNotFound(addr.String())
+	}
+
+	pid := pidNode.value()
+	if err := x.assembleChunks(message.GetPayload()); err != nil {
+		return NewErrInvalidRemoteMessage(err)
+	}
+
+	// the watched actor has stopped, the watch is released
+	if message.GetPayload().GetManifest() == terminatedManifest {
+		x.remoteWatches.remove(pid.ID(), address.From(message.GetSender()).String())
+	}
+
+	msgCtx := contextWithMetadataValues(extractContext(ctx, x.propagator, message.GetHeaders()), withPeerIdentity(ctx, message.GetMetadata()))
+	if err := x.handleRemoteTell(msgCtx, pid, message); err != nil {
+		return NewErrRemoteSendFailure(err)
+	}
+	return nil
+}
+
 // handleRemoteTell handles an asynchronous message to an actor
-func (x *actorSystem) handleRemoteTell(ctx context.Context, to *PID, message proto.Message) error {
+func (x *actorSystem) handleRemoteTell(ctx context.Context, to *PID, message any) error {
 	return Tell(ctx, to, message)
 }
 
@@ -2199,6 +2678,11 @@ func (x *actorSystem) isShuttingDown() bool {
 	return x.shuttingDown.Load()
 }
 
+// isReadOnly returns true when the split brain resolver has switched the actor system to read-only
+func (x *actorSystem) isReadOnly() bool {
+	return x.readOnly.Load()
+}
+
 // getRemoting returns the remoting instance of the actor system
 // This method is used internally to access the remoting functionality
 // and is not intended for external use.
@@ -2208,6 +2692,72 @@ func (x *actorSystem) getRemoting() *Remoting {
 	return x.remoting
 }
 
+// getDurableStateStore returns the durable state store of the actor system when set
+func (x *actorSystem) getDurableStateStore() persistence.DurableStateStore {
+	x.locker.Lock()
+	defer x.locker.Unlock()
+	return x.durableStateStore
+}
+
+// getDeliveryStore returns the delivery store of the actor system when set
+func (x *actorSystem) getDeliveryStore() persistence.DeliveryStore {
+	x.locker.Lock()
+	defer x.locker.Unlock()
+	return x.deliveryStore
+}
+
+// getSerializers returns the serializers used to exchange messages with the other nodes
+func (x *actorSystem) getSerializers() *serialization.Registry {
+	return x.serializers
+}
+
+// getRemoteWatches returns the actors watched on remote nodes
+func (x *actorSystem) getRemoteWatches() *remoteWatches {
+	return x.remoteWatches
+}
+
+// remoteWatchNode returns the peers address of the cluster node hosting the given actor address.
+// It returns an empty string when the actor system is not in a cluster or the node is not a cluster member.
+func (x *actorSystem) remoteWatchNode(ctx context.Context, addr *address.Address) string {
+	if !x.InCluster() {
+		return ""
+	}
+
+	peers, err := x.cluster.Peers(ctx)
+	if err != nil {
+		x.logger.Warnf("failed to fetch the cluster peers: %v", err)
+		return ""
+	}
+
+	for _, peer := range peers {
+		if peer.Host == addr.GetHost() && peer.RemotingPort == int(addr.GetPort()) {
+			return peer.PeerAddress()
+		}
+	}
+	return ""
+}
+
+// getRememberedGrainStore returns the remembered grain store of the cluster when set
+func (x *actorSystem) getRememberedGrainStore() persistence.RememberedGrainStore {
+	if !x.clusterEnabled.Load() || x.clusterConfig == nil {
+		return nil
+	}
+	return x.clusterConfig.RememberedGrainStore()
+}
+
+// getCircuitBreakers returns the circuit breakers of the actor system
+func (x *actorSystem) getCircuitBreakers() *circuitBreakers {
+	return x.circuitBreakers
+}
+
+// getMetricsRecorder returns the metrics recorder of the actor system.
+// It returns nil when metrics are not enabled
+func (x *actorSystem) getMetricsRecorder() *metricsRecorder {
+	x.locker.Lock()
+	defer x.locker.Unlock()
+	return x.metrics
+}
+
 // getGrains returns the grains map of the actor system
 func (x *actorSystem) getGrains() *collection.Map[GrainIdentity, *grainPID] {
 	x.locker.Lock()
@@ -2253,48 +2803,74 @@ func (x *actorSystem) getPeerStateFromStore(address string) (*internalpb.PeerSta
 // putActorOnCluster broadcast the newly (re)spawned actor into the cluster
 func (x *actorSystem) putActorOnCluster(pid *PID) error {
 	if x.clusterEnabled.Load() {
-		dependencies, err := marshalDependencies(pid.Dependencies()...)
+		actor, err := marshalActor(pid)
 		if err != nil {
 			return err
 		}
 
-		x.actorsQueue <- &internalpb.Actor{
-			Address:             pid.Address().Address,
-			Type:                registry.Name(pid.Actor()),
-			IsSingleton:         pid.IsSingleton(),
-			Relocatable:         pid.IsRelocatable(),
-			PassivationStrategy: marshalPassivationStrategy(pid.PassivationStrategy()),
-			Dependencies:        dependencies,
-			EnableStash:         pid.stashBox != nil,
-		}
+		x.actorsQueue <- actor
 	}
 	return nil
 }
 
+// marshalActor returns the cluster record of the given actor
+func marshalActor(pid *PID) (*internalpb.Actor, error) {
+	dependencies, err := marshalDependencies(pid.Dependencies()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalpb.Actor{
+		Address:             pid.Address().Address,
+		Type:                registry.Name(pid.Actor()),
+		IsSingleton:         pid.IsSingleton(),
+		Relocatable:         pid.IsRelocatable(),
+		PassivationStrategy: marshalPassivationStrategy(pid.PassivationStrategy()),
+		Dependencies:        dependencies,
+		EnableStash:         pid.stashBox != nil,
+		SnapshotInterval:    pid.snapshotInterval(),
+		Factory:             pid.factory,
+		Args:                pid.factoryArgs,
+		Role:                pid.role,
+	}, nil
+}
+
 // putGrainOnCluster broadcast the newly (re)activated grain into the cluster
 func (x *actorSystem) putGrainOnCluster(pid *grainPID) error {
 	if x.clusterEnabled.Load() {
-		dependencies, err := marshalDependencies(pid.dependencies.Values()...)
+		grain, err := marshalGrain(pid, x.Host(), x.Port())
 		if err != nil {
 			return err
 		}
 
-		x.grainsQueue <- &internalpb.Grain{
-			GrainId: &internalpb.GrainId{
-				Kind:  pid.identity.Kind(),
-				Name:  pid.identity.Name(),
-				Value: pid.identity.String(),
-			},
-			Host:              x.Host(),
-			Port:              int32(x.Port()),
-			Dependencies:      dependencies,
-			ActivationTimeout: durationpb.New(pid.config.initTimeout.Load()),
-			ActivationRetries: pid.config.initMaxRetries.Load(),
-		}
+		x.grainsQueue <- grain
 	}
 	return nil
 }
 
+// marshalGrain returns the cluster record of the given grain activated on the given host and port
+func marshalGrain(pid *grainPID, host string, port int) (*internalpb.Grain, error) {
+	dependencies, err := marshalDependencies(pid.dependencies.Values()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalpb.Grain{
+		GrainId: &internalpb.GrainId{
+			Kind:  pid.identity.Kind(),
+			Name:  pid.identity.Name(),
+			Value: pid.identity.String(),
+		},
+		Host:              host,
+		Port:              int32(port),
+		Dependencies:      dependencies,
+		ActivationTimeout: durationpb.New(pid.config.initTimeout.Load()),
+		ActivationRetries: pid.config.initMaxRetries.Load(),
+		DeactivateAfter:   durationpb.New(pid.config.deactivateAfter),
+		Role:              pid.config.role,
+	}, nil
+}
+
 // enableClustering enables clustering. When clustering is enabled remoting is also enabled to facilitate remote
 // communication
 func (x *actorSystem) enableClustering(ctx context.Context) error {
@@ -2330,6 +2906,7 @@ func (x *actorSystem) enableClustering(ctx context.Context) error {
 		DiscoveryPort: x.clusterConfig.DiscoveryPort(),
 		PeersPort:     x.clusterConfig.PeersPort(),
 		RemotingPort:  x.remoteConfig.BindPort(),
+		Roles:         x.clusterConfig.Roles(),
 	}
 
 	clusterEngine, err := cluster.NewEngine(
@@ -2392,9 +2969,16 @@ func (x *actorSystem) enableClustering(ctx context.Context) error {
 
 	x.locker.Unlock()
 
+	// start the split brain resolver when set
+	if x.clusterConfig.SplitBrainResolver() != nil {
+		x.splitBrain = newSplitBrainState()
+		go x.splitBrainLoop()
+	}
+
 	go x.clusterEventsLoop()
 	go x.replicateActors()
 	go x.replicateGrains()
+	go x.grainsReactivationLoop()
 
 	// start the various relocation loops when relocation is enabled
 	if x.relocationEnabled.Load() {
@@ -2406,6 +2990,82 @@ func (x *actorSystem) enableClustering(ctx context.Context) error {
 	return nil
 }
 
+// enableMetrics creates the OpenTelemetry instruments when a meter provider is set
+func (x *actorSystem) enableMetrics() error {
+	if x.meterProvider == nil {
+		return nil
+	}
+
+	recorder, err := newMetricsRecorder(x, x.meterProvider)
+	if err != nil {
+		return fmt.Errorf("failed to enable metrics: %w", err)
+	}
+
+	x.locker.Lock()
+	x.metrics = recorder
+	x.locker.Unlock()
+	return nil
+}
+
+// connectPersistenceStores connects the journal, snapshot, durable state, delivery and remembered grain stores when set
+func (x *actorSystem) connectPersistenceStores(ctx context.Context) error {
+	if x.journalStore != nil {
+		if err := x.journalStore.Connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect the journal store: %w", err)
+		}
+	}
+
+	if x.snapshotStore != nil {
+		if err := x.snapshotStore.Connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect the snapshot store: %w", err)
+		}
+	}
+
+	if x.durableStateStore != nil {
+		if err := x.durableStateStore.Connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect the durable state store: %w", err)
+		}
+	}
+
+	if x.deliveryStore != nil {
+		if err := x.deliveryStore.Connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect the delivery store: %w", err)
+		}
+	}
+
+	if store := x.getRememberedGrainStore(); store != nil {
+		if err := store.Connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect the remembered grain store: %w", err)
+		}
+	}
+	return nil
+}
+
+// disconnectPersistenceStores disconnects the journal, snapshot, durable state, delivery and remembered grain stores when set
+func (x *actorSystem) disconnectPersistenceStores(ctx context.Context) error {
+	var err error
+	if x.journalStore != nil {
+		err = multierr.Append(err, x.journalStore.Disconnect(ctx))
+	}
+
+	if x.snapshotStore != nil {
+		err = multierr.Append(err, x.snapshotStore.Disconnect(ctx))
+	}
+
+	if x.durableStateStore != nil {
+		err = multierr.Append(err, x.durableStateStore.Disconnect(ctx))
+	}
+
+	if x.deliveryStore != nil {
+		err = multierr.Append(err, x.deliveryStore.Disconnect(ctx))
+	}
+
+	if store := x.getRememberedGrainStore(); store != nil {
+		err = multierr.Append(err, store.Disconnect(ctx))
+	}
+	return err
+}
+
 // enableRemoting enables the remoting service to handle remote messaging
 func (x *actorSystem) enableRemoting(ctx context.Context) error {
 	if !x.remotingEnabled.Load() {
@@ -2419,11 +3079,28 @@ func (x *actorSystem) enableRemoting(ctx context.Context) error {
 			proto.UnmarshalOptions{DiscardUnknown: true},
 		),
 	}
+	opts = append(opts, compression.HandlerOptions(x.remoteConfig.CompressMinSize())...)
+	if x.metrics != nil {
+		opts = append(opts, connect.WithInterceptors(x.metrics.remotingInterceptor()))
+	}
+
+	if authenticator := x.remoteConfig.Authenticator(); authenticator != nil {
+		opts = append(opts, connect.WithInterceptors(newAuthInterceptor(authenticator, x.remoteConfig.Authorizer())))
+	}
+
 	remotingServicePath, remotingServiceHandler := internalpbconnect.NewRemotingServiceHandler(x, opts...)
 	clusterServicePath, clusterServiceHandler := internalpbconnect.NewClusterServiceHandler(x, opts...)
 
+	x.remoteStreamsStopSig = make(chan registry.Unit)
+	x.receivedChunks = chunking.NewAssembler(x.remoteConfig.ChunkTimeout(),
+		int64(x.remoteConfig.ChunkBufferSize()),
+		x.remoteConfig.ChunkTransfers())
+	x.sentChunks = chunking.NewStore(x.remoteConfig.ChunkTimeout(),
+		int64(x.remoteConfig.ChunkBufferSize()),
+		x.remoteConfig.ChunkTransfers())
+
 	mux := stdhttp.NewServeMux()
-	mux.Handle(remotingServicePath, remotingServiceHandler)
+	mux.Handle(remotingServicePath, longLivedStreamHandler(remotingServiceHandler))
 	mux.Handle(clusterServicePath, clusterServiceHandler)
 
 	x.locker.Lock()
@@ -2452,14 +3129,54 @@ func (x *actorSystem) enableRemoting(ctx context.Context) error {
 
 // setRemoting sets the remoting service
 func (x *actorSystem) setRemoting() {
+	opts := []RemotingOption{
+		WithRemotingMaxReadFameSize(int(x.remoteConfig.MaxFrameSize())), // nolint
+		WithRemotingContextPropagator(x.propagator),
+		WithRemotingCompression(x.remoteConfig.Compression()),
+		WithRemotingCompressMinSize(x.remoteConfig.CompressMinSize()),
+		WithRemotingStreamBatchSize(x.remoteConfig.StreamBatchSize()),
+		WithRemotingStreamWindow(x.remoteConfig.StreamWindow()),
+		WithRemotingStreamTimeout(x.remoteConfig.StreamTimeout()),
+		WithRemotingOutboundQueue(x.remoteConfig.OutboundQueueSize(), x.remoteConfig.OverflowPolicy()),
+		WithRemotingChunkSize(x.remoteConfig.ChunkSize()),
+		withRemotingSerializers(x.serializers),
+		withRemotingDeadletter(x.remoteDeadletter),
+	}
+
 	if x.clientTLS != nil {
-		x.remoting = NewRemoting(
-			WithRemotingTLS(x.clientTLS),
-			WithRemotingMaxReadFameSize(int(x.remoteConfig.MaxFrameSize())), // nolint
-		)
+		opts = append(opts, WithRemotingTLS(x.clientTLS))
+	}
+
+	if x.remoteConfig.Streaming() {
+		opts = append(opts, WithRemotingStreaming())
+	}
+
+	if credentials := x.remoteConfig.Credentials(); credentials != nil {
+		opts = append(opts, WithRemotingCredentials(credentials))
+	}
+
+	x.remoting = NewRemoting(opts...)
+}
+
+// remoteDeadletter sends a message that could not be delivered to a remote actor to the deadletter
+func (x *actorSystem) remoteDeadletter(message *internalpb.RemoteMessage, err error) {
+	deadletter := x.getDeadletter()
+	if deadletter == nil || !deadletter.IsRunning() {
 		return
 	}
-	x.remoting = NewRemoting(WithRemotingMaxReadFameSize(int(x.remoteConfig.MaxFrameSize())))
+
+	// the message is reported even when it cannot be deserialized
+	msg, _ := x.serializers.Deserialize(message.GetPayload())
+	_ = Tell(context.Background(), deadletter, &internalpb.EmitDeadletter{
+		Deadletter: &goaktpb.Deadletter{
+			Sender:      message.GetSender(),
+			Receiver:    message.GetReceiver(),
+			Message:     marshalAny(msg),
+			SendTime:    timestamppb.Now(),
+			Reason:      err.Error(),
+			MessageType: message.GetPayload().GetManifest(),
+		},
+	})
 }
 
 // startMessagesScheduler starts the messages scheduler
@@ -2467,11 +3184,35 @@ func (x *actorSystem) startMessagesScheduler(ctx context.Context) {
 	// set the scheduler
 	x.scheduler = newScheduler(x.logger,
 		x.shutdownTimeout,
-		withSchedulerRemoting(x.remoting))
+		withSchedulerRemoting(x.remoting),
+		withSchedulerContextPropagator(x.propagator))
 	// start the scheduler
 	x.scheduler.Start(ctx)
 }
 
+// ensureCertificateProvider sets the TLS settings to fetch the node certificate from the certificate
+// provider on every handshake so that rotated certificates are used without a restart
+func (x *actorSystem) ensureCertificateProvider() {
+	if x.certificateProvider == nil || x.serverTLS == nil || x.clientTLS == nil {
+		return
+	}
+
+	provider := x.certificateProvider
+
+	// the server only calls GetCertificate when no certificate is set
+	x.serverTLS = x.serverTLS.Clone()
+	x.serverTLS.Certificates = nil
+	x.serverTLS.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return provider.Certificate()
+	}
+
+	x.clientTLS = x.clientTLS.Clone()
+	x.clientTLS.Certificates = nil
+	x.clientTLS.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return provider.Certificate()
+	}
+}
+
 func (x *actorSystem) ensureTLSProtos() {
 	if x.serverTLS != nil && x.clientTLS != nil {
 		// ensure that the required protocols are set for the TLS
@@ -2511,6 +3252,8 @@ func (x *actorSystem) reset() {
 	x.grains.Reset()
 	x.spawnOnNext.Store(0)
 	x.shuttingDown.Store(false)
+	x.readOnly.Store(false)
+	x.draining.Store(false)
 }
 
 // shutdown stops the actor system
@@ -2578,6 +3321,10 @@ func (x *actorSystem) shutdown(ctx context.Context) error {
 		}
 	}
 
+	if err := x.metrics.shutdown(); err != nil {
+		x.logger.Errorf("%s failed to unregister the metrics callback: %v", x.name, err)
+	}
+
 	if x.eventsStream != nil {
 		x.eventsStream.Close()
 	}
@@ -2588,6 +3335,11 @@ func (x *actorSystem) shutdown(ctx context.Context) error {
 		return multierr.Combine(hooksErr, err)
 	}
 
+	if err := x.disconnectPersistenceStores(ctx); err != nil {
+		x.logger.Errorf("%s failed to disconnect the persistence stores: %v", x.name, err)
+		return multierr.Combine(hooksErr, err)
+	}
+
 	if hooksErr != nil {
 		x.logger.Errorf("%s failed to shutdown cleanly. Shutdown hooks Failure: %v", x.name, hooksErr)
 		return hooksErr
@@ -2631,12 +3383,23 @@ func (x *actorSystem) replicateGrains() {
 	}
 }
 
-// resyncActors resyncs all actors in the actor system
+// resyncActors resyncs the actors in the actor system whose records are held by the given partitions,
+// or all of them when no partitions are given.
 // This is only called during cluster events like NodeLeft or NodeJoined
 // to ensure that all actors are properly synchronized across the cluster.
-func (x *actorSystem) resyncActors() error {
+func (x *actorSystem) resyncActors(partitions []int) error {
 	actors := x.Actors()
 	for _, actor := range actors {
+		// the kind of a singleton actor is recorded as well
+		var kind string
+		if actor.IsSingleton() {
+			kind = registry.Name(actor.Actor())
+		}
+
+		if !inPartitions(partitions, x.cluster.ActorPartitions(actor.Name(), kind)) {
+			continue
+		}
+
 		if err := x.putActorOnCluster(actor); err != nil {
 			x.logger.Errorf("failed to resync actor=(%s): %v", actor.Address().String(), err)
 			return fmt.Errorf("failed to resync actor=(%s): %w", actor.Address().String(), err)
@@ -2645,12 +3408,18 @@ func (x *actorSystem) resyncActors() error {
 	return nil
 }
 
-// resyncGrains resyncs all grains in the actor system
+// resyncGrains resyncs the grains in the actor system whose records are held by the given partitions,
+// or all of them when no partitions are given.
 // This is only called during cluster events like NodeLeft or NodeJoined
 // to ensure that all actors are properly synchronized across the cluster.
-func (x *actorSystem) resyncGrains() error {
+func (x *actorSystem) resyncGrains(partitions []int) error {
 	grains := x.grains.Values()
 	for _, grain := range grains {
+		identity := grain.getIdentity()
+		if !inPartitions(partitions, x.cluster.GrainPartitions(identity.String(), identity.Kind())) {
+			continue
+		}
+
 		if err := x.putGrainOnCluster(grain); err != nil {
 			x.logger.Errorf("failed to resync grain=(%s): %v", grain.getIdentity().String(), err)
 			return fmt.Errorf("failed to resync grain=(%s): %w", grain.getIdentity().String(), err)
@@ -2659,6 +3428,18 @@ func (x *actorSystem) resyncGrains() error {
 	return nil
 }
 
+// inPartitions states whether any of the given record partitions is one of the given partitions.
+// Every record is considered held when no partitions are given
+func inPartitions(partitions, records []int) bool {
+	if partitions == nil {
+		return true
+	}
+
+	return slices.ContainsFunc(records, func(partition int) bool {
+		return slices.Contains(partitions, partition)
+	})
+}
+
 // clusterEventsLoop listens to cluster events and send them to the event streams
 func (x *actorSystem) clusterEventsLoop() {
 	for event := range x.eventsQueue {
@@ -2676,15 +3457,23 @@ func (x *actorSystem) clusterEventsLoop() {
 
 		switch event.Type {
 		case cluster.NodeLeft:
+			x.terminateRemoteWatches(event)
 			x.handleNodeLeftEvent(event)
 		case cluster.NodeJoined:
 			x.handleNodeJoinedEvent(event)
+			// the partitions have moved, the new owners reactivate the remembered grains not yet active
+			x.triggerGrainsReactivation()
 		}
 	}
 }
 
 // handleNodeJoinedEvent processes a NodeJoined cluster event.
 func (x *actorSystem) handleNodeJoinedEvent(event *cluster.Event) {
+	// a read-only node no longer alters the cluster state
+	if x.readOnly.Load() {
+		return
+	}
+
 	nodeJoined := new(goaktpb.NodeJoined)
 	_ = event.Payload.UnmarshalTo(nodeJoined)
 	x.logger.Infof("node=[name=%s, addr=%s] detected node joined event: node=(%s)",
@@ -2696,7 +3485,7 @@ func (x *actorSystem) handleNodeJoinedEvent(event *cluster.Event) {
 		x.name,
 		x.clusterNode.PeersAddress(), nodeJoined.GetAddress())
 
-	if err := x.resyncActors(); err != nil {
+	if err := x.resyncActors(nil); err != nil {
 		x.logger.Errorf("failed to resync actors after node joined event: %v", err)
 	}
 
@@ -2709,7 +3498,7 @@ func (x *actorSystem) handleNodeJoinedEvent(event *cluster.Event) {
 			x.name,
 			x.clusterNode.PeersAddress(), nodeJoined.GetAddress())
 
-		if err := x.resyncGrains(); err != nil {
+		if err := x.resyncGrains(nil); err != nil {
 			x.logger.Errorf("failed to resync grains after node joined event: %v", err)
 		}
 
@@ -2719,25 +3508,82 @@ func (x *actorSystem) handleNodeJoinedEvent(event *cluster.Event) {
 	}
 }
 
+// terminateRemoteWatches sends a Terminated message to the local actors watching the actors
+// of the node that has left the cluster, and drops the watchers of the local actors hosted on that node
+func (x *actorSystem) terminateRemoteWatches(event *cluster.Event) {
+	nodeLeft := new(goaktpb.NodeLeft)
+	_ = event.Payload.UnmarshalTo(nodeLeft)
+
+	for _, node := range x.actors.nodes() {
+		removeRemoteWatchers(node.value().remoteWatchers, nodeLeft.GetAddress())
+	}
+
+	ctx := context.Background()
+	for _, watch := range x.remoteWatches.removeNode(nodeLeft.GetAddress()) {
+		if !watch.watcher.IsRunning() {
+			continue
+		}
+
+		terminated := &goaktpb.Terminated{
+			ActorId: watch.watchee.String(),
+			Reason:  goaktpb.TerminationReason_TERMINATION_REASON_NODE_LEFT,
+		}
+
+		if err := Tell(ctx, watch.watcher, terminated); err != nil {
+			x.logger.Warnf("failed to notify watcher=(%s) of node=(%s) left: %v", watch.watcher.ID(), nodeLeft.GetAddress(), err)
+		}
+	}
+}
+
 // handleNodeLeftEvent processes a NodeLeft cluster event.
 func (x *actorSystem) handleNodeLeftEvent(event *cluster.Event) {
-	if !x.relocationEnabled.Load() {
+	nodeLeft := new(goaktpb.NodeLeft)
+	_ = event.Payload.UnmarshalTo(nodeLeft)
+
+	// when the split brain resolver is set, the actors of an unreachable node are only
+	// relocated once the resolver has decided that this side of the partition survives
+	if x.splitBrain != nil &&
+		!x.cluster.HasLeft(nodeLeft.GetAddress()) &&
+		!x.splitBrain.isResolved(nodeLeft.GetAddress()) {
+		x.logger.Infof("node=[name=%s, addr=%s] awaits the split brain resolution before relocating node=(%s)",
+			x.name, x.clusterNode.PeersAddress(), nodeLeft.GetAddress())
+		return
+	}
+
+	x.handleNodeLeft(nodeLeft.GetAddress(), event.Partitions)
+}
+
+// handleNodeLeft registers again the local actors and grains whose records were held by the given partitions
+// of the given node that has left the cluster, and relocates the actors of that node.
+// All the local actors and grains are registered again when the partitions are not known.
+func (x *actorSystem) handleNodeLeft(address string, partitions []int) {
+	// a read-only node no longer alters the cluster state
+	if !x.relocationEnabled.Load() || x.readOnly.Load() {
 		return
 	}
 
-	nodeLeft := new(goaktpb.NodeLeft)
-	_ = event.Payload.UnmarshalTo(nodeLeft)
 	ctx := context.Background()
 
+	// the records of the local actors and grains held by the partitions of the left node are lost with it
+	if err := x.resyncActors(partitions); err != nil {
+		x.logger.Errorf("failed to resync actors after node left event: %v", err)
+	}
+
+	if x.grains.Len() > 0 {
+		if err := x.resyncGrains(partitions); err != nil {
+			x.logger.Errorf("failed to resync grains after node left event: %v", err)
+		}
+	}
+
 	if x.cluster.IsLeader(ctx) {
 		x.logger.Infof(
 			"cluster leader node=[name=%s, addr=%s] detected node left event: node=(%s); initiating rebalancing.",
-			x.name, x.clusterNode.PeersAddress(), nodeLeft.GetAddress(),
+			x.name, x.clusterNode.PeersAddress(), address,
 		)
 
-		if !x.rebalancedNodes.Contains(nodeLeft.GetAddress()) {
-			x.rebalancedNodes.Add(nodeLeft.GetAddress())
-			if peerState, ok := x.clusterStore.GetPeerState(nodeLeft.GetAddress()); ok {
+		if !x.rebalancedNodes.Contains(address) {
+			x.rebalancedNodes.Add(address)
+			if peerState, ok := x.clusterStore.GetPeerState(address); ok {
 				x.rebalanceLocker.Lock()
 				x.rebalancingQueue <- peerState
 				x.rebalanceLocker.Unlock()
@@ -2747,15 +3593,15 @@ func (x *actorSystem) handleNodeLeftEvent(event *cluster.Event) {
 	}
 
 	x.logger.Debugf(
-		"node=[name=%s, addr=%s] is not the cluster leader; cleaning up node=(%s) left from state cache",
-		x.name, x.clusterNode.PeersAddress(), nodeLeft.GetAddress(),
+		"node=[name=%s, addr=%s] cleaning up node=(%s) left from state cache",
+		x.name, x.clusterNode.PeersAddress(), address,
 	)
 
-	if err := x.clusterStore.DeletePeerState(nodeLeft.GetAddress()); err != nil {
-		x.logger.Errorf("%s failed to remove left node=(%s) from cluster store: %w", x.name, nodeLeft.GetAddress(), err)
+	if err := x.clusterStore.DeletePeerState(address); err != nil {
+		x.logger.Errorf("%s failed to remove left node=(%s) from cluster store: %w", x.name, address, err)
 	}
 
-	x.logger.Debugf("node=[name=%s, addr=%s] successfully cleaned up node=(%s) left from state cache", x.name, x.clusterNode.PeersAddress(), nodeLeft.GetAddress())
+	x.logger.Debugf("node=[name=%s, addr=%s] successfully cleaned up node=(%s) left from state cache", x.name, x.clusterNode.PeersAddress(), address)
 }
 
 // peersStateLoop fetches the cluster peers' PeerState and update the node Store
@@ -2911,12 +3757,25 @@ func (x *actorSystem) configPID(ctx context.Context, name string, actor Actor, o
 		withInitTimeout(x.actorInitTimeout),
 		withRemoting(x.remoting),
 		withWorkerPool(x.workerPool),
+		withPersistenceStores(x.journalStore, x.snapshotStore),
+	}
+
+	if x.durableStateStore != nil {
+		pidOpts = append(pidOpts, withDurableStateStore(x.durableStateStore))
+	}
+
+	if x.metrics != nil {
+		pidOpts = append(pidOpts, withMetricsRecorder(x.metrics))
 	}
 
 	if err := spawnConfig.Validate(); err != nil {
 		return nil, err
 	}
 
+	if spawnConfig.snapshotInterval > 0 {
+		pidOpts = append(pidOpts, withSnapshotInterval(spawnConfig.snapshotInterval))
+	}
+
 	// set the mailbox option
 	if spawnConfig.mailbox != nil {
 		pidOpts = append(pidOpts, withMailbox(spawnConfig.mailbox))
@@ -2949,6 +3808,18 @@ func (x *actorSystem) configPID(ctx context.Context, name string, actor Actor, o
 		pidOpts = append(pidOpts, withDependencies(spawnConfig.dependencies...))
 	}
 
+	if spawnConfig.factory != "" {
+		pidOpts = append(pidOpts, withActorFactory(spawnConfig.factory, spawnConfig.factoryArgs))
+	}
+
+	if spawnConfig.handOffState != nil {
+		pidOpts = append(pidOpts, withHandOffState(spawnConfig.handOffState))
+	}
+
+	if spawnConfig.role != "" {
+		pidOpts = append(pidOpts, withRole(spawnConfig.role))
+	}
+
 	pidOpts = append(pidOpts, withPassivationStrategy(spawnConfig.passivationStrategy))
 
 	pid, err := newPID(
@@ -3016,7 +3887,7 @@ func (x *actorSystem) configureServer(ctx context.Context, mux *stdhttp.ServeMux
 	if x.serverTLS != nil {
 		x.server = httpServer
 		x.server.TLSConfig = x.serverTLS
-		x.server.Handler = mux
+		x.server.Handler = peerIdentityHandler(mux)
 		x.listener = tls.NewListener(listener, x.serverTLS)
 		return http2.ConfigureServer(x.server, http2Server)
 	}
@@ -3168,6 +4039,16 @@ func (x *actorSystem) spawnDeadletter(ctx context.Context) error {
 
 // checkSpawnPreconditions make sure before an actor is created some pre-conditions are checks
 func (x *actorSystem) checkSpawnPreconditions(ctx context.Context, actorName string, kind Actor, singleton bool) error {
+	// a node on the losing side of a network partition does not create actors
+	if x.readOnly.Load() {
+		return ErrActorSystemReadOnly
+	}
+
+	// a node leaving the cluster does not accept new actors
+	if x.draining.Load() {
+		return ErrActorSystemDraining
+	}
+
 	// check the existence of the actor given the kind prior to creating it
 	if x.clusterEnabled.Load() {
 		// a singleton actor must only have one instance at a given time of its kind
@@ -3200,23 +4081,29 @@ func (x *actorSystem) checkSpawnPreconditions(ctx context.Context, actorName str
 
 // cleanupCluster cleans up the cluster
 func (x *actorSystem) cleanupCluster(ctx context.Context, actorRefs []ActorRef) error {
+	// a node on the losing side of a network partition must not alter
+	// the cluster state owned by the surviving side
+	if x.readOnly.Load() {
+		return nil
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 
-	// Remove singleton actors from the cluster
-	if x.cluster.IsLeader(ctx) {
-		for _, actorRef := range actorRefs {
-			if actorRef.IsSingleton() {
-				actorRef := actorRef
-				eg.Go(func() error {
-					kind := actorRef.Kind()
-					if err := x.cluster.RemoveKind(ctx, kind); err != nil {
-						x.logger.Errorf("failed to remove [actor kind=%s] from cluster: %v", kind, err)
-						return err
-					}
-					x.logger.Infof("[actor kind=%s] removed from cluster", kind)
-					return nil
-				})
-			}
+	// Remove singleton actors from the cluster. A singleton actor requiring a role
+	// is not necessarily hosted by the leader
+	for _, actorRef := range actorRefs {
+		if actorRef.IsSingleton() {
+			actorRef := actorRef
+			eg.Go(func() error {
+				x.releaseSingletonLease(ctx, actorRef.Name())
+				kind := actorRef.Kind()
+				if err := x.cluster.RemoveKind(ctx, kind); err != nil {
+					x.logger.Errorf("failed to remove [actor kind=%s] from cluster: %v", kind, err)
+					return err
+				}
+				x.logger.Infof("[actor kind=%s] removed from cluster", kind)
+				return nil
+			})
 		}
 	}
 
@@ -3289,6 +4176,10 @@ func (x *actorSystem) shutdownCluster(ctx context.Context, actorRefs []ActorRef)
 		}
 
 		x.clusterSyncStopSig <- registry.Unit{}
+		if x.splitBrain != nil {
+			x.splitBrain.stop()
+		}
+
 		x.clusterEnabled.Store(false)
 		x.rebalancing.Store(false)
 		x.pubsubEnabled.Store(false)
@@ -3302,6 +4193,10 @@ func (x *actorSystem) shutdownCluster(ctx context.Context, actorRefs []ActorRef)
 			close(x.grainsQueue)
 		}
 
+		if x.grainsReactivationQueue != nil {
+			close(x.grainsReactivationQueue)
+		}
+
 		x.rebalanceLocker.Unlock()
 		if x.clusterStore != nil && x.relocationEnabled.Load() {
 			return x.clusterStore.Close()
@@ -3317,6 +4212,8 @@ func (x *actorSystem) shutdownRemoting(ctx context.Context) error {
 		}
 
 		if x.server != nil {
+			// end the streams opened by the remote nodes for the server to shut down
+			close(x.remoteStreamsStopSig)
 			if err := x.shutdownHTTPServer(ctx); err != nil {
 				x.logger.Errorf("%s failed to shutdown: %w", x.name, err)
 				return err
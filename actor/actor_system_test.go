@@ -30,6 +30,7 @@ import (
 	"errors"
 	"net"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -230,8 +231,9 @@ func TestActorSystem(t *testing.T) {
 		require.True(t, exists)
 
 		// get the actor
-		addr, _, err := newActorSystem.ActorOf(ctx, actorName)
+		ref, err := newActorSystem.ActorOf(ctx, actorName)
 		require.NoError(t, err)
+		addr := ref.Address()
 		require.NotNil(t, addr)
 
 		// use RemoteActor method and compare the results
@@ -256,11 +258,10 @@ func TestActorSystem(t *testing.T) {
 		require.NoError(t, err)
 		require.False(t, exists)
 
-		addr, pid, err := newActorSystem.ActorOf(ctx, actorName)
+		ref, err = newActorSystem.ActorOf(ctx, actorName)
 		require.Error(t, err)
 		require.ErrorIs(t, err, ErrActorNotFound)
-		require.Nil(t, addr)
-		require.Nil(t, pid)
+		require.Nil(t, ref.Address())
 
 		remoteAddr, err = newActorSystem.RemoteActor(ctx, actorName)
 		require.Error(t, err)
@@ -299,11 +300,10 @@ func TestActorSystem(t *testing.T) {
 		// create an actor
 		actorName := uuid.NewString()
 
-		addr, pid, err := newActorSystem.ActorOf(ctx, actorName)
+		ref, err := newActorSystem.ActorOf(ctx, actorName)
 		require.Error(t, err)
 		require.ErrorIs(t, err, ErrMethodCallNotAllowed)
-		require.Nil(t, addr)
-		require.Nil(t, pid)
+		require.Nil(t, ref.Address())
 
 		t.Cleanup(
 			func() {
@@ -326,10 +326,10 @@ func TestActorSystem(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, actorRef)
 
-		addr, pid, err := sys.ActorOf(ctx, actorName)
+		ref, err := sys.ActorOf(ctx, actorName)
 		require.NoError(t, err)
-		require.NotNil(t, pid)
-		require.NotNil(t, addr)
+		require.NotNil(t, ref.localPID())
+		require.NotNil(t, ref.Address())
 
 		// stop the actor after some time
 		pause.For(time.Second)
@@ -355,11 +355,10 @@ func TestActorSystem(t *testing.T) {
 		require.NoError(t, err)
 		require.False(t, exists)
 
-		addr, pid, err := sys.ActorOf(ctx, actorName)
+		ref, err := sys.ActorOf(ctx, actorName)
 		require.Error(t, err)
 		require.ErrorIs(t, err, ErrActorNotFound)
-		require.Nil(t, pid)
-		require.Nil(t, addr)
+		require.Nil(t, ref.Address())
 
 		// stop the actor after some time
 		pause.For(time.Second)
@@ -388,11 +387,10 @@ func TestActorSystem(t *testing.T) {
 		// create an actor
 		actorName := uuid.NewString()
 
-		addr, pid, err := newActorSystem.ActorOf(ctx, actorName)
+		ref, err := newActorSystem.ActorOf(ctx, actorName)
 		require.Error(t, err)
 		require.ErrorIs(t, err, ErrActorSystemNotStarted)
-		require.Nil(t, addr)
-		require.Nil(t, pid)
+		require.Nil(t, ref.Address())
 	})
 	t.Run("With ReSpawn", func(t *testing.T) {
 		ctx := context.TODO()
@@ -418,7 +416,7 @@ func TestActorSystem(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expected := new(testpb.Reply)
-		require.True(t, proto.Equal(expected, reply))
+		require.True(t, proto.Equal(expected, reply.(proto.Message)))
 		require.True(t, actorRef.IsRunning())
 
 		pause.For(500 * time.Millisecond)
@@ -508,7 +506,7 @@ func TestActorSystem(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expected := new(testpb.Reply)
-		require.True(t, proto.Equal(expected, reply))
+		require.True(t, proto.Equal(expected, reply.(proto.Message)))
 		require.True(t, actorRef.IsRunning())
 		// stop the actor after some time
 		pause.For(time.Second)
@@ -564,7 +562,7 @@ func TestActorSystem(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expected := new(testpb.Reply)
-		require.True(t, proto.Equal(expected, reply))
+		require.True(t, proto.Equal(expected, reply.(proto.Message)))
 		require.True(t, actorRef.IsRunning())
 		// stop the actor after some time
 		pause.For(time.Second)
@@ -1056,11 +1054,10 @@ func TestActorSystem(t *testing.T) {
 		pause.For(time.Second)
 
 		// get the actor
-		addr, pid, err := newActorSystem.ActorOf(ctx, actorName)
+		ref, err := newActorSystem.ActorOf(ctx, actorName)
 		require.Error(t, err)
 		require.ErrorIs(t, err, ErrActorNotFound)
-		require.Nil(t, addr)
-		require.Nil(t, pid)
+		require.Nil(t, ref.Address())
 
 		// use RemoteActor method and compare the results
 		remoteAddr, err := newActorSystem.RemoteActor(ctx, actorName)
@@ -1181,9 +1178,9 @@ func TestActorSystem(t *testing.T) {
 		err = newActorSystem.Start(ctx)
 		require.NoError(t, err)
 
-		receiveFn := func(_ context.Context, message proto.Message) error {
+		receiveFn := func(_ context.Context, message any) error {
 			expected := &testpb.Reply{Content: "test spawn from func"}
-			assert.True(t, proto.Equal(expected, message))
+			assert.True(t, proto.Equal(expected, message.(proto.Message)))
 			return nil
 		}
 
@@ -1250,9 +1247,9 @@ func TestActorSystem(t *testing.T) {
 		err = newActorSystem.Start(ctx)
 		require.NoError(t, err)
 
-		receiveFn := func(_ context.Context, message proto.Message) error {
+		receiveFn := func(_ context.Context, message any) error {
 			expected := &testpb.Reply{Content: "test spawn from func"}
-			assert.True(t, proto.Equal(expected, message))
+			assert.True(t, proto.Equal(expected, message.(proto.Message)))
 			return nil
 		}
 
@@ -1282,9 +1279,9 @@ func TestActorSystem(t *testing.T) {
 		err := sys.Start(ctx)
 		assert.NoError(t, err)
 
-		receiveFn := func(_ context.Context, message proto.Message) error {
+		receiveFn := func(_ context.Context, message any) error {
 			expected := &testpb.Reply{Content: "test spawn from func"}
-			assert.True(t, proto.Equal(expected, message))
+			assert.True(t, proto.Equal(expected, message.(proto.Message)))
 			return nil
 		}
 
@@ -1311,9 +1308,9 @@ func TestActorSystem(t *testing.T) {
 		err := sys.Start(ctx)
 		assert.NoError(t, err)
 
-		receiveFn := func(ctx context.Context, message proto.Message) error {
+		receiveFn := func(ctx context.Context, message any) error {
 			expected := &testpb.Reply{Content: "test spawn from func"}
-			assert.True(t, proto.Equal(expected, message))
+			assert.True(t, proto.Equal(expected, message.(proto.Message)))
 			return nil
 		}
 
@@ -1341,9 +1338,9 @@ func TestActorSystem(t *testing.T) {
 		ctx := context.TODO()
 		sys, _ := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
 
-		receiveFn := func(ctx context.Context, message proto.Message) error {
+		receiveFn := func(ctx context.Context, message any) error {
 			expected := &testpb.Reply{Content: "test spawn from func"}
-			assert.True(t, proto.Equal(expected, message))
+			assert.True(t, proto.Equal(expected, message.(proto.Message)))
 			return nil
 		}
 
@@ -1452,6 +1449,74 @@ func TestActorSystem(t *testing.T) {
 			},
 		)
 	})
+	t.Run("With SpawnFromFactory", func(t *testing.T) {
+		ctx := context.TODO()
+		logger := log.DiscardLogger
+
+		// create the actor system
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(logger),
+		)
+		// assert there are no error
+		require.NoError(t, err)
+
+		// start the actor system
+		err = sys.Start(ctx)
+		assert.NoError(t, err)
+
+		// register the actor factory
+		err = sys.RegisterFactory(ctx, "factory", mockActorFactory)
+		require.NoError(t, err)
+
+		pid, err := sys.SpawnFromFactory(ctx, "actor", "factory", &testpb.TestLog{Text: "hello"})
+		require.NoError(t, err)
+		require.NotNil(t, pid)
+
+		reply, err := Ask(ctx, pid, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		require.IsType(t, new(testpb.Reply), reply)
+		assert.Equal(t, "hello", reply.(*testpb.Reply).GetContent())
+
+		// the factory fails with invalid arguments
+		_, err = sys.SpawnFromFactory(ctx, "other", "factory", new(testpb.TestReply))
+		require.Error(t, err)
+
+		_, err = sys.SpawnFromFactory(ctx, "other", "unknown", nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrFactoryNotRegistered)
+
+		err = sys.RegisterFactory(ctx, "invalid", nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidInstance)
+
+		t.Cleanup(
+			func() {
+				err = sys.Stop(ctx)
+				assert.NoError(t, err)
+			},
+		)
+	})
+	t.Run("With SpawnFromFactory when actor system not started", func(t *testing.T) {
+		ctx := context.TODO()
+		logger := log.DiscardLogger
+
+		// create the actor system
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(logger),
+		)
+		// assert there are no error
+		require.NoError(t, err)
+
+		err = sys.RegisterFactory(ctx, "factory", mockActorFactory)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrActorSystemNotStarted)
+
+		_, err = sys.SpawnFromFactory(ctx, "actor", "factory", &testpb.TestLog{Text: "hello"})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrActorSystemNotStarted)
+	})
 	t.Run("With RemoteSpawn with clustering enabled", func(t *testing.T) {
 		ctx := context.TODO()
 		nodePorts := dynaport.Get(3)
@@ -1528,11 +1593,9 @@ func TestActorSystem(t *testing.T) {
 
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		require.True(t, reply.MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), reply)
 
-		actual := new(testpb.Reply)
-		err = reply.UnmarshalTo(actual)
-		require.NoError(t, err)
+		actual := reply.(*testpb.Reply)
 
 		expected := new(testpb.Reply)
 		assert.True(t, proto.Equal(expected, actual))
@@ -2258,11 +2321,10 @@ func TestActorSystem(t *testing.T) {
 		pause.For(time.Second)
 
 		name := "GoAktXYZ"
-		addr, pid, err := sys.ActorOf(ctx, name)
+		ref, err := sys.ActorOf(ctx, name)
 		require.Error(t, err)
 		require.ErrorIs(t, err, ErrActorNotFound)
-		require.Nil(t, addr)
-		require.Nil(t, pid)
+		require.Nil(t, ref.Address())
 
 		err = sys.Stop(ctx)
 		require.NoError(t, err)
@@ -2527,6 +2589,162 @@ func TestActorSystem(t *testing.T) {
 		// shutdown the nats server gracefully
 		srv.Shutdown()
 	})
+	t.Run("SpawnOn with role", func(t *testing.T) {
+		// create a context
+		ctx := context.TODO()
+		// start the NATS server
+		srv := startNatsServer(t)
+
+		// create and start system cluster
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		// create and start system cluster with the payments role
+		node2, sd2 := testCluster(t, srv.Addr().String(), withTestRoles("payments"))
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		pause.For(time.Second)
+
+		// the local node does not have the role
+		_, err := node1.Spawn(ctx, "local", NewMockActor(), WithRole("payments"))
+		require.ErrorIs(t, err, ErrRoleNotFound)
+
+		// the actor can only be placed on node2
+		for actorName, placement := range map[string]SpawnPlacement{"actor-1": RoundRobin, "actor-2": Random, "actor-3": RoundRobin} {
+			require.NoError(t, node1.SpawnOn(ctx, actorName, NewMockActor(), WithRole("payments"), WithPlacement(placement)))
+
+			pause.For(200 * time.Millisecond)
+
+			addr, err := node1.RemoteActor(ctx, actorName)
+			require.NoError(t, err)
+			assert.Equal(t, node2.Port(), addr.Port())
+		}
+
+		// no node has the role
+		err = node1.SpawnOn(ctx, "unknown", NewMockActor(), WithRole("unknown"))
+		require.ErrorIs(t, err, ErrRoleNotFound)
+
+		// free resources
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, node1.Stop(ctx))
+
+		require.NoError(t, sd2.Close())
+		require.NoError(t, sd1.Close())
+
+		// shutdown the nats server gracefully
+		srv.Shutdown()
+	})
+	t.Run("SpawnOn with least load placement", func(t *testing.T) {
+		// create a context
+		ctx := context.TODO()
+		// start the NATS server
+		srv := startNatsServer(t)
+
+		// create and start system cluster
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		// create and start system cluster
+		node2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		// create and start system cluster
+		node3, sd3 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node3)
+		require.NotNil(t, sd3)
+
+		// load node1 and node2 so that node3 hosts the fewest actors
+		for _, name := range []string{"a", "b", "c", "d"} {
+			_, err := node1.Spawn(ctx, "node1-"+name, NewMockActor())
+			require.NoError(t, err)
+			_, err = node2.Spawn(ctx, "node2-"+name, NewMockActor())
+			require.NoError(t, err)
+		}
+
+		pause.For(time.Second)
+
+		require.NoError(t, node1.SpawnOn(ctx, "actorID", NewMockActor(), WithPlacement(LeastLoad)))
+
+		pause.For(200 * time.Millisecond)
+
+		addr, err := node1.RemoteActor(ctx, "actorID")
+		require.NoError(t, err)
+		assert.Equal(t, node3.Port(), addr.Port())
+
+		// free resources
+		require.NoError(t, node3.Stop(ctx))
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, node1.Stop(ctx))
+
+		require.NoError(t, sd3.Close())
+		require.NoError(t, sd2.Close())
+		require.NoError(t, sd1.Close())
+
+		// shutdown the nats server gracefully
+		srv.Shutdown()
+	})
+	t.Run("SpawnOn with placement strategy", func(t *testing.T) {
+		// create a context
+		ctx := context.TODO()
+		// start the NATS server
+		srv := startNatsServer(t)
+
+		// create and start system cluster
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		// create and start system cluster
+		node2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		pause.For(time.Second)
+
+		strategy := NewMockPlacementStrategy(node2.Port())
+		require.NoError(t, node1.SpawnOn(ctx, "actorID", NewMockActor(), WithPlacementStrategy(strategy)))
+
+		// the local node is part of the candidates
+		candidates := strategy.Candidates()
+		require.Len(t, candidates, 2)
+		assert.True(t, slices.ContainsFunc(candidates, func(node *NodeMetric) bool {
+			return node.IsLocal() && node.RemotingPort() == node1.Port()
+		}))
+
+		pause.For(200 * time.Millisecond)
+
+		addr, err := node1.RemoteActor(ctx, "actorID")
+		require.NoError(t, err)
+		assert.Equal(t, node2.Port(), addr.Port())
+
+		// the strategy also applies to the grains activation
+		identity, err := node1.GrainIdentity(ctx, "grain", func(context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		}, WithGrainPlacementStrategy(strategy))
+		require.NoError(t, err)
+
+		grain, err := node1.getCluster().GetGrain(ctx, identity.String())
+		require.NoError(t, err)
+		assert.EqualValues(t, node2.Port(), grain.GetPort())
+
+		// the strategy does not choose any candidate
+		err = node1.SpawnOn(ctx, "other", NewMockActor(), WithPlacementStrategy(NewMockPlacementStrategy(0)))
+		require.Error(t, err)
+
+		// free resources
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, node1.Stop(ctx))
+
+		require.NoError(t, sd2.Close())
+		require.NoError(t, sd1.Close())
+
+		// shutdown the nats server gracefully
+		srv.Shutdown()
+	})
 	t.Run("SpawnOn with single node cluster", func(t *testing.T) {
 		// create a context
 		ctx := context.TODO()
@@ -29,19 +29,26 @@ import (
 	"errors"
 	"time"
 
-	"google.golang.org/protobuf/proto"
-
 	"github.com/tochemey/goakt/v3/address"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 )
 
 // Ask sends a synchronous message to another actor and expect a response.
 // This block until a response is received or timed out.
-func Ask(ctx context.Context, to *PID, message proto.Message, timeout time.Duration) (response proto.Message, err error) {
+func Ask(ctx context.Context, to *PID, message any, timeout time.Duration) (response any, err error) {
 	if !to.IsRunning() {
 		return nil, ErrDead
 	}
 
+	breaker := actorCircuitBreaker(to.ActorSystem(), to)
+	generation, err := breaker.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	defer func() { breaker.record(generation, err, time.Since(start)) }()
+
 	receiveContext, err := toReceiveContext(ctx, to, message, false)
 	if err != nil {
 		return nil, err
@@ -70,7 +77,7 @@ func Ask(ctx context.Context, to *PID, message proto.Message, timeout time.Durat
 }
 
 // Tell sends an asynchronous message to an actor
-func Tell(ctx context.Context, to *PID, message proto.Message) error {
+func Tell(ctx context.Context, to *PID, message any) error {
 	if !to.IsRunning() {
 		return ErrDead
 	}
@@ -87,7 +94,7 @@ func Tell(ctx context.Context, to *PID, message proto.Message) error {
 // BatchTell sends bulk asynchronous messages to an actor
 // The messages will be processed one after the other in the order they are sent
 // This is a design choice to follow the simple principle of one message at a time processing by actors.
-func BatchTell(ctx context.Context, to *PID, messages ...proto.Message) error {
+func BatchTell(ctx context.Context, to *PID, messages ...any) error {
 	// messages are processed one after the other
 	for _, mesage := range messages {
 		if err := Tell(ctx, to, mesage); err != nil {
@@ -100,8 +107,8 @@ func BatchTell(ctx context.Context, to *PID, messages ...proto.Message) error {
 // BatchAsk sends a synchronous bunch of messages to the given PID and expect responses in the same order as the messages.
 // The messages will be processed one after the other in the order they are sent
 // This is a design choice to follow the simple principle of one message at a time processing by actors.
-func BatchAsk(ctx context.Context, to *PID, timeout time.Duration, messages ...proto.Message) (responses chan proto.Message, err error) {
-	responses = make(chan proto.Message, len(messages))
+func BatchAsk(ctx context.Context, to *PID, timeout time.Duration, messages ...any) (responses chan any, err error) {
+	responses = make(chan any, len(messages))
 	defer close(responses)
 	for _, mesage := range messages {
 		response, err := Ask(ctx, to, mesage, timeout)
@@ -114,10 +121,10 @@ func BatchAsk(ctx context.Context, to *PID, timeout time.Duration, messages ...p
 }
 
 // toReceiveContext creates a ReceiveContext provided a message and a receiver
-func toReceiveContext(ctx context.Context, to *PID, message proto.Message, async bool) (*ReceiveContext, error) {
+func toReceiveContext(ctx context.Context, to *PID, message any, async bool) (*ReceiveContext, error) {
 	switch msg := message.(type) {
 	case *internalpb.RemoteMessage:
-		actual, err := msg.GetMessage().UnmarshalNew()
+		actual, err := to.ActorSystem().getSerializers().Deserialize(msg.GetPayload())
 		if err != nil {
 			return nil, NewErrInvalidRemoteMessage(err)
 		}
@@ -32,7 +32,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/pause"
@@ -75,7 +74,7 @@ func TestAsk(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, reply)
 		expected := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 
 		err = sys.Stop(ctx)
 		require.NoError(t, err)
@@ -234,7 +233,7 @@ func TestAsk(t *testing.T) {
 
 		// create a message to send to the test actor
 		message := &internalpb.RemoteMessage{
-			Message: &anypb.Any{},
+			Payload: &internalpb.Payload{},
 		}
 		// send the message to the actor
 		reply, err := Ask(ctx, actorRef, message, replyTimeout)
@@ -283,7 +282,7 @@ func TestAsk(t *testing.T) {
 
 		for reply := range replies {
 			expected := &testpb.Reply{Content: "received message"}
-			assert.True(t, proto.Equal(expected, reply))
+			assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 		}
 
 		err = sys.Stop(ctx)
@@ -487,7 +486,7 @@ func TestTell(t *testing.T) {
 
 		// create a message to send to the test actor
 		message := &internalpb.RemoteMessage{
-			Message: &anypb.Any{},
+			Payload: &internalpb.Payload{},
 		}
 		// send the message to the actor
 		err = Tell(ctx, actorRef, message)
@@ -0,0 +1,529 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/persistence"
+)
+
+// AtLeastOnceDeliveryOption defines the various options to apply to a given AtLeastOnceDelivery
+type AtLeastOnceDeliveryOption func(*AtLeastOnceDelivery)
+
+// WithRedeliveryInterval sets the interval after which an unconfirmed message is sent again.
+// The default value is DefaultRedeliveryInterval
+func WithRedeliveryInterval(interval time.Duration) AtLeastOnceDeliveryOption {
+	return func(x *AtLeastOnceDelivery) {
+		x.redeliveryInterval = interval
+	}
+}
+
+// WithMaxDeliveryAttempts sets the maximum number of times a message is sent before giving up.
+// When the limit is reached the message is no longer redelivered and a goaktpb.DeliveryFailed
+// message is sent to the producer actor. Zero, the default, means no limit.
+func WithMaxDeliveryAttempts(attempts int) AtLeastOnceDeliveryOption {
+	return func(x *AtLeastOnceDelivery) {
+		x.maxAttempts = attempts
+	}
+}
+
+// WithMaxUnconfirmedDeliveries sets the maximum number of messages waiting to be confirmed.
+// Once reached, sending a new message fails with ErrMaxUnconfirmedDeliveries until some
+// messages are confirmed. Zero, the default, means no limit.
+func WithMaxUnconfirmedDeliveries(limit int) AtLeastOnceDeliveryOption {
+	return func(x *AtLeastOnceDelivery) {
+		x.maxUnconfirmed = limit
+	}
+}
+
+// AtLeastOnceDelivery sends messages from an actor with at-least-once delivery guarantee.
+//
+// Every message gets a sequence number and is tracked until the recipient acknowledges it.
+// The unconfirmed messages are redelivered on a timer built on the actor system scheduler.
+// The recipient must use a DeliveryDeduplicator to acknowledge the messages and drop the duplicates.
+//
+// When a delivery store is set on the actor system with WithDeliveryStore, the unconfirmed messages are
+// stored and redelivered after the actor is restarted or relocated. The producer ID must then be unique
+// and stable across the actor lifecycles, e.g. the actor name. Without a delivery store, the unconfirmed
+// messages are lost when the actor is relocated.
+//
+// AtLeastOnceDelivery is meant to be held by the producer actor and is not safe for concurrent use.
+// Every message received by the actor must be handed over to Receive first:
+//
+//	func (x *Producer) Receive(ctx *ReceiveContext) {
+//	    if x.delivery.Receive(ctx) {
+//	        return
+//	    }
+//	    switch msg := ctx.Message().(type) {
+//	    case *PlaceOrder:
+//	        if _, err := x.delivery.Deliver(ctx, "orders", msg); err != nil {
+//	            ctx.Err(err)
+//	        }
+//	    case *goaktpb.DeliveryFailed:
+//	        // the message could not be delivered
+//	    }
+//	}
+type AtLeastOnceDelivery struct {
+	producerID         string
+	incarnation        string
+	redeliveryInterval time.Duration
+	maxAttempts        int
+	maxUnconfirmed     int
+
+	store          persistence.DeliveryStore
+	recovered      bool
+	sequenceNumber uint64
+	unconfirmed    map[uint64]*unconfirmedDelivery
+	nextRedelivery time.Time
+}
+
+// unconfirmedDelivery defines a message waiting to be confirmed
+type unconfirmedDelivery struct {
+	recipient string
+	message   *internalpb.Payload
+	attempts  int
+	sentAt    time.Time
+}
+
+// NewAtLeastOnceDelivery creates an instance of AtLeastOnceDelivery for the given producer ID
+func NewAtLeastOnceDelivery(producerID string, opts ...AtLeastOnceDeliveryOption) *AtLeastOnceDelivery {
+	x := &AtLeastOnceDelivery{
+		producerID:         producerID,
+		redeliveryInterval: DefaultRedeliveryInterval,
+		unconfirmed:        make(map[uint64]*unconfirmedDelivery),
+	}
+
+	for _, opt := range opts {
+		opt(x)
+	}
+
+	return x
+}
+
+// Deliver sends the given message to the named actor with at-least-once delivery.
+// The location of the given actor is transparent to the caller, which means the message
+// follows the recipient when it is relocated to another node of the cluster.
+//
+// The message is serialized with the serializer registered for its type, or as protocol buffers message.
+//
+// It returns the sequence number of the delivery.
+func (x *AtLeastOnceDelivery) Deliver(ctx *ReceiveContext, actorName string, message any) (uint64, error) {
+	return x.deliver(ctx, actorName, message)
+}
+
+// RemoteDeliver sends the given message to the remote actor with at-least-once delivery.
+// The message is serialized with the serializer registered for its type, or as protocol buffers message.
+//
+// It returns the sequence number of the delivery.
+func (x *AtLeastOnceDelivery) RemoteDeliver(ctx *ReceiveContext, to *address.Address, message any) (uint64, error) {
+	return x.deliver(ctx, to.String(), message)
+}
+
+// Receive handles the acknowledgements and the redelivery ticks of the producer.
+// It returns true when the message has been handled and must not be processed by the actor.
+func (x *AtLeastOnceDelivery) Receive(ctx *ReceiveContext) bool {
+	if err := x.recover(ctx); err != nil {
+		ctx.Logger().Errorf("%s failed to recover the unconfirmed deliveries: %v", x.producerID, err)
+	}
+
+	switch msg := ctx.Message().(type) {
+	case *internalpb.DeliveryAck:
+		if msg.GetProducerId() != x.producerID || msg.GetIncarnation() != x.incarnation {
+			return false
+		}
+		x.confirm(ctx, msg.GetSequenceNumber())
+		return true
+	case *internalpb.RedeliveryTick:
+		if msg.GetProducerId() != x.producerID {
+			return false
+		}
+		x.nextRedelivery = time.Time{}
+		x.redeliver(ctx)
+		return true
+	default:
+		// reschedule the redelivery when a tick got lost, e.g. while the actor was restarting
+		x.scheduleRedelivery(ctx)
+		return false
+	}
+}
+
+// SequenceNumber returns the sequence number of the latest delivery
+func (x *AtLeastOnceDelivery) SequenceNumber() uint64 {
+	return x.sequenceNumber
+}
+
+// Unconfirmed returns the number of messages waiting to be confirmed
+func (x *AtLeastOnceDelivery) Unconfirmed() int {
+	return len(x.unconfirmed)
+}
+
+// deliver records and sends the given message to the given recipient
+func (x *AtLeastOnceDelivery) deliver(ctx *ReceiveContext, recipient string, message any) (uint64, error) {
+	if message == nil {
+		return 0, ErrInvalidMessage
+	}
+
+	if err := x.recover(ctx); err != nil {
+		return 0, err
+	}
+
+	if x.maxUnconfirmed > 0 && len(x.unconfirmed) >= x.maxUnconfirmed {
+		return 0, ErrMaxUnconfirmedDeliveries
+	}
+
+	payload, err := ctx.ActorSystem().getSerializers().Serialize(message)
+	if err != nil {
+		return 0, err
+	}
+
+	sequenceNumber := x.sequenceNumber + 1
+	if x.store != nil {
+		if err := x.store.WriteDelivery(context.WithoutCancel(ctx.Context()), &persistence.Delivery{
+			ProducerID:     x.producerID,
+			SequenceNumber: sequenceNumber,
+			Recipient:      recipient,
+			Message:        payload.GetData(),
+			SerializerID:   payload.GetSerializerId(),
+			Manifest:       payload.GetManifest(),
+			Timestamp:      time.Now().UTC(),
+		}); err != nil {
+			return 0, NewErrPersistFailure(err)
+		}
+	}
+
+	delivery := &unconfirmedDelivery{
+		recipient: recipient,
+		message:   payload,
+	}
+
+	x.sequenceNumber = sequenceNumber
+	x.unconfirmed[sequenceNumber] = delivery
+	x.send(ctx, sequenceNumber, delivery)
+	x.scheduleRedelivery(ctx)
+	return sequenceNumber, nil
+}
+
+// recover loads the unconfirmed deliveries from the delivery store and redelivers them.
+// Without a delivery store, a new incarnation is set so that recipients do not mistake
+// the sequence numbers of this instance of the producer for duplicates
+func (x *AtLeastOnceDelivery) recover(ctx *ReceiveContext) error {
+	if x.recovered {
+		return nil
+	}
+
+	x.store = ctx.ActorSystem().getDeliveryStore()
+	if x.store == nil {
+		x.incarnation = uuid.NewString()
+		x.recovered = true
+		return nil
+	}
+
+	sequenceNumber, deliveries, err := x.store.GetDeliveries(context.WithoutCancel(ctx.Context()), x.producerID)
+	if err != nil {
+		return err
+	}
+
+	x.recovered = true
+	x.sequenceNumber = max(x.sequenceNumber, sequenceNumber)
+	for _, delivery := range deliveries {
+		unconfirmed := &unconfirmedDelivery{
+			recipient: delivery.Recipient,
+			message: &internalpb.Payload{
+				SerializerId: delivery.SerializerID,
+				Manifest:     delivery.Manifest,
+				Data:         delivery.Message,
+			},
+		}
+
+		x.unconfirmed[delivery.SequenceNumber] = unconfirmed
+		x.send(ctx, delivery.SequenceNumber, unconfirmed)
+	}
+
+	x.scheduleRedelivery(ctx)
+	return nil
+}
+
+// send sends the given delivery to its recipient.
+// Failures are only logged since the delivery is sent again on the next redelivery
+func (x *AtLeastOnceDelivery) send(ctx *ReceiveContext, sequenceNumber uint64, delivery *unconfirmedDelivery) {
+	delivery.attempts++
+	delivery.sentAt = time.Now()
+
+	envelope := &internalpb.Delivery{
+		ProducerId:              x.producerID,
+		SequenceNumber:          sequenceNumber,
+		Message:                 delivery.message,
+		Incarnation:             x.incarnation,
+		ConfirmedSequenceNumber: x.confirmedSequenceNumber(),
+	}
+
+	var (
+		self   = ctx.Self()
+		goCtx  = context.WithoutCancel(ctx.Context())
+		sendFn = func() error { return self.SendAsync(goCtx, delivery.recipient, envelope) }
+	)
+
+	// the recipient is either an actor name or the address of a remote actor
+	if to, err := address.Parse(delivery.recipient); err == nil {
+		sendFn = func() error { return self.RemoteTell(goCtx, to, envelope) }
+	}
+
+	if err := sendFn(); err != nil {
+		ctx.Logger().Warnf("%s failed to send delivery=(%d) to %s: %v", x.producerID, sequenceNumber, delivery.recipient, err)
+	}
+}
+
+// redeliver sends again the deliveries that have not been confirmed within the redelivery interval
+// and gives up on the deliveries that reached the maximum number of attempts
+func (x *AtLeastOnceDelivery) redeliver(ctx *ReceiveContext) {
+	now := time.Now()
+	for _, sequenceNumber := range slices.Sorted(maps.Keys(x.unconfirmed)) {
+		delivery := x.unconfirmed[sequenceNumber]
+		if now.Sub(delivery.sentAt) < x.redeliveryInterval {
+			continue
+		}
+
+		if x.maxAttempts > 0 && delivery.attempts >= x.maxAttempts {
+			x.giveUp(ctx, sequenceNumber, delivery)
+			continue
+		}
+
+		x.send(ctx, sequenceNumber, delivery)
+	}
+
+	x.scheduleRedelivery(ctx)
+}
+
+// giveUp stops redelivering the given delivery and notifies the producer actor
+func (x *AtLeastOnceDelivery) giveUp(ctx *ReceiveContext, sequenceNumber uint64, delivery *unconfirmedDelivery) {
+	ctx.Logger().Warnf("%s gives up on delivery=(%d) to %s after %d attempts", x.producerID, sequenceNumber, delivery.recipient, delivery.attempts)
+	x.confirm(ctx, sequenceNumber)
+
+	// the message field is only set for protocol buffers messages
+	message, _ := ctx.ActorSystem().getSerializers().Deserialize(delivery.message)
+
+	self := ctx.Self()
+	if err := self.Tell(context.WithoutCancel(ctx.Context()), self, &goaktpb.DeliveryFailed{
+		ProducerId:     x.producerID,
+		SequenceNumber: sequenceNumber,
+		Recipient:      delivery.recipient,
+		Message:        marshalAny(message),
+		Attempts:       uint32(delivery.attempts),
+		MessageType:    delivery.message.GetManifest(),
+	}); err != nil {
+		ctx.Logger().Errorf("%s failed to notify the failed delivery=(%d): %v", x.producerID, sequenceNumber, err)
+	}
+}
+
+// confirm removes the given delivery from the unconfirmed deliveries
+func (x *AtLeastOnceDelivery) confirm(ctx *ReceiveContext, sequenceNumber uint64) {
+	if _, ok := x.unconfirmed[sequenceNumber]; !ok {
+		return
+	}
+
+	delete(x.unconfirmed, sequenceNumber)
+	if x.store != nil {
+		if err := x.store.ConfirmDelivery(context.WithoutCancel(ctx.Context()), x.producerID, sequenceNumber); err != nil {
+			ctx.Logger().Errorf("%s failed to confirm delivery=(%d): %v", x.producerID, sequenceNumber, err)
+		}
+	}
+}
+
+// scheduleRedelivery schedules the next redelivery tick when there are unconfirmed deliveries.
+// A tick is considered lost when it has not been received one interval after its due time
+func (x *AtLeastOnceDelivery) scheduleRedelivery(ctx *ReceiveContext) {
+	if len(x.unconfirmed) == 0 {
+		return
+	}
+
+	now := time.Now()
+	if !x.nextRedelivery.IsZero() && now.Before(x.nextRedelivery.Add(x.redeliveryInterval)) {
+		return
+	}
+
+	tick := &internalpb.RedeliveryTick{ProducerId: x.producerID}
+	if err := ctx.ActorSystem().ScheduleOnce(context.WithoutCancel(ctx.Context()), tick, ctx.Self(), x.redeliveryInterval); err != nil {
+		ctx.Logger().Errorf("%s failed to schedule the redelivery: %v", x.producerID, err)
+		return
+	}
+	x.nextRedelivery = now.Add(x.redeliveryInterval)
+}
+
+// confirmedSequenceNumber returns the sequence number up to which all the deliveries
+// have been either confirmed or given up
+func (x *AtLeastOnceDelivery) confirmedSequenceNumber() uint64 {
+	if len(x.unconfirmed) == 0 {
+		return x.sequenceNumber
+	}
+	return slices.Min(slices.Collect(maps.Keys(x.unconfirmed))) - 1
+}
+
+// DeliveryDeduplicator is used by the recipients of at-least-once deliveries
+// to acknowledge the deliveries and drop the duplicates.
+//
+// Duplicates are detected by producer and sequence number. The deduplication state is held in memory,
+// which means a message redelivered after the recipient restarted may be processed twice.
+// DeliveryDeduplicator is meant to be held by the recipient actor and is not safe for concurrent use:
+//
+//	func (x *Consumer) Receive(ctx *ReceiveContext) {
+//	    message, ok := x.deduplicator.Receive(ctx)
+//	    if !ok {
+//	        return
+//	    }
+//	    switch msg := message.(type) {
+//	    case *PlaceOrder:
+//	        // handle the order
+//	    }
+//	}
+type DeliveryDeduplicator struct {
+	windows map[string]*deliveryWindow
+}
+
+// deliveryWindow tracks the sequence numbers received from a producer
+type deliveryWindow struct {
+	// all the sequence numbers up to confirmed have been received or given up
+	confirmed uint64
+	// sequence numbers received above confirmed
+	received map[uint64]struct{}
+}
+
+// NewDeliveryDeduplicator creates an instance of DeliveryDeduplicator
+func NewDeliveryDeduplicator() *DeliveryDeduplicator {
+	return &DeliveryDeduplicator{
+		windows: make(map[string]*deliveryWindow),
+	}
+}
+
+// Receive acknowledges the at-least-once deliveries and unwraps their actual message.
+//
+// It returns the message to process and true, or false when the message is a duplicate and must be dropped.
+// Messages that have not been sent with at-least-once delivery are returned as-is.
+func (x *DeliveryDeduplicator) Receive(ctx *ReceiveContext) (any, bool) {
+	delivery, ok := ctx.Message().(*internalpb.Delivery)
+	if !ok {
+		return ctx.Message(), true
+	}
+
+	message, err := ctx.ActorSystem().getSerializers().Deserialize(delivery.GetMessage())
+	if err != nil {
+		ctx.Err(err)
+		return nil, false
+	}
+
+	acknowledge(ctx, delivery)
+
+	key := delivery.GetProducerId() + "/" + delivery.GetIncarnation()
+	window, ok := x.windows[key]
+	if !ok {
+		window = &deliveryWindow{received: make(map[uint64]struct{})}
+		x.windows[key] = window
+	}
+
+	window.advance(delivery.GetConfirmedSequenceNumber())
+	if !window.add(delivery.GetSequenceNumber()) {
+		return nil, false
+	}
+	return message, true
+}
+
+// acknowledge sends the delivery acknowledgement back to the producer
+func acknowledge(ctx *ReceiveContext, delivery *internalpb.Delivery) {
+	ack := &internalpb.DeliveryAck{
+		ProducerId:     delivery.GetProducerId(),
+		SequenceNumber: delivery.GetSequenceNumber(),
+		Incarnation:    delivery.GetIncarnation(),
+	}
+
+	var (
+		self         = ctx.Self()
+		goCtx        = context.WithoutCancel(ctx.Context())
+		sender       = ctx.Sender()
+		remoteSender = ctx.RemoteSender()
+		err          error
+	)
+
+	switch {
+	case sender != nil && !sender.Equals(NoSender):
+		err = self.Tell(goCtx, sender, ack)
+	case remoteSender != nil && !remoteSender.Equals(address.NoSender()):
+		err = self.RemoteTell(goCtx, remoteSender, ack)
+	default:
+		return
+	}
+
+	if err != nil {
+		ctx.Logger().Warnf("%s failed to acknowledge delivery=(%d): %v", self.Name(), delivery.GetSequenceNumber(), err)
+	}
+}
+
+// advance moves the window forward to the given confirmed sequence number
+func (w *deliveryWindow) advance(confirmed uint64) {
+	if confirmed <= w.confirmed {
+		return
+	}
+
+	w.confirmed = confirmed
+	for sequenceNumber := range w.received {
+		if sequenceNumber <= confirmed {
+			delete(w.received, sequenceNumber)
+		}
+	}
+	w.compact()
+}
+
+// add records the given sequence number. It returns false when it has already been received
+func (w *deliveryWindow) add(sequenceNumber uint64) bool {
+	if sequenceNumber <= w.confirmed {
+		return false
+	}
+
+	if _, ok := w.received[sequenceNumber]; ok {
+		return false
+	}
+
+	w.received[sequenceNumber] = struct{}{}
+	w.compact()
+	return true
+}
+
+// compact moves the confirmed sequence number forward over the contiguous received sequence numbers
+func (w *deliveryWindow) compact() {
+	for {
+		if _, ok := w.received[w.confirmed+1]; !ok {
+			return
+		}
+		delete(w.received, w.confirmed+1)
+		w.confirmed++
+	}
+}
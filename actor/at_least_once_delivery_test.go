@@ -0,0 +1,318 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestAtLeastOnceDelivery(t *testing.T) {
+	t.Run("With lost deliveries redelivered", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		consumer := NewMockDeliveryConsumer(2)
+		_, err = actorSystem.Spawn(ctx, "consumer", consumer)
+		require.NoError(t, err)
+
+		delivery := NewAtLeastOnceDelivery("producer", WithRedeliveryInterval(200*time.Millisecond))
+		producer, err := actorSystem.Spawn(ctx, "producer", NewMockDeliveryProducer("consumer", delivery))
+		require.NoError(t, err)
+
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+
+		pause.For(time.Second)
+
+		received := collectValues(consumer.received)
+		assert.ElementsMatch(t, []int32{1, 2}, received)
+
+		reply, err := Ask(ctx, producer, new(testpb.TestGetCount), time.Second)
+		require.NoError(t, err)
+		assert.Zero(t, reply.(*testpb.TestCount).GetValue())
+		assert.EqualValues(t, 2, delivery.SequenceNumber())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With maximum delivery attempts", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		delivery := NewAtLeastOnceDelivery("producer",
+			WithRedeliveryInterval(100*time.Millisecond),
+			WithMaxDeliveryAttempts(2))
+		mock := NewMockDeliveryProducer("unknown", delivery)
+		producer, err := actorSystem.Spawn(ctx, "producer", mock)
+		require.NoError(t, err)
+
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+
+		select {
+		case failed := <-mock.failed:
+			assert.Equal(t, "producer", failed.GetProducerId())
+			assert.EqualValues(t, 1, failed.GetSequenceNumber())
+			assert.Equal(t, "unknown", failed.GetRecipient())
+			assert.EqualValues(t, 2, failed.GetAttempts())
+			message, err := failed.GetMessage().UnmarshalNew()
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(&testpb.TestCount{Value: 1}, message))
+			assert.Equal(t, "testpb.TestCount", failed.GetMessageType())
+		case <-time.After(2 * time.Second):
+			t.Fatal("delivery failure not received")
+		}
+
+		assert.Zero(t, delivery.Unconfirmed())
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With messages that are not protocol buffers messages", func(t *testing.T) {
+		ctx := context.TODO()
+		store := persistence.NewMemoryDeliveryStore()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithDeliveryStore(store),
+			WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0],
+				remote.WithSerializer(new(MockGreeting), remote.NewJSONSerializer()))))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		delivery := NewAtLeastOnceDelivery("producer",
+			WithRedeliveryInterval(100*time.Millisecond),
+			WithMaxDeliveryAttempts(2))
+		mock := NewMockDeliveryProducer("unknown", delivery)
+		mock.message = &MockGreeting{Name: "delivery"}
+		producer, err := actorSystem.Spawn(ctx, "producer", mock)
+		require.NoError(t, err)
+
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+		pause.For(50 * time.Millisecond)
+
+		manifest := actorSystem.getSerializers().Manifest(mock.message)
+		_, deliveries, err := store.GetDeliveries(ctx, "producer")
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, manifest, deliveries[0].Manifest)
+		assert.JSONEq(t, `{"name":"delivery"}`, string(deliveries[0].Message))
+
+		select {
+		case failed := <-mock.failed:
+			assert.Nil(t, failed.GetMessage())
+			assert.Equal(t, manifest, failed.GetMessageType())
+		case <-time.After(2 * time.Second):
+			t.Fatal("delivery failure not received")
+		}
+
+		// messages without any serializer cannot be delivered
+		mock = NewMockDeliveryProducer("unknown", NewAtLeastOnceDelivery("other"))
+		mock.message = MockGreeting{Name: "delivery"}
+		producer, err = actorSystem.Spawn(ctx, "other", mock)
+		require.NoError(t, err)
+
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+
+		select {
+		case err := <-mock.errs:
+			assert.ErrorIs(t, err, remote.ErrSerializerNotFound)
+		case <-time.After(time.Second):
+			t.Fatal("error not received")
+		}
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With maximum unconfirmed deliveries", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		delivery := NewAtLeastOnceDelivery("producer", WithMaxUnconfirmedDeliveries(1))
+		mock := NewMockDeliveryProducer("unknown", delivery)
+		producer, err := actorSystem.Spawn(ctx, "producer", mock)
+		require.NoError(t, err)
+
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+
+		select {
+		case err := <-mock.errs:
+			assert.ErrorIs(t, err, ErrMaxUnconfirmedDeliveries)
+		case <-time.After(time.Second):
+			t.Fatal("error not received")
+		}
+
+		assert.Equal(t, 1, delivery.Unconfirmed())
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With delivery store", func(t *testing.T) {
+		ctx := context.TODO()
+		store := persistence.NewMemoryDeliveryStore()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithDeliveryStore(store))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		producer, err := actorSystem.Spawn(ctx, "producer",
+			NewMockDeliveryProducer("consumer", NewAtLeastOnceDelivery("producer")))
+		require.NoError(t, err)
+
+		// the consumer does not exist yet
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+		pause.For(500 * time.Millisecond)
+
+		sequenceNumber, deliveries, err := store.GetDeliveries(ctx, "producer")
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, sequenceNumber)
+		require.Len(t, deliveries, 1)
+
+		// the producer is stopped before the message is confirmed
+		require.NoError(t, actorSystem.Kill(ctx, "producer"))
+
+		consumer := NewMockDeliveryConsumer(0)
+		_, err = actorSystem.Spawn(ctx, "consumer", consumer)
+		require.NoError(t, err)
+
+		// a new instance of the producer redelivers the unconfirmed message
+		producer, err = actorSystem.Spawn(ctx, "producer",
+			NewMockDeliveryProducer("consumer", NewAtLeastOnceDelivery("producer")))
+		require.NoError(t, err)
+
+		pause.For(500 * time.Millisecond)
+
+		assert.Equal(t, []int32{1}, collectValues(consumer.received))
+		reply, err := Ask(ctx, producer, new(testpb.TestGetCount), time.Second)
+		require.NoError(t, err)
+		assert.Zero(t, reply.(*testpb.TestCount).GetValue())
+
+		// sequence numbers are not reused, hence the new message is not mistaken for a duplicate
+		require.NoError(t, Tell(ctx, producer, new(testpb.TestSend)))
+		pause.For(500 * time.Millisecond)
+		assert.Equal(t, []int32{1}, collectValues(consumer.received))
+
+		sequenceNumber, deliveries, err = store.GetDeliveries(ctx, "producer")
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, sequenceNumber)
+		assert.Empty(t, deliveries)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+}
+
+func TestDeliveryDeduplicator(t *testing.T) {
+	t.Run("With duplicates dropped", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		consumer := NewMockDeliveryConsumer(0)
+		pid, err := actorSystem.Spawn(ctx, "consumer", consumer)
+		require.NoError(t, err)
+
+		newDelivery := func(producerID string, sequenceNumber uint64, value int32) *internalpb.Delivery {
+			message, _ := actorSystem.getSerializers().Serialize(&testpb.TestCount{Value: value})
+			return &internalpb.Delivery{
+				ProducerId:     producerID,
+				SequenceNumber: sequenceNumber,
+				Message:        message,
+			}
+		}
+
+		require.NoError(t, Tell(ctx, pid, newDelivery("producer", 1, 1)))
+		require.NoError(t, Tell(ctx, pid, newDelivery("producer", 1, 1)))
+		require.NoError(t, Tell(ctx, pid, newDelivery("producer", 3, 3)))
+		require.NoError(t, Tell(ctx, pid, newDelivery("producer", 2, 2)))
+		require.NoError(t, Tell(ctx, pid, newDelivery("producer", 3, 3)))
+		// other producers have their own sequence numbers
+		require.NoError(t, Tell(ctx, pid, newDelivery("other", 1, 10)))
+		// regular messages are passed through
+		require.NoError(t, Tell(ctx, pid, &testpb.TestCount{Value: 20}))
+
+		pause.For(500 * time.Millisecond)
+		assert.Equal(t, []int32{1, 3, 2, 10, 20}, collectValues(consumer.received))
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With delivery window", func(t *testing.T) {
+		window := &deliveryWindow{received: make(map[uint64]struct{})}
+		assert.True(t, window.add(2))
+		assert.True(t, window.add(4))
+		assert.Zero(t, window.confirmed)
+
+		assert.True(t, window.add(1))
+		assert.EqualValues(t, 2, window.confirmed)
+		assert.False(t, window.add(1))
+		assert.False(t, window.add(4))
+
+		// the producer gave up on the third delivery
+		window.advance(3)
+		assert.EqualValues(t, 4, window.confirmed)
+		assert.Empty(t, window.received)
+		assert.False(t, window.add(3))
+		assert.True(t, window.add(5))
+	})
+}
+
+// collectValues drains the given channel
+func collectValues(ch chan int32) []int32 {
+	var values []int32
+	for {
+		select {
+		case value := <-ch:
+			values = append(values, value)
+		default:
+			return values
+		}
+	}
+}
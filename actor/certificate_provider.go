@@ -0,0 +1,151 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+)
+
+// CertificateProvider provides the certificate a node presents during TLS handshakes.
+//
+// Certificate is called on every handshake, by the remoting and the cluster servers and clients,
+// so that rotated certificates are used by new connections without restarting the node.
+// Implementations must be safe for concurrent use and must return quickly.
+type CertificateProvider interface {
+	// Certificate returns the current certificate
+	Certificate() (*tls.Certificate, error)
+}
+
+// FileCertificateProvider is a CertificateProvider that reads the certificate from PEM encoded files.
+//
+// The files are reloaded whenever their modification time changes. When the files cannot be
+// loaded, for instance while they are being replaced, the previously loaded certificate is kept.
+type FileCertificateProvider struct {
+	certFile    string
+	keyFile     string
+	mu          sync.Mutex
+	certificate *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+// enforce compilation error
+var _ CertificateProvider = (*FileCertificateProvider)(nil)
+
+// NewFileCertificateProvider creates an instance of FileCertificateProvider reading the certificate
+// and its private key from the given PEM encoded files. An error is returned when the files cannot be loaded.
+func NewFileCertificateProvider(certFile, keyFile string) (*FileCertificateProvider, error) {
+	provider := &FileCertificateProvider{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	if err := provider.reload(); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// Certificate returns the current certificate, reloading the files when they have changed
+func (p *FileCertificateProvider) Certificate() (*tls.Certificate, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// keep the previous certificate when the new one cannot be loaded yet
+	_ = p.reload()
+	return p.certificate, nil
+}
+
+// reload loads the certificate files when they have changed since the last load
+func (p *FileCertificateProvider) reload() error {
+	certInfo, err := os.Stat(p.certFile)
+	if err != nil {
+		return err
+	}
+
+	keyInfo, err := os.Stat(p.keyFile)
+	if err != nil {
+		return err
+	}
+
+	if p.certificate != nil && certInfo.ModTime().Equal(p.certModTime) && keyInfo.ModTime().Equal(p.keyModTime) {
+		return nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(p.certFile, p.keyFile)
+	if err != nil {
+		return err
+	}
+
+	p.certificate = &certificate
+	p.certModTime = certInfo.ModTime()
+	p.keyModTime = keyInfo.ModTime()
+	return nil
+}
+
+// MemoryCertificateProvider is a CertificateProvider holding the certificate in memory.
+// It is meant to be fed by an external source such as a secrets manager.
+type MemoryCertificateProvider struct {
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+}
+
+// enforce compilation error
+var _ CertificateProvider = (*MemoryCertificateProvider)(nil)
+
+// NewMemoryCertificateProvider creates an instance of MemoryCertificateProvider with the given initial certificate
+func NewMemoryCertificateProvider(certificate *tls.Certificate) *MemoryCertificateProvider {
+	return &MemoryCertificateProvider{certificate: certificate}
+}
+
+// SetCertificate replaces the current certificate. New connections use the given certificate
+// while the existing connections keep the certificate they were established with.
+func (p *MemoryCertificateProvider) SetCertificate(certificate *tls.Certificate) {
+	p.mu.Lock()
+	p.certificate = certificate
+	p.mu.Unlock()
+}
+
+// SetKeyPair parses the given PEM encoded certificate and private key and replaces the current certificate
+func (p *MemoryCertificateProvider) SetKeyPair(certPEM, keyPEM []byte) error {
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+	p.SetCertificate(&certificate)
+	return nil
+}
+
+// Certificate returns the current certificate
+func (p *MemoryCertificateProvider) Certificate() (*tls.Certificate, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.certificate == nil {
+		return nil, ErrCertificateNotFound
+	}
+	return p.certificate, nil
+}
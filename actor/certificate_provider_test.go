@@ -0,0 +1,190 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCertificateProvider(t *testing.T) {
+	t.Run("With certificate rotation", func(t *testing.T) {
+		ca := newTestCertificateAuthority(t)
+		dir := t.TempDir()
+		certFile := filepath.Join(dir, "node.crt")
+		keyFile := filepath.Join(dir, "node.key")
+
+		certPEM, keyPEM := ca.issue(t, "node-1")
+		require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+		require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+
+		provider, err := NewFileCertificateProvider(certFile, keyFile)
+		require.NoError(t, err)
+
+		certificate, err := provider.Certificate()
+		require.NoError(t, err)
+		assert.Equal(t, "node-1", certificate.Leaf.Subject.CommonName)
+
+		// rotate the certificate
+		certPEM, keyPEM = ca.issue(t, "node-2")
+		require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+		require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+		modTime := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+		require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+
+		certificate, err = provider.Certificate()
+		require.NoError(t, err)
+		assert.Equal(t, "node-2", certificate.Leaf.Subject.CommonName)
+
+		// a broken certificate keeps the previous one
+		require.NoError(t, os.WriteFile(certFile, []byte("invalid"), 0o600))
+		modTime = modTime.Add(time.Minute)
+		require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+
+		certificate, err = provider.Certificate()
+		require.NoError(t, err)
+		assert.Equal(t, "node-2", certificate.Leaf.Subject.CommonName)
+	})
+	t.Run("With missing files", func(t *testing.T) {
+		dir := t.TempDir()
+		provider, err := NewFileCertificateProvider(filepath.Join(dir, "node.crt"), filepath.Join(dir, "node.key"))
+		require.Error(t, err)
+		assert.Nil(t, provider)
+	})
+}
+
+func TestMemoryCertificateProvider(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+
+	provider := NewMemoryCertificateProvider(nil)
+	certificate, err := provider.Certificate()
+	require.ErrorIs(t, err, ErrCertificateNotFound)
+	assert.Nil(t, certificate)
+
+	certPEM, keyPEM := ca.issue(t, "node-1")
+	require.NoError(t, provider.SetKeyPair(certPEM, keyPEM))
+
+	certificate, err = provider.Certificate()
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", certificate.Leaf.Subject.CommonName)
+
+	require.Error(t, provider.SetKeyPair([]byte("invalid"), keyPEM))
+
+	certificate, err = provider.Certificate()
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", certificate.Leaf.Subject.CommonName)
+}
+
+// testCertificateAuthority is a certificate authority used to issue test certificates
+type testCertificateAuthority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pool        *x509.CertPool
+}
+
+func newTestCertificateAuthority(t *testing.T) *testCertificateAuthority {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "goakt-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+
+	return &testCertificateAuthority{
+		certificate: certificate,
+		key:         key,
+		pool:        pool,
+	}
+}
+
+// issue returns a PEM encoded certificate and private key valid for both client and server authentication
+func (ca *testCertificateAuthority) issue(t *testing.T, commonName string) (certPEM []byte, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM
+}
+
+// keyPair returns a TLS certificate issued by the certificate authority
+func (ca *testCertificateAuthority) keyPair(t *testing.T, commonName string) *tls.Certificate {
+	t.Helper()
+	certificate, err := tls.X509KeyPair(ca.issue(t, commonName))
+	require.NoError(t, err)
+	return &certificate
+}
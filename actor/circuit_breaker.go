@@ -0,0 +1,494 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/cluster"
+	"github.com/tochemey/goakt/v3/internal/registry"
+)
+
+const (
+	// DefaultCircuitBreakerFailureRate defines the default failure rate above which a circuit breaker opens
+	DefaultCircuitBreakerFailureRate = 0.5
+	// DefaultCircuitBreakerWindowSize defines the default number of calls recorded by a circuit breaker
+	DefaultCircuitBreakerWindowSize = 100
+	// DefaultCircuitBreakerMinimumCalls defines the default number of calls a circuit breaker needs before computing its rates
+	DefaultCircuitBreakerMinimumCalls = 10
+	// DefaultCircuitBreakerOpenTimeout defines the default duration a circuit breaker stays open
+	DefaultCircuitBreakerOpenTimeout = 10 * time.Second
+	// DefaultCircuitBreakerHalfOpenMaxCalls defines the default number of trial calls of a half-open circuit breaker
+	DefaultCircuitBreakerHalfOpenMaxCalls = 3
+)
+
+// circuitBreakerIdleTimeout defines how long a closed circuit breaker is kept without being used
+const circuitBreakerIdleTimeout = 5 * time.Minute
+
+// CircuitBreakerOption defines the various options to apply to a circuit breaker
+type CircuitBreakerOption func(*circuitBreakerConfig)
+
+// WithFailureRateThreshold sets the rate of failed calls, between 0 and 1, at which the circuit breaker opens.
+// A call fails when it returns an error, e.g. when it times out.
+// The default value is DefaultCircuitBreakerFailureRate.
+func WithFailureRateThreshold(rate float64) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.failureRate = rate
+	}
+}
+
+// WithSlowCallThreshold sets the duration above which a call is considered slow, even when it succeeds,
+// and the rate of slow calls, between 0 and 1, at which the circuit breaker opens.
+// Slow calls are not tracked by default.
+func WithSlowCallThreshold(duration time.Duration, rate float64) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.slowCallDuration = duration
+		c.slowCallRate = rate
+	}
+}
+
+// WithSlidingWindow sets the number of latest calls the rates are computed on, and the minimum
+// number of calls required before the circuit breaker can open.
+// The default values are DefaultCircuitBreakerWindowSize and DefaultCircuitBreakerMinimumCalls.
+func WithSlidingWindow(size, minimumCalls int) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.windowSize = size
+		c.minimumCalls = minimumCalls
+	}
+}
+
+// WithOpenTimeout sets how long the circuit breaker rejects the calls once open, before letting trial calls through.
+// The default value is DefaultCircuitBreakerOpenTimeout.
+func WithOpenTimeout(timeout time.Duration) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.openTimeout = timeout
+	}
+}
+
+// WithHalfOpenMaxCalls sets the number of trial calls let through by the half-open circuit breaker.
+// The circuit breaker closes when all of them succeed and opens again as soon as one of them fails.
+// The default value is DefaultCircuitBreakerHalfOpenMaxCalls.
+func WithHalfOpenMaxCalls(calls int) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.halfOpenMaxCalls = calls
+	}
+}
+
+// circuitBreakerConfig defines the circuit breaker settings
+type circuitBreakerConfig struct {
+	failureRate      float64
+	slowCallDuration time.Duration
+	slowCallRate     float64
+	windowSize       int
+	minimumCalls     int
+	openTimeout      time.Duration
+	halfOpenMaxCalls int
+}
+
+// newCircuitBreakerConfig creates an instance of circuitBreakerConfig
+func newCircuitBreakerConfig(opts ...CircuitBreakerOption) *circuitBreakerConfig {
+	config := &circuitBreakerConfig{
+		failureRate:      DefaultCircuitBreakerFailureRate,
+		windowSize:       DefaultCircuitBreakerWindowSize,
+		minimumCalls:     DefaultCircuitBreakerMinimumCalls,
+		openTimeout:      DefaultCircuitBreakerOpenTimeout,
+		halfOpenMaxCalls: DefaultCircuitBreakerHalfOpenMaxCalls,
+	}
+
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	config.windowSize = max(config.windowSize, 1)
+	config.minimumCalls = min(max(config.minimumCalls, 1), config.windowSize)
+	config.halfOpenMaxCalls = max(config.halfOpenMaxCalls, 1)
+	return config
+}
+
+// callOutcome defines the outcome of a call recorded by the circuit breaker
+type callOutcome struct {
+	failed bool
+	slow   bool
+}
+
+// circuitBreaker guards the calls made to a given target.
+//
+// It uses a count-based sliding window: once the window holds the minimum number of calls,
+// the circuit breaker opens when the failure rate or the slow call rate reaches its threshold.
+// While open, the calls are rejected with a CircuitOpenError. After the open timeout, a limited
+// number of trial calls is let through: the circuit breaker closes when all of them succeed
+// and opens again otherwise.
+type circuitBreaker struct {
+	mu     sync.Mutex
+	target string
+	config *circuitBreakerConfig
+	state  goaktpb.CircuitBreakerState
+
+	outcomes []callOutcome
+	next     int
+	failures int
+	slows    int
+
+	openedAt          time.Time
+	lastUsedAt        time.Time
+	halfOpenCalls     int
+	halfOpenSucceeded int
+	// generation is bumped at every state change so that late outcomes are ignored
+	generation uint64
+
+	onStateChange func(event *goaktpb.CircuitBreakerStateChanged)
+}
+
+// newCircuitBreaker creates an instance of circuitBreaker for the given target
+func newCircuitBreaker(target string, config *circuitBreakerConfig, onStateChange func(event *goaktpb.CircuitBreakerStateChanged)) *circuitBreaker {
+	return &circuitBreaker{
+		target:        target,
+		config:        config,
+		state:         goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED,
+		outcomes:      make([]callOutcome, 0, config.windowSize),
+		lastUsedAt:    time.Now(),
+		onStateChange: onStateChange,
+	}
+}
+
+// allow returns a CircuitOpenError when the call must be rejected.
+// It returns the generation of the circuit breaker the outcome of the call must be recorded with
+func (b *circuitBreaker) allow() (generation uint64, err error) {
+	if b == nil {
+		return 0, nil
+	}
+
+	b.mu.Lock()
+	var event *goaktpb.CircuitBreakerStateChanged
+	defer func() {
+		b.mu.Unlock()
+		b.notify(event)
+	}()
+
+	b.lastUsedAt = time.Now()
+	switch b.state {
+	case goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN:
+		elapsed := time.Since(b.openedAt)
+		if elapsed < b.config.openTimeout {
+			return 0, NewCircuitOpenError(b.target, b.config.openTimeout-elapsed)
+		}
+		event = b.transition(goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN)
+	case goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN:
+		if b.halfOpenCalls >= b.config.halfOpenMaxCalls {
+			return 0, NewCircuitOpenError(b.target, 0)
+		}
+	default:
+		return b.generation, nil
+	}
+
+	b.halfOpenCalls++
+	return b.generation, nil
+}
+
+// record records the outcome of a call let through by the circuit breaker with the given generation.
+// The outcomes of the calls let through before the last state change are ignored
+func (b *circuitBreaker) record(generation uint64, err error, elapsed time.Duration) {
+	if b == nil {
+		return
+	}
+
+	outcome := callOutcome{
+		failed: err != nil,
+		slow:   b.config.slowCallDuration > 0 && elapsed >= b.config.slowCallDuration,
+	}
+
+	b.mu.Lock()
+	var event *goaktpb.CircuitBreakerStateChanged
+	defer func() {
+		b.mu.Unlock()
+		b.notify(event)
+	}()
+
+	if generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN:
+		if outcome.failed || outcome.slow {
+			event = b.transition(goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN)
+			return
+		}
+
+		b.halfOpenSucceeded++
+		if b.halfOpenSucceeded >= b.config.halfOpenMaxCalls {
+			event = b.transition(goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED)
+		}
+	case goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED:
+		b.push(outcome)
+		if len(b.outcomes) < b.config.minimumCalls {
+			return
+		}
+
+		calls := float64(len(b.outcomes))
+		failureRate := float64(b.failures) / calls
+		slowCallRate := float64(b.slows) / calls
+		if failureRate >= b.config.failureRate ||
+			(b.config.slowCallDuration > 0 && slowCallRate >= b.config.slowCallRate) {
+			event = b.transition(goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN)
+		}
+	}
+}
+
+// State returns the circuit breaker state
+func (b *circuitBreaker) State() goaktpb.CircuitBreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// idle returns true when the circuit breaker is closed and has not been used for the given duration
+func (b *circuitBreaker) idle(timeout time.Duration) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state == goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED &&
+		time.Since(b.lastUsedAt) >= timeout
+}
+
+// push adds the given outcome to the sliding window
+func (b *circuitBreaker) push(outcome callOutcome) {
+	if len(b.outcomes) < b.config.windowSize {
+		b.outcomes = append(b.outcomes, outcome)
+	} else {
+		evicted := b.outcomes[b.next]
+		if evicted.failed {
+			b.failures--
+		}
+		if evicted.slow {
+			b.slows--
+		}
+		b.outcomes[b.next] = outcome
+		b.next = (b.next + 1) % b.config.windowSize
+	}
+
+	if outcome.failed {
+		b.failures++
+	}
+	if outcome.slow {
+		b.slows++
+	}
+}
+
+// transition moves the circuit breaker to the given state and resets its counters.
+// It returns the state change event to publish once the lock is released
+func (b *circuitBreaker) transition(state goaktpb.CircuitBreakerState) *goaktpb.CircuitBreakerStateChanged {
+	event := &goaktpb.CircuitBreakerStateChanged{
+		Target:    b.target,
+		From:      b.state,
+		To:        state,
+		ChangedAt: timestamppb.Now(),
+	}
+
+	b.state = state
+	b.generation++
+	b.outcomes = b.outcomes[:0]
+	b.next = 0
+	b.failures = 0
+	b.slows = 0
+	b.halfOpenCalls = 0
+	b.halfOpenSucceeded = 0
+	if state == goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN {
+		b.openedAt = time.Now()
+	}
+	return event
+}
+
+// notify publishes the given state change event
+func (b *circuitBreaker) notify(event *goaktpb.CircuitBreakerStateChanged) {
+	if event != nil && b.onStateChange != nil {
+		b.onStateChange(event)
+	}
+}
+
+// circuitBreakers holds the circuit breakers of the actor system, one per target.
+//
+// The settings of a target are looked up by its name first, then by its kind,
+// and finally fall back to the default settings when set.
+// Closed circuit breakers that have not been used for the idle timeout are evicted,
+// so that short-lived targets do not accumulate.
+// The kinds looked up are cached per target for the idle timeout, including the kinds
+// that do not match any settings, so that the lookup is not repeated on every request
+type circuitBreakers struct {
+	defaults      *circuitBreakerConfig
+	configs       map[string]*circuitBreakerConfig
+	breakers      sync.Map
+	kinds         sync.Map
+	onStateChange func(event *goaktpb.CircuitBreakerStateChanged)
+	idleTimeout   time.Duration
+	lastEvictedAt atomic.Time
+	evicting      atomic.Bool
+}
+
+// newCircuitBreakers creates an instance of circuitBreakers
+func newCircuitBreakers(defaults *circuitBreakerConfig, configs map[string]*circuitBreakerConfig, onStateChange func(event *goaktpb.CircuitBreakerStateChanged)) *circuitBreakers {
+	return &circuitBreakers{
+		defaults:      defaults,
+		configs:       configs,
+		onStateChange: onStateChange,
+		idleTimeout:   circuitBreakerIdleTimeout,
+	}
+}
+
+// get returns the circuit breaker of the given target, creating it when needed.
+// It returns nil when no circuit breaker applies to the target
+func (x *circuitBreakers) get(target, name, kind string) *circuitBreaker {
+	return x.resolve(target, name, func() (string, bool) { return kind, true })
+}
+
+// resolve returns the circuit breaker of the given target, creating it when needed.
+// The kind of the target is only looked up when its settings cannot be found by name,
+// and the lookup returns false when the kind cannot be determined at the moment.
+// It returns nil when no circuit breaker applies to the target or when its kind cannot be determined
+func (x *circuitBreakers) resolve(target, name string, kind func() (string, bool)) *circuitBreaker {
+	if x == nil {
+		return nil
+	}
+
+	x.evictIdle()
+	if breaker, ok := x.breakers.Load(target); ok {
+		return breaker.(*circuitBreaker)
+	}
+
+	config, ok := x.configs[name]
+	if !ok && len(x.configs) > 0 {
+		resolved, found := x.kindOf(target, kind)
+		if !found {
+			// do not guard the target with the default settings until its kind is known
+			return nil
+		}
+		config, ok = x.configs[resolved]
+	}
+
+	if !ok {
+		config = x.defaults
+	}
+
+	if config == nil {
+		return nil
+	}
+
+	breaker, _ := x.breakers.LoadOrStore(target, newCircuitBreaker(target, config, x.onStateChange))
+	return breaker.(*circuitBreaker)
+}
+
+// kindOf returns the kind of the given target, looking it up when it is not cached.
+// A kind is only cached when the lookup succeeds
+func (x *circuitBreakers) kindOf(target string, kind func() (string, bool)) (string, bool) {
+	if cached, ok := x.kinds.Load(target); ok {
+		return cached.(*resolvedKind).kind, true
+	}
+
+	resolved, ok := kind()
+	if ok {
+		x.kinds.Store(target, &resolvedKind{kind: resolved, resolvedAt: time.Now()})
+	}
+	return resolved, ok
+}
+
+// evictIdle removes the idle circuit breakers and the kinds cached for longer than the idle timeout.
+// It runs at most once per idle timeout
+func (x *circuitBreakers) evictIdle() {
+	if time.Since(x.lastEvictedAt.Load()) < x.idleTimeout || !x.evicting.CompareAndSwap(false, true) {
+		return
+	}
+
+	defer x.evicting.Store(false)
+	x.lastEvictedAt.Store(time.Now())
+	x.breakers.Range(func(target, breaker any) bool {
+		if breaker.(*circuitBreaker).idle(x.idleTimeout) {
+			x.breakers.CompareAndDelete(target, breaker)
+		}
+		return true
+	})
+	x.kinds.Range(func(target, kind any) bool {
+		if time.Since(kind.(*resolvedKind).resolvedAt) >= x.idleTimeout {
+			x.kinds.CompareAndDelete(target, kind)
+		}
+		return true
+	})
+}
+
+// resolvedKind is the kind of a target cached by the circuit breakers
+type resolvedKind struct {
+	kind       string
+	resolvedAt time.Time
+}
+
+// enabled returns true when at least one circuit breaker setting is defined
+func (x *circuitBreakers) enabled() bool {
+	return x != nil && (x.defaults != nil || len(x.configs) > 0)
+}
+
+// actorCircuitBreaker returns the circuit breaker guarding the requests to the given local actor
+func actorCircuitBreaker(system ActorSystem, to *PID) *circuitBreaker {
+	if system == nil {
+		return nil
+	}
+
+	breakers := system.getCircuitBreakers()
+	if !breakers.enabled() {
+		return nil
+	}
+	return breakers.get(to.Address().String(), to.Name(), registry.Name(to.Actor()))
+}
+
+// remoteCircuitBreaker returns the circuit breaker guarding the requests to the given remote actor.
+// In cluster mode the kind of the actor is resolved from its cluster record
+func remoteCircuitBreaker(ctx context.Context, system ActorSystem, to *address.Address) *circuitBreaker {
+	if system == nil {
+		return nil
+	}
+
+	breakers := system.getCircuitBreakers()
+	if !breakers.enabled() {
+		return nil
+	}
+
+	return breakers.resolve(to.String(), to.Name(), func() (string, bool) {
+		if !system.InCluster() {
+			return "", true
+		}
+
+		actor, err := system.getCluster().GetActor(ctx, to.Name())
+		if err != nil {
+			// the actor not being found is a definitive answer, unlike a failed lookup
+			return "", errors.Is(err, cluster.ErrActorNotFound)
+		}
+		return actor.GetType(), true
+	})
+}
@@ -0,0 +1,355 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/internal/registry"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	failure := errors.New("failure")
+	call := func(breaker *circuitBreaker, err error, elapsed time.Duration) error {
+		generation, allowErr := breaker.allow()
+		if allowErr != nil {
+			return allowErr
+		}
+		breaker.record(generation, err, elapsed)
+		return nil
+	}
+
+	t.Run("With failure rate threshold", func(t *testing.T) {
+		var (
+			mu     sync.Mutex
+			events []*goaktpb.CircuitBreakerStateChanged
+		)
+
+		config := newCircuitBreakerConfig(
+			WithFailureRateThreshold(0.5),
+			WithSlidingWindow(4, 4),
+			WithOpenTimeout(200*time.Millisecond),
+			WithHalfOpenMaxCalls(2))
+
+		breaker := newCircuitBreaker("target", config, func(event *goaktpb.CircuitBreakerStateChanged) {
+			mu.Lock()
+			events = append(events, event)
+			mu.Unlock()
+		})
+
+		require.NoError(t, call(breaker, nil, 0))
+		require.NoError(t, call(breaker, failure, 0))
+		require.NoError(t, call(breaker, nil, 0))
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED, breaker.State())
+
+		// the minimum number of calls is reached with a failure rate of 50%
+		require.NoError(t, call(breaker, failure, 0))
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, breaker.State())
+
+		err := call(breaker, nil, 0)
+		require.ErrorIs(t, err, ErrCircuitOpen)
+		var openErr *CircuitOpenError
+		require.ErrorAs(t, err, &openErr)
+		assert.Equal(t, "target", openErr.Target())
+		assert.Positive(t, openErr.RetryAfter())
+
+		pause.For(300 * time.Millisecond)
+
+		// only the trial calls are let through
+		generation, err := breaker.allow()
+		require.NoError(t, err)
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN, breaker.State())
+		_, err = breaker.allow()
+		require.NoError(t, err)
+		_, err = breaker.allow()
+		require.ErrorIs(t, err, ErrCircuitOpen)
+
+		breaker.record(generation, nil, 0)
+		breaker.record(generation, nil, 0)
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED, breaker.State())
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, events, 3)
+		assert.Equal(t, "target", events[0].GetTarget())
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED, events[0].GetFrom())
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, events[0].GetTo())
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN, events[1].GetTo())
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED, events[2].GetTo())
+	})
+	t.Run("With failed trial call", func(t *testing.T) {
+		config := newCircuitBreakerConfig(
+			WithSlidingWindow(2, 2),
+			WithOpenTimeout(100*time.Millisecond))
+		breaker := newCircuitBreaker("target", config, nil)
+
+		require.NoError(t, call(breaker, failure, 0))
+		require.NoError(t, call(breaker, failure, 0))
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, breaker.State())
+
+		pause.For(200 * time.Millisecond)
+
+		require.NoError(t, call(breaker, failure, 0))
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, breaker.State())
+		require.ErrorIs(t, call(breaker, nil, 0), ErrCircuitOpen)
+	})
+	t.Run("With slow call threshold", func(t *testing.T) {
+		config := newCircuitBreakerConfig(
+			WithSlowCallThreshold(100*time.Millisecond, 0.5),
+			WithSlidingWindow(4, 2))
+		breaker := newCircuitBreaker("target", config, nil)
+
+		require.NoError(t, call(breaker, nil, 10*time.Millisecond))
+		require.NoError(t, call(breaker, nil, 10*time.Millisecond))
+		require.NoError(t, call(breaker, nil, time.Second))
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED, breaker.State())
+
+		require.NoError(t, call(breaker, nil, time.Second))
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, breaker.State())
+	})
+	t.Run("With sliding window", func(t *testing.T) {
+		config := newCircuitBreakerConfig(WithSlidingWindow(4, 4))
+		breaker := newCircuitBreaker("target", config, nil)
+
+		// the old failures are evicted from the window
+		require.NoError(t, call(breaker, failure, 0))
+		for range 10 {
+			require.NoError(t, call(breaker, nil, 0))
+		}
+		require.NoError(t, call(breaker, failure, 0))
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED, breaker.State())
+		assert.Equal(t, 1, breaker.failures)
+	})
+	t.Run("With late outcomes of calls let through before a state change", func(t *testing.T) {
+		config := newCircuitBreakerConfig(
+			WithSlidingWindow(2, 2),
+			WithOpenTimeout(100*time.Millisecond),
+			WithHalfOpenMaxCalls(1))
+		breaker := newCircuitBreaker("target", config, nil)
+
+		// a call is let through while the circuit breaker is closed
+		late, err := breaker.allow()
+		require.NoError(t, err)
+
+		require.NoError(t, call(breaker, failure, 0))
+		require.NoError(t, call(breaker, failure, 0))
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, breaker.State())
+
+		pause.For(200 * time.Millisecond)
+
+		trial, err := breaker.allow()
+		require.NoError(t, err)
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN, breaker.State())
+
+		// the late outcome does not count as a trial call
+		breaker.record(late, nil, 0)
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN, breaker.State())
+		breaker.record(late, failure, 0)
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN, breaker.State())
+
+		breaker.record(trial, nil, 0)
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED, breaker.State())
+	})
+	t.Run("With invalid settings", func(t *testing.T) {
+		config := newCircuitBreakerConfig(WithSlidingWindow(0, 10), WithHalfOpenMaxCalls(-1))
+		assert.Equal(t, 1, config.windowSize)
+		assert.Equal(t, 1, config.minimumCalls)
+		assert.Equal(t, 1, config.halfOpenMaxCalls)
+	})
+	t.Run("With nil circuit breaker", func(t *testing.T) {
+		var breaker *circuitBreaker
+		generation, err := breaker.allow()
+		assert.NoError(t, err)
+		assert.NotPanics(t, func() { breaker.record(generation, failure, 0) })
+	})
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Run("With settings lookup", func(t *testing.T) {
+		byName := newCircuitBreakerConfig(WithOpenTimeout(time.Second))
+		byKind := newCircuitBreakerConfig(WithOpenTimeout(time.Minute))
+		defaults := newCircuitBreakerConfig()
+
+		breakers := newCircuitBreakers(defaults, map[string]*circuitBreakerConfig{
+			"name": byName,
+			"kind": byKind,
+		}, nil)
+
+		assert.Same(t, byName, breakers.get("target1", "name", "kind").config)
+		assert.Same(t, byKind, breakers.get("target2", "other", "kind").config)
+		assert.Same(t, defaults, breakers.get("target3", "other", "other").config)
+		// the circuit breakers are created once per target
+		assert.Same(t, breakers.get("target1", "name", "kind"), breakers.get("target1", "name", "kind"))
+
+		// the kind is only resolved when the settings are not found by name
+		resolved := 0
+		kind := func() (string, bool) {
+			resolved++
+			return "kind", true
+		}
+		assert.Same(t, byName, breakers.resolve("target4", "name", kind).config)
+		assert.Zero(t, resolved)
+		assert.Same(t, byKind, breakers.resolve("target5", "other", kind).config)
+		assert.Equal(t, 1, resolved)
+
+		// no circuit breaker is built from a failed kind lookup
+		failed := func() (string, bool) {
+			resolved++
+			return "", false
+		}
+		assert.Nil(t, breakers.resolve("target6", "other", failed))
+		assert.Same(t, byKind, breakers.resolve("target6", "other", kind).config)
+
+		breakers = newCircuitBreakers(nil, map[string]*circuitBreakerConfig{"name": byName}, nil)
+		assert.True(t, breakers.enabled())
+		assert.Nil(t, breakers.get("target", "other", "other"))
+
+		// the kind that does not match any settings is cached per target
+		resolved = 0
+		assert.Nil(t, breakers.resolve("target7", "other", kind))
+		assert.Nil(t, breakers.resolve("target7", "other", kind))
+		assert.Equal(t, 1, resolved)
+
+		breakers = newCircuitBreakers(nil, nil, nil)
+		assert.False(t, breakers.enabled())
+	})
+	t.Run("With idle circuit breakers evicted", func(t *testing.T) {
+		breakers := newCircuitBreakers(newCircuitBreakerConfig(
+			WithSlidingWindow(1, 1),
+			WithOpenTimeout(time.Minute)), nil, nil)
+		breakers.idleTimeout = 100 * time.Millisecond
+
+		idle := breakers.get("idle", "idle", "kind")
+		opened := breakers.get("opened", "opened", "kind")
+		generation, err := opened.allow()
+		require.NoError(t, err)
+		opened.record(generation, assert.AnError, 0)
+		require.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, opened.State())
+
+		pause.For(200 * time.Millisecond)
+		active := breakers.get("active", "active", "kind")
+
+		// only the idle closed circuit breaker is evicted
+		assert.NotSame(t, idle, breakers.get("idle", "idle", "kind"))
+		assert.Same(t, opened, breakers.get("opened", "opened", "kind"))
+		assert.Same(t, active, breakers.get("active", "active", "kind"))
+	})
+	t.Run("With Ask", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithKindCircuitBreaker(NewMockActor(),
+				WithSlidingWindow(2, 2),
+				WithOpenTimeout(time.Minute)))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		subscriber, err := actorSystem.Subscribe()
+		require.NoError(t, err)
+
+		pid, err := actorSystem.Spawn(ctx, "test", NewMockActor())
+		require.NoError(t, err)
+
+		// unhandled messages are never replied to
+		for range 2 {
+			_, err = Ask(ctx, pid, new(testpb.TestBye), 100*time.Millisecond)
+			require.ErrorIs(t, err, ErrRequestTimeout)
+		}
+
+		start := time.Now()
+		_, err = Ask(ctx, pid, new(testpb.TestReply), time.Second)
+		require.ErrorIs(t, err, ErrCircuitOpen)
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+		// the circuit breaker is per target
+		other, err := actorSystem.Spawn(ctx, "other", NewMockActor())
+		require.NoError(t, err)
+		_, err = Ask(ctx, other, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+
+		// actors of other kinds are not guarded
+		supervised, err := actorSystem.Spawn(ctx, "supervised", NewMockSupervised())
+		require.NoError(t, err)
+		assert.Nil(t, actorCircuitBreaker(actorSystem, supervised))
+
+		pause.For(100 * time.Millisecond)
+
+		var events []*goaktpb.CircuitBreakerStateChanged
+		for message := range subscriber.Iterator() {
+			if event, ok := message.Payload().(*goaktpb.CircuitBreakerStateChanged); ok {
+				events = append(events, event)
+			}
+		}
+
+		require.Len(t, events, 1)
+		assert.Equal(t, pid.Address().String(), events[0].GetTarget())
+		assert.Equal(t, goaktpb.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, events[0].GetTo())
+
+		require.NoError(t, actorSystem.Unsubscribe(subscriber))
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With AskGrain", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithCircuitBreaker(WithSlidingWindow(2, 2), WithOpenTimeout(time.Minute)))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		identity, err := actorSystem.GrainIdentity(ctx, "grain", func(context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, registry.Name(NewMockGrain()), identity.Kind())
+
+		for range 2 {
+			_, err = actorSystem.AskGrain(ctx, identity, new(testpb.TestBye), time.Second)
+			require.Error(t, err)
+		}
+
+		_, err = actorSystem.AskGrain(ctx, identity, new(testpb.TestReply), time.Second)
+		require.ErrorIs(t, err, ErrCircuitOpen)
+		var openErr *CircuitOpenError
+		require.ErrorAs(t, err, &openErr)
+		assert.Equal(t, identity.String(), openErr.Target())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+}
@@ -25,6 +25,7 @@
 package actor
 
 import (
+	"slices"
 	"time"
 
 	"github.com/tochemey/goakt/v3/discovery"
@@ -32,6 +33,7 @@ import (
 	"github.com/tochemey/goakt/v3/internal/registry"
 	"github.com/tochemey/goakt/v3/internal/size"
 	"github.com/tochemey/goakt/v3/internal/validation"
+	"github.com/tochemey/goakt/v3/persistence"
 )
 
 // defaultKinds defines the default system kinds
@@ -59,6 +61,10 @@ type ClusterConfig struct {
 	bootstrapTimeout         time.Duration
 	clusterStateSyncInterval time.Duration
 	peersStateSyncInterval   time.Duration
+	rememberedGrainStore     persistence.RememberedGrainStore
+	splitBrainResolver       *SplitBrainResolver
+	roles                    []string
+	singletonLeaseDuration   time.Duration
 }
 
 // enforce compilation error
@@ -81,6 +87,7 @@ func NewClusterConfig() *ClusterConfig {
 		bootstrapTimeout:         DefaultClusterBootstrapTimeout,
 		clusterStateSyncInterval: DefaultClusterStateSyncInterval,
 		peersStateSyncInterval:   DefaultPeerStateSyncInterval,
+		singletonLeaseDuration:   DefaultSingletonLeaseDuration,
 	}
 
 	fnActor := new(FuncActor)
@@ -277,6 +284,110 @@ func (x *ClusterConfig) WithPeersStateSyncInterval(interval time.Duration) *Clus
 	return x
 }
 
+// WithRememberedGrains sets the store used to remember the long-lived grains of the cluster.
+//
+// Grains activated with WithLongLivedGrain only live in memory and disappear when the whole cluster
+// is restarted. When a store is set, every long-lived grain is recorded in the store on activation and
+// removed from it when it is deactivated, except when the deactivation is caused by its node shutting down.
+// Once a node has started, and again whenever a node joins the cluster, it reactivates in the background the recorded
+// grains whose partition it owns and that are not active yet, so that the grains are spread across the nodes of the cluster.
+// A grain bound to a role is reactivated on a node having that role, along with its dependencies.
+// Use WithMinimumPeersQuorum to make sure enough nodes have joined the cluster before the grains are reactivated.
+//
+// The grain kinds must be registered using WithGrains and the store must be shared by all the nodes of the cluster.
+//
+// Example usage:
+//
+//	cfg := NewClusterConfig().
+//		WithGrains(new(DeviceSession)).
+//		WithRememberedGrains(persistence.NewFileRememberedGrainStore("/mnt/shared/grains"))
+//
+// Returns the updated ClusterConfig instance for chaining.
+func (x *ClusterConfig) WithRememberedGrains(store persistence.RememberedGrainStore) *ClusterConfig {
+	x.rememberedGrainStore = store
+	return x
+}
+
+// RememberedGrainStore returns the store used to remember the long-lived grains of the cluster when set
+func (x *ClusterConfig) RememberedGrainStore() persistence.RememberedGrainStore {
+	return x.rememberedGrainStore
+}
+
+// WithSplitBrainResolver sets the split brain resolver of the cluster.
+//
+// WithMinimumPeersQuorum only gates the cluster startup. Once a network partition happens, both sides of the
+// cluster keep running on their own and the singleton and relocatable actors get duplicated. The split brain
+// resolver waits for the cluster membership to be stable and decides with its strategy which side survives.
+// The nodes of the losing side either shut down or switch to read-only. All the nodes of the cluster must use the
+// same resolver settings.
+//
+// Example usage:
+//
+//	cfg := NewClusterConfig().
+//		WithSplitBrainResolver(NewSplitBrainResolver(KeepMajority).WithStableAfter(30 * time.Second))
+//
+// Returns the updated ClusterConfig instance for chaining.
+func (x *ClusterConfig) WithSplitBrainResolver(resolver *SplitBrainResolver) *ClusterConfig {
+	x.splitBrainResolver = resolver
+	return x
+}
+
+// SplitBrainResolver returns the split brain resolver of the cluster when set
+func (x *ClusterConfig) SplitBrainResolver() *SplitBrainResolver {
+	return x.splitBrainResolver
+}
+
+// WithRoles sets the roles of the cluster node.
+//
+// Roles let the nodes of a cluster play different parts, for instance "edge" and "worker" nodes.
+// Actors spawned with the WithRole option and grains activated with the WithGrainRole option
+// are only placed on the nodes having the given role, including when they are relocated.
+// A node without roles only hosts the actors and grains that do not require any role.
+//
+// Example usage:
+//
+//	cfg := NewClusterConfig().
+//		WithRoles("worker", "gpu")
+//
+// Returns the updated ClusterConfig instance for chaining.
+func (x *ClusterConfig) WithRoles(roles ...string) *ClusterConfig {
+	for _, role := range roles {
+		if !slices.Contains(x.roles, role) {
+			x.roles = append(x.roles, role)
+		}
+	}
+	return x
+}
+
+// Roles returns the roles of the cluster node
+func (x *ClusterConfig) Roles() []string {
+	return x.roles
+}
+
+// WithSingletonLeaseDuration sets the duration of the cluster lease held by the node hosting a singleton actor.
+//
+// The lease guarantees that two instances of a singleton actor never run at once. It is renewed while the singleton
+// actor is running and a node creating a singleton actor waits at most the lease duration for the lease held by another
+// node to expire. A shorter duration lets a singleton actor be taken over sooner when its node crashes, but the lease
+// is renewed more often and may be lost when the cluster is slow to respond.
+//
+// The default value is DefaultSingletonLeaseDuration.
+//
+// Example usage:
+//
+//	cfg := NewClusterConfig().WithSingletonLeaseDuration(5 * time.Second)
+//
+// Returns the updated ClusterConfig instance for chaining.
+func (x *ClusterConfig) WithSingletonLeaseDuration(duration time.Duration) *ClusterConfig {
+	x.singletonLeaseDuration = duration
+	return x
+}
+
+// SingletonLeaseDuration returns the duration of the cluster lease held by the node hosting a singleton actor
+func (x *ClusterConfig) SingletonLeaseDuration() time.Duration {
+	return x.singletonLeaseDuration
+}
+
 // ClusterStateSyncInterval returns the interval at which the cluster synchronizes its routing tables across all nodes.
 //
 // This interval determines how frequently the cluster updates its internal routing information to reflect changes
@@ -467,5 +578,8 @@ func (x *ClusterConfig) Validate() error {
 		AddAssertion(x.replicaCount >= 1, "cluster replicaCount is invalid").
 		AddAssertion(x.writeQuorum >= 1, "cluster writeQuorum is invalid").
 		AddAssertion(x.readQuorum >= 1, "cluster readQuorum is invalid").
+		AddAssertion(!slices.Contains(x.roles, ""), "cluster node role is invalid").
+		AddAssertion(x.singletonLeaseDuration > 0, "singleton lease duration is invalid").
+		AddValidator(validation.NewConditionalValidator(x.splitBrainResolver != nil, x.splitBrainResolver)).
 		Validate()
 }
@@ -33,6 +33,7 @@ import (
 
 	"github.com/tochemey/goakt/v3/internal/size"
 	testkit "github.com/tochemey/goakt/v3/mocks/discovery"
+	"github.com/tochemey/goakt/v3/persistence"
 )
 
 func TestClusterConfig(t *testing.T) {
@@ -87,6 +88,7 @@ func TestClusterConfig(t *testing.T) {
 	t.Run("With happy path with Grains", func(t *testing.T) {
 		provider := new(testkit.Provider)
 		tempdir := t.TempDir()
+		store := persistence.NewMemoryRememberedGrainStore()
 
 		config := NewClusterConfig().
 			WithGrains(new(MockGrain)).
@@ -105,6 +107,7 @@ func TestClusterConfig(t *testing.T) {
 			WithBootstrapTimeout(10 * time.Second).
 			WithClusterStateSyncInterval(10 * time.Second).
 			WithPeersStateSyncInterval(10 * time.Second).
+			WithRememberedGrains(store).
 			WithDiscovery(provider)
 
 		require.NoError(t, config.Validate())
@@ -128,6 +131,7 @@ func TestClusterConfig(t *testing.T) {
 		assert.Exactly(t, uint64(10*size.MB), config.TableSize())
 		assert.True(t, provider == config.Discovery())
 		assert.Len(t, config.Grains(), 1)
+		assert.True(t, store == config.RememberedGrainStore())
 	})
 	t.Run("With invalid config setting", func(t *testing.T) {
 		config := NewClusterConfig().
@@ -141,4 +145,63 @@ func TestClusterConfig(t *testing.T) {
 
 		assert.Error(t, config.Validate())
 	})
+	t.Run("With invalid split brain resolver", func(t *testing.T) {
+		config := NewClusterConfig().
+			WithKinds(new(exchanger), new(MockActor)).
+			WithDiscoveryPort(3220).
+			WithPeersPort(3222).
+			WithMinimumPeersQuorum(1).
+			WithReplicaCount(1).
+			WithPartitionCount(3).
+			WithDiscovery(new(testkit.Provider)).
+			WithSplitBrainResolver(NewSplitBrainResolver(StaticQuorum)) // missing quorum size
+
+		assert.NotNil(t, config.SplitBrainResolver())
+		assert.Error(t, config.Validate())
+	})
+	t.Run("With roles", func(t *testing.T) {
+		config := NewClusterConfig().
+			WithKinds(new(exchanger), new(MockActor)).
+			WithDiscoveryPort(3220).
+			WithPeersPort(3222).
+			WithMinimumPeersQuorum(1).
+			WithReplicaCount(1).
+			WithPartitionCount(3).
+			WithDiscovery(new(testkit.Provider)).
+			WithRoles("payments", "api", "payments")
+
+		require.NoError(t, config.Validate())
+		assert.Equal(t, []string{"payments", "api"}, config.Roles())
+	})
+	t.Run("With singleton lease duration", func(t *testing.T) {
+		config := NewClusterConfig().
+			WithKinds(new(exchanger), new(MockActor)).
+			WithDiscoveryPort(3220).
+			WithPeersPort(3222).
+			WithMinimumPeersQuorum(1).
+			WithReplicaCount(1).
+			WithPartitionCount(3).
+			WithDiscovery(new(testkit.Provider))
+		assert.Equal(t, DefaultSingletonLeaseDuration, config.SingletonLeaseDuration())
+
+		config.WithSingletonLeaseDuration(5 * time.Second)
+		require.NoError(t, config.Validate())
+		assert.Equal(t, 5*time.Second, config.SingletonLeaseDuration())
+
+		config.WithSingletonLeaseDuration(0)
+		assert.Error(t, config.Validate())
+	})
+	t.Run("With invalid role", func(t *testing.T) {
+		config := NewClusterConfig().
+			WithKinds(new(exchanger), new(MockActor)).
+			WithDiscoveryPort(3220).
+			WithPeersPort(3222).
+			WithMinimumPeersQuorum(1).
+			WithReplicaCount(1).
+			WithPartitionCount(3).
+			WithDiscovery(new(testkit.Provider)).
+			WithRoles("payments", "")
+
+		assert.Error(t, config.Validate())
+	})
 }
@@ -26,10 +26,15 @@ package actor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/tochemey/goakt/v3/goaktpb"
 	"github.com/tochemey/goakt/v3/internal/cluster"
+	"github.com/tochemey/goakt/v3/internal/collection"
 	"github.com/tochemey/goakt/v3/internal/registry"
 	"github.com/tochemey/goakt/v3/log"
 	"github.com/tochemey/goakt/v3/remote"
@@ -110,7 +115,7 @@ func (x *actorSystem) spawnSingletonManager(ctx context.Context) error {
 	return nil
 }
 
-func (x *actorSystem) spawnSingletonOnLeader(ctx context.Context, cl cluster.Interface, name string, actor Actor) error {
+func (x *actorSystem) spawnSingletonOnLeader(ctx context.Context, cl cluster.Interface, name string, actor Actor, config *spawnConfig) error {
 	peers, err := cl.Peers(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to spawn singleton actor: %w", err)
@@ -129,15 +134,104 @@ func (x *actorSystem) spawnSingletonOnLeader(ctx context.Context, cl cluster.Int
 		return ErrLeaderNotFound
 	}
 
-	var (
-		actorType = registry.Name(actor)
-		host      = leader.Host
-		port      = leader.RemotingPort
-	)
+	return x.spawnSingletonOn(ctx, leader, name, actor, config)
+}
 
-	return x.remoting.RemoteSpawn(ctx, host, port, &remote.SpawnRequest{
+// spawnSingletonOn creates the singleton actor on the given cluster node
+// with the factory it was created with, if any
+func (x *actorSystem) spawnSingletonOn(ctx context.Context, peer *cluster.Peer, name string, actor Actor, config *spawnConfig) error {
+	return x.remoting.RemoteSpawn(ctx, peer.Host, peer.RemotingPort, &remote.SpawnRequest{
 		Name:      name,
-		Kind:      actorType,
+		Kind:      registry.Name(actor),
 		Singleton: true,
+		Role:      config.role,
+		Factory:   config.factory,
+		Args:      config.factoryArgs,
+	})
+}
+
+// oldestWithRole returns the oldest cluster member having the given role,
+// leaving out the members that are leaving the cluster
+func (x *actorSystem) oldestWithRole(ctx context.Context, role string) (*cluster.Peer, error) {
+	members, err := x.getCluster().Members(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to spawn singleton actor: %w", err)
+	}
+
+	members = collection.Filter(members, func(member *cluster.Peer) bool {
+		return member.HasRole(role) && !x.getCluster().HasLeft(member.PeerAddress())
 	})
+
+	if len(members) == 0 {
+		return nil, ErrRoleNotFound
+	}
+
+	return slices.MinFunc(members, func(a, b *cluster.Peer) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PeerAddress(), b.PeerAddress())
+	}), nil
+}
+
+// acquireSingletonLease acquires the cluster lease of the given singleton actor.
+// It waits for a lease duration so that the lease held by a node that has crashed
+// expires before the wait is over
+func (x *actorSystem) acquireSingletonLease(ctx context.Context, name string) (*cluster.Lease, error) {
+	duration := x.clusterConfig.SingletonLeaseDuration()
+	lease, err := x.getCluster().AcquireLease(ctx, singletonLeaseKey(name), duration, duration)
+	if err != nil {
+		if errors.Is(err, cluster.ErrLeaseNotAcquired) {
+			return nil, ErrSingletonAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to acquire singleton actor=(%s) lease: %w", name, err)
+	}
+
+	x.singletonLeases.Set(name, lease)
+	return lease, nil
+}
+
+// releaseSingletonLease releases the cluster lease of the given singleton actor when held by the node
+func (x *actorSystem) releaseSingletonLease(ctx context.Context, name string) {
+	lease, ok := x.singletonLeases.Get(name)
+	if !ok {
+		return
+	}
+
+	x.singletonLeases.Delete(name)
+	if err := lease.Release(context.WithoutCancel(ctx)); err != nil {
+		x.logger.Warnf("failed to release singleton actor=(%s) lease: %v", name, err)
+	}
+}
+
+// holdSingletonLease renews the lease of the given singleton actor until it is released,
+// which happens once the actor has stopped for good or when the node is shutting down.
+// The singleton actor is stopped when its lease cannot be renewed so that another node can safely take it over
+func (x *actorSystem) holdSingletonLease(pid *PID, lease *cluster.Lease) {
+	// the lease is renewed a few times per lease duration so that a slow renewal does not let it expire
+	duration := x.clusterConfig.SingletonLeaseDuration()
+	ticker := time.NewTicker(duration / 3)
+	defer ticker.Stop()
+
+	ctx := context.Background()
+	for range ticker.C {
+		// the lease has been released when the actor has stopped or the node is shutting down
+		if held, ok := x.singletonLeases.Get(pid.Name()); !ok || held != lease {
+			return
+		}
+
+		if err := lease.Renew(ctx, duration); err != nil {
+			x.logger.Errorf("failed to renew singleton actor=(%s) lease: %v", pid.Name(), err)
+			x.singletonLeases.Delete(pid.Name())
+			if err := pid.Shutdown(ctx); err != nil {
+				x.logger.Warnf("failed to stop singleton actor=(%s): %v", pid.Name(), err)
+			}
+			return
+		}
+	}
+}
+
+// singletonLeaseKey returns the cluster lease key of the given singleton actor
+func singletonLeaseKey(name string) string {
+	return fmt.Sprintf("singleton:%s", name)
 }
@@ -26,6 +26,7 @@ package actor
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/travisjeffery/go-dynaport"
 
+	"github.com/tochemey/goakt/v3/internal/cluster"
 	"github.com/tochemey/goakt/v3/internal/pause"
 	"github.com/tochemey/goakt/v3/log"
 	"github.com/tochemey/goakt/v3/remote"
@@ -81,6 +83,92 @@ func TestSingletonActor(t *testing.T) {
 		// shutdown the nats server gracefully
 		srv.Shutdown()
 	})
+	t.Run("With Singleton Actor holding the cluster lease", func(t *testing.T) {
+		// create a context
+		ctx := context.TODO()
+		// start the NATS server
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+		require.NotNil(t, sd1)
+
+		cl2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl2)
+		require.NotNil(t, sd2)
+
+		pause.For(time.Second)
+
+		actorName := "actorID"
+		require.NoError(t, cl1.SpawnSingleton(ctx, actorName, NewMockActor()))
+
+		// the lease is held by the node hosting the singleton actor
+		engine := cl2.getCluster()
+		lease, err := engine.AcquireLease(ctx, singletonLeaseKey(actorName), time.Second, 0)
+		require.ErrorIs(t, err, cluster.ErrLeaseNotAcquired)
+		require.Nil(t, lease)
+
+		// the lease is still held after the first renewal
+		pause.For(DefaultSingletonLeaseDuration / 2)
+		lease, err = engine.AcquireLease(ctx, singletonLeaseKey(actorName), time.Second, 0)
+		require.ErrorIs(t, err, cluster.ErrLeaseNotAcquired)
+		require.Nil(t, lease)
+
+		// the lease is released once the singleton actor stops
+		require.NoError(t, cl1.Kill(ctx, actorName))
+		require.Eventually(t, func() bool {
+			lease, err = engine.AcquireLease(ctx, singletonLeaseKey(actorName), time.Second, 0)
+			return err == nil
+		}, DefaultSingletonLeaseDuration, 500*time.Millisecond)
+		require.NoError(t, lease.Release(ctx))
+
+		// free resources
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		require.NoError(t, cl2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		// shutdown the nats server gracefully
+		srv.Shutdown()
+	})
+	t.Run("With Singleton Actor keeping the cluster lease when restarted or suspended", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+		require.NotNil(t, sd1)
+
+		cl2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl2)
+		require.NotNil(t, sd2)
+
+		pause.For(time.Second)
+
+		actorName := "actorID"
+		require.NoError(t, cl1.SpawnSingleton(ctx, actorName, NewMockActor()))
+
+		pid, err := cl1.LocalActor(actorName)
+		require.NoError(t, err)
+		require.NoError(t, pid.Restart(ctx))
+		require.True(t, pid.IsRunning())
+
+		pid.suspend("test")
+		require.False(t, pid.IsRunning())
+
+		// the lease is still held past its duration
+		pause.For(DefaultSingletonLeaseDuration + time.Second)
+		engine := cl2.getCluster()
+		lease, err := engine.AcquireLease(ctx, singletonLeaseKey(actorName), time.Second, 0)
+		require.ErrorIs(t, err, cluster.ErrLeaseNotAcquired)
+		require.Nil(t, lease)
+
+		// free resources
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		require.NoError(t, cl2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
 	t.Run("With Singleton Actor when cluster is not enabled returns error", func(t *testing.T) {
 		ctx := context.TODO()
 		remotingPort := dynaport.Get(1)[0]
@@ -128,6 +216,14 @@ func TestSingletonActor(t *testing.T) {
 		err := cl1.SpawnSingleton(ctx, actorName, actor)
 		require.Error(t, err)
 
+		// no proxy is created for a singleton actor that could not be placed
+		err = cl1.SpawnSingleton(ctx, "singleton", actor, WithRole("unknown"))
+		require.ErrorIs(t, err, ErrRoleNotFound)
+		sys := cl1.(*actorSystem)
+		proxyName := fmt.Sprintf("%s-%s", sys.reservedName(singletonProxyType), "singleton")
+		_, ok := sys.actors.node(sys.actorAddress(proxyName).String())
+		require.False(t, ok)
+
 		// free resources
 		require.NoError(t, cl1.Stop(ctx))
 		require.NoError(t, sd1.Close())
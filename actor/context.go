@@ -27,6 +27,8 @@ package actor
 import (
 	"context"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/tochemey/goakt/v3/extension"
 )
 
@@ -47,6 +49,7 @@ type Context struct {
 	actorSystem  ActorSystem
 	actorName    string
 	dependencies []extension.Dependency
+	durableState *durableState
 }
 
 // newContext creates and returns a new Context instance.
@@ -129,3 +132,31 @@ func (x *Context) ActorName() string {
 func (x *Context) Dependencies() []extension.Dependency {
 	return x.dependencies
 }
+
+// LoadState returns the latest state of the actor from the durable state store
+// configured on the actor system using WithDurableStateStore.
+//
+// It is typically called in PreStart to bring the actor state back after a restart,
+// a passivation or a relocation. It returns nil when no state has been saved yet.
+//
+// Returns:
+//   - proto.Message: the latest saved state, or nil when none exists.
+//   - error: ErrDurableStateStoreNotSet when no durable state store is configured, or any store failure.
+func (x *Context) LoadState() (proto.Message, error) {
+	return x.durableState.load(x.ctx)
+}
+
+// SaveState writes the given state of the actor to the durable state store.
+//
+// It is typically called in PostStop to keep the actor state across passivation and relocation.
+// The write fails with persistence.ErrRevisionConflict when another instance of the actor has
+// saved its state since the last LoadState or SaveState call.
+//
+// Parameters:
+//   - state: the actor state to save.
+//
+// Returns:
+//   - error: ErrDurableStateStoreNotSet when no durable state store is configured, or any store failure.
+func (x *Context) SaveState(state proto.Message) error {
+	return x.durableState.save(x.ctx, state)
+}
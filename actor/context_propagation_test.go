@@ -0,0 +1,123 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestContextPropagation(t *testing.T) {
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+		SpanID:     trace.SpanID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		TraceFlags: trace.FlagsSampled,
+	})
+	tracedContext := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	t.Run("With headers injection and extraction", func(t *testing.T) {
+		propagator := propagation.TraceContext{}
+		headers := injectContext(tracedContext, propagator)
+		require.NotEmpty(t, headers)
+		assert.Contains(t, headers, "traceparent")
+
+		extracted := trace.SpanContextFromContext(extractContext(context.Background(), propagator, headers))
+		assert.Equal(t, spanContext.TraceID(), extracted.TraceID())
+		assert.Equal(t, spanContext.SpanID(), extracted.SpanID())
+		assert.True(t, extracted.IsRemote())
+	})
+	t.Run("With no propagated values", func(t *testing.T) {
+		ctx := context.Background()
+		assert.Nil(t, injectContext(ctx, propagation.TraceContext{}))
+		assert.Equal(t, ctx, extractContext(ctx, propagation.TraceContext{}, nil))
+	})
+	t.Run("With RemoteTell and RemoteAsk", func(t *testing.T) {
+		ctx := context.TODO()
+		ports := dynaport.Get(1)
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", ports[0])),
+			WithContextPropagator(propagation.TraceContext{}))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		actor := NewMockTracedActor()
+		pid, err := actorSystem.Spawn(ctx, "traced", actor)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingContextPropagator(propagation.TraceContext{}))
+		t.Cleanup(remoting.Close)
+
+		require.NoError(t, remoting.RemoteTell(tracedContext, pid.Address(), pid.Address(), new(testpb.TestSend)))
+		received := <-actor.spanContexts
+		assert.Equal(t, spanContext.TraceID(), received.TraceID())
+		assert.Equal(t, spanContext.SpanID(), received.SpanID())
+
+		_, err = remoting.RemoteAsk(tracedContext, pid.Address(), pid.Address(), new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		received = <-actor.spanContexts
+		assert.Equal(t, spanContext.TraceID(), received.TraceID())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With scheduled message", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithContextPropagator(propagation.TraceContext{}))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		actor := NewMockTracedActor()
+		pid, err := actorSystem.Spawn(ctx, "traced", actor)
+		require.NoError(t, err)
+
+		require.NoError(t, actorSystem.ScheduleOnce(tracedContext, new(testpb.TestSend), pid, 100*time.Millisecond))
+		select {
+		case received := <-actor.spanContexts:
+			assert.Equal(t, spanContext.TraceID(), received.TraceID())
+		case <-time.After(2 * time.Second):
+			t.Fatal("scheduled message not received")
+		}
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+}
@@ -138,6 +138,43 @@ func TestDeadletter(t *testing.T) {
 		err = sys.Stop(ctx)
 		assert.NoError(t, err)
 	})
+	t.Run("With messages that are not protocol buffers messages", func(t *testing.T) {
+		ctx := context.TODO()
+		sys, _ := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		consumer, err := sys.Subscribe()
+		require.NoError(t, err)
+
+		actorRef, err := sys.Spawn(ctx, "actor", &MockUnhandled{})
+		require.NoError(t, err)
+
+		pause.For(time.Second)
+
+		require.NoError(t, Tell(ctx, actorRef, new(testpb.TestSend)))
+		require.NoError(t, Tell(ctx, actorRef, "hello"))
+
+		pause.For(time.Second)
+
+		var items []*goaktpb.Deadletter
+		for message := range consumer.Iterator() {
+			if deadletter, ok := message.Payload().(*goaktpb.Deadletter); ok {
+				items = append(items, deadletter)
+			}
+		}
+
+		// the type of the message is set for any message
+		require.Len(t, items, 2)
+		assert.Equal(t, "testpb.TestSend", items[0].GetMessageType())
+		assert.NotNil(t, items[0].GetMessage())
+		assert.Equal(t, "string", items[1].GetMessageType())
+		assert.Nil(t, items[1].GetMessage())
+
+		require.NoError(t, sys.Unsubscribe(consumer))
+		require.NoError(t, sys.Stop(ctx))
+	})
 	t.Run("With GetDeadletters", func(t *testing.T) {
 		ctx := context.TODO()
 		sys, _ := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
@@ -96,7 +96,10 @@ func (x *deathWatch) handleTerminated(ctx *ReceiveContext) error {
 
 	if node, ok := x.tree.node(actorID); ok {
 		x.tree.deleteNode(node.value())
-		removeFromCluster := x.actorSystem.InCluster() && !isReservedName(actorName) && !x.actorSystem.isShuttingDown()
+		removeFromCluster := x.actorSystem.InCluster() &&
+			!isReservedName(actorName) &&
+			!x.actorSystem.isShuttingDown() &&
+			!x.actorSystem.isReadOnly()
 		if removeFromCluster {
 			if err := x.cluster.RemoveActor(context.WithoutCancel(ctx.Context()), node.value().Name()); err != nil {
 				x.logger.Errorf("%s failed to remove [actor=%s] from cluster: %v", x.pid.Name(), actorID, err)
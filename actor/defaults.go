@@ -45,12 +45,35 @@ const (
 	DefaultAskTimeout = 5 * time.Second
 	// DefaultMaxReadFrameSize defines the default HTTP maximum read frame size
 	DefaultMaxReadFrameSize = 16 * size.MB
+	// DefaultCompressMinSize defines the default minimum size of a remoting message to be compressed
+	DefaultCompressMinSize = size.KB
+	// DefaultStreamBatchSize defines the default maximum number of messages sent in a single batch over a remoting stream
+	DefaultStreamBatchSize = 256
+	// DefaultStreamWindow defines the default number of batches queued per remote node over a remoting stream
+	DefaultStreamWindow = 32
+	// DefaultStreamTimeout defines the default duration a remoting stream waits for the remote node before being canceled
+	DefaultStreamTimeout = 10 * time.Second
+	// DefaultStreamIdleTimeout defines the default duration a remoting stream or outbound queue stays open without any message sent
+	DefaultStreamIdleTimeout = time.Minute
 	// DefaultClusterBootstrapTimeout defines the default cluster bootstrap timeout
 	DefaultClusterBootstrapTimeout = 10 * time.Second
 	// DefaultClusterStateSyncInterval defines the default cluster state synchronization interval
 	DefaultClusterStateSyncInterval = time.Minute
 	// DefaultGrainRequestTimeout defines the default grain request timeout
 	DefaultGrainRequestTimeout = 5 * time.Second
+	// DefaultRedeliveryInterval defines the default interval between two deliveries of an unconfirmed message
+	DefaultRedeliveryInterval = 5 * time.Second
+	// DefaultSplitBrainStableAfter defines the default period the cluster membership must be stable for
+	// before the split brain resolver takes a decision
+	DefaultSplitBrainStableAfter = 20 * time.Second
+	// DefaultSingletonLeaseDuration defines the default duration of the lease held by a singleton actor.
+	// The lease is renewed while the singleton actor is running
+	DefaultSingletonLeaseDuration = 10 * time.Second
+	// DefaultSingletonProxyBufferSize defines the default maximum number of messages buffered by a singleton proxy
+	DefaultSingletonProxyBufferSize = 1000
+	// DefaultSingletonProxyRetryInterval defines the default interval between two attempts of a singleton proxy
+	// to deliver its buffered messages
+	DefaultSingletonProxyRetryInterval = 100 * time.Millisecond
 )
 
 var (
@@ -0,0 +1,501 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/cluster"
+	"github.com/tochemey/goakt/v3/internal/collection"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+)
+
+// HandoffActor defines an actor that passes its state along to its new incarnation
+// when it is moved to another cluster member while its node is drained.
+//
+// The state is handed off once the actor has stopped processing messages on the draining node,
+// and taken over by the new incarnation right after PreStart, before it processes any message.
+// The state must be a registered protocol buffer message on every node of the cluster.
+type HandoffActor interface {
+	Actor
+	// HandOff returns the state to pass along to the new incarnation of the actor.
+	// A nil state means that there is nothing to hand off.
+	HandOff() (proto.Message, error)
+	// TakeOver sets the actor state from the state handed off by its previous incarnation.
+	TakeOver(state proto.Message) error
+}
+
+// Drain gracefully removes the node from the cluster and stops the actor system.
+func (x *actorSystem) Drain(ctx context.Context) error {
+	if err := x.handOff(ctx); err != nil {
+		return err
+	}
+	return x.Stop(ctx)
+}
+
+// handOff announces the node as leaving the cluster and moves its relocatable actors
+// and its grains one by one to the other cluster members.
+// When the hand-off fails the node is no longer draining and the actors not handed off yet stay on the node.
+func (x *actorSystem) handOff(ctx context.Context) (err error) {
+	if !x.started.Load() {
+		return ErrActorSystemNotStarted
+	}
+
+	if !x.InCluster() {
+		return ErrClusterDisabled
+	}
+
+	if x.readOnly.Load() {
+		return ErrActorSystemReadOnly
+	}
+
+	if !x.draining.CompareAndSwap(false, true) {
+		return ErrActorSystemDraining
+	}
+
+	defer func() {
+		if err != nil {
+			x.cancelDrain(ctx)
+		}
+	}()
+
+	x.logger.Infof("node=[name=%s, addr=%s] is draining...", x.name, x.clusterNode.PeersAddress())
+
+	// let the cluster members know that no new actors should be placed on this node
+	if err := x.cluster.AnnounceLeaving(ctx); err != nil {
+		return fmt.Errorf("failed to announce node=(%s) leaving: %w", x.clusterNode.PeersAddress(), err)
+	}
+
+	peers, err := x.placementPeers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch cluster nodes: %w", err)
+	}
+
+	pids := collection.Filter(x.actors.children(x.userGuardian), func(pid *PID) bool {
+		return !isReservedName(pid.Name()) && !pid.IsSingleton() && pid.IsRelocatable()
+	})
+
+	grains := x.grainsToHandOff()
+
+	if len(pids)+len(grains) == 0 {
+		return nil
+	}
+
+	if len(peers) == 0 {
+		return fmt.Errorf("failed to drain node=(%s): %w", x.clusterNode.PeersAddress(), ErrNoPeersToHandOff)
+	}
+
+	// move the actors in a predictable order
+	slices.SortFunc(pids, func(a, b *PID) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	// actors and grains requiring a role are only handed off to the peers having that role
+	next := make(map[string]int)
+	nextPeer := func(role string) (*cluster.Peer, error) {
+		candidates := collection.Filter(peers, func(peer *cluster.Peer) bool {
+			return peer.HasRole(role)
+		})
+
+		if len(candidates) == 0 {
+			return nil, ErrRoleNotFound
+		}
+
+		peer := candidates[next[role]%len(candidates)]
+		next[role]++
+		return peer, nil
+	}
+
+	for _, pid := range pids {
+		peer, err := nextPeer(pid.role)
+		if err == nil {
+			err = x.handOffActor(ctx, pid, peer)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to hand off actor=(%s): %w", pid.Name(), err)
+		}
+	}
+
+	// the grains activated on this node while it was announced leaving are handed off as well
+	for ; len(grains) > 0; grains = x.grainsToHandOff() {
+		for _, pid := range grains {
+			peer, err := nextPeer(pid.config.role)
+			if err == nil {
+				err = x.handOffGrain(ctx, pid, peer)
+			}
+
+			if err != nil {
+				return fmt.Errorf("failed to hand off grain=(%s): %w", pid.identity.String(), err)
+			}
+		}
+	}
+
+	x.logger.Infof("node=[name=%s, addr=%s] successfully drained", x.name, x.clusterNode.PeersAddress())
+	return nil
+}
+
+// handOffActor moves the given actor to the given peer with the state it hands off, if any.
+// The actor holds the messages it receives while its new incarnation is created on the given peer,
+// then forwards them to its new incarnation before it stops. The actor resumes processing its messages
+// on this node when it cannot be created on the given peer.
+func (x *actorSystem) handOffActor(ctx context.Context, pid *PID, peer *cluster.Peer) error {
+	actor, err := marshalActor(pid)
+	if err != nil {
+		return err
+	}
+
+	release, err := pid.holdMessages(ctx)
+	if err != nil {
+		return err
+	}
+
+	var state *anypb.Any
+	if handoffActor, ok := pid.Actor().(HandoffActor); ok {
+		message, err := handoffActor.HandOff()
+		if err != nil {
+			release()
+			return err
+		}
+
+		if message != nil {
+			if state, err = anypb.New(message); err != nil {
+				release()
+				return err
+			}
+		}
+	}
+
+	// the actor stays registered in the cluster until its new incarnation is created
+	remoteClient := x.remoting.remotingServiceClient(peer.Host, peer.RemotingPort)
+	request := connect.NewRequest(&internalpb.RemoteSpawnRequest{
+		Host:                peer.Host,
+		Port:                int32(peer.RemotingPort),
+		ActorName:           pid.Name(),
+		ActorType:           actor.GetType(),
+		Relocatable:         true,
+		PassivationStrategy: actor.GetPassivationStrategy(),
+		Dependencies:        actor.GetDependencies(),
+		EnableStash:         actor.GetEnableStash(),
+		SnapshotInterval:    actor.GetSnapshotInterval(),
+		Factory:             actor.GetFactory(),
+		Args:                actor.GetArgs(),
+		HandOffState:        state,
+		Role:                actor.GetRole(),
+		HandOff:             true,
+	})
+
+	if _, err := remoteClient.RemoteSpawn(ctx, request); err != nil {
+		x.logger.Warnf("failed to hand off actor=(%s), it keeps running locally: %v", pid.Name(), err)
+		release()
+		return NewSpawnError(err)
+	}
+
+	to := address.New(pid.Name(), x.name, peer.Host, peer.RemotingPort)
+	pid.forwardTo(to)
+
+	// the new incarnation of the actor also registers itself, asynchronously
+	actor.Address = to.Address
+	if err := x.cluster.PutActor(ctx, actor); err != nil {
+		x.logger.Warnf("failed to register actor=(%s) handed off to node=(%s): %v", pid.Name(), peer.PeerAddress(), err)
+	}
+
+	// the actor is freed here rather than by the death watch so that it cannot
+	// free its new incarnation from the cluster
+	x.deathWatch.UnWatch(pid)
+	if err := pid.Shutdown(ctx); err != nil {
+		x.logger.Warnf("failed to cleanly stop actor=(%s) handed off to node=(%s): %v", pid.Name(), peer.PeerAddress(), err)
+	}
+	x.actors.deleteNode(pid)
+
+	x.logger.Infof("actor=(%s) successfully handed off to node=(%s)", pid.Name(), peer.PeerAddress())
+	return nil
+}
+
+// cancelDrain lets the cluster members place actors on this node again after a failed drain
+func (x *actorSystem) cancelDrain(ctx context.Context) {
+	if err := x.cluster.CancelLeaving(context.WithoutCancel(ctx)); err != nil {
+		x.logger.Warnf("failed to cancel node=(%s) leaving: %v", x.clusterNode.PeersAddress(), err)
+	}
+
+	x.draining.Store(false)
+	x.logger.Infof("node=[name=%s, addr=%s] is no longer draining", x.name, x.clusterNode.PeersAddress())
+}
+
+// handOffGrain moves the given grain to the given peer.
+// The grain holds the messages it receives while it is deactivated and activated again on the given peer,
+// then forwards them to its new activation. The grain is activated again on this node when it cannot be
+// activated on the given peer. It stays in the remembered grain store, if any, since it is only moved.
+func (x *actorSystem) handOffGrain(ctx context.Context, pid *grainPID, peer *cluster.Peer) error {
+	identity := pid.getIdentity()
+	grain, err := marshalGrain(pid, peer.Host, peer.RemotingPort)
+	if err != nil {
+		return err
+	}
+
+	release, err := pid.holdMessages(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := pid.doDeactivate(ctx, false); err != nil {
+		x.reactivateGrain(ctx, pid)
+		release()
+		return err
+	}
+
+	if err := x.activateGrainOn(ctx, peer, grain); err != nil {
+		x.logger.Warnf("failed to hand off grain=(%s), it is activated again locally: %v", identity.String(), err)
+		x.reactivateGrain(ctx, pid)
+		release()
+		return NewSpawnError(err)
+	}
+
+	pid.forwardTo(grain)
+
+	x.logger.Infof("grain=(%s) successfully handed off to node=(%s)", identity.String(), peer.PeerAddress())
+	return nil
+}
+
+// reactivateGrain activates again on this node the given grain that could not be handed off
+func (x *actorSystem) reactivateGrain(ctx context.Context, pid *grainPID) {
+	identity := pid.getIdentity()
+	if err := pid.activate(ctx); err != nil {
+		x.logger.Errorf("failed to activate grain=(%s) again: %v", identity.String(), err)
+		return
+	}
+
+	x.grains.Set(*identity, pid)
+	if err := x.putGrainOnCluster(pid); err != nil {
+		x.logger.Errorf("failed to register grain=(%s) in the cluster: %v", identity.String(), err)
+	}
+}
+
+// grainsToHandOff returns the grains still activated on this node.
+// A grain handed off is deactivated, hence it is no longer listed
+func (x *actorSystem) grainsToHandOff() []*grainPID {
+	return collection.Filter(x.grains.Values(), func(pid *grainPID) bool {
+		return !isReservedName(pid.identity.Name())
+	})
+}
+
+// placementPeers returns the cluster peers new actors can be placed on,
+// leaving out the peers that are leaving the cluster
+func (x *actorSystem) placementPeers(ctx context.Context) ([]*cluster.Peer, error) {
+	peers, err := x.cluster.Peers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return collection.Filter(peers, func(peer *cluster.Peer) bool {
+		return !x.cluster.HasLeft(peer.PeerAddress())
+	}), nil
+}
+
+// takeOver sets the actor state from the state handed off by its previous incarnation.
+// This is a no-op when there is no handed off state.
+func (pid *PID) takeOver() error {
+	if pid.handOffState == nil {
+		return nil
+	}
+
+	handoffActor, ok := pid.actor.(HandoffActor)
+	if !ok {
+		pid.handOffState = nil
+		return nil
+	}
+
+	state, err := pid.handOffState.UnmarshalNew()
+	if err != nil {
+		return fmt.Errorf("failed to decode actor=(%s) handed off state: %w", pid.Name(), err)
+	}
+
+	if err := handoffActor.TakeOver(state); err != nil {
+		return fmt.Errorf("failed to take over actor=(%s) handed off state: %w", pid.Name(), err)
+	}
+
+	// the handed off state only applies to the first start of the actor
+	pid.handOffState = nil
+	return nil
+}
+
+// holdMessages stops the actor from processing its messages, which are kept in its mailbox,
+// and returns the function that lets the actor process them again.
+// It waits for the actor to finish processing its current message, if any.
+func (pid *PID) holdMessages(ctx context.Context) (func(), error) {
+	for !pid.processing.CompareAndSwap(idle, busy) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	// the actor is not passivated while its messages are held
+	paused := pid.passivationPaused.Load() || isLongLivedPassivationStrategy(pid.passivationStrategy)
+	if !paused {
+		pid.pausePassivation()
+	}
+
+	return func() {
+		if !paused {
+			pid.resumePassivation()
+		}
+		pid.processing.Store(idle)
+		pid.schedule()
+	}, nil
+}
+
+// forwardTo forwards the messages held by the actor, and the ones it receives until it stops,
+// to its new incarnation located at the given address
+func (pid *PID) forwardTo(to *address.Address) {
+	pid.handedOffTo.Store(to)
+	if pid.stashBox != nil {
+		// the stashed messages are put back in the mailbox and forwarded as well
+		_ = pid.unstashAll()
+	}
+	pid.forwardMessages()
+}
+
+// forwardMessages forwards the messages in the mailbox of the actor to its new incarnation
+func (pid *PID) forwardMessages() {
+	to := pid.handedOffTo.Load()
+
+	pid.forwardLock.Lock()
+	defer pid.forwardLock.Unlock()
+
+	for received := pid.mailbox.Dequeue(); received != nil; received = pid.mailbox.Dequeue() {
+		pid.forward(received, to)
+		releaseContext(received)
+	}
+}
+
+// forward sends the given message to the new incarnation of the actor on behalf of its sender,
+// and passes the response along to the sender when it awaits one
+func (pid *PID) forward(received *ReceiveContext, to *address.Address) {
+	switch received.Message().(type) {
+	case *internalpb.Down, *goaktpb.PausePassivation, *goaktpb.ResumePassivation:
+		// the supervision and passivation signals only apply to this incarnation of the actor
+		return
+	}
+
+	from := address.NoSender()
+	if sender := received.Sender(); sender != nil && !sender.Equals(NoSender) {
+		from = sender.Address()
+	} else if remoteSender := received.RemoteSender(); remoteSender != nil {
+		from = remoteSender
+	}
+
+	if !received.awaitingReply {
+		if err := pid.remoting.RemoteTell(context.WithoutCancel(received.Context()), from, to, received.Message()); err != nil {
+			pid.toDeadletters(received, err)
+		}
+		return
+	}
+
+	response, err := pid.remoting.RemoteAsk(received.Context(), from, to, received.Message(), DefaultAskTimeout)
+	if err != nil {
+		pid.toDeadletters(received, err)
+		return
+	}
+	received.Response(response)
+}
+
+// holdMessages stops the grain from processing its messages, which are kept in its mailbox,
+// and returns the function that lets the grain process them again.
+// It waits for the grain to finish processing its current message, if any.
+func (pid *grainPID) holdMessages(ctx context.Context) (func(), error) {
+	for !pid.processing.CompareAndSwap(idle, busy) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	// the messages are kept while the grain is deactivated
+	pid.held.Store(true)
+	return func() {
+		pid.held.Store(false)
+		pid.processing.Store(idle)
+		pid.schedule()
+	}, nil
+}
+
+// forwardTo forwards the messages held by the grain, and the ones it receives afterward,
+// to its new activation
+func (pid *grainPID) forwardTo(to *internalpb.Grain) {
+	pid.handedOffTo.Store(to)
+	pid.forwardMessages()
+}
+
+// forwardMessages forwards the messages in the mailbox of the grain to its new activation
+func (pid *grainPID) forwardMessages() {
+	to := pid.handedOffTo.Load()
+
+	pid.forwardLock.Lock()
+	defer pid.forwardLock.Unlock()
+
+	for received := pid.mailbox.Dequeue(); received != nil; received = pid.mailbox.Dequeue() {
+		pid.forward(received, to)
+		releaseGrainContext(received)
+	}
+}
+
+// forward sends the given message to the new activation of the grain
+// and passes the outcome along to the sender
+func (pid *grainPID) forward(received *GrainContext, to *internalpb.Grain) {
+	ctx := received.Context()
+	if !received.synchronous {
+		if err := pid.actorSystem.tellGrainOn(ctx, to, received.Message()); err != nil {
+			received.Err(err)
+			return
+		}
+		received.NoErr()
+		return
+	}
+
+	timeout := DefaultGrainRequestTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	response, err := pid.actorSystem.askGrainOn(ctx, to, received.Message(), timeout)
+	if err != nil {
+		received.Err(err)
+		return
+	}
+	received.Response(response)
+}
@@ -0,0 +1,423 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestDrain(t *testing.T) {
+	t.Run("With cluster enabled", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		node2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		node3, sd3 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node3)
+		require.NotNil(t, sd3)
+
+		sender, err := node1.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		for j := 1; j <= 2; j++ {
+			_, err := node2.Spawn(ctx, fmt.Sprintf("Node2-Actor-%d", j), NewMockActor())
+			require.NoError(t, err)
+		}
+
+		pid, err := node2.Spawn(ctx, "handoff", new(MockHandoffActor))
+		require.NoError(t, err)
+		for range 3 {
+			require.NoError(t, Tell(ctx, pid, new(testpb.TestSend)))
+		}
+
+		identity, err := node2.GrainIdentity(ctx, "grain", func(context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		})
+		require.NoError(t, err)
+
+		pause.For(time.Second)
+
+		require.NoError(t, node2.Drain(ctx))
+		require.NoError(t, sd2.Close())
+		assert.False(t, node2.Running())
+
+		// the records held by the partitions of the drained node are registered again by the nodes hosting the actors
+		drained := net.JoinHostPort(node2.Host(), strconv.Itoa(node2.Port()))
+		for j := 1; j <= 2; j++ {
+			var addr *address.Address
+			require.Eventually(t, func() bool {
+				addr, err = node1.RemoteActor(ctx, fmt.Sprintf("Node2-Actor-%d", j))
+				return err == nil
+			}, 5*time.Second, 100*time.Millisecond)
+			assert.NotEqual(t, drained, addr.HostPort())
+		}
+
+		// the actor has taken over the state handed off on the drained node
+		reply, err := sender.SendSync(ctx, "handoff", new(testpb.TestGetCount), time.Minute)
+		require.NoError(t, err)
+		require.IsType(t, new(testpb.TestCount), reply)
+		assert.EqualValues(t, 3, reply.(*testpb.TestCount).GetValue())
+
+		// the grain is put on the cluster asynchronously by its new node
+		var grain *internalpb.Grain
+		require.Eventually(t, func() bool {
+			grain, err = node1.getCluster().GetGrain(ctx, identity.String())
+			return err == nil
+		}, 5*time.Second, 100*time.Millisecond)
+		assert.NotEqual(t, drained, net.JoinHostPort(grain.GetHost(), strconv.Itoa(int(grain.GetPort()))))
+
+		assert.NoError(t, node1.Stop(ctx))
+		assert.NoError(t, node3.Stop(ctx))
+		assert.NoError(t, sd1.Close())
+		assert.NoError(t, sd3.Close())
+		srv.Shutdown()
+	})
+	t.Run("With no peers to hand off to", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node, sd := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node)
+		require.NotNil(t, sd)
+
+		_, err := node.Spawn(ctx, "actor", NewMockActor())
+		require.NoError(t, err)
+
+		err = node.Drain(ctx)
+		require.ErrorIs(t, err, ErrNoPeersToHandOff)
+
+		// the node is no longer draining after the failed drain
+		_, err = node.Spawn(ctx, "other", NewMockActor())
+		require.NoError(t, err)
+
+		require.ErrorIs(t, node.Drain(ctx), ErrNoPeersToHandOff)
+
+		assert.NoError(t, node.Stop(ctx))
+		assert.NoError(t, sd.Close())
+		srv.Shutdown()
+	})
+	t.Run("With messages received during the hand off forwarded", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		node2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		sender, err := node1.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		actor := &MockHandoffActor{
+			handingOff: make(chan struct{}),
+			resume:     make(chan struct{}),
+		}
+		pid, err := node2.Spawn(ctx, "handoff", actor)
+		require.NoError(t, err)
+		require.NoError(t, Tell(ctx, pid, new(testpb.TestSend)))
+
+		pause.For(time.Second)
+
+		handOff := make(chan error, 1)
+		go func() {
+			handOff <- node2.(*actorSystem).handOff(ctx)
+		}()
+
+		// the actor holds the messages it receives, locally or remotely, while it is handed off
+		<-actor.handingOff
+		for range 2 {
+			require.NoError(t, Tell(ctx, pid, new(testpb.TestSend)))
+		}
+		require.NoError(t, sender.SendAsync(ctx, "handoff", new(testpb.TestSend)))
+		require.Eventually(t, func() bool {
+			return pid.mailbox.Len() == 3
+		}, 5*time.Second, 100*time.Millisecond)
+
+		replies := make(chan any, 1)
+		go func() {
+			reply, err := Ask(ctx, pid, new(testpb.TestGetCount), time.Minute)
+			if err != nil {
+				replies <- err
+				return
+			}
+			replies <- reply
+		}()
+		require.Eventually(t, func() bool {
+			return pid.mailbox.Len() == 4
+		}, 5*time.Second, 100*time.Millisecond)
+
+		close(actor.resume)
+		require.NoError(t, <-handOff)
+
+		// the held messages are forwarded in order to the new incarnation of the actor
+		reply := <-replies
+		require.IsType(t, new(testpb.TestCount), reply)
+		assert.EqualValues(t, 4, reply.(*testpb.TestCount).GetValue())
+		assert.False(t, pid.IsRunning())
+
+		moved, err := node1.LocalActor("handoff")
+		require.NoError(t, err)
+		assert.True(t, moved.IsRunning())
+
+		assert.NoError(t, node2.Stop(ctx))
+		assert.NoError(t, node1.Stop(ctx))
+		assert.NoError(t, sd2.Close())
+		assert.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With actor kept running when the hand off fails", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		node2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		// the factory is not registered on node1, so the actor cannot be handed off to it
+		require.NoError(t, node2.RegisterFactory(ctx, "factory", mockActorFactory))
+		_, err := node2.SpawnFromFactory(ctx, "actor", "factory", &testpb.TestLog{Text: "hello"})
+		require.NoError(t, err)
+
+		pause.For(time.Second)
+
+		require.Error(t, node2.Drain(ctx))
+		assert.True(t, node2.Running())
+
+		// the actor keeps running on node2 with its factory arguments
+		pid, err := node2.LocalActor("actor")
+		require.NoError(t, err)
+		assert.True(t, pid.IsRunning())
+		reply, err := Ask(ctx, pid, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", reply.(*testpb.Reply).GetContent())
+
+		addr, err := node1.RemoteActor(ctx, "actor")
+		require.NoError(t, err)
+		assert.Equal(t, net.JoinHostPort(node2.Host(), strconv.Itoa(node2.Port())), addr.HostPort())
+
+		// node2 is no longer leaving the cluster and accepts actors again
+		drained := node2.(*actorSystem).clusterNode.PeersAddress()
+		require.Eventually(t, func() bool {
+			return !node1.getCluster().HasLeft(drained)
+		}, 5*time.Second, 100*time.Millisecond)
+		_, err = node2.Spawn(ctx, "other", NewMockActor())
+		require.NoError(t, err)
+
+		assert.NoError(t, node1.Stop(ctx))
+		assert.NoError(t, node2.Stop(ctx))
+		assert.NoError(t, sd1.Close())
+		assert.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
+	t.Run("With remembered grain handed off", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+		store := persistence.NewMemoryRememberedGrainStore()
+
+		node1, sd1 := testCluster(t, srv.Addr().String(), withTestRememberedGrainStore(store))
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		node2, sd2 := testCluster(t, srv.Addr().String(), withTestRememberedGrainStore(store))
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		identity, err := node2.GrainIdentity(ctx, "session", func(context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		}, WithLongLivedGrain())
+		require.NoError(t, err)
+
+		// the grain is handed off whether or not it is already replicated in the cluster
+		require.NoError(t, node2.Drain(ctx))
+		require.NoError(t, sd2.Close())
+
+		gp, ok := node1.(*actorSystem).grains.Get(*identity)
+		require.True(t, ok)
+		require.True(t, gp.isActive())
+
+		// the grain is still remembered once moved
+		grains, err := store.RememberedGrains(ctx)
+		require.NoError(t, err)
+		require.Len(t, grains, 1)
+		require.Equal(t, identity.String(), grains[0].ID)
+
+		assert.NoError(t, node1.Stop(ctx))
+		assert.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With grain kept active when the hand off fails", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		node2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		// the grain kind is not registered on node1, so the grain cannot be handed off to it
+		grain := &MockMetadataGrain{metadata: make(chan map[string]string, 1)}
+		identity, err := node2.GrainIdentity(ctx, "grain", func(context.Context) (Grain, error) {
+			return grain, nil
+		})
+		require.NoError(t, err)
+
+		pause.For(time.Second)
+
+		require.Error(t, node2.Drain(ctx))
+		assert.True(t, node2.Running())
+
+		// the grain is activated again on node2 and processes its messages
+		gp, ok := node2.(*actorSystem).grains.Get(*identity)
+		require.True(t, ok)
+		require.True(t, gp.isActive())
+		require.NoError(t, node2.TellGrain(ctx, identity, new(testpb.TestSend)))
+		select {
+		case <-grain.metadata:
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		assert.NoError(t, node1.Stop(ctx))
+		assert.NoError(t, node2.Stop(ctx))
+		assert.NoError(t, sd1.Close())
+		assert.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
+	t.Run("With grains activated on the other nodes while draining", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		node2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		pause.For(time.Second)
+
+		system := node2.(*actorSystem)
+		system.draining.Store(true)
+
+		factory := func(context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		}
+
+		identity, err := node2.GrainIdentity(ctx, "grain", factory)
+		require.NoError(t, err)
+
+		placed, err := node2.GrainIdentity(ctx, "placed", factory, WithGrainPlacementStrategy(NewLeastLoadPlacement()))
+		require.NoError(t, err)
+
+		// none of the grains is activated on the draining node
+		for _, id := range []*GrainIdentity{identity, placed} {
+			_, ok := system.grains.Get(*id)
+			assert.False(t, ok)
+
+			gp, ok := node1.(*actorSystem).grains.Get(*id)
+			require.True(t, ok)
+			assert.True(t, gp.isActive())
+		}
+
+		_, err = system.ensureGrainProcess(identity)
+		require.ErrorIs(t, err, ErrActorSystemDraining)
+
+		system.draining.Store(false)
+
+		assert.NoError(t, node1.Stop(ctx))
+		assert.NoError(t, node2.Stop(ctx))
+		assert.NoError(t, sd1.Close())
+		assert.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
+	t.Run("When cluster is not enabled", func(t *testing.T) {
+		ctx := context.TODO()
+		sys, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, sys.Drain(ctx), ErrActorSystemNotStarted)
+
+		require.NoError(t, sys.Start(ctx))
+		require.ErrorIs(t, sys.Drain(ctx), ErrClusterDisabled)
+
+		_, err = sys.(*actorSystem).DrainNode(ctx, connect.NewRequest(&internalpb.DrainNodeRequest{}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With DrainNode and invalid node address", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node, sd := testCluster(t, srv.Addr().String())
+		require.NotNil(t, node)
+		require.NotNil(t, sd)
+
+		sys := node.(*actorSystem)
+		_, err := sys.DrainNode(ctx, connect.NewRequest(&internalpb.DrainNodeRequest{NodeAddress: "127.0.0.1:1"}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		assert.True(t, node.Running())
+
+		assert.NoError(t, node.Stop(ctx))
+		assert.NoError(t, sd.Close())
+		srv.Shutdown()
+	})
+}
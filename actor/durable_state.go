@@ -0,0 +1,112 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tochemey/goakt/v3/persistence"
+)
+
+// durableState binds the durable state store to the persistence ID of an actor or a grain.
+// It keeps track of the latest revision read or written so that concurrent writers are detected
+// by the store optimistic revision checks.
+type durableState struct {
+	store         persistence.DurableStateStore
+	persistenceID string
+	revision      *atomic.Uint64
+}
+
+// newDurableState creates an instance of durableState
+func newDurableState(store persistence.DurableStateStore, persistenceID string) *durableState {
+	return &durableState{
+		store:         store,
+		persistenceID: persistenceID,
+		revision:      atomic.NewUint64(0),
+	}
+}
+
+// load fetches the latest state from the store.
+// It returns nil when no state has been saved yet
+func (x *durableState) load(ctx context.Context) (proto.Message, error) {
+	if x == nil {
+		return nil, ErrDurableStateStoreNotSet
+	}
+
+	state, err := x.store.GetState(ctx, x.persistenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if state == nil {
+		x.revision.Store(0)
+		return nil, nil
+	}
+
+	x.revision.Store(state.Revision)
+	return state.State, nil
+}
+
+// save writes the given state to the store with the next revision
+func (x *durableState) save(ctx context.Context, state proto.Message) error {
+	if x == nil {
+		return ErrDurableStateStoreNotSet
+	}
+
+	if state == nil {
+		return ErrInvalidMessage
+	}
+
+	revision := x.revision.Load() + 1
+	if err := x.store.UpsertState(ctx, &persistence.DurableState{
+		PersistenceID: x.persistenceID,
+		Revision:      revision,
+		State:         state,
+		Timestamp:     time.Now().UTC(),
+	}); err != nil {
+		return NewErrPersistFailure(err)
+	}
+
+	x.revision.Store(revision)
+	return nil
+}
+
+// remove deletes the state from the store
+func (x *durableState) remove(ctx context.Context) error {
+	if x == nil {
+		return ErrDurableStateStoreNotSet
+	}
+
+	if err := x.store.DeleteState(ctx, x.persistenceID, x.revision.Load()); err != nil {
+		return NewErrPersistFailure(err)
+	}
+
+	x.revision.Store(0)
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestDurableState(t *testing.T) {
+	t.Run("With actor state saved and loaded", func(t *testing.T) {
+		ctx := context.TODO()
+		store := persistence.NewMemoryDurableStateStore()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithDurableStateStore(store))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		// the store is registered as an extension
+		assert.Equal(t, store, actorSystem.Extension(persistence.DurableStateStoreID))
+
+		pid, err := actorSystem.Spawn(ctx, "account", &MockDurableStateActor{})
+		require.NoError(t, err)
+		require.NotNil(t, pid)
+
+		for range 2 {
+			_, err := Ask(ctx, pid, &testpb.CreditAccount{Balance: 100}, time.Second)
+			require.NoError(t, err)
+		}
+
+		state, err := store.GetState(ctx, "account")
+		require.NoError(t, err)
+		require.NotNil(t, state)
+		assert.EqualValues(t, 2, state.Revision)
+
+		// stop the actor and spawn it again to check its state is loaded
+		require.NoError(t, pid.Shutdown(ctx))
+		pause.For(500 * time.Millisecond)
+
+		pid, err = actorSystem.Spawn(ctx, "account", &MockDurableStateActor{})
+		require.NoError(t, err)
+
+		reply, err := Ask(ctx, pid, new(testpb.GetAccount), time.Second)
+		require.NoError(t, err)
+		account, ok := reply.(*testpb.Account)
+		require.True(t, ok)
+		assert.EqualValues(t, 200, account.GetAccountBalance())
+
+		// the actor keeps writing on top of the loaded revision
+		_, err = Ask(ctx, pid, &testpb.CreditAccount{Balance: 100}, time.Second)
+		require.NoError(t, err)
+
+		state, err = store.GetState(ctx, "account")
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, state.Revision)
+
+		_, err = Ask(ctx, pid, new(testpb.TestBye), time.Second)
+		require.NoError(t, err)
+
+		state, err = store.GetState(ctx, "account")
+		require.NoError(t, err)
+		assert.Nil(t, state)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With grain state kept across deactivation", func(t *testing.T) {
+		ctx := context.TODO()
+		store := persistence.NewMemoryDurableStateStore()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithDurableStateStore(store))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		factory := func(context.Context) (Grain, error) {
+			return &MockDurableStateGrain{}, nil
+		}
+
+		identity, err := actorSystem.GrainIdentity(ctx, "account", factory)
+		require.NoError(t, err)
+
+		_, err = actorSystem.AskGrain(ctx, identity, &testpb.CreditAccount{Balance: 100}, time.Second)
+		require.NoError(t, err)
+
+		// deactivate the grain
+		require.NoError(t, actorSystem.TellGrain(ctx, identity, new(goaktpb.PoisonPill)))
+		pause.For(500 * time.Millisecond)
+
+		state, err := store.GetState(ctx, identity.String())
+		require.NoError(t, err)
+		require.NotNil(t, state)
+		assert.EqualValues(t, 1, state.Revision)
+
+		identity, err = actorSystem.GrainIdentity(ctx, "account", factory)
+		require.NoError(t, err)
+
+		reply, err := actorSystem.AskGrain(ctx, identity, new(testpb.GetAccount), time.Second)
+		require.NoError(t, err)
+		account, ok := reply.(*testpb.Account)
+		require.True(t, ok)
+		assert.EqualValues(t, 100, account.GetAccountBalance())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+
+		// the grain state is saved again on shutdown
+		state, err = store.GetState(ctx, identity.String())
+		require.NoError(t, err)
+		require.NotNil(t, state)
+		assert.EqualValues(t, 2, state.Revision)
+	})
+	t.Run("With durable state store not set", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		pid, err := actorSystem.Spawn(ctx, "account", &MockDurableStateActor{})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrDurableStateStoreNotSet)
+		assert.Nil(t, pid)
+
+		props := newGrainProps(nil, actorSystem, nil)
+		_, err = props.LoadState(ctx)
+		assert.ErrorIs(t, err, ErrDurableStateStoreNotSet)
+		assert.ErrorIs(t, props.SaveState(ctx, new(testpb.Account)), ErrDurableStateStoreNotSet)
+		assert.ErrorIs(t, props.DeleteState(ctx), ErrDurableStateStoreNotSet)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With revision conflict", func(t *testing.T) {
+		ctx := context.TODO()
+		store := persistence.NewMemoryDurableStateStore()
+		require.NoError(t, store.Connect(ctx))
+
+		first := newDurableState(store, "account")
+		second := newDurableState(store, "account")
+
+		require.NoError(t, first.save(ctx, new(testpb.Account)))
+		// the second writer has not seen the first write
+		err := second.save(ctx, new(testpb.Account))
+		require.ErrorIs(t, err, ErrPersistFailure)
+		require.ErrorIs(t, err, persistence.ErrRevisionConflict)
+
+		_, err = second.load(ctx)
+		require.NoError(t, err)
+		require.NoError(t, second.save(ctx, new(testpb.Account)))
+		require.ErrorIs(t, second.save(ctx, nil), ErrInvalidMessage)
+	})
+}
@@ -27,6 +27,7 @@ package actor
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 )
@@ -59,6 +60,9 @@ var (
 	// ErrAddressNotFound is returned when an actor's address cannot be resolved.
 	ErrAddressNotFound = errors.New("address not found")
 
+	// ErrOutboundQueueFull is returned when a message is sent to a remote node whose outbound queue is full
+	ErrOutboundQueueFull = errors.New("remote outbound queue is full")
+
 	// ErrRemoteSendFailure is returned when sending a remote message fails due to network or protocol issues.
 	ErrRemoteSendFailure = errors.New("remote send failed")
 
@@ -86,6 +90,29 @@ var (
 	// ErrActorSystemNotStarted indicates that an actor system has not been started before use.
 	ErrActorSystemNotStarted = errors.New("actor system is not running")
 
+	// ErrActorSystemReadOnly is returned when the actor system has been switched to read-only
+	// by the split brain resolver because it is on the losing side of a network partition.
+	ErrActorSystemReadOnly = errors.New("actor system is read-only")
+
+	// ErrActorSystemDraining is returned when the actor system is handing off its actors
+	// to the other cluster members before leaving the cluster.
+	ErrActorSystemDraining = errors.New("actor system is draining")
+
+	// ErrNoPeersToHandOff is returned when a node is drained while there is no other cluster member
+	// to hand its actors off to.
+	ErrNoPeersToHandOff = errors.New("no cluster peers to hand off to")
+
+	// ErrRoleNotFound is returned when an actor or a grain requires a role that no cluster node,
+	// or not the given node, has.
+	ErrRoleNotFound = errors.New("no cluster node with the required role")
+
+	// ErrNoPlacementCandidates is returned when a placement strategy has no node to choose from,
+	// e.g. when none of the candidate nodes can report its load.
+	ErrNoPlacementCandidates = errors.New("no cluster node to place on")
+
+	// ErrInvalidPlacement is returned when a placement strategy does not choose one of the given candidate nodes.
+	ErrInvalidPlacement = errors.New("placement strategy did not choose a candidate node")
+
 	// ErrReservedName is returned when attempting to register an actor with a reserved name.
 	ErrReservedName = errors.New("actor name is reserved")
 
@@ -95,6 +122,9 @@ var (
 	// ErrTypeNotRegistered is returned when attempting to use an unregistered actor type.
 	ErrTypeNotRegistered = errors.New("actor type is not registered")
 
+	// ErrFactoryNotRegistered is returned when attempting to use an unregistered actor factory.
+	ErrFactoryNotRegistered = errors.New("actor factory is not registered")
+
 	// ErrPeerNotFound is returned when the specified peer in the cluster is not available.
 	ErrPeerNotFound = errors.New("peer is not found")
 
@@ -119,6 +149,9 @@ var (
 	// ErrInvalidTLSConfiguration is returned when TLS settings are missing or misconfigured.
 	ErrInvalidTLSConfiguration = errors.New("TLS configuration is invalid")
 
+	// ErrCertificateNotFound is returned when a certificate provider has no certificate to provide.
+	ErrCertificateNotFound = errors.New("certificate not found")
+
 	// ErrSingletonAlreadyExists is returned when a singleton actor type is already registered.
 	ErrSingletonAlreadyExists = errors.New("singleton already exists")
 
@@ -154,6 +187,33 @@ var (
 
 	// ErrUnhanledMessage is returned when a message is received that the actor/grain does not know how to handle.
 	ErrUnhanledMessage = errors.New("unhandled message")
+
+	// ErrJournalStoreNotSet is returned when a persistent actor is used without a journal store configured on the actor system.
+	ErrJournalStoreNotSet = errors.New("journal store is not set")
+
+	// ErrNotPersistentActor is returned when a persistence operation is attempted by an actor that does not implement the PersistentActor interface.
+	ErrNotPersistentActor = errors.New("actor is not a persistent actor")
+
+	// ErrPersistFailure is returned when events or snapshots cannot be written to the persistence stores.
+	ErrPersistFailure = errors.New("failed to persist")
+
+	// ErrDurableStateStoreNotSet is returned when the durable state of an actor or a grain is accessed without a durable state store configured on the actor system.
+	ErrDurableStateStoreNotSet = errors.New("durable state store is not set")
+
+	// ErrMaxUnconfirmedDeliveries is returned when a message is sent with at-least-once delivery while the maximum number of unconfirmed deliveries is reached.
+	ErrMaxUnconfirmedDeliveries = errors.New("maximum number of unconfirmed deliveries reached")
+
+	// ErrActorRestarting is returned when a message is received by an actor waiting to be restarted by its supervisor.
+	ErrActorRestarting = errors.New("actor is restarting")
+
+	// ErrCircuitOpen is returned when a request is rejected because the circuit breaker guarding its target is open.
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+
+	// ErrSingletonProxyBufferFull is returned when a singleton proxy cannot buffer any more messages while the singleton actor cannot be reached.
+	ErrSingletonProxyBufferFull = errors.New("singleton proxy buffer is full")
+
+	// ErrSingletonUnreachable is returned when a singleton proxy cannot relay a message awaiting a reply because the singleton actor cannot be reached.
+	ErrSingletonUnreachable = errors.New("singleton actor is unreachable")
 )
 
 // NewErrUnhandledMessage wraps a base error with ErrUnhanledMessage to indicate an unhandled message.
@@ -211,6 +271,11 @@ func NewErrInvalidRemoteMessage(err error) error {
 	return errors.Join(ErrInvalidRemoteMessage, err)
 }
 
+// NewErrPersistFailure wraps a base error with ErrPersistFailure to indicate a persistence failure.
+func NewErrPersistFailure(err error) error {
+	return errors.Join(ErrPersistFailure, err)
+}
+
 // NewErrInitFailure wraps a base error with ErrInitFailure to indicate a startup failure.
 func NewErrInitFailure(err error) error {
 	return errors.Join(ErrInitFailure, err)
@@ -239,6 +304,45 @@ func (e *PanicError) Unwrap() error {
 	return e.err
 }
 
+// CircuitOpenError is returned when a request is rejected by the circuit breaker guarding its target.
+// It matches ErrCircuitOpen with errors.Is
+type CircuitOpenError struct {
+	target     string
+	retryAfter time.Duration
+}
+
+// enforce compilation error
+var _ error = (*CircuitOpenError)(nil)
+
+// NewCircuitOpenError creates an instance of CircuitOpenError
+func NewCircuitOpenError(target string, retryAfter time.Duration) *CircuitOpenError {
+	return &CircuitOpenError{
+		target:     target,
+		retryAfter: retryAfter,
+	}
+}
+
+// Error implements the standard error interface
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("(target=%s) %v", e.target, ErrCircuitOpen)
+}
+
+// Unwrap returns ErrCircuitOpen
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// Target returns the target guarded by the circuit breaker
+func (e *CircuitOpenError) Target() string {
+	return e.target
+}
+
+// RetryAfter returns the duration after which the circuit breaker lets trial requests through.
+// It is zero when the circuit breaker is already letting its trial requests through.
+func (e *CircuitOpenError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
 // InternalError defines an error that is explicit to the application
 type InternalError struct {
 	err error
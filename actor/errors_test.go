@@ -27,6 +27,7 @@ package actor
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -43,4 +44,10 @@ func TestErrors(t *testing.T) {
 	rebalancingErr := newRebalancingError(errors.New("something went wrong"))
 	require.Error(t, rebalancingErr)
 	require.EqualError(t, rebalancingErr, "rebalancing: something went wrong")
+
+	circuitOpenErr := NewCircuitOpenError("target", time.Second)
+	require.ErrorIs(t, circuitOpenErr, ErrCircuitOpen)
+	require.EqualError(t, circuitOpenErr, "(target=target) circuit breaker is open")
+	require.Equal(t, "target", circuitOpenErr.Target())
+	require.Equal(t, time.Second, circuitOpenErr.RetryAfter())
 }
@@ -38,14 +38,18 @@ import (
 	natsserver "github.com/nats-io/nats-server/v2/server"
 	"github.com/stretchr/testify/require"
 	"github.com/travisjeffery/go-dynaport"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/tochemey/goakt/v3/discovery"
 	"github.com/tochemey/goakt/v3/discovery/nats"
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/pause"
 	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
 	"github.com/tochemey/goakt/v3/remote"
 	"github.com/tochemey/goakt/v3/test/data/testpb"
 )
@@ -470,6 +474,10 @@ type testClusterConfig struct {
 	relocationEnabled bool
 	extension         extension.Extension
 	dependency        extension.Dependency
+	journalStore      persistence.JournalStore
+	grainStore        persistence.RememberedGrainStore
+	remoteOptions     []remote.Option
+	roles             []string
 }
 
 type testClusterOption func(*testClusterConfig)
@@ -499,6 +507,30 @@ func withMockExtension(ext extension.Extension) testClusterOption {
 	}
 }
 
+func withTestJournalStore(store persistence.JournalStore) testClusterOption {
+	return func(tcc *testClusterConfig) {
+		tcc.journalStore = store
+	}
+}
+
+func withTestRememberedGrainStore(store persistence.RememberedGrainStore) testClusterOption {
+	return func(tcc *testClusterConfig) {
+		tcc.grainStore = store
+	}
+}
+
+func withTestRemoteOptions(opts ...remote.Option) testClusterOption {
+	return func(tcc *testClusterConfig) {
+		tcc.remoteOptions = append(tcc.remoteOptions, opts...)
+	}
+}
+
+func withTestRoles(roles ...string) testClusterOption {
+	return func(tcc *testClusterConfig) {
+		tcc.roles = roles
+	}
+}
+
 func testCluster(t *testing.T, serverAddr string, opts ...testClusterOption) (ActorSystem, discovery.Provider) {
 	ctx := context.TODO()
 	logger := log.DiscardLogger
@@ -525,28 +557,25 @@ func testCluster(t *testing.T, serverAddr string, opts ...testClusterOption) (Ac
 	// create the instance of provider
 	provider := nats.NewDiscovery(&config, nats.WithLogger(log.DiscardLogger))
 
-	// create the actor system options
-	options := []Option{
-		WithLogger(logger),
-		WithRemote(remote.NewConfig(host, remotingPort)),
-		WithCluster(
-			NewClusterConfig().
-				WithKinds(
-					new(MockActor),
-					new(MockEntity),
-					new(MockGrainActor),
-				).
-				WithGrains(new(MockGrain)).
-				WithPartitionCount(7).
-				WithReplicaCount(1).
-				WithPeersPort(clusterPort).
-				WithMinimumPeersQuorum(1).
-				WithDiscoveryPort(discoveryPort).
-				WithBootstrapTimeout(time.Second).
-				WithClusterStateSyncInterval(300 * time.Millisecond).
-				WithPeersStateSyncInterval(500 * time.Millisecond).
-				WithDiscovery(provider)),
-	}
+	clusterConfig := NewClusterConfig().
+		WithKinds(
+			new(MockActor),
+			new(MockEntity),
+			new(MockGrainActor),
+			new(MockPersistentActor),
+			new(MockHandoffActor),
+			new(MockBouncer),
+		).
+		WithGrains(new(MockGrain)).
+		WithPartitionCount(7).
+		WithReplicaCount(1).
+		WithPeersPort(clusterPort).
+		WithMinimumPeersQuorum(1).
+		WithDiscoveryPort(discoveryPort).
+		WithBootstrapTimeout(time.Second).
+		WithClusterStateSyncInterval(300 * time.Millisecond).
+		WithPeersStateSyncInterval(500 * time.Millisecond).
+		WithDiscovery(provider)
 
 	cfg := &testClusterConfig{
 		tlsEnabled:        false,
@@ -557,6 +586,13 @@ func testCluster(t *testing.T, serverAddr string, opts ...testClusterOption) (Ac
 		opt(cfg)
 	}
 
+	// create the actor system options
+	options := []Option{
+		WithLogger(logger),
+		WithRemote(remote.NewConfig(host, remotingPort, cfg.remoteOptions...)),
+		WithCluster(clusterConfig),
+	}
+
 	if cfg.pubsubEnabled {
 		options = append(options, WithPubSub())
 	}
@@ -576,6 +612,18 @@ func testCluster(t *testing.T, serverAddr string, opts ...testClusterOption) (Ac
 		options = append(options, WithExtensions(cfg.extension))
 	}
 
+	if cfg.journalStore != nil {
+		options = append(options, WithJournalStore(cfg.journalStore))
+	}
+
+	if cfg.grainStore != nil {
+		clusterConfig.WithRememberedGrains(cfg.grainStore)
+	}
+
+	if len(cfg.roles) > 0 {
+		clusterConfig.WithRoles(cfg.roles...)
+	}
+
 	// create the actor system
 	system, err := NewActorSystem(actorSystemName, options...)
 
@@ -941,7 +989,7 @@ func (m *MockGrain) OnDeactivate(ctx context.Context, props *GrainProps) error {
 
 // OnReceive implements Grain.
 func (m *MockGrain) OnReceive(ctx *GrainContext) {
-	switch ctx.Message().(type) {
+	switch msg := ctx.Message().(type) {
 	case *testpb.TestSend:
 		ctx.ActorSystem().Logger().Infof("%s received TestSend message in MockGrain", ctx.Self().Name())
 		ctx.NoErr()
@@ -995,6 +1043,10 @@ func (m *MockGrain) OnReceive(ctx *GrainContext) {
 
 	case *testpb.TestReply:
 		ctx.Response(&testpb.Reply{Content: "received message"})
+	case *testpb.Reply:
+		ctx.Response(&testpb.Reply{Content: msg.GetContent()})
+	case *testpb.TestLog:
+		ctx.NoErr()
 	case *testpb.TestTimeout:
 		wg := sync.WaitGroup{}
 		wg.Add(1)
@@ -1309,3 +1361,612 @@ func (m *MockShutdownHookWithoutRecovery) Execute(context.Context, ActorSystem)
 func (m *MockShutdownHookWithoutRecovery) Recovery() *ShutdownHookRecovery {
 	return nil
 }
+
+type MockPersistentActor struct {
+	account *testpb.Account
+}
+
+func (x *MockPersistentActor) PreStart(ctx *Context) error {
+	x.account = &testpb.Account{AccountId: ctx.ActorName()}
+	return nil
+}
+
+func (x *MockPersistentActor) Receive(ctx *ReceiveContext) {
+	switch msg := ctx.Message().(type) {
+	case *testpb.CreditAccount:
+		ctx.Persist(&testpb.AccountCredited{
+			AccountId:      x.account.GetAccountId(),
+			AccountBalance: msg.GetBalance(),
+		})
+		ctx.Response(x.account)
+	case *testpb.GetAccount:
+		ctx.Response(x.account)
+	case *testpb.TestPanic:
+		panic("test panic")
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockPersistentActor) PostStop(*Context) error {
+	return nil
+}
+
+func (x *MockPersistentActor) HandleEvent(event proto.Message) error {
+	switch evt := event.(type) {
+	case *testpb.AccountCredited:
+		x.account = &testpb.Account{
+			AccountId:      x.account.GetAccountId(),
+			AccountBalance: x.account.GetAccountBalance() + evt.GetAccountBalance(),
+		}
+		return nil
+	default:
+		return errors.New("unhandled event")
+	}
+}
+
+func (x *MockPersistentActor) State() proto.Message {
+	return x.account
+}
+
+func (x *MockPersistentActor) RestoreState(state proto.Message) error {
+	account, ok := state.(*testpb.Account)
+	if !ok {
+		return errors.New("invalid state")
+	}
+	x.account = account
+	return nil
+}
+
+var _ PersistentActor = &MockPersistentActor{}
+
+type MockDurableStateActor struct {
+	account *testpb.Account
+}
+
+var _ Actor = (*MockDurableStateActor)(nil)
+
+func (x *MockDurableStateActor) PreStart(ctx *Context) error {
+	x.account = &testpb.Account{AccountId: ctx.ActorName()}
+	state, err := ctx.LoadState()
+	if err != nil {
+		return err
+	}
+
+	if state != nil {
+		x.account = state.(*testpb.Account)
+	}
+	return nil
+}
+
+func (x *MockDurableStateActor) Receive(ctx *ReceiveContext) {
+	switch msg := ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.CreditAccount:
+		x.account = &testpb.Account{
+			AccountId:      x.account.GetAccountId(),
+			AccountBalance: x.account.GetAccountBalance() + msg.GetBalance(),
+		}
+		ctx.SaveState(x.account)
+		ctx.Response(x.account)
+	case *testpb.GetAccount:
+		ctx.Response(x.account)
+	case *testpb.TestBye:
+		ctx.DeleteState()
+		ctx.Response(new(testpb.Reply))
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockDurableStateActor) PostStop(*Context) error {
+	return nil
+}
+
+type MockDurableStateGrain struct {
+	account *testpb.Account
+}
+
+var _ Grain = (*MockDurableStateGrain)(nil)
+
+func (x *MockDurableStateGrain) OnActivate(ctx context.Context, props *GrainProps) error {
+	x.account = &testpb.Account{AccountId: props.Identity().Name()}
+	state, err := props.LoadState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state != nil {
+		x.account = state.(*testpb.Account)
+	}
+	return nil
+}
+
+func (x *MockDurableStateGrain) OnReceive(ctx *GrainContext) {
+	switch msg := ctx.Message().(type) {
+	case *testpb.CreditAccount:
+		x.account = &testpb.Account{
+			AccountId:      x.account.GetAccountId(),
+			AccountBalance: x.account.GetAccountBalance() + msg.GetBalance(),
+		}
+		ctx.Response(x.account)
+	case *testpb.GetAccount:
+		ctx.Response(x.account)
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockDurableStateGrain) OnDeactivate(ctx context.Context, props *GrainProps) error {
+	return props.SaveState(ctx, x.account)
+}
+
+// MockTracedActor records the span context of the messages it receives
+type MockTracedActor struct {
+	spanContexts chan trace.SpanContext
+}
+
+var _ Actor = (*MockTracedActor)(nil)
+
+func NewMockTracedActor() *MockTracedActor {
+	return &MockTracedActor{spanContexts: make(chan trace.SpanContext, 10)}
+}
+
+func (x *MockTracedActor) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockTracedActor) Receive(ctx *ReceiveContext) {
+	switch ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestSend:
+		x.spanContexts <- trace.SpanContextFromContext(ctx.Context())
+	case *testpb.TestReply:
+		x.spanContexts <- trace.SpanContextFromContext(ctx.Context())
+		ctx.Response(new(testpb.Reply))
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockTracedActor) PostStop(*Context) error {
+	return nil
+}
+
+// MockMetadataActor records the metadata of the messages it receives
+type MockMetadataActor struct {
+	metadata chan map[string]string
+}
+
+var _ Actor = (*MockMetadataActor)(nil)
+
+func NewMockMetadataActor() *MockMetadataActor {
+	return &MockMetadataActor{metadata: make(chan map[string]string, 10)}
+}
+
+func (x *MockMetadataActor) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockMetadataActor) Receive(ctx *ReceiveContext) {
+	switch ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestSend, *testpb.TestBye, *testpb.TestRemoteForward:
+		x.metadata <- ctx.Metadata().Map()
+		// changes made by the receiver must not leak to the sender
+		ctx.Metadata().Set("receiver", ctx.Self().Name())
+	case *testpb.TestReply:
+		x.metadata <- ctx.Metadata().Map()
+		ctx.Response(new(testpb.Reply))
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockMetadataActor) PostStop(*Context) error {
+	return nil
+}
+
+// MockMetadataGrain records the metadata of the messages it receives
+type MockMetadataGrain struct {
+	metadata chan map[string]string
+}
+
+var _ Grain = (*MockMetadataGrain)(nil)
+
+func (x *MockMetadataGrain) OnActivate(context.Context, *GrainProps) error {
+	return nil
+}
+
+func (x *MockMetadataGrain) OnReceive(ctx *GrainContext) {
+	switch ctx.Message().(type) {
+	case *testpb.TestSend:
+		x.metadata <- ctx.Metadata().Map()
+		ctx.NoErr()
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockMetadataGrain) OnDeactivate(context.Context, *GrainProps) error {
+	return nil
+}
+
+// MockDeliveryProducer sends a TestCount message with at-least-once delivery for every TestSend received.
+// It sends the given message instead when set
+type MockDeliveryProducer struct {
+	delivery  *AtLeastOnceDelivery
+	recipient string
+	message   any
+	sent      int32
+	errs      chan error
+	failed    chan *goaktpb.DeliveryFailed
+}
+
+var _ Actor = (*MockDeliveryProducer)(nil)
+
+func NewMockDeliveryProducer(recipient string, delivery *AtLeastOnceDelivery) *MockDeliveryProducer {
+	return &MockDeliveryProducer{
+		delivery:  delivery,
+		recipient: recipient,
+		errs:      make(chan error, 10),
+		failed:    make(chan *goaktpb.DeliveryFailed, 10),
+	}
+}
+
+func (x *MockDeliveryProducer) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockDeliveryProducer) Receive(ctx *ReceiveContext) {
+	if x.delivery.Receive(ctx) {
+		return
+	}
+
+	switch msg := ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestSend:
+		x.sent++
+		var message any = &testpb.TestCount{Value: x.sent}
+		if x.message != nil {
+			message = x.message
+		}
+		if _, err := x.delivery.Deliver(ctx, x.recipient, message); err != nil {
+			x.errs <- err
+		}
+	case *testpb.TestGetCount:
+		ctx.Response(&testpb.TestCount{Value: int32(x.delivery.Unconfirmed())})
+	case *goaktpb.DeliveryFailed:
+		x.failed <- msg
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockDeliveryProducer) PostStop(*Context) error {
+	return nil
+}
+
+// MockDeliveryConsumer records the messages received with at-least-once delivery.
+// It loses the first deliveries it receives to simulate an unreliable network
+type MockDeliveryConsumer struct {
+	deduplicator *DeliveryDeduplicator
+	lose         int
+	received     chan int32
+}
+
+var _ Actor = (*MockDeliveryConsumer)(nil)
+
+func NewMockDeliveryConsumer(lose int) *MockDeliveryConsumer {
+	return &MockDeliveryConsumer{
+		deduplicator: NewDeliveryDeduplicator(),
+		lose:         lose,
+		received:     make(chan int32, 10),
+	}
+}
+
+func (x *MockDeliveryConsumer) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockDeliveryConsumer) Receive(ctx *ReceiveContext) {
+	if _, ok := ctx.Message().(*internalpb.Delivery); ok && x.lose > 0 {
+		x.lose--
+		return
+	}
+
+	message, ok := x.deduplicator.Receive(ctx)
+	if !ok {
+		return
+	}
+
+	switch msg := message.(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestCount:
+		x.received <- msg.GetValue()
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockDeliveryConsumer) PostStop(*Context) error {
+	return nil
+}
+
+// MockGreeting is a plain Go message serialized with the JSON serializer across nodes
+type MockGreeting struct {
+	Name string `json:"name"`
+}
+
+// MockGreetingReply is a plain Go reply serialized with the JSON serializer across nodes
+type MockGreetingReply struct {
+	Text string `json:"text"`
+}
+
+// MockGreeter replies to plain Go messages
+type MockGreeter struct {
+	received chan string
+}
+
+var _ Actor = (*MockGreeter)(nil)
+
+func NewMockGreeter() *MockGreeter {
+	return &MockGreeter{received: make(chan string, 10)}
+}
+
+func (x *MockGreeter) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockGreeter) Receive(ctx *ReceiveContext) {
+	switch msg := ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case MockGreeting:
+		x.received <- msg.Name
+	case *MockGreeting:
+		ctx.Response(&MockGreetingReply{Text: "hello " + msg.Name})
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockGreeter) PostStop(*Context) error {
+	return nil
+}
+
+// MockCounter records the values of the TestCount messages it receives in order
+type MockCounter struct {
+	received chan int32
+}
+
+var _ Actor = (*MockCounter)(nil)
+
+func NewMockCounter(capacity int) *MockCounter {
+	return &MockCounter{received: make(chan int32, capacity)}
+}
+
+func (x *MockCounter) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockCounter) Receive(ctx *ReceiveContext) {
+	switch msg := ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestCount:
+		x.received <- msg.GetValue()
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockCounter) PostStop(*Context) error {
+	return nil
+}
+
+// MockWatcher records the Terminated messages it receives
+type MockWatcher struct {
+	terminated chan *goaktpb.Terminated
+}
+
+var _ Actor = (*MockWatcher)(nil)
+
+func NewMockWatcher() *MockWatcher {
+	return &MockWatcher{terminated: make(chan *goaktpb.Terminated, 10)}
+}
+
+func (x *MockWatcher) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockWatcher) Receive(ctx *ReceiveContext) {
+	switch msg := ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *goaktpb.Terminated:
+		x.terminated <- msg
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockWatcher) PostStop(*Context) error {
+	return nil
+}
+
+// MockEcho records the text of the TestLog messages it receives
+// and replies to the Reply messages with their content
+type MockEcho struct {
+	received chan string
+}
+
+var _ Actor = (*MockEcho)(nil)
+
+func NewMockEcho() *MockEcho {
+	return &MockEcho{received: make(chan string, 10)}
+}
+
+func (x *MockEcho) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockEcho) Receive(ctx *ReceiveContext) {
+	switch msg := ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestLog:
+		x.received <- msg.GetText()
+	case *testpb.Reply:
+		ctx.Response(&testpb.Reply{Content: msg.GetContent()})
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockEcho) PostStop(*Context) error {
+	return nil
+}
+
+// MockFactoryActor is created by an actor factory with the greeting
+// it replies to the TestReply messages with
+type MockFactoryActor struct {
+	greeting string
+}
+
+var _ Actor = (*MockFactoryActor)(nil)
+
+// mockActorFactory creates a MockFactoryActor from a TestLog argument
+func mockActorFactory(_ context.Context, args proto.Message) (Actor, error) {
+	msg, ok := args.(*testpb.TestLog)
+	if !ok {
+		return nil, errors.New("invalid factory arguments")
+	}
+	return &MockFactoryActor{greeting: msg.GetText()}, nil
+}
+
+func (x *MockFactoryActor) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockFactoryActor) Receive(ctx *ReceiveContext) {
+	switch ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestReply:
+		ctx.Response(&testpb.Reply{Content: x.greeting})
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockFactoryActor) PostStop(*Context) error {
+	return nil
+}
+
+// MockHandoffActor counts the TestSend messages it receives
+// and hands the count off when its node is drained.
+// When set, handingOff is closed once the hand off starts, which then waits for resume to be closed.
+type MockHandoffActor struct {
+	counter    int32
+	handingOff chan struct{}
+	resume     chan struct{}
+}
+
+var _ HandoffActor = (*MockHandoffActor)(nil)
+
+func (x *MockHandoffActor) PreStart(*Context) error {
+	x.counter = 0
+	return nil
+}
+
+func (x *MockHandoffActor) Receive(ctx *ReceiveContext) {
+	switch ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestSend:
+		x.counter++
+	case *testpb.TestGetCount:
+		ctx.Response(&testpb.TestCount{Value: x.counter})
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockHandoffActor) PostStop(*Context) error {
+	return nil
+}
+
+func (x *MockHandoffActor) HandOff() (proto.Message, error) {
+	if x.handingOff != nil {
+		close(x.handingOff)
+		<-x.resume
+	}
+	return &testpb.TestCount{Value: x.counter}, nil
+}
+
+func (x *MockHandoffActor) TakeOver(state proto.Message) error {
+	count, ok := state.(*testpb.TestCount)
+	if !ok {
+		return errors.New("invalid handed off state")
+	}
+	x.counter = count.GetValue()
+	return nil
+}
+
+// MockPlacementStrategy places actors and grains on the node with the given remoting port
+type MockPlacementStrategy struct {
+	port       int
+	mu         sync.Mutex
+	candidates []*NodeMetric
+}
+
+var _ PlacementStrategy = (*MockPlacementStrategy)(nil)
+
+func NewMockPlacementStrategy(port int) *MockPlacementStrategy {
+	return &MockPlacementStrategy{port: port}
+}
+
+func (x *MockPlacementStrategy) Place(_ context.Context, nodes []*NodeMetric) (*NodeMetric, error) {
+	x.mu.Lock()
+	x.candidates = nodes
+	x.mu.Unlock()
+
+	for _, node := range nodes {
+		if node.RemotingPort() == x.port {
+			return node, nil
+		}
+	}
+	return nil, errors.New("node not found")
+}
+
+func (x *MockPlacementStrategy) Candidates() []*NodeMetric {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.candidates
+}
+
+// MockBouncer sends the TestCount messages it receives back to their sender
+type MockBouncer struct{}
+
+var _ Actor = (*MockBouncer)(nil)
+
+func NewMockBouncer() *MockBouncer {
+	return &MockBouncer{}
+}
+
+func (x *MockBouncer) PreStart(*Context) error {
+	return nil
+}
+
+func (x *MockBouncer) Receive(ctx *ReceiveContext) {
+	switch msg := ctx.Message().(type) {
+	case *goaktpb.PostStart:
+	case *testpb.TestCount:
+		if !ctx.Sender().Equals(NoSender) {
+			ctx.Tell(ctx.Sender(), msg)
+			return
+		}
+		ctx.RemoteTell(ctx.RemoteSender(), msg)
+	default:
+		ctx.Unhandled()
+	}
+}
+
+func (x *MockBouncer) PostStop(*Context) error {
+	return nil
+}
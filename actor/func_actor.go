@@ -27,13 +27,11 @@ package actor
 import (
 	"context"
 
-	"google.golang.org/protobuf/proto"
-
 	"github.com/tochemey/goakt/v3/goaktpb"
 )
 
 // ReceiveFunc is a message handling placeholder
-type ReceiveFunc = func(ctx context.Context, message proto.Message) error
+type ReceiveFunc = func(ctx context.Context, message any) error
 
 // PreStartFunc defines the PreStartFunc hook for an actor creation
 type PreStartFunc = func(ctx context.Context) error
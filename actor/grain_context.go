@@ -29,8 +29,6 @@ import (
 	"fmt"
 	"sync"
 	"time"
-
-	"google.golang.org/protobuf/proto"
 )
 
 // pool holds a pool of ReceiveContext
@@ -70,8 +68,8 @@ type GrainContext struct {
 	ctx         context.Context
 	self        *GrainIdentity
 	actorSystem ActorSystem
-	message     proto.Message
-	response    chan proto.Message
+	message     any
+	response    chan any
 	err         chan error
 	synchronous bool
 	pid         *grainPID
@@ -85,6 +83,17 @@ func (gctx *GrainContext) Context() context.Context {
 	return gctx.ctx
 }
 
+// Metadata returns the metadata sent along with the message.
+// Changes made to the returned metadata are carried by the messages sent from this context.
+func (gctx *GrainContext) Metadata() *Metadata {
+	md := MetadataFromContext(gctx.ctx)
+	if md == nil {
+		md = NewMetadata()
+		gctx.ctx = context.WithValue(gctx.ctx, metadataKey{}, md)
+	}
+	return md
+}
+
 // Self returns the unique identifier of the Grain instance.
 func (gctx *GrainContext) Self() *GrainIdentity {
 	return gctx.self
@@ -100,7 +109,7 @@ func (gctx *GrainContext) ActorSystem() ActorSystem {
 
 // Message returns the message currently being processed by the Grain.
 //
-// This method provides access to the incoming protobuf message that triggered the current Grain invocation.
+// This method provides access to the incoming message that triggered the current Grain invocation.
 // Use this to inspect, type-assert, or handle the message within your Grain's OnReceive method.
 //
 // Example:
@@ -113,7 +122,7 @@ func (gctx *GrainContext) ActorSystem() ActorSystem {
 //	        ctx.Unhandled()
 //	    }
 //	}
-func (gctx *GrainContext) Message() proto.Message {
+func (gctx *GrainContext) Message() any {
 	return gctx.message
 }
 
@@ -176,7 +185,7 @@ func (gctx *GrainContext) NoErr() {
 }
 
 // Response sets the message response
-func (gctx *GrainContext) Response(resp proto.Message) {
+func (gctx *GrainContext) Response(resp any) {
 	gctx.response <- resp
 	close(gctx.response)
 }
@@ -207,7 +216,7 @@ func (gctx *GrainContext) Response(resp proto.Message) {
 //	}
 func (gctx *GrainContext) Unhandled() {
 	msg := gctx.Message()
-	gctx.err <- NewErrUnhandledMessage(fmt.Errorf("unhandled message type %s", msg.ProtoReflect().Descriptor().FullName()))
+	gctx.err <- NewErrUnhandledMessage(fmt.Errorf("unhandled message type %T", msg))
 	close(gctx.err)
 }
 
@@ -223,7 +232,7 @@ func (gctx *GrainContext) Unhandled() {
 //	if err != nil {
 //	    // handle error
 //	}
-func (gctx *GrainContext) AskActor(actorName string, message proto.Message, timeout time.Duration) (proto.Message, error) {
+func (gctx *GrainContext) AskActor(actorName string, message any, timeout time.Duration) (any, error) {
 	ctx := context.WithoutCancel(gctx.Context())
 	return NoSender.SendSync(ctx, actorName, message, timeout)
 }
@@ -239,7 +248,7 @@ func (gctx *GrainContext) AskActor(actorName string, message proto.Message, time
 //	if err != nil {
 //	    // handle error
 //	}
-func (gctx *GrainContext) TellActor(actorName string, message proto.Message) error {
+func (gctx *GrainContext) TellActor(actorName string, message any) error {
 	ctx := context.WithoutCancel(gctx.Context())
 	return NoSender.SendAsync(ctx, actorName, message)
 }
@@ -256,7 +265,7 @@ func (gctx *GrainContext) TellActor(actorName string, message proto.Message) err
 //	if err != nil {
 //	    // handle error
 //	}
-func (gctx *GrainContext) AskGrain(to *GrainIdentity, message proto.Message, timeout time.Duration) (proto.Message, error) {
+func (gctx *GrainContext) AskGrain(to *GrainIdentity, message any, timeout time.Duration) (any, error) {
 	ctx := context.WithoutCancel(gctx.Context())
 	return gctx.actorSystem.AskGrain(ctx, to, message, timeout)
 }
@@ -272,7 +281,7 @@ func (gctx *GrainContext) AskGrain(to *GrainIdentity, message proto.Message, tim
 //	if err != nil {
 //	    // handle error
 //	}
-func (gctx *GrainContext) TellGrain(to *GrainIdentity, message proto.Message) error {
+func (gctx *GrainContext) TellGrain(to *GrainIdentity, message any) error {
 	ctx := context.WithoutCancel(gctx.Context())
 	return gctx.actorSystem.TellGrain(ctx, to, message)
 }
@@ -305,17 +314,17 @@ func (gctx *GrainContext) GrainIdentity(name string, factory GrainFactory, opts
 }
 
 // build sets the necessary fields of ReceiveContext
-func (gctx *GrainContext) build(ctx context.Context, pid *grainPID, actorSystem ActorSystem, to *GrainIdentity, message proto.Message, synchronous bool) *GrainContext {
+func (gctx *GrainContext) build(ctx context.Context, pid *grainPID, actorSystem ActorSystem, to *GrainIdentity, message any, synchronous bool) *GrainContext {
 	gctx.self = to
 	gctx.message = message
-	gctx.ctx = ctx
+	gctx.ctx = copyMetadata(ctx)
 	gctx.actorSystem = actorSystem
 	gctx.err = make(chan error, 1)
 	gctx.synchronous = synchronous
 	gctx.pid = pid
 
 	if synchronous {
-		gctx.response = make(chan proto.Message, 1)
+		gctx.response = make(chan any, 1)
 	}
 
 	return gctx
@@ -335,6 +344,6 @@ func (gctx *GrainContext) getError() <-chan error {
 	return gctx.err
 }
 
-func (gctx *GrainContext) getResponse() <-chan proto.Message {
+func (gctx *GrainContext) getResponse() <-chan any {
 	return gctx.response
 }
@@ -28,17 +28,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"net"
 	"strconv"
 	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/tochemey/goakt/v3/internal/cluster"
+	"github.com/tochemey/goakt/v3/internal/collection"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/registry"
+	"github.com/tochemey/goakt/v3/persistence"
 )
 
 // GrainIdentity retrieves or activates a Grain (virtual actor) identified by the given name.
@@ -104,6 +107,23 @@ func (x *actorSystem) GrainIdentity(ctx context.Context, name string, factory Gr
 			}
 			return identity, nil
 		}
+
+		// the grain is activated on another node when the local node does not have its role,
+		// when its placement strategy chooses another node or when the local node is draining
+		if _, ok := x.grains.Get(*identity); !ok && (!x.hasRole(config.role) || config.placementStrategy != nil || x.draining.Load()) {
+			placed, err := x.placeGrain(ctx, identity, config)
+			if err != nil {
+				return nil, err
+			}
+
+			if placed {
+				return identity, nil
+			}
+
+			if x.draining.Load() {
+				return nil, ErrActorSystemDraining
+			}
+		}
 	}
 
 	process, ok := x.grains.Get(*identity)
@@ -128,16 +148,16 @@ func (x *actorSystem) GrainIdentity(ctx context.Context, name string, factory Gr
 // TellGrain sends an asynchronous message to a Grain (virtual actor) identified by the given identity.
 //
 // This method locates or activates the target Grain (locally or in the cluster) and delivers the provided
-// protobuf message without waiting for a response. Use this for fire-and-forget scenarios where no reply is expected.
+// message without waiting for a response. Use this for fire-and-forget scenarios where no reply is expected.
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeout control.
 //   - identity: The unique identity of the Grain.
-//   - message: The protobuf message to send to the Grain.
+//   - message: The message to send to the Grain.
 //
 // Returns:
 //   - error: An error if the message could not be delivered or the system is not started.
-func (x *actorSystem) TellGrain(ctx context.Context, identity *GrainIdentity, message proto.Message) error {
+func (x *actorSystem) TellGrain(ctx context.Context, identity *GrainIdentity, message any) error {
 	if !x.started.Load() || x.isShuttingDown() {
 		return ErrActorSystemNotStarted
 	}
@@ -157,19 +177,19 @@ func (x *actorSystem) TellGrain(ctx context.Context, identity *GrainIdentity, me
 // AskGrain sends a synchronous request message to a Grain (virtual actor) identified by the given identity.
 //
 // This method locates or activates the target Grain (locally or in the cluster), sends the provided
-// protobuf message, and waits for a response or error. The request will block until a response is received,
+// message, and waits for a response or error. The request will block until a response is received,
 // the context is canceled, or the timeout elapses.
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeout control.
 //   - identity: The unique identity of the Grain.
-//   - message: The protobuf message to send to the Grain.
+//   - message: The message to send to the Grain.
 //   - timeout: The maximum duration to wait for a response.
 //
 // Returns:
 //   - response: The response message from the Grain, if successful.
 //   - error: An error if the request fails, times out, or the system is not started.
-func (x *actorSystem) AskGrain(ctx context.Context, identity *GrainIdentity, message proto.Message, timeout time.Duration) (response proto.Message, err error) {
+func (x *actorSystem) AskGrain(ctx context.Context, identity *GrainIdentity, message any, timeout time.Duration) (response any, err error) {
 	if !x.started.Load() || x.isShuttingDown() {
 		return nil, ErrActorSystemNotStarted
 	}
@@ -179,6 +199,15 @@ func (x *actorSystem) AskGrain(ctx context.Context, identity *GrainIdentity, mes
 		return nil, NewErrInvalidGrainIdentity(err)
 	}
 
+	breaker := x.circuitBreakers.get(identity.String(), identity.String(), identity.Kind())
+	generation, err := breaker.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	defer func() { breaker.record(generation, err, time.Since(start)) }()
+
 	if x.InCluster() {
 		return x.remoteAskGrain(ctx, identity, message, timeout)
 	}
@@ -213,7 +242,15 @@ func (x *actorSystem) RemoteAskGrain(ctx context.Context, request *connect.Reque
 		return nil, err
 	}
 
-	message, _ := msg.GetMessage().UnmarshalNew()
+	if err := x.assembleChunks(msg.GetPayload()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, NewErrInvalidRemoteMessage(err))
+	}
+
+	message, err := x.serializers.Deserialize(msg.GetPayload())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, NewErrInvalidRemoteMessage(err))
+	}
+
 	timeout := msg.GetRequestTimeout()
 
 	identity, err := toIdentity(msg.GetGrain().GetGrainId().GetValue())
@@ -228,14 +265,23 @@ func (x *actorSystem) RemoteAskGrain(ctx context.Context, request *connect.Reque
 		return nil, connect.NewError(connect.CodeFailedPrecondition, NewErrReservedName(identity.String()))
 	}
 
+	ctx = contextWithMetadataValues(extractContext(ctx, x.propagator, msg.GetHeaders()), withPeerIdentity(ctx, msg.GetMetadata()))
 	reply, err := x.localSend(ctx, identity, message, timeout.AsDuration(), true)
 	if err != nil {
 		logger.Errorf("failed to create grain (%s) on [host=%s, port=%d]: reason: (%v)", identity.String(), msg.GetGrain().GetHost(), msg.GetGrain().GetPort(), err)
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	response, _ := anypb.New(reply)
-	return connect.NewResponse(&internalpb.RemoteAskGrainResponse{Message: response}), nil
+	response, err := x.serializers.Serialize(reply)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, NewErrInvalidRemoteMessage(err))
+	}
+
+	if err := x.storeChunks(response); err != nil {
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
+
+	return connect.NewResponse(&internalpb.RemoteAskGrainResponse{Payload: response}), nil
 }
 
 // RemoteTellGrain handles remote fire-and-forget messages to a Grain from another node.
@@ -267,7 +313,14 @@ func (x *actorSystem) RemoteTellGrain(ctx context.Context, request *connect.Requ
 		return nil, err
 	}
 
-	message, _ := msg.GetMessage().UnmarshalNew()
+	if err := x.assembleChunks(msg.GetPayload()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, NewErrInvalidRemoteMessage(err))
+	}
+
+	message, err := x.serializers.Deserialize(msg.GetPayload())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, NewErrInvalidRemoteMessage(err))
+	}
 
 	identity, err := toIdentity(msg.GetGrain().GetGrainId().GetValue())
 	if err != nil {
@@ -281,6 +334,7 @@ func (x *actorSystem) RemoteTellGrain(ctx context.Context, request *connect.Requ
 		return nil, connect.NewError(connect.CodeFailedPrecondition, NewErrReservedName(identity.String()))
 	}
 
+	ctx = contextWithMetadataValues(extractContext(ctx, x.propagator, msg.GetHeaders()), withPeerIdentity(ctx, msg.GetMetadata()))
 	_, err = x.localSend(ctx, identity, message, DefaultGrainRequestTimeout, false)
 	if err != nil {
 		logger.Errorf("failed to create grain (%s) on [host=%s, port=%d]: reason: (%v)", identity.String(), msg.GetGrain().GetHost(), msg.GetGrain().GetPort(), err)
@@ -334,13 +388,13 @@ func (x *actorSystem) validateRemoteHost(host string, port int32) error {
 // Parameters:
 //   - ctx: context for cancellation and timeout control.
 //   - id: identity of the target Grain.
-//   - message: protobuf message to send.
+//   - message: message to send.
 //   - sender: identity of the sender (optional).
 //   - timeout: request timeout duration.
 //
 // Returns:
 //   - error: error if the request fails.
-func (x *actorSystem) remoteTellGrain(ctx context.Context, id *GrainIdentity, message proto.Message, timeout time.Duration) error {
+func (x *actorSystem) remoteTellGrain(ctx context.Context, id *GrainIdentity, message any, timeout time.Duration) error {
 	grain, err := x.getCluster().GetGrain(ctx, id.String())
 	if err != nil {
 		if errors.Is(err, cluster.ErrGrainNotFound) {
@@ -350,12 +404,26 @@ func (x *actorSystem) remoteTellGrain(ctx context.Context, id *GrainIdentity, me
 		return err
 	}
 
-	// just send the message without activating the grain
-	serialized, _ := anypb.New(message)
+	return x.tellGrainOn(ctx, grain, message)
+}
+
+// tellGrainOn sends a message to the given Grain activated on a remote node without activating it
+func (x *actorSystem) tellGrainOn(ctx context.Context, grain *internalpb.Grain, message any) error {
+	serialized, err := x.serializers.Serialize(message)
+	if err != nil {
+		return NewErrInvalidMessage(err)
+	}
+
+	if err := x.remoting.sendChunks(ctx, grain.GetHost(), int(grain.GetPort()), serialized); err != nil {
+		return err
+	}
+
 	remoteClient := x.remoting.remotingServiceClient(grain.GetHost(), int(grain.GetPort()))
 	request := connect.NewRequest(&internalpb.RemoteTellGrainRequest{
-		Grain:   grain,
-		Message: serialized,
+		Grain:    grain,
+		Payload:  serialized,
+		Headers:  injectContext(ctx, x.propagator),
+		Metadata: metadataValues(ctx),
 	})
 
 	_, err = remoteClient.RemoteTellGrain(ctx, request)
@@ -370,14 +438,14 @@ func (x *actorSystem) remoteTellGrain(ctx context.Context, id *GrainIdentity, me
 // Parameters:
 //   - ctx: context for cancellation and timeout control.
 //   - id: identity of the target Grain.
-//   - message: protobuf message to send.
+//   - message: message to send.
 //   - sender: identity of the sender (optional).
 //   - timeout: request timeout duration.
 //
 // Returns:
-//   - proto.Message: the response from the Grain.
+//   - any: the response from the Grain.
 //   - error: error if the request fails.
-func (x *actorSystem) remoteAskGrain(ctx context.Context, id *GrainIdentity, message proto.Message, timeout time.Duration) (proto.Message, error) {
+func (x *actorSystem) remoteAskGrain(ctx context.Context, id *GrainIdentity, message any, timeout time.Duration) (any, error) {
 	gw, err := x.getCluster().GetGrain(ctx, id.String())
 	if err != nil {
 		if errors.Is(err, cluster.ErrGrainNotFound) {
@@ -386,19 +454,39 @@ func (x *actorSystem) remoteAskGrain(ctx context.Context, id *GrainIdentity, mes
 		return nil, err
 	}
 
-	msg, _ := anypb.New(message)
+	return x.askGrainOn(ctx, gw, message, timeout)
+}
+
+// askGrainOn sends a message to the given Grain activated on a remote node and returns its response
+func (x *actorSystem) askGrainOn(ctx context.Context, gw *internalpb.Grain, message any, timeout time.Duration) (any, error) {
+	msg, err := x.serializers.Serialize(message)
+	if err != nil {
+		return nil, NewErrInvalidMessage(err)
+	}
+
+	if err := x.remoting.sendChunks(ctx, gw.GetHost(), int(gw.GetPort()), msg); err != nil {
+		return nil, err
+	}
+
 	remoteClient := x.remoting.remotingServiceClient(gw.GetHost(), int(gw.GetPort()))
 	request := connect.NewRequest(&internalpb.RemoteAskGrainRequest{
 		Grain:          gw,
 		RequestTimeout: durationpb.New(timeout),
-		Message:        msg,
+		Payload:        msg,
+		Headers:        injectContext(ctx, x.propagator),
+		Metadata:       metadataValues(ctx),
 	})
 
 	res, err := remoteClient.RemoteAskGrain(ctx, request)
 	if err != nil {
 		return nil, err
 	}
-	return res.Msg.GetMessage().UnmarshalNew()
+
+	reply := res.Msg.GetPayload()
+	if err := x.remoting.fetchChunks(ctx, gw.GetHost(), int(gw.GetPort()), reply); err != nil {
+		return nil, err
+	}
+	return x.serializers.Deserialize(reply)
 }
 
 // localSend sends a message to a local Grain.
@@ -408,15 +496,15 @@ func (x *actorSystem) remoteAskGrain(ctx context.Context, id *GrainIdentity, mes
 // Parameters:
 //   - ctx: context for cancellation and timeout control.
 //   - id: identity of the target Grain.
-//   - message: protobuf message to send.
+//   - message: message to send.
 //   - sender: identity of the sender (optional).
 //   - timeout: request timeout duration.
 //   - synchronous: whether to wait for a response (true for Ask, false for Tell).
 //
 // Returns:
-//   - proto.Message: the response from the Grain (if synchronous).
+//   - any: the response from the Grain (if synchronous).
 //   - error: error if the request fails.
-func (x *actorSystem) localSend(ctx context.Context, id *GrainIdentity, message proto.Message, timeout time.Duration, synchronous bool) (proto.Message, error) {
+func (x *actorSystem) localSend(ctx context.Context, id *GrainIdentity, message any, timeout time.Duration, synchronous bool) (any, error) {
 	// Ensure the grain process exists
 	pid, err := x.ensureGrainProcess(id)
 	if err != nil {
@@ -463,7 +551,8 @@ func (x *actorSystem) localSend(ctx context.Context, id *GrainIdentity, message
 	}
 }
 
-// ensureGrainProcess returns an existing grain process or creates and activates a new one.
+// ensureGrainProcess returns the existing grain process of the given identity.
+// Grains are only activated through GrainIdentity, hence a missing process is reported as not registered.
 func (x *actorSystem) ensureGrainProcess(id *GrainIdentity) (*grainPID, error) {
 	process, ok := x.grains.Get(*id)
 	if ok {
@@ -475,7 +564,69 @@ func (x *actorSystem) ensureGrainProcess(id *GrainIdentity) (*grainPID, error) {
 		return process, nil
 	}
 
-	return process, nil
+	// a draining node does not activate new grains
+	if x.draining.Load() {
+		return nil, ErrActorSystemDraining
+	}
+
+	return nil, ErrGrainNotRegistered
+}
+
+// placeGrain activates the given grain on the cluster node, having its role, chosen by its placement strategy
+// or on a random one when the grain has no placement strategy. It returns false when the local node is chosen
+func (x *actorSystem) placeGrain(ctx context.Context, identity *GrainIdentity, config *grainConfig) (bool, error) {
+	peers, err := x.placementPeers(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch cluster nodes: %w", err)
+	}
+
+	peers = collection.Filter(peers, func(peer *cluster.Peer) bool {
+		return peer.HasRole(config.role)
+	})
+
+	var peer *cluster.Peer
+	switch {
+	case config.placementStrategy != nil:
+		if peer, err = x.place(ctx, config.placementStrategy, peers, config.role); err != nil {
+			return false, fmt.Errorf("failed to place grain=(%s): %w", identity.String(), err)
+		}
+
+		if peer == nil {
+			return false, nil
+		}
+	case len(peers) == 0:
+		return false, ErrRoleNotFound
+	default:
+		peer = peers[rand.IntN(len(peers))] //nolint:gosec
+	}
+
+	grain := &internalpb.Grain{
+		GrainId: &internalpb.GrainId{
+			Kind:  identity.Kind(),
+			Name:  identity.Name(),
+			Value: identity.String(),
+		},
+		ActivationTimeout: durationpb.New(config.initTimeout.Load()),
+		ActivationRetries: config.initMaxRetries.Load(),
+		DeactivateAfter:   durationpb.New(config.deactivateAfter),
+		Role:              config.role,
+	}
+
+	if err := x.activateGrainOn(ctx, peer, grain); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// activateGrainOn activates the given serialized grain on the given cluster node
+func (x *actorSystem) activateGrainOn(ctx context.Context, peer *cluster.Peer, grain *internalpb.Grain) error {
+	grain.Host = peer.Host
+	grain.Port = int32(peer.RemotingPort)
+
+	remoteClient := x.remoting.remotingServiceClient(peer.Host, peer.RemotingPort)
+	request := connect.NewRequest(&internalpb.RemoteActivateGrainRequest{Grain: grain})
+	_, err := remoteClient.RemoteActivateGrain(ctx, request)
+	return err
 }
 
 // recreateGrain recreates a serialized Grain.
@@ -504,17 +655,41 @@ func (x *actorSystem) recreateGrain(ctx context.Context, serializedGrain *intern
 
 	process, ok = x.grains.Get(*identity)
 	if !ok {
+		// a draining node does not activate new grains
+		if x.draining.Load() {
+			return ErrActorSystemDraining
+		}
+
 		grain, err := x.getReflection().NewGrain(identity.Kind())
 		if err != nil {
 			return err
 		}
 
-		config := newGrainConfig(
+		opts := []GrainOption{
 			WithGrainInitTimeout(serializedGrain.GetActivationTimeout().AsDuration()),
 			WithGrainInitMaxRetries(int(serializedGrain.GetActivationRetries())),
-		)
+		}
+
+		if serializedGrain.GetDeactivateAfter() != nil {
+			opts = append(opts, WithGrainDeactivateAfter(serializedGrain.GetDeactivateAfter().AsDuration()))
+		}
+
+		if serializedGrain.GetRole() != "" {
+			opts = append(opts, WithGrainRole(serializedGrain.GetRole()))
+		}
+
+		config := newGrainConfig(opts...)
+
+		dependencies, err := x.getReflection().NewDependencies(serializedGrain.GetDependencies()...)
+		if err != nil {
+			return err
+		}
 
 		process = newGrainPID(identity, grain, x, config)
+		for _, dependency := range dependencies {
+			process.dependencies.Set(dependency.ID(), dependency)
+		}
+
 		if err := process.activate(ctx); err != nil {
 			return err
 		}
@@ -539,3 +714,142 @@ func (x *actorSystem) recreateGrain(ctx context.Context, serializedGrain *intern
 	// Register in the cluster
 	return x.putGrainOnCluster(process)
 }
+
+// reactivateRememberedGrains reactivates the long-lived grains recorded in the remembered grain store.
+//
+// Only the grains whose partition is owned by the given node and that are not already active
+// in the cluster are reactivated. A grain requiring a role the node does not have is activated on
+// a node having that role. Failures are logged and the grains are retried on the next reactivation.
+func (x *actorSystem) reactivateRememberedGrains(ctx context.Context) {
+	store := x.getRememberedGrainStore()
+	if store == nil || !x.InCluster() {
+		return
+	}
+
+	logger := x.logger
+	grains, err := store.RememberedGrains(ctx)
+	if err != nil {
+		logger.Errorf("failed to fetch the remembered grains: %v", err)
+		return
+	}
+
+	if len(grains) == 0 {
+		return
+	}
+
+	partitions, err := x.getCluster().OwnedPartitions(ctx)
+	if err != nil {
+		logger.Errorf("failed to fetch the owned partitions: %v", err)
+		return
+	}
+
+	owned := make(map[int]struct{}, len(partitions))
+	for _, partition := range partitions {
+		owned[partition] = struct{}{}
+	}
+
+	logger.Infof("reactivating remembered grains on node=(%s)...", x.clusterNode.PeersAddress())
+	for _, grain := range grains {
+		if x.isShuttingDown() || !x.InCluster() {
+			return
+		}
+
+		if _, ok := owned[x.getCluster().GetPartition(grain.ID)]; !ok {
+			continue
+		}
+
+		exists, err := x.getCluster().GrainExists(ctx, grain.ID)
+		if err != nil {
+			logger.Errorf("failed to check remembered grain (%s) existence: %v", grain.ID, err)
+			continue
+		}
+
+		if exists {
+			continue
+		}
+
+		if err := x.reactivateRememberedGrain(ctx, grain); err != nil {
+			logger.Errorf("failed to reactivate remembered grain (%s): %v", grain.ID, err)
+			continue
+		}
+
+		logger.Infof("remembered grain (%s) reactivated", grain.ID)
+	}
+}
+
+// reactivateRememberedGrain reactivates the given remembered grain locally,
+// or on a node having its role when the local node does not have it or is draining
+func (x *actorSystem) reactivateRememberedGrain(ctx context.Context, grain *persistence.RememberedGrain) error {
+	dependencies := make([]*internalpb.Dependency, 0, len(grain.Dependencies))
+	for _, dependency := range grain.Dependencies {
+		dependencies = append(dependencies, &internalpb.Dependency{
+			Id:       dependency.ID,
+			TypeName: dependency.TypeName,
+			Bytea:    dependency.Bytea,
+		})
+	}
+
+	serializedGrain := &internalpb.Grain{
+		GrainId: &internalpb.GrainId{
+			Kind:  grain.Kind,
+			Name:  grain.Name,
+			Value: grain.ID,
+		},
+		Host:              x.Host(),
+		Port:              int32(x.Port()),
+		Dependencies:      dependencies,
+		ActivationTimeout: durationpb.New(grain.ActivationTimeout),
+		ActivationRetries: grain.ActivationRetries,
+		DeactivateAfter:   durationpb.New(-1),
+		Role:              grain.Role,
+	}
+
+	if x.hasRole(grain.Role) && !x.draining.Load() {
+		return x.recreateGrain(ctx, serializedGrain)
+	}
+
+	peers, err := x.placementPeers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch cluster nodes: %w", err)
+	}
+
+	peers = collection.Filter(peers, func(peer *cluster.Peer) bool {
+		return peer.HasRole(grain.Role)
+	})
+
+	if len(peers) == 0 {
+		return ErrRoleNotFound
+	}
+
+	return x.activateGrainOn(ctx, peers[rand.IntN(len(peers))], serializedGrain) //nolint:gosec
+}
+
+// triggerGrainsReactivation requests the reactivation of the remembered grains.
+// The request is dropped when a reactivation is already pending
+func (x *actorSystem) triggerGrainsReactivation() {
+	if x.getRememberedGrainStore() == nil {
+		return
+	}
+
+	x.rebalanceLocker.Lock()
+	defer x.rebalanceLocker.Unlock()
+	if !x.InCluster() {
+		return
+	}
+
+	select {
+	case x.grainsReactivationQueue <- registry.Unit{}:
+	default:
+	}
+}
+
+// grainsReactivationLoop reactivates the remembered grains whenever requested.
+// It runs in the background so that the actor system start does not wait for the grains activation
+func (x *actorSystem) grainsReactivationLoop() {
+	for range x.grainsReactivationQueue {
+		if x.isShuttingDown() {
+			continue
+		}
+		x.reactivateRememberedGrains(context.Background())
+	}
+}
@@ -37,6 +37,10 @@ type grainConfig struct {
 	// initTimeout is the timeout duration for grain initialization.
 	initTimeout     atomic.Duration
 	deactivateAfter time.Duration
+	// role is the cluster node role required to host the grain
+	role string
+	// placementStrategy chooses the cluster node the grain is activated on
+	placementStrategy PlacementStrategy
 }
 
 // newGrainConfig creates a new grainConfig instance and applies the provided GrainOption(s).
@@ -134,3 +138,37 @@ func WithLongLivedGrain() GrainOption {
 		config.deactivateAfter = -1
 	}
 }
+
+// WithGrainRole returns a GrainOption that restricts the activation of the grain, in cluster mode,
+// to the nodes having the given role. See WithRoles in the cluster config.
+//
+// When the local node does not have the role, the grain is activated on a cluster node having it.
+// When relocated or handed off, the grain only moves to cluster nodes having the role.
+//
+// Parameters:
+//   - role: the cluster node role required to host the grain.
+//
+// Returns:
+//   - GrainOption: a function that sets the role.
+func WithGrainRole(role string) GrainOption {
+	return func(config *grainConfig) {
+		config.role = role
+	}
+}
+
+// WithGrainPlacementStrategy returns a GrainOption that sets the PlacementStrategy choosing, in cluster mode,
+// the node the grain is activated on when it is not active anywhere in the cluster. By default a grain
+// is activated on the node requesting it.
+//
+// The built-in strategies are created with NewLeastLoadPlacement and NewMemoryAwarePlacement.
+//
+// Parameters:
+//   - strategy: the PlacementStrategy choosing the node.
+//
+// Returns:
+//   - GrainOption: a function that sets the placementStrategy.
+func WithGrainPlacementStrategy(strategy PlacementStrategy) GrainOption {
+	return func(config *grainConfig) {
+		config.placementStrategy = strategy
+	}
+}
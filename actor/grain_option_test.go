@@ -59,4 +59,19 @@ func TestGrainOptions(t *testing.T) {
 		option(config)
 		require.Equal(t, time.Duration(-1), config.deactivateAfter)
 	})
+
+	t.Run("WithGrainRole", func(t *testing.T) {
+		config := &grainConfig{}
+		option := WithGrainRole("payments")
+		option(config)
+		require.Equal(t, "payments", config.role)
+	})
+
+	t.Run("WithGrainPlacementStrategy", func(t *testing.T) {
+		config := &grainConfig{}
+		strategy := NewMemoryAwarePlacement()
+		option := WithGrainPlacementStrategy(strategy)
+		option(config)
+		require.Equal(t, strategy, config.placementStrategy)
+	})
 }
@@ -33,15 +33,18 @@ import (
 	"time"
 
 	"github.com/flowchartsman/retry"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/atomic"
 
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/goaktpb"
 	"github.com/tochemey/goakt/v3/internal/collection"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/registry"
 	"github.com/tochemey/goakt/v3/internal/ticker"
 	"github.com/tochemey/goakt/v3/internal/workerpool"
 	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
 )
 
 type grainPID struct {
@@ -73,6 +76,15 @@ type grainPID struct {
 	deactivateAfter    *atomic.Duration
 
 	onPoisonPill *atomic.Bool
+	durableState *durableState
+
+	metrics          *metricsRecorder
+	metricAttributes metric.MeasurementOption
+
+	// set while the grain is handed off to another node
+	held        atomic.Bool
+	handedOffTo atomic.Pointer[internalpb.Grain]
+	forwardLock sync.Mutex
 }
 
 func newGrainPID(identity *GrainIdentity, grain Grain, actorSystem ActorSystem, config *grainConfig) *grainPID {
@@ -95,6 +107,15 @@ func newGrainPID(identity *GrainIdentity, grain Grain, actorSystem ActorSystem,
 
 	pid.processing.Store(idle)
 
+	if recorder := actorSystem.getMetricsRecorder(); recorder != nil {
+		pid.metrics = recorder
+		pid.metricAttributes = recorder.grainAttributes(identity)
+	}
+
+	if store := actorSystem.getDurableStateStore(); store != nil {
+		pid.durableState = newDurableState(store, identity.String())
+	}
+
 	return pid
 }
 
@@ -110,7 +131,7 @@ func (pid *grainPID) activate(ctx context.Context) error {
 	retrier := retry.NewRetrier(int(retries), timeout, timeout)
 
 	if err := retrier.RunContext(cctx, func(ctx context.Context) error {
-		return pid.grain.OnActivate(ctx, newGrainProps(pid.identity, pid.actorSystem))
+		return pid.grain.OnActivate(ctx, newGrainProps(pid.identity, pid.actorSystem, pid.durableState))
 	}); err != nil {
 		cancel()
 		pid.logger.Errorf("Grain %s activation failed.", pid.identity.String())
@@ -118,10 +139,19 @@ func (pid *grainPID) activate(ctx context.Context) error {
 	}
 
 	pid.activated.Store(true)
+	pid.metrics.recordGrainActivation(pid.metricAttributes)
 	pid.deactivateAfter = atomic.NewDuration(pid.config.deactivateAfter)
 	pid.logger.Infof("Grain %s successfully activated.", pid.identity.String())
 	cancel()
 
+	pid.remember(ctx)
+
+	// drop the halt signal of a previous deactivation, if any
+	select {
+	case <-pid.haltPassivationLnr:
+	default:
+	}
+
 	if pid.deactivateAfter.Load() > 0 {
 		go pid.deactivationLoop()
 	}
@@ -131,6 +161,13 @@ func (pid *grainPID) activate(ctx context.Context) error {
 
 // deactivate deactivates the Grain
 func (pid *grainPID) deactivate(ctx context.Context) error {
+	// the grain is kept in the remembered grain store when its node shuts down
+	// so that it is reactivated when the cluster restarts
+	return pid.doDeactivate(ctx, !pid.actorSystem.isShuttingDown())
+}
+
+// doDeactivate deactivates the Grain and removes it from the remembered grain store when forget is set
+func (pid *grainPID) doDeactivate(ctx context.Context, forget bool) error {
 	logger := pid.logger
 
 	defer func() {
@@ -142,15 +179,20 @@ func (pid *grainPID) deactivate(ctx context.Context) error {
 	pid.haltPassivationLnr <- registry.Unit{}
 
 	logger.Infof("Deactivating Grain %s ...", pid.identity.String())
+	// the remoting is shared with the actor system, only free its idle connections
 	if pid.remoting != nil {
-		pid.remoting.Close()
+		pid.remoting.HTTPClient().CloseIdleConnections()
 	}
 
-	if err := pid.grain.OnDeactivate(ctx, newGrainProps(pid.identity, pid.actorSystem)); err != nil {
+	if err := pid.grain.OnDeactivate(ctx, newGrainProps(pid.identity, pid.actorSystem, pid.durableState)); err != nil {
 		pid.logger.Errorf("Grain %s deactivation failed.", pid.identity.String())
 		return NewErrGrainDeactivationFailure(err)
 	}
 
+	if forget {
+		pid.forget(ctx)
+	}
+
 	pid.actorSystem.getGrains().Delete(*pid.identity)
 	if pid.actorSystem.InCluster() {
 		if err := pid.actorSystem.getCluster().RemoveGrain(ctx, pid.identity.String()); err != nil {
@@ -163,6 +205,55 @@ func (pid *grainPID) deactivate(ctx context.Context) error {
 	return nil
 }
 
+// remember records the Grain in the remembered grain store when it is long-lived
+func (pid *grainPID) remember(ctx context.Context) {
+	store := pid.actorSystem.getRememberedGrainStore()
+	if store == nil || pid.config.deactivateAfter > 0 || isReservedName(pid.identity.Name()) {
+		return
+	}
+
+	dependencies, err := marshalDependencies(pid.dependencies.Values()...)
+	if err != nil {
+		pid.logger.Errorf("failed to remember Grain %s: %v", pid.identity.String(), err)
+		return
+	}
+
+	grain := &persistence.RememberedGrain{
+		ID:                pid.identity.String(),
+		Kind:              pid.identity.Kind(),
+		Name:              pid.identity.Name(),
+		ActivationTimeout: pid.config.initTimeout.Load(),
+		ActivationRetries: pid.config.initMaxRetries.Load(),
+		Role:              pid.config.role,
+		Dependencies:      make([]*persistence.GrainDependency, 0, len(dependencies)),
+		Timestamp:         time.Now().UTC(),
+	}
+
+	for _, dependency := range dependencies {
+		grain.Dependencies = append(grain.Dependencies, &persistence.GrainDependency{
+			ID:       dependency.GetId(),
+			TypeName: dependency.GetTypeName(),
+			Bytea:    dependency.GetBytea(),
+		})
+	}
+
+	if err := store.RememberGrain(ctx, grain); err != nil {
+		pid.logger.Errorf("failed to remember Grain %s: %v", pid.identity.String(), err)
+	}
+}
+
+// forget removes the Grain from the remembered grain store when it is long-lived
+func (pid *grainPID) forget(ctx context.Context) {
+	store := pid.actorSystem.getRememberedGrainStore()
+	if store == nil || pid.config.deactivateAfter > 0 {
+		return
+	}
+
+	if err := store.ForgetGrain(ctx, pid.identity.String()); err != nil {
+		pid.logger.Errorf("failed to forget Grain %s: %v", pid.identity.String(), err)
+	}
+}
+
 // isActive returns true when the actor is alive ready to process messages and false
 // when the actor is stopped or not started at all
 func (pid *grainPID) isActive() bool {
@@ -172,8 +263,15 @@ func (pid *grainPID) isActive() bool {
 // receive pushes a given message to the actor mailbox
 // and signals the receiveLoop to process it
 func (pid *grainPID) receive(grainContext *GrainContext) {
-	if pid.isActive() {
+	if pid.isActive() || pid.held.Load() {
 		pid.mailbox.Enqueue(grainContext)
+
+		// the grain handed off to another node no longer processes its messages
+		if pid.handedOffTo.Load() != nil {
+			pid.forwardMessages()
+			return
+		}
+
 		pid.schedule()
 	}
 }
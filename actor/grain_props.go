@@ -24,6 +24,12 @@
 
 package actor
 
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
 // GrainProps encapsulates configuration and metadata for a Grain (virtual actor) in the goakt actor system.
 //
 // It holds the unique identity of the Grain and a reference to the ActorSystem that manages it.
@@ -31,11 +37,13 @@ package actor
 // and associated with the correct actor system.
 //
 // Fields:
-//   - identity:    The unique identity of the Grain, used for addressing and routing.
-//   - actorSystem: The ActorSystem instance that owns and manages the Grain.
+//   - identity:     The unique identity of the Grain, used for addressing and routing.
+//   - actorSystem:  The ActorSystem instance that owns and manages the Grain.
+//   - durableState: The binding of the Grain to the durable state store, when configured.
 type GrainProps struct {
-	identity    *GrainIdentity
-	actorSystem ActorSystem
+	identity     *GrainIdentity
+	actorSystem  ActorSystem
+	durableState *durableState
 }
 
 // newGrainProps creates and returns a new GrainProps instance for the specified identity and actor system.
@@ -43,15 +51,17 @@ type GrainProps struct {
 // This function is used internally by the framework to construct the properties required to manage a Grain.
 //
 // Parameters:
-//   - identity:    The unique identity of the Grain.
-//   - actorSystem: The ActorSystem that will manage the Grain.
+//   - identity:     The unique identity of the Grain.
+//   - actorSystem:  The ActorSystem that will manage the Grain.
+//   - durableState: The binding of the Grain to the durable state store. It can be nil.
 //
 // Returns:
 //   - *GrainProps: A new instance containing the provided identity and actor system.
-func newGrainProps(identity *GrainIdentity, actorSystem ActorSystem) *GrainProps {
+func newGrainProps(identity *GrainIdentity, actorSystem ActorSystem, durableState *durableState) *GrainProps {
 	return &GrainProps{
-		identity:    identity,
-		actorSystem: actorSystem,
+		identity:     identity,
+		actorSystem:  actorSystem,
+		durableState: durableState,
 	}
 }
 
@@ -76,3 +86,46 @@ func (p *GrainProps) Identity() *GrainIdentity {
 func (p *GrainProps) ActorSystem() ActorSystem {
 	return p.actorSystem
 }
+
+// LoadState returns the latest state of the Grain from the durable state store
+// configured on the actor system using WithDurableStateStore.
+//
+// It is typically called in OnActivate so that a Grain gets its state back after being
+// deactivated or moved to another node. The persistence ID of the Grain is its identity.
+//
+// Parameters:
+//   - ctx: context for cancellation and deadlines.
+//
+// Returns:
+//   - proto.Message: the latest saved state, or nil when none exists.
+//   - error: ErrDurableStateStoreNotSet when no durable state store is configured, or any store failure.
+func (p *GrainProps) LoadState(ctx context.Context) (proto.Message, error) {
+	return p.durableState.load(ctx)
+}
+
+// SaveState writes the given state of the Grain to the durable state store.
+//
+// It is typically called in OnDeactivate, or whenever the Grain state changes.
+// The write fails with persistence.ErrRevisionConflict when another activation of the Grain
+// has saved its state since the last LoadState or SaveState call.
+//
+// Parameters:
+//   - ctx:   context for cancellation and deadlines.
+//   - state: the Grain state to save.
+//
+// Returns:
+//   - error: ErrDurableStateStoreNotSet when no durable state store is configured, or any store failure.
+func (p *GrainProps) SaveState(ctx context.Context, state proto.Message) error {
+	return p.durableState.save(ctx, state)
+}
+
+// DeleteState removes the state of the Grain from the durable state store.
+//
+// Parameters:
+//   - ctx: context for cancellation and deadlines.
+//
+// Returns:
+//   - error: ErrDurableStateStoreNotSet when no durable state store is configured, or any store failure.
+func (p *GrainProps) DeleteState(ctx context.Context) error {
+	return p.durableState.remove(ctx)
+}
@@ -37,13 +37,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/travisjeffery/go-dynaport"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/tochemey/goakt/v3/goaktpb"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/pause"
 	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
 	"github.com/tochemey/goakt/v3/remote"
 	"github.com/tochemey/goakt/v3/test/data/testpb"
 )
@@ -197,6 +197,138 @@ func TestGrain(t *testing.T) {
 		require.NoError(t, sd3.Close())
 		srv.Shutdown()
 	})
+	t.Run("With remembered grains after a cluster restart", func(t *testing.T) {
+		ctx := t.Context()
+		// start the NATS server
+		srv := startNatsServer(t)
+		store := persistence.NewMemoryRememberedGrainStore()
+
+		node1, sd1 := testCluster(t, srv.Addr().String(), withTestRememberedGrainStore(store))
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		identity, err := node1.GrainIdentity(ctx, "session", func(_ context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		}, WithLongLivedGrain())
+		require.NoError(t, err)
+		require.NotNil(t, identity)
+
+		// only long-lived grains are remembered
+		other, err := node1.GrainIdentity(ctx, "other", func(_ context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		})
+		require.NoError(t, err)
+		require.NotNil(t, other)
+
+		grains, err := store.RememberedGrains(ctx)
+		require.NoError(t, err)
+		require.Len(t, grains, 1)
+		require.Equal(t, identity.String(), grains[0].ID)
+
+		// stop the whole cluster
+		require.NoError(t, node1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+
+		grains, err = store.RememberedGrains(ctx)
+		require.NoError(t, err)
+		require.Len(t, grains, 1)
+
+		// restart the cluster
+		node2, sd2 := testCluster(t, srv.Addr().String(), withTestRememberedGrainStore(store))
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		// the long-lived grain is reactivated without any message
+		gp, ok := node2.(*actorSystem).grains.Get(*identity)
+		require.True(t, ok)
+		require.True(t, gp.isActive())
+		require.Negative(t, gp.config.deactivateAfter)
+
+		_, ok = node2.(*actorSystem).grains.Get(*other)
+		require.False(t, ok)
+
+		exists, err := node2.(*actorSystem).getCluster().GrainExists(ctx, identity.String())
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		response, err := node2.AskGrain(ctx, identity, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		require.IsType(t, &testpb.Reply{}, response)
+
+		// a deactivated grain is forgotten
+		require.NoError(t, node2.TellGrain(ctx, identity, new(goaktpb.PoisonPill)))
+		pause.For(time.Second)
+
+		grains, err = store.RememberedGrains(ctx)
+		require.NoError(t, err)
+		require.Empty(t, grains)
+
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
+	t.Run("With remembered grain reactivated on a node having its role", func(t *testing.T) {
+		ctx := t.Context()
+		// start the NATS server
+		srv := startNatsServer(t)
+		store := persistence.NewMemoryRememberedGrainStore()
+
+		node1, sd1 := testCluster(t, srv.Addr().String(), withTestRememberedGrainStore(store), withTestRoles("edge"))
+		require.NotNil(t, node1)
+		require.NotNil(t, sd1)
+
+		identity, err := node1.GrainIdentity(ctx, "session", func(_ context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		}, WithLongLivedGrain(), WithGrainRole("edge"))
+		require.NoError(t, err)
+		require.NotNil(t, identity)
+
+		grains, err := store.RememberedGrains(ctx)
+		require.NoError(t, err)
+		require.Len(t, grains, 1)
+		require.Equal(t, "edge", grains[0].Role)
+
+		// stop the whole cluster
+		require.NoError(t, node1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+
+		// restart the cluster with a node that does not have the grain role
+		node2, sd2 := testCluster(t, srv.Addr().String(), withTestRememberedGrainStore(store))
+		require.NotNil(t, node2)
+		require.NotNil(t, sd2)
+
+		_, ok := node2.(*actorSystem).grains.Get(*identity)
+		require.False(t, ok)
+
+		// the grain is reactivated once a node having its role joins the cluster
+		node3, sd3 := testCluster(t, srv.Addr().String(), withTestRememberedGrainStore(store), withTestRoles("edge"))
+		require.NotNil(t, node3)
+		require.NotNil(t, sd3)
+
+		require.Eventually(t, func() bool {
+			gp, ok := node3.(*actorSystem).grains.Get(*identity)
+			return ok && gp.isActive()
+		}, 5*time.Second, 100*time.Millisecond)
+
+		_, ok = node2.(*actorSystem).grains.Get(*identity)
+		require.False(t, ok)
+
+		// the grain is put on the cluster asynchronously by node3
+		require.Eventually(t, func() bool {
+			_, err := node2.getCluster().GetGrain(ctx, identity.String())
+			return err == nil
+		}, 5*time.Second, 100*time.Millisecond)
+
+		response, err := node2.AskGrain(ctx, identity, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		require.IsType(t, &testpb.Reply{}, response)
+
+		require.NoError(t, node3.Stop(ctx))
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, sd3.Close())
+		require.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
 	t.Run("With unhandled message", func(t *testing.T) {
 		ctx := t.Context()
 		testSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
@@ -537,12 +669,12 @@ func TestGrain(t *testing.T) {
 			Dependencies: nil,
 		}
 
-		serialized, _ := anypb.New(grain)
+		serialized, _ := testSystem.getSerializers().Serialize(grain)
 		remoteClient := testSystem.getRemoting().remotingServiceClient(grain.GetHost(), int(grain.GetPort()))
 
 		_, err = remoteClient.RemoteTellGrain(ctx, connect.NewRequest(&internalpb.RemoteTellGrainRequest{
 			Grain:   grain,
-			Message: serialized,
+			Payload: serialized,
 		}))
 		require.Error(t, err)
 		var connectErr *connect.Error
@@ -553,7 +685,7 @@ func TestGrain(t *testing.T) {
 		_, err = remoteClient.RemoteAskGrain(ctx, connect.NewRequest(&internalpb.RemoteAskGrainRequest{
 			Grain:          grain,
 			RequestTimeout: durationpb.New(timeout),
-			Message:        serialized,
+			Payload:        serialized,
 		}))
 		require.Error(t, err)
 		require.True(t, errors.As(err, &connectErr))
@@ -590,12 +722,12 @@ func TestGrain(t *testing.T) {
 			Dependencies: nil,
 		}
 
-		serialized, _ := anypb.New(grain)
+		serialized, _ := testSystem.getSerializers().Serialize(grain)
 		remoteClient := testSystem.getRemoting().remotingServiceClient(grain.GetHost(), int(grain.GetPort()))
 
 		_, err = remoteClient.RemoteTellGrain(ctx, connect.NewRequest(&internalpb.RemoteTellGrainRequest{
 			Grain:   grain,
-			Message: serialized,
+			Payload: serialized,
 		}))
 		require.Error(t, err)
 		var connectErr *connect.Error
@@ -606,7 +738,7 @@ func TestGrain(t *testing.T) {
 		_, err = remoteClient.RemoteAskGrain(ctx, connect.NewRequest(&internalpb.RemoteAskGrainRequest{
 			Grain:          grain,
 			RequestTimeout: durationpb.New(timeout),
-			Message:        serialized,
+			Payload:        serialized,
 		}))
 		require.Error(t, err)
 		require.True(t, errors.As(err, &connectErr))
@@ -647,12 +779,12 @@ func TestGrain(t *testing.T) {
 			Dependencies: nil,
 		}
 
-		serialized, _ := anypb.New(grain)
+		serialized, _ := testSystem.getSerializers().Serialize(grain)
 		remoteClient := testSystem.getRemoting().remotingServiceClient(grain.GetHost(), int(grain.GetPort()))
 
 		_, err = remoteClient.RemoteTellGrain(ctx, connect.NewRequest(&internalpb.RemoteTellGrainRequest{
 			Grain:   grain,
-			Message: serialized,
+			Payload: serialized,
 		}))
 		require.Error(t, err)
 		var connectErr *connect.Error
@@ -663,7 +795,7 @@ func TestGrain(t *testing.T) {
 		_, err = remoteClient.RemoteAskGrain(ctx, connect.NewRequest(&internalpb.RemoteAskGrainRequest{
 			Grain:          grain,
 			RequestTimeout: durationpb.New(timeout),
-			Message:        serialized,
+			Payload:        serialized,
 		}))
 		require.Error(t, err)
 		require.True(t, errors.As(err, &connectErr))
@@ -709,12 +841,12 @@ func TestGrain(t *testing.T) {
 			Dependencies: nil,
 		}
 
-		serialized, _ := anypb.New(grain)
+		serialized, _ := testSystem.getSerializers().Serialize(grain)
 		remoteClient := testSystem.getRemoting().remotingServiceClient(grain.GetHost(), int(grain.GetPort()))
 
 		_, err = remoteClient.RemoteTellGrain(ctx, connect.NewRequest(&internalpb.RemoteTellGrainRequest{
 			Grain:   grain,
-			Message: serialized,
+			Payload: serialized,
 		}))
 		require.Error(t, err)
 		var connectErr *connect.Error
@@ -725,7 +857,7 @@ func TestGrain(t *testing.T) {
 		_, err = remoteClient.RemoteAskGrain(ctx, connect.NewRequest(&internalpb.RemoteAskGrainRequest{
 			Grain:          grain,
 			RequestTimeout: durationpb.New(timeout),
-			Message:        serialized,
+			Payload:        serialized,
 		}))
 		require.Error(t, err)
 		require.True(t, errors.As(err, &connectErr))
@@ -25,11 +25,14 @@
 package actor
 
 import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/registry"
+	"github.com/tochemey/goakt/v3/internal/serialization"
 	"github.com/tochemey/goakt/v3/passivation"
 )
 
@@ -97,3 +100,42 @@ func marshalDependencies(dependencies ...extension.Dependency) ([]*internalpb.De
 	}
 	return output, nil
 }
+
+// marshalAny packs the given message into an Any when it is a protocol buffers message.
+// It returns nil for any other message.
+func marshalAny(message any) *anypb.Any {
+	msg, ok := message.(proto.Message)
+	if !ok {
+		return nil
+	}
+	packed, _ := anypb.New(msg)
+	return packed
+}
+
+// marshalPayload serializes the given message with the given serializers.
+// The payload of a message that cannot be serialized only carries the type of the message.
+// It returns nil when there is no message
+func marshalPayload(serializers *serialization.Registry, message any) *internalpb.Payload {
+	if message == nil {
+		return nil
+	}
+
+	payload, err := serializers.Serialize(message)
+	if err != nil {
+		return &internalpb.Payload{Manifest: serializers.Manifest(message)}
+	}
+	return payload
+}
+
+// marshalFactoryArgs packs the arguments of an actor factory into an Any.
+// Arguments already packed are returned as is.
+func marshalFactoryArgs(args proto.Message) (*anypb.Any, error) {
+	switch msg := args.(type) {
+	case nil:
+		return nil, nil
+	case *anypb.Any:
+		return msg, nil
+	default:
+		return anypb.New(msg)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"maps"
+	"slices"
+)
+
+type metadataKey struct{}
+
+// Metadata holds the key/value pairs that travel along with a message, such as
+// correlation IDs, tenant IDs or authentication claims.
+//
+// Metadata is attached to the messages sent with a given context using ContextWithMetadata
+// and is read by the receiver through ReceiveContext.Metadata or GrainContext.Metadata.
+// Metadata is not safe for concurrent use.
+type Metadata struct {
+	values map[string]string
+}
+
+// NewMetadata creates an empty Metadata
+func NewMetadata() *Metadata {
+	return &Metadata{values: make(map[string]string)}
+}
+
+// Set sets the value of the given key, replacing any existing value.
+// When called on a nil Metadata, Set returns a new Metadata holding the key/value pair.
+func (m *Metadata) Set(key, value string) *Metadata {
+	if m == nil {
+		return NewMetadata().Set(key, value)
+	}
+	if m.values == nil {
+		m.values = make(map[string]string)
+	}
+	m.values[key] = value
+	return m
+}
+
+// Get returns the value of the given key and whether the key exists
+func (m *Metadata) Get(key string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Delete removes the given key
+func (m *Metadata) Delete(key string) {
+	if m == nil {
+		return
+	}
+	delete(m.values, key)
+}
+
+// Keys returns the sorted list of keys
+func (m *Metadata) Keys() []string {
+	if m == nil {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(m.values))
+}
+
+// Len returns the number of key/value pairs
+func (m *Metadata) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.values)
+}
+
+// Map returns a copy of the key/value pairs
+func (m *Metadata) Map() map[string]string {
+	if m == nil {
+		return nil
+	}
+	return maps.Clone(m.values)
+}
+
+// ContextWithMetadata returns a copy of the given context carrying the given metadata.
+// The metadata is sent along with every message sent with the returned context, whether
+// locally or remotely, including when the message is forwarded.
+// The metadata is copied so that later changes to md do not affect the returned context.
+func ContextWithMetadata(ctx context.Context, md *Metadata) context.Context {
+	return context.WithValue(ctx, metadataKey{}, &Metadata{values: md.Map()})
+}
+
+// MetadataFromContext returns the metadata carried by the given context.
+// It returns nil when the context does not carry any metadata
+func MetadataFromContext(ctx context.Context) *Metadata {
+	md, _ := ctx.Value(metadataKey{}).(*Metadata)
+	return md
+}
+
+// copyMetadata returns a copy of the given context carrying its own copy of the metadata
+// so that the receiver of a message cannot alter the metadata of the sender
+func copyMetadata(ctx context.Context) context.Context {
+	if md := MetadataFromContext(ctx); md != nil {
+		return ContextWithMetadata(ctx, md)
+	}
+	return ctx
+}
+
+// metadataValues returns the metadata carried by the given context as
+// key/value pairs ready to be sent over the wire
+func metadataValues(ctx context.Context) map[string]string {
+	md := MetadataFromContext(ctx)
+	if md.Len() == 0 {
+		return nil
+	}
+	return md.values
+}
+
+// contextWithMetadataValues returns a copy of the given context carrying
+// the metadata received over the wire
+func contextWithMetadataValues(ctx context.Context, values map[string]string) context.Context {
+	if len(values) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, metadataKey{}, &Metadata{values: values})
+}
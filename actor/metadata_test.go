@@ -0,0 +1,175 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestMetadata(t *testing.T) {
+	t.Run("With key/value pairs", func(t *testing.T) {
+		md := NewMetadata().Set("tenant", "acme").Set("correlation-id", "123")
+		assert.Equal(t, 2, md.Len())
+		assert.Equal(t, []string{"correlation-id", "tenant"}, md.Keys())
+
+		value, ok := md.Get("tenant")
+		require.True(t, ok)
+		assert.Equal(t, "acme", value)
+
+		md.Delete("tenant")
+		_, ok = md.Get("tenant")
+		assert.False(t, ok)
+		assert.Equal(t, map[string]string{"correlation-id": "123"}, md.Map())
+
+		var empty *Metadata
+		assert.Zero(t, empty.Len())
+		assert.Nil(t, empty.Keys())
+		assert.Nil(t, empty.Map())
+		_, ok = empty.Get("tenant")
+		assert.False(t, ok)
+		empty.Delete("tenant")
+		assert.Equal(t, map[string]string{"tenant": "acme"}, empty.Set("tenant", "acme").Map())
+	})
+	t.Run("With context", func(t *testing.T) {
+		assert.Nil(t, MetadataFromContext(context.Background()))
+
+		md := NewMetadata().Set("tenant", "acme")
+		ctx := ContextWithMetadata(context.Background(), md)
+		// later changes do not affect the context
+		md.Set("tenant", "other")
+
+		value, ok := MetadataFromContext(ctx).Get("tenant")
+		require.True(t, ok)
+		assert.Equal(t, "acme", value)
+	})
+	t.Run("With local Tell, Ask and Forward", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		actor := NewMockMetadataActor()
+		pid, err := actorSystem.Spawn(ctx, "receiver", actor)
+		require.NoError(t, err)
+
+		forwarder, err := actorSystem.Spawn(ctx, "forwarder", &MockForward{actorRef: pid})
+		require.NoError(t, err)
+
+		md := NewMetadata().Set("tenant", "acme")
+		expected := map[string]string{"tenant": "acme"}
+		sendCtx := ContextWithMetadata(ctx, md)
+
+		require.NoError(t, Tell(sendCtx, pid, new(testpb.TestSend)))
+		assert.Equal(t, expected, <-actor.metadata)
+
+		_, err = Ask(sendCtx, pid, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, expected, <-actor.metadata)
+
+		require.NoError(t, Tell(sendCtx, forwarder, new(testpb.TestBye)))
+		assert.Equal(t, expected, <-actor.metadata)
+
+		// the receiver changes are not visible to the sender
+		assert.Equal(t, expected, MetadataFromContext(sendCtx).Map())
+
+		// messages without metadata
+		require.NoError(t, Tell(ctx, pid, new(testpb.TestSend)))
+		assert.Empty(t, <-actor.metadata)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With remote Tell, Ask and RemoteForward", func(t *testing.T) {
+		ctx := context.TODO()
+		ports := dynaport.Get(1)
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", ports[0])))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		actor := NewMockMetadataActor()
+		pid, err := actorSystem.Spawn(ctx, "receiver", actor)
+		require.NoError(t, err)
+
+		forwarder, err := actorSystem.Spawn(ctx, "forwarder", &MockForward{remoteRef: pid})
+		require.NoError(t, err)
+
+		sender, err := actorSystem.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		expected := map[string]string{"tenant": "acme"}
+		sendCtx := ContextWithMetadata(ctx, NewMetadata().Set("tenant", "acme"))
+
+		remoting := NewRemoting()
+		t.Cleanup(remoting.Close)
+
+		require.NoError(t, remoting.RemoteTell(sendCtx, sender.Address(), pid.Address(), new(testpb.TestSend)))
+		assert.Equal(t, expected, <-actor.metadata)
+
+		_, err = remoting.RemoteAsk(sendCtx, sender.Address(), pid.Address(), new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, expected, <-actor.metadata)
+
+		require.NoError(t, sender.Tell(sendCtx, forwarder, new(testpb.TestRemoteForward)))
+		assert.Equal(t, expected, <-actor.metadata)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With grain", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		grain := &MockMetadataGrain{metadata: make(chan map[string]string, 1)}
+		identity, err := actorSystem.GrainIdentity(ctx, "grain", func(context.Context) (Grain, error) {
+			return grain, nil
+		})
+		require.NoError(t, err)
+
+		sendCtx := ContextWithMetadata(ctx, NewMetadata().Set("tenant", "acme"))
+		require.NoError(t, actorSystem.TellGrain(sendCtx, identity, new(testpb.TestSend)))
+		assert.Equal(t, map[string]string{"tenant": "acme"}, <-grain.metadata)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+}
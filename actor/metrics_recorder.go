@@ -0,0 +1,273 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/tochemey/goakt/v3/internal/registry"
+)
+
+const (
+	// meterName defines the instrumentation scope of the actor system metrics
+	meterName = "github.com/tochemey/goakt/v3"
+
+	nodeAttributeKey      = attribute.Key("node")
+	actorKindAttributeKey = attribute.Key("actor.kind")
+	grainKindAttributeKey = attribute.Key("grain.kind")
+	rpcMethodAttributeKey = attribute.Key("rpc.method")
+	peerAttributeKey      = attribute.Key("remoting.peer")
+)
+
+// metricsRecorder records the actor system OpenTelemetry instruments.
+// A nil metricsRecorder is valid and records nothing, which is the case when metrics are not enabled.
+type metricsRecorder struct {
+	node attribute.KeyValue
+
+	processedCount        metric.Int64Counter
+	messageLatency        metric.Float64Histogram
+	restartCount          metric.Int64Counter
+	deadlettersCount      metric.Int64Counter
+	passivationsCount     metric.Int64Counter
+	grainActivationsCount metric.Int64Counter
+	remotingLatency       metric.Float64Histogram
+
+	registration metric.Registration
+}
+
+// newMetricsRecorder creates the actor system instruments using the given meter provider
+func newMetricsRecorder(system *actorSystem, provider metric.MeterProvider) (*metricsRecorder, error) {
+	meter := provider.Meter(meterName)
+	recorder := &metricsRecorder{
+		node: nodeAttributeKey.String(net.JoinHostPort(system.Host(), strconv.Itoa(system.Port()))),
+	}
+
+	var err error
+	if recorder.processedCount, err = meter.Int64Counter("goakt.actor.processed.count",
+		metric.WithDescription("The total number of messages processed by actors"),
+		metric.WithUnit("{message}")); err != nil {
+		return nil, err
+	}
+
+	if recorder.messageLatency, err = meter.Float64Histogram("goakt.actor.message.latency",
+		metric.WithDescription("The time taken by actors to process a message"),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+
+	if recorder.restartCount, err = meter.Int64Counter("goakt.actor.restart.count",
+		metric.WithDescription("The total number of actor restarts"),
+		metric.WithUnit("{restart}")); err != nil {
+		return nil, err
+	}
+
+	if recorder.deadlettersCount, err = meter.Int64Counter("goakt.actor.deadletters.count",
+		metric.WithDescription("The total number of messages sent to the deadletter"),
+		metric.WithUnit("{message}")); err != nil {
+		return nil, err
+	}
+
+	if recorder.passivationsCount, err = meter.Int64Counter("goakt.actor.passivation.count",
+		metric.WithDescription("The total number of actor passivations"),
+		metric.WithUnit("{passivation}")); err != nil {
+		return nil, err
+	}
+
+	if recorder.grainActivationsCount, err = meter.Int64Counter("goakt.grain.activation.count",
+		metric.WithDescription("The total number of grain activations"),
+		metric.WithUnit("{activation}")); err != nil {
+		return nil, err
+	}
+
+	if recorder.remotingLatency, err = meter.Float64Histogram("goakt.remoting.request.latency",
+		metric.WithDescription("The time taken to handle a remoting request"),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+
+	mailboxSize, err := meter.Int64ObservableGauge("goakt.actor.mailbox.size",
+		metric.WithDescription("The number of messages waiting in actors mailboxes"),
+		metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, err
+	}
+
+	clusterMembers, err := meter.Int64ObservableGauge("goakt.cluster.members",
+		metric.WithDescription("The number of nodes in the cluster as seen by this node"),
+		metric.WithUnit("{node}"))
+	if err != nil {
+		return nil, err
+	}
+
+	outboundQueueSize, err := meter.Int64ObservableGauge("goakt.remoting.outbound.queue.size",
+		metric.WithDescription("The number of messages waiting in the outbound queue of a remote node"),
+		metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, err
+	}
+
+	outboundSent, err := meter.Int64ObservableCounter("goakt.remoting.outbound.sent.count",
+		metric.WithDescription("The total number of messages sent through the outbound queue of a remote node"),
+		metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, err
+	}
+
+	outboundDropped, err := meter.Int64ObservableCounter("goakt.remoting.outbound.dropped.count",
+		metric.WithDescription("The total number of messages dropped because the outbound queue of a remote node was full"),
+		metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, err
+	}
+
+	outboundFailed, err := meter.Int64ObservableCounter("goakt.remoting.outbound.failed.count",
+		metric.WithDescription("The total number of messages of the outbound queue of a remote node that could not be delivered"),
+		metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, err
+	}
+
+	recorder.registration, err = meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		sizes := make(map[string]int64)
+		for _, pid := range system.Actors() {
+			sizes[registry.Name(pid.Actor())] += pid.mailbox.Len()
+		}
+
+		for kind, size := range sizes {
+			observer.ObserveInt64(mailboxSize, size, metric.WithAttributes(actorKindAttributeKey.String(kind), recorder.node))
+		}
+
+		if system.InCluster() {
+			peers, err := system.getCluster().Peers(ctx)
+			if err != nil {
+				return err
+			}
+			// add the node itself
+			observer.ObserveInt64(clusterMembers, int64(len(peers)+1), metric.WithAttributes(recorder.node))
+		}
+
+		if remoting := system.getRemoting(); remoting != nil {
+			for _, stats := range remoting.OutboundQueues() {
+				attributes := metric.WithAttributes(peerAttributeKey.String(stats.Peer), recorder.node)
+				observer.ObserveInt64(outboundQueueSize, int64(stats.Queued), attributes)
+				observer.ObserveInt64(outboundSent, stats.Sent, attributes)
+				observer.ObserveInt64(outboundDropped, stats.Dropped, attributes)
+				observer.ObserveInt64(outboundFailed, stats.Failed, attributes)
+			}
+		}
+		return nil
+	}, mailboxSize, clusterMembers, outboundQueueSize, outboundSent, outboundDropped, outboundFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	return recorder, nil
+}
+
+// actorAttributes returns the measurement attributes of the given actor
+func (m *metricsRecorder) actorAttributes(actor Actor) metric.MeasurementOption {
+	if m == nil {
+		return nil
+	}
+	return metric.WithAttributeSet(attribute.NewSet(actorKindAttributeKey.String(registry.Name(actor)), m.node))
+}
+
+// grainAttributes returns the measurement attributes of the given grain identity
+func (m *metricsRecorder) grainAttributes(identity *GrainIdentity) metric.MeasurementOption {
+	if m == nil {
+		return nil
+	}
+	return metric.WithAttributeSet(attribute.NewSet(grainKindAttributeKey.String(identity.Kind()), m.node))
+}
+
+// recordProcessed records a message processed by an actor together with its processing latency
+func (m *metricsRecorder) recordProcessed(attributes metric.MeasurementOption, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	ctx := context.Background()
+	m.processedCount.Add(ctx, 1, attributes)
+	m.messageLatency.Record(ctx, latency.Seconds(), attributes)
+}
+
+// recordRestart records an actor restart
+func (m *metricsRecorder) recordRestart(attributes metric.MeasurementOption) {
+	if m == nil {
+		return
+	}
+	m.restartCount.Add(context.Background(), 1, attributes)
+}
+
+// recordDeadletter records a message sent to the deadletter
+func (m *metricsRecorder) recordDeadletter(attributes metric.MeasurementOption) {
+	if m == nil {
+		return
+	}
+	m.deadlettersCount.Add(context.Background(), 1, attributes)
+}
+
+// recordPassivation records an actor passivation
+func (m *metricsRecorder) recordPassivation(attributes metric.MeasurementOption) {
+	if m == nil {
+		return
+	}
+	m.passivationsCount.Add(context.Background(), 1, attributes)
+}
+
+// recordGrainActivation records a grain activation
+func (m *metricsRecorder) recordGrainActivation(attributes metric.MeasurementOption) {
+	if m == nil {
+		return
+	}
+	m.grainActivationsCount.Add(context.Background(), 1, attributes)
+}
+
+// remotingInterceptor returns the connect interceptor that records the remoting requests latency
+func (m *metricsRecorder) remotingInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			response, err := next(ctx, request)
+			m.remotingLatency.Record(ctx, time.Since(start).Seconds(),
+				metric.WithAttributes(rpcMethodAttributeKey.String(request.Spec().Procedure), m.node))
+			return response, err
+		}
+	})
+}
+
+// shutdown unregisters the observable instruments callback
+func (m *metricsRecorder) shutdown() error {
+	if m == nil || m.registration == nil {
+		return nil
+	}
+	return m.registration.Unregister()
+}
@@ -0,0 +1,203 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/internal/registry"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/passivation"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestMetricsRecorder(t *testing.T) {
+	t.Run("With metrics enabled", func(t *testing.T) {
+		ctx := context.TODO()
+		reader := sdkmetric.NewManualReader()
+		provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+		ports := dynaport.Get(1)
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", ports[0])),
+			WithMetrics(provider))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		supervisor := NewSupervisor(WithDirective(&PanicError{}, RestartDirective))
+		pid, err := actorSystem.Spawn(ctx, "test", NewMockActor(), WithSupervisor(supervisor))
+		require.NoError(t, err)
+
+		for range 5 {
+			_, err := Ask(ctx, pid, new(testpb.TestReply), time.Second)
+			require.NoError(t, err)
+		}
+
+		// unhandled message goes to the deadletter
+		require.NoError(t, Tell(ctx, pid, new(testpb.TestBye)))
+		// panic triggers a restart
+		require.NoError(t, Tell(ctx, pid, new(testpb.TestPanic)))
+
+		_, err = actorSystem.Spawn(ctx, "passivated", NewMockActor(),
+			WithPassivationStrategy(passivation.NewTimeBasedStrategy(200*time.Millisecond)))
+		require.NoError(t, err)
+
+		identity, err := actorSystem.GrainIdentity(ctx, "grain", func(context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		})
+		require.NoError(t, err)
+		require.NotNil(t, identity)
+
+		remoting := NewRemoting()
+		_, err = remoting.RemoteLookup(ctx, actorSystem.Host(), actorSystem.Port(), "test")
+		require.NoError(t, err)
+		remoting.Close()
+
+		pause.For(time.Second)
+
+		var data metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(ctx, &data))
+		require.Len(t, data.ScopeMetrics, 1)
+		assert.Equal(t, meterName, data.ScopeMetrics[0].Scope.Name)
+
+		metrics := make(map[string]metricdata.Metrics)
+		for _, m := range data.ScopeMetrics[0].Metrics {
+			metrics[m.Name] = m
+		}
+
+		kind := attribute.String("actor.kind", registry.Name(NewMockActor()))
+		assert.GreaterOrEqual(t, sumOf(t, metrics["goakt.actor.processed.count"], kind), int64(6))
+		assert.EqualValues(t, 1, sumOf(t, metrics["goakt.actor.restart.count"], kind))
+		assert.GreaterOrEqual(t, sumOf(t, metrics["goakt.actor.deadletters.count"], kind), int64(1))
+		assert.EqualValues(t, 1, sumOf(t, metrics["goakt.actor.passivation.count"], kind))
+		assert.EqualValues(t, 1, sumOf(t, metrics["goakt.grain.activation.count"], attribute.String("grain.kind", identity.Kind())))
+
+		latency, ok := metrics["goakt.actor.message.latency"].Data.(metricdata.Histogram[float64])
+		require.True(t, ok)
+		assert.NotEmpty(t, latency.DataPoints)
+
+		remotingLatency, ok := metrics["goakt.remoting.request.latency"].Data.(metricdata.Histogram[float64])
+		require.True(t, ok)
+		require.Len(t, remotingLatency.DataPoints, 1)
+		method, ok := remotingLatency.DataPoints[0].Attributes.Value("rpc.method")
+		require.True(t, ok)
+		assert.Contains(t, method.AsString(), "RemoteLookup")
+
+		mailbox, ok := metrics["goakt.actor.mailbox.size"].Data.(metricdata.Gauge[int64])
+		require.True(t, ok)
+		assert.NotEmpty(t, mailbox.DataPoints)
+		node, ok := mailbox.DataPoints[0].Attributes.Value("node")
+		require.True(t, ok)
+		assert.Equal(t, address.New("", "", actorSystem.Host(), actorSystem.Port()).HostPort(), node.AsString())
+
+		// no cluster membership outside of cluster mode
+		_, ok = metrics["goakt.cluster.members"]
+		assert.False(t, ok)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With outbound queues", func(t *testing.T) {
+		ctx := context.TODO()
+		reader := sdkmetric.NewManualReader()
+		provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+		ports := dynaport.Get(1)
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", ports[0], remote.WithOutboundQueue(10, remote.BlockOnOverflow))),
+			WithMetrics(provider))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		pid, err := actorSystem.Spawn(ctx, "test", NewMockActor())
+		require.NoError(t, err)
+		require.NoError(t, pid.RemoteTell(ctx, pid.Address(), new(testpb.TestSend)))
+
+		pause.For(500 * time.Millisecond)
+
+		var data metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(ctx, &data))
+		require.Len(t, data.ScopeMetrics, 1)
+
+		metrics := make(map[string]metricdata.Metrics)
+		for _, m := range data.ScopeMetrics[0].Metrics {
+			metrics[m.Name] = m
+		}
+
+		peer := attribute.String("remoting.peer", pid.Address().HostPort())
+		assert.EqualValues(t, 1, sumOf(t, metrics["goakt.remoting.outbound.sent.count"], peer))
+		assert.Zero(t, sumOf(t, metrics["goakt.remoting.outbound.dropped.count"], peer))
+		assert.Zero(t, sumOf(t, metrics["goakt.remoting.outbound.failed.count"], peer))
+
+		queueSize, ok := metrics["goakt.remoting.outbound.queue.size"].Data.(metricdata.Gauge[int64])
+		require.True(t, ok)
+		require.Len(t, queueSize.DataPoints, 1)
+		assert.Zero(t, queueSize.DataPoints[0].Value)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With metrics disabled", func(t *testing.T) {
+		var recorder *metricsRecorder
+		assert.Nil(t, recorder.actorAttributes(NewMockActor()))
+		assert.NotPanics(t, func() {
+			recorder.recordProcessed(nil, time.Second)
+			recorder.recordRestart(nil)
+			recorder.recordDeadletter(nil)
+			recorder.recordPassivation(nil)
+			recorder.recordGrainActivation(nil)
+		})
+		assert.NoError(t, recorder.shutdown())
+	})
+}
+
+// sumOf returns the value of the int64 sum data point with the given attribute
+func sumOf(t *testing.T, m metricdata.Metrics, attr attribute.KeyValue) int64 {
+	sum, ok := m.Data.(metricdata.Sum[int64])
+	require.True(t, ok, "metric %s is not an int64 sum", m.Name)
+	var total int64
+	for _, point := range sum.DataPoints {
+		if value, ok := point.Attributes.Value(attr.Key); ok && value == attr.Value {
+			total += point.Value
+		}
+	}
+	return total
+}
@@ -27,10 +27,15 @@ package actor
 import (
 	"time"
 
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/hash"
 	"github.com/tochemey/goakt/v3/internal/collection"
+	"github.com/tochemey/goakt/v3/internal/registry"
 	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
 	"github.com/tochemey/goakt/v3/remote"
 )
 
@@ -151,6 +156,7 @@ func WithTLS(tlsInfo *TLSInfo) Option {
 	return OptionFunc(func(system *actorSystem) {
 		system.serverTLS = tlsInfo.ServerTLS
 		system.clientTLS = tlsInfo.ClientTLS
+		system.certificateProvider = tlsInfo.CertificateProvider
 	})
 }
 
@@ -227,3 +233,202 @@ func WithExtensions(extensions ...extension.Extension) Option {
 		}
 	})
 }
+
+// WithJournalStore sets the journal store used by persistent actors to persist their events.
+//
+// The journal store is connected when the actor system starts and disconnected when it stops.
+// In cluster mode, all the nodes must share the same journal store so that relocated
+// persistent actors can rebuild their state on their new host.
+//
+// Parameters:
+//   - store: the JournalStore implementation to use.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithJournalStore(store persistence.JournalStore) Option {
+	return OptionFunc(func(system *actorSystem) {
+		system.journalStore = store
+	})
+}
+
+// WithDurableStateStore sets the durable state store used by actors and grains to load and save their latest state.
+//
+// The store is also registered as an extension of the actor system under its ID.
+// It is connected when the actor system starts and disconnected when it stops.
+// In cluster mode, all the nodes must share the same durable state store so that passivated
+// or relocated actors and grains get their state back on their new host.
+//
+// Parameters:
+//   - store: the DurableStateStore implementation to use.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithDurableStateStore(store persistence.DurableStateStore) Option {
+	return OptionFunc(func(system *actorSystem) {
+		system.durableStateStore = store
+		if system.extensions == nil {
+			system.extensions = collection.NewMap[string, extension.Extension]()
+		}
+		system.extensions.Set(store.ID(), store)
+	})
+}
+
+// WithMetrics enables the export of the actor system metrics as OpenTelemetry instruments
+// created from the given meter provider.
+//
+// The following instruments are recorded, all labelled with the node address and, when relevant,
+// the actor or grain kind:
+//   - goakt.actor.mailbox.size: the number of messages waiting in the actors mailboxes
+//   - goakt.actor.processed.count: the number of messages processed by actors
+//   - goakt.actor.message.latency: the time taken by actors to process a message
+//   - goakt.actor.restart.count: the number of actor restarts
+//   - goakt.actor.deadletters.count: the number of messages sent to the deadletter
+//   - goakt.actor.passivation.count: the number of actor passivations
+//   - goakt.grain.activation.count: the number of grain activations
+//   - goakt.remoting.request.latency: the time taken to handle a remoting request
+//   - goakt.cluster.members: the number of nodes in the cluster
+//
+// Metrics are disabled by default.
+//
+// Parameters:
+//   - provider: the OpenTelemetry meter provider to create the instruments from.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithMetrics(provider metric.MeterProvider) Option {
+	return OptionFunc(func(system *actorSystem) {
+		system.meterProvider = provider
+	})
+}
+
+// WithContextPropagator sets the propagator used to carry the sender's context across actor boundaries.
+//
+// The propagated values (e.g. the W3C trace context) travel with every message sent remotely,
+// to a grain located on another node or through the scheduler, and are restored into the
+// context of the receiver. This allows a single request handled by several actors across
+// several nodes to show up as one trace.
+//
+// When not set the globally registered OpenTelemetry propagator is used.
+//
+// Parameters:
+//   - propagator: the OpenTelemetry text map propagator, e.g. propagation.TraceContext{}.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithContextPropagator(propagator propagation.TextMapPropagator) Option {
+	return OptionFunc(func(system *actorSystem) {
+		system.propagator = propagator
+	})
+}
+
+// WithSnapshotStore sets the snapshot store used by persistent actors to save their state.
+//
+// Snapshots are taken every number of events set with the WithSnapshotInterval spawn option.
+// The snapshot store is connected when the actor system starts and disconnected when it stops.
+//
+// Parameters:
+//   - store: the SnapshotStore implementation to use.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithSnapshotStore(store persistence.SnapshotStore) Option {
+	return OptionFunc(func(system *actorSystem) {
+		system.snapshotStore = store
+	})
+}
+
+// WithDeliveryStore sets the store used by actors sending messages with at-least-once delivery
+// to keep track of their unconfirmed messages.
+//
+// With a delivery store, the unconfirmed messages of an actor are redelivered after the actor restarts
+// or is relocated to another node. The delivery store is connected when the actor system starts
+// and disconnected when it stops. In cluster mode, all the nodes must share the same delivery store.
+//
+// Parameters:
+//   - store: the DeliveryStore implementation to use.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithDeliveryStore(store persistence.DeliveryStore) Option {
+	return OptionFunc(func(system *actorSystem) {
+		system.deliveryStore = store
+	})
+}
+
+// WithCircuitBreaker guards the requests made with Ask, RemoteAsk and AskGrain with circuit breakers.
+//
+// Every target, i.e. every actor or grain asked, gets its own circuit breaker created with the given settings.
+// When the requests to a target keep failing or are too slow, its circuit breaker opens and the subsequent
+// requests are rejected right away with a CircuitOpenError instead of waiting for their timeout.
+// Every state change is published to the events stream as a goaktpb.CircuitBreakerStateChanged event.
+//
+// Use WithTargetCircuitBreaker and WithKindCircuitBreaker to override the settings of specific targets.
+// Circuit breakers are disabled by default.
+//
+// Parameters:
+//   - opts: the circuit breaker settings.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithCircuitBreaker(opts ...CircuitBreakerOption) Option {
+	return OptionFunc(func(system *actorSystem) {
+		system.circuitBreakerDefaults = newCircuitBreakerConfig(opts...)
+	})
+}
+
+// WithTargetCircuitBreaker sets the circuit breaker settings of the given target.
+// The target is either the name of an actor or the string representation of a grain identity.
+// These settings take precedence over the ones set with WithKindCircuitBreaker and WithCircuitBreaker.
+//
+// Parameters:
+//   - target: the actor name or the grain identity.
+//   - opts: the circuit breaker settings.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithTargetCircuitBreaker(target string, opts ...CircuitBreakerOption) Option {
+	return OptionFunc(func(system *actorSystem) {
+		if system.circuitBreakerConfigs == nil {
+			system.circuitBreakerConfigs = make(map[string]*circuitBreakerConfig)
+		}
+		system.circuitBreakerConfigs[target] = newCircuitBreakerConfig(opts...)
+	})
+}
+
+// WithKindCircuitBreaker sets the circuit breaker settings of all the local actors or grains of the given kind.
+// Every actor or grain still gets its own circuit breaker. Remote actors can only be configured by name
+// with WithTargetCircuitBreaker since their kind is not known by the caller.
+// These settings take precedence over the ones set with WithCircuitBreaker.
+//
+// Parameters:
+//   - kind: an instance of the Actor or Grain kind.
+//   - opts: the circuit breaker settings.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithKindCircuitBreaker(kind any, opts ...CircuitBreakerOption) Option {
+	return OptionFunc(func(system *actorSystem) {
+		if system.circuitBreakerConfigs == nil {
+			system.circuitBreakerConfigs = make(map[string]*circuitBreakerConfig)
+		}
+		system.circuitBreakerConfigs[registry.Name(kind)] = newCircuitBreakerConfig(opts...)
+	})
+}
+
+// WithActorFactory registers the given actor factory under the given name.
+//
+// Registered factories create the actors spawned with SpawnFromFactory or remotely with a remote.SpawnRequest
+// naming the factory. In cluster mode the factory must be registered on every node, under the same name,
+// so that the actors it creates can be relocated.
+//
+// Parameters:
+//   - name: the unique name of the factory.
+//   - factory: the function creating the actor from its arguments.
+//
+// Returns:
+//   - Option: A configuration option used when constructing the ActorSystem.
+func WithActorFactory(name string, factory ActorFactory) Option {
+	return OptionFunc(func(system *actorSystem) {
+		system.reflection.RegisterFactory(name, factory)
+	})
+}
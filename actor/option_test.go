@@ -34,7 +34,9 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/tochemey/goakt/v3/hash"
+	"github.com/tochemey/goakt/v3/internal/registry"
 	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
 	"github.com/tochemey/goakt/v3/remote"
 )
 
@@ -47,12 +49,17 @@ func TestOption(t *testing.T) {
 	hasher := hash.DefaultHasher()
 	// nolint
 	tlsConfig := &tls.Config{}
+	certificateProvider := NewMemoryCertificateProvider(nil)
 	tlsInfo := &TLSInfo{
-		ClientTLS: tlsConfig,
-		ServerTLS: tlsConfig,
+		ClientTLS:           tlsConfig,
+		ServerTLS:           tlsConfig,
+		CertificateProvider: certificateProvider,
 	}
 
 	remoteConfig := remote.DefaultConfig()
+	journalStore := persistence.NewMemoryJournalStore()
+	snapshotStore := persistence.NewMemorySnapshotStore()
+	deliveryStore := persistence.NewMemoryDeliveryStore()
 
 	testCases := []struct {
 		name     string
@@ -92,7 +99,7 @@ func TestOption(t *testing.T) {
 		{
 			name:     "WithTLS",
 			option:   WithTLS(tlsInfo),
-			expected: actorSystem{serverTLS: tlsConfig, clientTLS: tlsConfig},
+			expected: actorSystem{serverTLS: tlsConfig, clientTLS: tlsConfig, certificateProvider: certificateProvider},
 		},
 		{
 			name:     "WithRemote",
@@ -104,6 +111,40 @@ func TestOption(t *testing.T) {
 			option:   WithoutRelocation(),
 			expected: actorSystem{relocationEnabled: atomicFalse},
 		},
+		{
+			name:     "WithJournalStore",
+			option:   WithJournalStore(journalStore),
+			expected: actorSystem{journalStore: journalStore},
+		},
+		{
+			name:     "WithSnapshotStore",
+			option:   WithSnapshotStore(snapshotStore),
+			expected: actorSystem{snapshotStore: snapshotStore},
+		},
+		{
+			name:     "WithDeliveryStore",
+			option:   WithDeliveryStore(deliveryStore),
+			expected: actorSystem{deliveryStore: deliveryStore},
+		},
+		{
+			name:     "WithCircuitBreaker",
+			option:   WithCircuitBreaker(WithOpenTimeout(time.Second)),
+			expected: actorSystem{circuitBreakerDefaults: newCircuitBreakerConfig(WithOpenTimeout(time.Second))},
+		},
+		{
+			name:   "WithTargetCircuitBreaker",
+			option: WithTargetCircuitBreaker("actor", WithOpenTimeout(time.Second)),
+			expected: actorSystem{circuitBreakerConfigs: map[string]*circuitBreakerConfig{
+				"actor": newCircuitBreakerConfig(WithOpenTimeout(time.Second)),
+			}},
+		},
+		{
+			name:   "WithKindCircuitBreaker",
+			option: WithKindCircuitBreaker(NewMockActor(), WithOpenTimeout(time.Second)),
+			expected: actorSystem{circuitBreakerConfigs: map[string]*circuitBreakerConfig{
+				registry.Name(NewMockActor()): newCircuitBreakerConfig(WithOpenTimeout(time.Second)),
+			}},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,3 +185,14 @@ func TestWithExtensions(t *testing.T) {
 	opt.Apply(system)
 	require.NotEmpty(t, system.extensions)
 }
+
+func TestWithDurableStateStore(t *testing.T) {
+	store := persistence.NewMemoryDurableStateStore()
+	system := new(actorSystem)
+	opt := WithDurableStateStore(store)
+	opt.Apply(system)
+	require.Equal(t, store, system.durableStateStore)
+	ext, ok := system.extensions.Get(store.ID())
+	require.True(t, ok)
+	require.Equal(t, store, ext)
+}
@@ -0,0 +1,101 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"crypto/x509"
+	nethttp "net/http"
+	"strings"
+)
+
+const (
+	// PeerCommonNameKey is the metadata key holding the subject common name of the verified
+	// client certificate of the node that sent a remote message.
+	PeerCommonNameKey = "goakt-peer-common-name"
+	// PeerSANKey is the metadata key holding the subject alternative names of the verified
+	// client certificate of the node that sent a remote message. The DNS names, IP addresses,
+	// URIs and email addresses are joined with a comma.
+	PeerSANKey = "goakt-peer-san"
+)
+
+type peerIdentityKey struct{}
+
+// peerIdentity defines the identity of a remote node as stated by its verified client certificate
+type peerIdentity struct {
+	commonName string
+	san        string
+}
+
+// newPeerIdentity creates a peerIdentity from the given certificate
+func newPeerIdentity(certificate *x509.Certificate) *peerIdentity {
+	names := make([]string, 0, len(certificate.DNSNames)+len(certificate.IPAddresses)+len(certificate.URIs)+len(certificate.EmailAddresses))
+	names = append(names, certificate.DNSNames...)
+	for _, ip := range certificate.IPAddresses {
+		names = append(names, ip.String())
+	}
+	for _, uri := range certificate.URIs {
+		names = append(names, uri.String())
+	}
+	names = append(names, certificate.EmailAddresses...)
+
+	return &peerIdentity{
+		commonName: certificate.Subject.CommonName,
+		san:        strings.Join(names, ","),
+	}
+}
+
+// peerIdentityHandler wraps the given handler to add the identity of the remote node
+// to the request context when the node presented a verified client certificate
+func peerIdentityHandler(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.PeerCertificates) > 0 {
+			ctx := context.WithValue(r.Context(), peerIdentityKey{}, newPeerIdentity(r.TLS.PeerCertificates[0]))
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withPeerIdentity sets the identity of the remote node carried by the given context into the
+// metadata values received over the wire. The peer identity keys sent by the remote node are always
+// discarded so that a node cannot impersonate another one.
+func withPeerIdentity(ctx context.Context, values map[string]string) map[string]string {
+	delete(values, PeerCommonNameKey)
+	delete(values, PeerSANKey)
+
+	identity, ok := ctx.Value(peerIdentityKey{}).(*peerIdentity)
+	if !ok {
+		return values
+	}
+
+	if values == nil {
+		values = make(map[string]string, 2)
+	}
+
+	values[PeerCommonNameKey] = identity.commonName
+	values[PeerSANKey] = identity.san
+	return values
+}
@@ -0,0 +1,123 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestPeerIdentity(t *testing.T) {
+	t.Run("With mutual TLS and certificate rotation", func(t *testing.T) {
+		ctx := context.TODO()
+		ca := newTestCertificateAuthority(t)
+
+		provider := NewMemoryCertificateProvider(ca.keyPair(t, "server"))
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0])),
+			WithTLS(&TLSInfo{
+				// nolint
+				ServerTLS: &tls.Config{ClientCAs: ca.pool, ClientAuth: tls.RequireAndVerifyClientCert},
+				// nolint
+				ClientTLS:           &tls.Config{RootCAs: ca.pool},
+				CertificateProvider: provider,
+			}),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		actor := NewMockMetadataActor()
+		pid, err := sys.Spawn(ctx, "receiver", actor)
+		require.NoError(t, err)
+
+		clientProvider := NewMemoryCertificateProvider(ca.keyPair(t, "node-1"))
+		remoting := NewRemoting(WithRemotingTLS(&tls.Config{ // nolint
+			RootCAs: ca.pool,
+			GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				return clientProvider.Certificate()
+			},
+		}))
+		t.Cleanup(remoting.Close)
+
+		// the peer identity sent by the remote node is discarded
+		sendCtx := ContextWithMetadata(ctx, NewMetadata().
+			Set("tenant", "acme").
+			Set(PeerCommonNameKey, "admin").
+			Set(PeerSANKey, "admin"))
+
+		expected := map[string]string{
+			"tenant":          "acme",
+			PeerCommonNameKey: "node-1",
+			PeerSANKey:        "node-1,127.0.0.1",
+		}
+
+		require.NoError(t, remoting.RemoteTell(sendCtx, pid.Address(), pid.Address(), new(testpb.TestSend)))
+		assert.Equal(t, expected, <-actor.metadata)
+
+		_, err = remoting.RemoteAsk(sendCtx, pid.Address(), pid.Address(), new(testpb.TestReply), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, expected, <-actor.metadata)
+
+		// rotate the server certificate and check that new connections use it
+		provider.SetCertificate(ca.keyPair(t, "server-rotated"))
+		// nolint
+		conn, err := tls.Dial("tcp", net.JoinHostPort(sys.Host(), strconv.Itoa(sys.Port())), &tls.Config{
+			RootCAs:      ca.pool,
+			Certificates: []tls.Certificate{*ca.keyPair(t, "node-2")},
+		})
+		require.NoError(t, err)
+		require.NoError(t, conn.Handshake())
+		assert.Equal(t, "server-rotated", conn.ConnectionState().PeerCertificates[0].Subject.CommonName)
+		require.NoError(t, conn.Close())
+
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("Without TLS", func(t *testing.T) {
+		values := withPeerIdentity(context.Background(), map[string]string{
+			"tenant":          "acme",
+			PeerCommonNameKey: "admin",
+			PeerSANKey:        "admin",
+		})
+		assert.Equal(t, map[string]string{"tenant": "acme"}, values)
+		assert.Nil(t, withPeerIdentity(context.Background(), nil))
+	})
+}
@@ -0,0 +1,232 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tochemey/goakt/v3/persistence"
+)
+
+// PersistentActor defines an event-sourced actor.
+//
+// A persistent actor never mutates its state directly when handling a command. Instead, its Receive
+// method, which acts as the command handler, validates the incoming command and persists the resulting
+// events using ReceiveContext.Persist or ReceiveContext.PersistAll. Every persisted event is then applied
+// to the actor state through HandleEvent, the event handler.
+//
+// The events are stored in the JournalStore configured on the actor system using WithJournalStore.
+// When the actor starts, restarts after a failure or is relocated to another node in cluster mode,
+// its state is rebuilt by first restoring the latest snapshot, when a SnapshotStore is configured,
+// and then replaying the events persisted after that snapshot.
+//
+// The persistence ID of the actor is its name, which is unique in the actor system and across the cluster.
+//
+// Recovery happens right after PreStart on every start, including supervisor restarts.
+// Because of that, PreStart must bring the actor state back to its initial value.
+type PersistentActor interface {
+	Actor
+	// HandleEvent applies the given event to the actor state.
+	//
+	// It is called for every event replayed during recovery and for every event successfully persisted
+	// while handling a command. It must be deterministic and free of side effects.
+	HandleEvent(event proto.Message) error
+	// State returns the current actor state. It is used to take snapshots.
+	State() proto.Message
+	// RestoreState sets the actor state from the given snapshot during recovery.
+	RestoreState(state proto.Message) error
+}
+
+// persistenceState holds the event sourcing settings and progress of a persistent actor
+type persistenceState struct {
+	journal   persistence.JournalStore
+	snapshots persistence.SnapshotStore
+	// specifies the number of events after which a snapshot is taken
+	snapshotInterval uint64
+	// specifies the sequence number of the last persisted event
+	sequenceNumber uint64
+	// specifies the sequence number of the latest snapshot
+	snapshotSequenceNumber uint64
+}
+
+// isPersistent returns true when the underlying actor is a persistent actor
+func (pid *PID) isPersistent() bool {
+	_, ok := pid.actor.(PersistentActor)
+	return ok
+}
+
+// recoverState rebuilds the state of a persistent actor from its latest snapshot and journal.
+// This is a no-op for non-persistent actors.
+func (pid *PID) recoverState(ctx context.Context) error {
+	persistentActor, ok := pid.actor.(PersistentActor)
+	if !ok {
+		return nil
+	}
+
+	state := pid.persistenceState
+	if state == nil || state.journal == nil {
+		return ErrJournalStoreNotSet
+	}
+
+	persistenceID := pid.Name()
+	state.sequenceNumber = 0
+	state.snapshotSequenceNumber = 0
+
+	if state.snapshots != nil {
+		snapshot, err := state.snapshots.LatestSnapshot(ctx, persistenceID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch actor=(%s) snapshot: %w", persistenceID, err)
+		}
+
+		if snapshot != nil {
+			if err := persistentActor.RestoreState(snapshot.State); err != nil {
+				return fmt.Errorf("failed to restore actor=(%s) snapshot: %w", persistenceID, err)
+			}
+			state.sequenceNumber = snapshot.SequenceNumber
+			state.snapshotSequenceNumber = snapshot.SequenceNumber
+		}
+	}
+
+	events, err := state.journal.ReplayEvents(ctx, persistenceID, state.sequenceNumber+1, math.MaxUint64, 0)
+	if err != nil {
+		return fmt.Errorf("failed to replay actor=(%s) events: %w", persistenceID, err)
+	}
+
+	for _, event := range events {
+		if err := persistentActor.HandleEvent(event.Payload); err != nil {
+			return fmt.Errorf("failed to apply actor=(%s) event=(%d): %w", persistenceID, event.SequenceNumber, err)
+		}
+		state.sequenceNumber = event.SequenceNumber
+	}
+
+	// the journal may hold a higher sequence number when the replayed events have been deleted
+	highest, err := state.journal.HighestSequenceNumber(ctx, persistenceID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch actor=(%s) highest sequence number: %w", persistenceID, err)
+	}
+
+	if highest > state.sequenceNumber {
+		state.sequenceNumber = highest
+	}
+
+	pid.logger.Debugf("actor=(%s) recovered at sequence number=(%d)", persistenceID, state.sequenceNumber)
+	return nil
+}
+
+// persist writes the given events to the journal, applies them to the actor state
+// and takes a snapshot when the snapshot interval is reached
+func (pid *PID) persist(ctx context.Context, events ...proto.Message) error {
+	persistentActor, ok := pid.actor.(PersistentActor)
+	if !ok {
+		return ErrNotPersistentActor
+	}
+
+	state := pid.persistenceState
+	if state == nil || state.journal == nil {
+		return ErrJournalStoreNotSet
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	persistenceID := pid.Name()
+	timestamp := time.Now().UTC()
+	entries := make([]*persistence.Event, 0, len(events))
+	for index, event := range events {
+		if event == nil {
+			return ErrInvalidMessage
+		}
+
+		entries = append(entries, &persistence.Event{
+			PersistenceID:  persistenceID,
+			SequenceNumber: state.sequenceNumber + uint64(index) + 1,
+			Payload:        event,
+			Timestamp:      timestamp,
+		})
+	}
+
+	if err := state.journal.WriteEvents(ctx, entries); err != nil {
+		return NewErrPersistFailure(err)
+	}
+
+	// the events are in the journal from this point on, so the sequence number must move past
+	// them even when one of them cannot be applied. Otherwise the next persist would reuse
+	// sequence numbers that are already taken. The failure is reported to the supervisor and
+	// a restart rebuilds the actor state by replaying the journal.
+	state.sequenceNumber = entries[len(entries)-1].SequenceNumber
+	for _, entry := range entries {
+		if err := persistentActor.HandleEvent(entry.Payload); err != nil {
+			return fmt.Errorf("failed to apply event at sequence number=(%d): %w", entry.SequenceNumber, err)
+		}
+	}
+
+	if state.snapshots != nil &&
+		state.snapshotInterval > 0 &&
+		state.sequenceNumber-state.snapshotSequenceNumber >= state.snapshotInterval {
+		return pid.saveSnapshot(ctx, persistentActor)
+	}
+	return nil
+}
+
+// saveSnapshot saves the current state of the persistent actor
+func (pid *PID) saveSnapshot(ctx context.Context, persistentActor PersistentActor) error {
+	state := pid.persistenceState
+	snapshot := &persistence.Snapshot{
+		PersistenceID:  pid.Name(),
+		SequenceNumber: state.sequenceNumber,
+		State:          persistentActor.State(),
+		Timestamp:      time.Now().UTC(),
+	}
+
+	if err := state.snapshots.WriteSnapshot(ctx, snapshot); err != nil {
+		return NewErrPersistFailure(err)
+	}
+
+	state.snapshotSequenceNumber = state.sequenceNumber
+	pid.logger.Debugf("actor=(%s) snapshot taken at sequence number=(%d)", pid.Name(), state.sequenceNumber)
+	return nil
+}
+
+// lastSequenceNumber returns the sequence number of the last persisted event
+func (pid *PID) lastSequenceNumber() uint64 {
+	if pid.persistenceState == nil {
+		return 0
+	}
+	return pid.persistenceState.sequenceNumber
+}
+
+// snapshotInterval returns the number of persisted events after which a snapshot is taken
+func (pid *PID) snapshotInterval() uint64 {
+	if pid.persistenceState == nil {
+		return 0
+	}
+	return pid.persistenceState.snapshotInterval
+}
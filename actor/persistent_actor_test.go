@@ -0,0 +1,234 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/persistence"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestPersistentActor(t *testing.T) {
+	t.Run("With events persisted and replayed on start", func(t *testing.T) {
+		ctx := context.TODO()
+		journal := persistence.NewMemoryJournalStore()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithJournalStore(journal))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		pid, err := actorSystem.Spawn(ctx, "account", &MockPersistentActor{})
+		require.NoError(t, err)
+		require.NotNil(t, pid)
+
+		for range 3 {
+			_, err := Ask(ctx, pid, &testpb.CreditAccount{Balance: 100}, time.Second)
+			require.NoError(t, err)
+		}
+
+		reply, err := Ask(ctx, pid, new(testpb.GetAccount), time.Second)
+		require.NoError(t, err)
+		account, ok := reply.(*testpb.Account)
+		require.True(t, ok)
+		assert.EqualValues(t, 300, account.GetAccountBalance())
+		assert.EqualValues(t, 3, pid.lastSequenceNumber())
+
+		highest, err := journal.HighestSequenceNumber(ctx, "account")
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, highest)
+
+		// stop the actor and spawn it again to check its state is rebuilt
+		require.NoError(t, pid.Shutdown(ctx))
+		pause.For(500 * time.Millisecond)
+
+		pid, err = actorSystem.Spawn(ctx, "account", &MockPersistentActor{})
+		require.NoError(t, err)
+
+		reply, err = Ask(ctx, pid, new(testpb.GetAccount), time.Second)
+		require.NoError(t, err)
+		account, ok = reply.(*testpb.Account)
+		require.True(t, ok)
+		assert.EqualValues(t, 300, account.GetAccountBalance())
+		assert.EqualValues(t, 3, pid.lastSequenceNumber())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With state rebuilt on supervisor restart", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithJournalStore(persistence.NewMemoryJournalStore()))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		supervisor := NewSupervisor(WithDirective(&PanicError{}, RestartDirective))
+		pid, err := actorSystem.Spawn(ctx, "account", &MockPersistentActor{}, WithSupervisor(supervisor))
+		require.NoError(t, err)
+
+		for range 2 {
+			_, err := Ask(ctx, pid, &testpb.CreditAccount{Balance: 50}, time.Second)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, Tell(ctx, pid, new(testpb.TestPanic)))
+		pause.For(time.Second)
+
+		require.True(t, pid.IsRunning())
+		reply, err := Ask(ctx, pid, new(testpb.GetAccount), time.Second)
+		require.NoError(t, err)
+		account, ok := reply.(*testpb.Account)
+		require.True(t, ok)
+		assert.EqualValues(t, 100, account.GetAccountBalance())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With snapshots", func(t *testing.T) {
+		ctx := context.TODO()
+		journal := persistence.NewMemoryJournalStore()
+		snapshots := persistence.NewMemorySnapshotStore()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithJournalStore(journal),
+			WithSnapshotStore(snapshots))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		pid, err := actorSystem.Spawn(ctx, "account", &MockPersistentActor{}, WithSnapshotInterval(2))
+		require.NoError(t, err)
+
+		for range 5 {
+			_, err := Ask(ctx, pid, &testpb.CreditAccount{Balance: 10}, time.Second)
+			require.NoError(t, err)
+		}
+
+		snapshot, err := snapshots.LatestSnapshot(ctx, "account")
+		require.NoError(t, err)
+		require.NotNil(t, snapshot)
+		assert.EqualValues(t, 4, snapshot.SequenceNumber)
+		assert.EqualValues(t, 40, snapshot.State.(*testpb.Account).GetAccountBalance())
+
+		// delete the events covered by the snapshot to make sure recovery starts from the snapshot
+		require.NoError(t, journal.DeleteEvents(ctx, "account", 4))
+		require.NoError(t, pid.Shutdown(ctx))
+		pause.For(500 * time.Millisecond)
+
+		pid, err = actorSystem.Spawn(ctx, "account", &MockPersistentActor{}, WithSnapshotInterval(2))
+		require.NoError(t, err)
+
+		reply, err := Ask(ctx, pid, new(testpb.GetAccount), time.Second)
+		require.NoError(t, err)
+		account, ok := reply.(*testpb.Account)
+		require.True(t, ok)
+		assert.EqualValues(t, 50, account.GetAccountBalance())
+		assert.EqualValues(t, 5, pid.lastSequenceNumber())
+
+		events, err := journal.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+		require.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With event persisted but not applied", func(t *testing.T) {
+		ctx := context.TODO()
+		journal := persistence.NewMemoryJournalStore()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithJournalStore(journal))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		pid, err := actorSystem.Spawn(ctx, "account", &MockPersistentActor{})
+		require.NoError(t, err)
+
+		// the second event is not handled by the actor
+		err = pid.persist(ctx, &testpb.AccountCredited{AccountBalance: 10}, new(testpb.TestSend), &testpb.AccountCredited{AccountBalance: 10})
+		require.Error(t, err)
+		assert.EqualValues(t, 3, pid.lastSequenceNumber())
+
+		require.NoError(t, pid.persist(ctx, &testpb.AccountCredited{AccountBalance: 10}))
+		assert.EqualValues(t, 4, pid.lastSequenceNumber())
+
+		events, err := journal.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+		require.NoError(t, err)
+		require.Len(t, events, 4)
+		for index, event := range events {
+			assert.EqualValues(t, index+1, event.SequenceNumber)
+		}
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With journal store not set", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		pid, err := actorSystem.Spawn(ctx, "account", &MockPersistentActor{})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrJournalStoreNotSet)
+		assert.Nil(t, pid)
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With persist called by a non-persistent actor", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithJournalStore(persistence.NewMemoryJournalStore()))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		pid, err := actorSystem.Spawn(ctx, "test", NewMockActor())
+		require.NoError(t, err)
+
+		err = pid.persist(ctx, new(testpb.AccountCredited))
+		assert.ErrorIs(t, err, ErrNotPersistentActor)
+		assert.Zero(t, pid.lastSequenceNumber())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+}
@@ -36,6 +36,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/flowchartsman/retry"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/atomic"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
@@ -72,7 +73,7 @@ const (
 // to pipe the result to the appropriate PID
 type taskCompletion struct {
 	Receiver *PID
-	Task     func() (proto.Message, error)
+	Task     func() (any, error)
 }
 
 // PID specifies an actor unique process
@@ -131,6 +132,16 @@ type PID struct {
 	supervisionChan       chan *supervisionSignal
 	supervisionStopSignal chan registry.Unit
 
+	// restart backoff settings
+	restarting       atomic.Bool
+	restartAttempts  atomic.Uint32
+	restartedAt      atomic.Time
+	restartRetries   atomic.Uint32
+	retryWindowStart atomic.Time
+
+	// set while the actor is being restarted, during which it is briefly not running
+	restartInProgress atomic.Bool
+
 	// atomic flag indicating whether the actor is processing messages
 	processing atomic.Int32
 
@@ -144,12 +155,38 @@ type PID struct {
 	// the list of dependencies
 	dependencies *collection.Map[string, extension.Dependency]
 
+	// the factory the actor is created with and its arguments, if any
+	factory     string
+	factoryArgs *anypb.Any
+
+	// the role a cluster node must have to host the actor, if any
+	role string
+
+	// the state handed off by the previous incarnation of the actor, if any
+	handOffState *anypb.Any
+
+	// the address of the new incarnation of the actor once it has been handed off to another node,
+	// to which the messages received until the actor stops are forwarded
+	handedOffTo atomic.Pointer[address.Address]
+	forwardLock sync.Mutex
+
+	// the actors located on remote nodes watching this actor
+	remoteWatchers *collection.Map[string, *remoteWatcher]
+
 	running   atomic.Bool
 	stopping  atomic.Bool
 	suspended atomic.Bool
 
 	passivationStrategy passivation.Strategy
 	passivationPaused   *atomic.Bool
+
+	// persistent actors settings
+	persistenceState *persistenceState
+	durableState     *durableState
+
+	// OpenTelemetry metrics settings
+	metrics          *metricsRecorder
+	metricAttributes metric.MeasurementOption
 }
 
 // newPID creates a new pid
@@ -184,6 +221,7 @@ func newPID(ctx context.Context, address *address.Address, actor Actor, opts ...
 		supervisor:            NewSupervisor(),
 		startedAt:             atomic.NewInt64(0),
 		dependencies:          collection.NewMap[string, extension.Dependency](),
+		remoteWatchers:        newRemoteWatchers(),
 		passivationStrategy:   passivation.NewTimeBasedStrategy(DefaultPassivationTimeout),
 		passivationPaused:     atomic.NewBool(false),
 	}
@@ -398,6 +436,11 @@ func (pid *PID) IsSuspended() bool {
 	return pid.suspended.Load()
 }
 
+// isRestarting returns true while the actor is being restarted
+func (pid *PID) isRestarting() bool {
+	return pid.restartInProgress.Load()
+}
+
 // IsSingleton returns true when the actor is a singleton.
 //
 // A singleton actor is instantiated when cluster mode is enabled.
@@ -456,6 +499,9 @@ func (pid *PID) Restart(ctx context.Context) error {
 	}
 
 	pid.logger.Debugf("restarting actor=(%s)", pid.Name())
+	pid.restartInProgress.Store(true)
+	defer pid.restartInProgress.Store(false)
+
 	actorSystem := pid.ActorSystem()
 	tree := actorSystem.tree()
 	deathWatch := actorSystem.getDeathWatch()
@@ -544,10 +590,12 @@ func (pid *PID) Restart(ctx context.Context) error {
 
 	pid.processing.Store(idle)
 	pid.suspended.Store(false)
+	pid.restarting.Store(false)
 	pid.startSupervision()
 	pid.startPassivation()
 
 	pid.restartCount.Inc()
+	pid.metrics.recordRestart(pid.metricAttributes)
 	pid.fireSystemMessage(ctx, new(goaktpb.PostStart))
 	if pid.eventsStream != nil {
 		pid.eventsStream.Publish(
@@ -626,6 +674,22 @@ func (pid *PID) SpawnChild(ctx context.Context, name string, actor Actor, opts .
 		withWorkerPool(pid.workerPool),
 	}
 
+	if pid.persistenceState != nil {
+		pidOptions = append(pidOptions, withPersistenceStores(pid.persistenceState.journal, pid.persistenceState.snapshots))
+	}
+
+	if spawnConfig.snapshotInterval > 0 {
+		pidOptions = append(pidOptions, withSnapshotInterval(spawnConfig.snapshotInterval))
+	}
+
+	if pid.durableState != nil {
+		pidOptions = append(pidOptions, withDurableStateStore(pid.durableState.store))
+	}
+
+	if pid.metrics != nil {
+		pidOptions = append(pidOptions, withMetricsRecorder(pid.metrics))
+	}
+
 	if spawnConfig.mailbox != nil {
 		pidOptions = append(pidOptions, withMailbox(spawnConfig.mailbox))
 	}
@@ -769,12 +833,12 @@ func (pid *PID) ReinstateNamed(ctx context.Context, actorName string) error {
 		return ErrDead
 	}
 
-	addr, cid, err := pid.ActorSystem().ActorOf(ctx, actorName)
+	actorRef, err := pid.ActorSystem().ActorOf(ctx, actorName)
 	if err != nil {
 		return err
 	}
 
-	if cid != nil && !cid.Equals(NoSender) {
+	if cid := actorRef.localPID(); cid != nil && !cid.Equals(NoSender) {
 		if !cid.IsSuspended() || cid.IsRunning() {
 			return nil
 		}
@@ -783,6 +847,7 @@ func (pid *PID) ReinstateNamed(ctx context.Context, actorName string) error {
 		return nil
 	}
 
+	addr := actorRef.Address()
 	return pid.remoting.RemoteReinstate(ctx, addr.Host(), addr.Port(), actorName)
 }
 
@@ -798,7 +863,7 @@ func (pid *PID) StashSize() uint64 {
 // The successful result of the task will be put onto the provided actor mailbox.
 // This is useful when interacting with external services.
 // It’s common that you would like to use the value of the response in the actor when the long-started task is completed
-func (pid *PID) PipeTo(ctx context.Context, to *PID, task func() (proto.Message, error)) error {
+func (pid *PID) PipeTo(ctx context.Context, to *PID, task func() (any, error)) error {
 	if task == nil {
 		return ErrUndefinedTask
 	}
@@ -819,7 +884,7 @@ func (pid *PID) PipeTo(ctx context.Context, to *PID, task func() (proto.Message,
 
 // Ask sends a synchronous message to another actor and expect a response.
 // This block until a response is received or timed out.
-func (pid *PID) Ask(ctx context.Context, to *PID, message proto.Message, timeout time.Duration) (response proto.Message, err error) {
+func (pid *PID) Ask(ctx context.Context, to *PID, message any, timeout time.Duration) (response any, err error) {
 	if !to.IsRunning() {
 		return nil, ErrDead
 	}
@@ -828,6 +893,15 @@ func (pid *PID) Ask(ctx context.Context, to *PID, message proto.Message, timeout
 		return nil, ErrInvalidTimeout
 	}
 
+	breaker := actorCircuitBreaker(pid.ActorSystem(), to)
+	generation, err := breaker.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	defer func() { breaker.record(generation, err, time.Since(start)) }()
+
 	receiveContext := getContext()
 	receiveContext.build(ctx, pid, to, message, false)
 	to.doReceive(receiveContext)
@@ -852,7 +926,7 @@ func (pid *PID) Ask(ctx context.Context, to *PID, message proto.Message, timeout
 }
 
 // Tell sends an asynchronous message to another PID
-func (pid *PID) Tell(ctx context.Context, to *PID, message proto.Message) error {
+func (pid *PID) Tell(ctx context.Context, to *PID, message any) error {
 	if !to.IsRunning() {
 		return ErrDead
 	}
@@ -866,52 +940,48 @@ func (pid *PID) Tell(ctx context.Context, to *PID, message proto.Message) error
 
 // SendAsync sends an asynchronous message to a given actor.
 // The location of the given actor is transparent to the caller.
-func (pid *PID) SendAsync(ctx context.Context, actorName string, message proto.Message) error {
+func (pid *PID) SendAsync(ctx context.Context, actorName string, message any) error {
 	if !pid.IsRunning() {
 		return ErrDead
 	}
 
-	addr, cid, err := pid.ActorSystem().ActorOf(ctx, actorName)
+	actorRef, err := pid.ActorSystem().ActorOf(ctx, actorName)
 	if err != nil {
 		return err
 	}
 
-	if cid != nil {
+	if cid := actorRef.localPID(); cid != nil {
 		return pid.Tell(ctx, cid, message)
 	}
 
-	return pid.RemoteTell(ctx, addr, message)
+	return pid.RemoteTell(ctx, actorRef.Address(), message)
 }
 
 // SendSync sends a synchronous message to another actor and expect a response.
 // The location of the given actor is transparent to the caller.
 // This block until a response is received or timed out.
-func (pid *PID) SendSync(ctx context.Context, actorName string, message proto.Message, timeout time.Duration) (response proto.Message, err error) {
+func (pid *PID) SendSync(ctx context.Context, actorName string, message any, timeout time.Duration) (response any, err error) {
 	if !pid.IsRunning() {
 		return nil, ErrDead
 	}
 
-	addr, cid, err := pid.ActorSystem().ActorOf(ctx, actorName)
+	actorRef, err := pid.ActorSystem().ActorOf(ctx, actorName)
 	if err != nil {
 		return nil, err
 	}
 
-	if cid != nil {
+	if cid := actorRef.localPID(); cid != nil {
 		return pid.Ask(ctx, cid, message, timeout)
 	}
 
-	reply, err := pid.RemoteAsk(ctx, addr, message, timeout)
-	if err != nil {
-		return nil, err
-	}
-	return reply.UnmarshalNew()
+	return pid.RemoteAsk(ctx, actorRef.Address(), message, timeout)
 }
 
 // BatchTell sends an asynchronous bunch of messages to the given PID
 // The messages will be processed one after the other in the order they are sent.
 // This is a design choice to follow the simple principle of one message at a time processing by actors.
 // When BatchTell encounter a single message it will fall back to a Tell call.
-func (pid *PID) BatchTell(ctx context.Context, to *PID, messages ...proto.Message) error {
+func (pid *PID) BatchTell(ctx context.Context, to *PID, messages ...any) error {
 	for _, message := range messages {
 		if err := pid.Tell(ctx, to, message); err != nil {
 			return err
@@ -923,8 +993,8 @@ func (pid *PID) BatchTell(ctx context.Context, to *PID, messages ...proto.Messag
 // BatchAsk sends a synchronous bunch of messages to the given PID and expect responses in the same order as the messages.
 // The messages will be processed one after the other in the order they are sent.
 // This is a design choice to follow the simple principle of one message at a time processing by actors.
-func (pid *PID) BatchAsk(ctx context.Context, to *PID, messages []proto.Message, timeout time.Duration) (responses chan proto.Message, err error) {
-	responses = make(chan proto.Message, len(messages))
+func (pid *PID) BatchAsk(ctx context.Context, to *PID, messages []any, timeout time.Duration) (responses chan any, err error) {
+	responses = make(chan any, len(messages))
 	defer close(responses)
 
 	for i := range messages {
@@ -965,30 +1035,32 @@ func (pid *PID) RemoteLookup(ctx context.Context, host string, port int, name st
 }
 
 // RemoteTell sends a message to an actor remotely without expecting any reply
-func (pid *PID) RemoteTell(ctx context.Context, to *address.Address, message proto.Message) error {
+func (pid *PID) RemoteTell(ctx context.Context, to *address.Address, message any) error {
 	if pid.remoting == nil {
 		return ErrRemotingDisabled
 	}
 
-	marshaled, err := anypb.New(message)
+	marshaled, err := pid.remoting.serializers.Serialize(message)
 	if err != nil {
+		return NewErrInvalidRemoteMessage(err)
+	}
+
+	if err := pid.remoting.sendChunks(ctx, to.GetHost(), int(to.GetPort()), marshaled); err != nil {
 		return err
 	}
 
-	remoteService := pid.remoting.remotingServiceClient(to.GetHost(), int(to.GetPort()))
-	request := connect.NewRequest(&internalpb.RemoteTellRequest{
-		RemoteMessages: []*internalpb.RemoteMessage{
-			{
-				Sender:   pid.Address().Address,
-				Receiver: to.Address,
-				Message:  marshaled,
-			},
+	remoteMessages := []*internalpb.RemoteMessage{
+		{
+			Sender:   pid.Address().Address,
+			Receiver: to.Address,
+			Payload:  marshaled,
+			Headers:  injectContext(ctx, pid.remoting.propagator),
+			Metadata: metadataValues(ctx),
 		},
-	})
+	}
 
 	pid.logger.Debugf("sending a message to remote=(%s:%d)", to.GetHost(), to.GetPort())
-	_, err = remoteService.RemoteTell(ctx, request)
-	if err != nil {
+	if err := pid.remoting.tell(ctx, to.GetHost(), int(to.GetPort()), remoteMessages); err != nil {
 		fmtErr := fmt.Errorf("failed to send message to remote=(%s:%d): %w", to.GetHost(), to.GetPort(), err)
 		pid.logger.Error(fmtErr)
 		return fmtErr
@@ -999,7 +1071,7 @@ func (pid *PID) RemoteTell(ctx context.Context, to *address.Address, message pro
 }
 
 // RemoteAsk sends a synchronous message to another actor remotely and expect a response.
-func (pid *PID) RemoteAsk(ctx context.Context, to *address.Address, message proto.Message, timeout time.Duration) (response *anypb.Any, err error) {
+func (pid *PID) RemoteAsk(ctx context.Context, to *address.Address, message any, timeout time.Duration) (response any, err error) {
 	if pid.remoting == nil {
 		return nil, ErrRemotingDisabled
 	}
@@ -1008,18 +1080,33 @@ func (pid *PID) RemoteAsk(ctx context.Context, to *address.Address, message prot
 		return nil, ErrInvalidTimeout
 	}
 
-	marshaled, err := anypb.New(message)
+	marshaled, err := pid.remoting.serializers.Serialize(message)
+	if err != nil {
+		return nil, NewErrInvalidRemoteMessage(err)
+	}
+
+	breaker := remoteCircuitBreaker(ctx, pid.ActorSystem(), to)
+	generation, err := breaker.allow()
 	if err != nil {
 		return nil, err
 	}
 
+	start := time.Now()
+	defer func() { breaker.record(generation, err, time.Since(start)) }()
+
+	if err := pid.remoting.sendChunks(ctx, to.GetHost(), int(to.GetPort()), marshaled); err != nil {
+		return nil, err
+	}
+
 	remoteService := pid.remoting.remotingServiceClient(to.GetHost(), int(to.GetPort()))
 	request := connect.NewRequest(&internalpb.RemoteAskRequest{
 		RemoteMessages: []*internalpb.RemoteMessage{
 			{
 				Sender:   pid.Address().Address,
 				Receiver: to.Address,
-				Message:  marshaled,
+				Payload:  marshaled,
+				Headers:  injectContext(ctx, pid.remoting.propagator),
+				Metadata: metadataValues(ctx),
 			},
 		},
 		Timeout: durationpb.New(timeout),
@@ -1030,11 +1117,12 @@ func (pid *PID) RemoteAsk(ctx context.Context, to *address.Address, message prot
 		return nil, err
 	}
 
-	if resp != nil {
-		for _, msg := range resp.Msg.GetMessages() {
-			response = msg
-			break
+	if resp != nil && len(resp.Msg.GetPayloads()) > 0 {
+		reply := resp.Msg.GetPayloads()[0]
+		if err := pid.remoting.fetchChunks(ctx, to.GetHost(), int(to.GetPort()), reply); err != nil {
+			return nil, err
 		}
+		return pid.remoting.serializers.Deserialize(reply)
 	}
 
 	return
@@ -1042,52 +1130,64 @@ func (pid *PID) RemoteAsk(ctx context.Context, to *address.Address, message prot
 
 // RemoteBatchTell sends a batch of messages to a remote actor in a way fire-and-forget manner
 // Messages are processed one after the other in the order they are sent.
-func (pid *PID) RemoteBatchTell(ctx context.Context, to *address.Address, messages []proto.Message) error {
+func (pid *PID) RemoteBatchTell(ctx context.Context, to *address.Address, messages []any) error {
 	if pid.remoting == nil {
 		return ErrRemotingDisabled
 	}
 
 	remoteMessages := make([]*internalpb.RemoteMessage, 0, len(messages))
+	headers := injectContext(ctx, pid.remoting.propagator)
+	metadata := metadataValues(ctx)
 	for _, message := range messages {
-		packed, err := anypb.New(message)
+		packed, err := pid.remoting.serializers.Serialize(message)
 		if err != nil {
 			return NewErrInvalidRemoteMessage(err)
 		}
 
+		if err := pid.remoting.sendChunks(ctx, to.GetHost(), int(to.GetPort()), packed); err != nil {
+			return err
+		}
+
 		remoteMessages = append(remoteMessages, &internalpb.RemoteMessage{
 			Sender:   pid.Address().Address,
 			Receiver: to.Address,
-			Message:  packed,
+			Payload:  packed,
+			Headers:  headers,
+			Metadata: metadata,
 		})
 	}
 
-	remoteService := pid.remoting.remotingServiceClient(to.GetHost(), int(to.GetPort()))
-	_, err := remoteService.RemoteTell(ctx, connect.NewRequest(&internalpb.RemoteTellRequest{
-		RemoteMessages: remoteMessages,
-	}))
-	return err
+	return pid.remoting.tell(ctx, to.GetHost(), int(to.GetPort()), remoteMessages)
 }
 
 // RemoteBatchAsk sends a synchronous bunch of messages to a remote actor and expect responses in the same order as the messages.
 // Messages are processed one after the other in the order they are sent.
 // This can hinder performance if it is not properly used.
-func (pid *PID) RemoteBatchAsk(ctx context.Context, to *address.Address, messages []proto.Message, timeout time.Duration) (responses []*anypb.Any, err error) {
+func (pid *PID) RemoteBatchAsk(ctx context.Context, to *address.Address, messages []any, timeout time.Duration) (responses []any, err error) {
 	if pid.remoting == nil {
 		return nil, ErrRemotingDisabled
 	}
 
 	remoteMessages := make([]*internalpb.RemoteMessage, 0, len(messages))
+	headers := injectContext(ctx, pid.remoting.propagator)
+	metadata := metadataValues(ctx)
 	for _, message := range messages {
-		packed, err := anypb.New(message)
+		packed, err := pid.remoting.serializers.Serialize(message)
 		if err != nil {
 			return nil, NewErrInvalidRemoteMessage(err)
 		}
 
+		if err := pid.remoting.sendChunks(ctx, to.GetHost(), int(to.GetPort()), packed); err != nil {
+			return nil, err
+		}
+
 		remoteMessages = append(
 			remoteMessages, &internalpb.RemoteMessage{
 				Sender:   pid.Address().Address,
 				Receiver: to.Address,
-				Message:  packed,
+				Payload:  packed,
+				Headers:  headers,
+				Metadata: metadata,
 			})
 	}
 
@@ -1102,7 +1202,17 @@ func (pid *PID) RemoteBatchAsk(ctx context.Context, to *address.Address, message
 	}
 
 	if resp != nil {
-		responses = append(responses, resp.Msg.GetMessages()...)
+		for _, msg := range resp.Msg.GetPayloads() {
+			if err := pid.remoting.fetchChunks(ctx, to.GetHost(), int(to.GetPort()), msg); err != nil {
+				return nil, err
+			}
+
+			response, err := pid.remoting.serializers.Deserialize(msg)
+			if err != nil {
+				return nil, err
+			}
+			responses = append(responses, response)
+		}
 	}
 
 	return
@@ -1264,6 +1374,60 @@ func (pid *PID) UnWatch(cid *PID) {
 	}
 }
 
+// RemoteWatch watches an actor located on a remote node for a Terminated message when the watched actor stops.
+//
+// When both nodes are in the same cluster and the node hosting the watched actor leaves the cluster,
+// a Terminated message with the TERMINATION_REASON_NODE_LEFT reason is sent instead.
+// An error is returned when the watched actor does not exist.
+func (pid *PID) RemoteWatch(ctx context.Context, to *address.Address) error {
+	if pid.remoting == nil {
+		return ErrRemotingDisabled
+	}
+
+	system := pid.ActorSystem()
+	watches := system.getRemoteWatches()
+
+	// the watch is recorded first in case the watched actor stops right away
+	watches.add(&remoteWatch{
+		watcher: pid,
+		watchee: to,
+		node:    system.remoteWatchNode(ctx, to),
+	})
+
+	remoteClient := pid.remoting.remotingServiceClient(to.GetHost(), int(to.GetPort()))
+	request := connect.NewRequest(&internalpb.RemoteWatchRequest{
+		Watcher: pid.Address().Address,
+		Watchee: to.Address,
+	})
+
+	if _, err := remoteClient.RemoteWatch(ctx, request); err != nil {
+		watches.remove(pid.ID(), to.String())
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return NewErrActorNotFound(to.String())
+		}
+		return err
+	}
+	return nil
+}
+
+// RemoteUnWatch stops watching an actor located on a remote node
+func (pid *PID) RemoteUnWatch(ctx context.Context, to *address.Address) error {
+	if pid.remoting == nil {
+		return ErrRemotingDisabled
+	}
+
+	pid.ActorSystem().getRemoteWatches().remove(pid.ID(), to.String())
+
+	remoteClient := pid.remoting.remotingServiceClient(to.GetHost(), int(to.GetPort()))
+	request := connect.NewRequest(&internalpb.RemoteUnWatchRequest{
+		Watcher: pid.Address().Address,
+		Watchee: to.Address,
+	})
+
+	_, err := remoteClient.RemoteUnWatch(ctx, request)
+	return err
+}
+
 // Logger returns the logger sets when creating the PID
 func (pid *PID) Logger() log.Logger {
 	pid.fieldsLocker.Lock()
@@ -1280,6 +1444,13 @@ func (pid *PID) doReceive(receiveCtx *ReceiveContext) {
 			pid.logger.Warn(err)
 			pid.toDeadletters(receiveCtx, err)
 		}
+
+		// the actor handed off to another node no longer processes its messages
+		if pid.handedOffTo.Load() != nil {
+			pid.forwardMessages()
+			return
+		}
+
 		pid.schedule()
 	}
 }
@@ -1334,10 +1505,18 @@ func (pid *PID) receiveLoop() {
 // handleReceived picks the right behavior and processes the message
 func (pid *PID) handleReceived(received *ReceiveContext) {
 	defer pid.recovery(received)
+	if pid.restarting.Load() {
+		// the actor is waiting for its supervisor to restart it
+		pid.toDeadletters(received, ErrActorRestarting)
+		return
+	}
+
 	if behavior := pid.behaviorStack.Peek(); behavior != nil {
-		pid.latestReceiveTime.Store(time.Now())
+		receivedAt := time.Now()
+		pid.latestReceiveTime.Store(receivedAt)
 		pid.processedCount.Inc()
 		behavior(received)
+		pid.metrics.recordProcessed(pid.metricAttributes, time.Since(receivedAt))
 	}
 }
 
@@ -1383,6 +1562,7 @@ func (pid *PID) init(ctx context.Context) error {
 	pid.logger.Infof("%s starting...", pid.Name())
 
 	initContext := newContext(ctx, pid.Name(), pid.system, pid.Dependencies()...)
+	initContext.durableState = pid.durableState
 
 	cctx, cancel := context.WithTimeout(ctx, pid.initTimeout.Load())
 	retrier := retry.NewRetrier(int(pid.initMaxRetries.Load()), time.Millisecond, pid.initTimeout.Load())
@@ -1395,6 +1575,20 @@ func (pid *PID) init(ctx context.Context) error {
 		return e
 	}
 
+	// rebuild the persistent actor state before processing any message
+	if err := pid.recoverState(cctx); err != nil {
+		e := NewErrInitFailure(err)
+		cancel()
+		return e
+	}
+
+	// restore the state handed off by the previous incarnation of the actor
+	if err := pid.takeOver(); err != nil {
+		e := NewErrInitFailure(err)
+		cancel()
+		return e
+	}
+
 	pid.running.Store(true)
 	pid.logger.Infof("%s successfully started.", pid.Name())
 
@@ -1423,12 +1617,12 @@ func (pid *PID) reset() {
 	pid.startedAt.Store(0)
 	pid.stopping.Store(false)
 	pid.suspended.Store(false)
-	pid.supervisor.Reset()
 	pid.mailbox.Dispose()
 	pid.isSingleton.Store(false)
 	pid.relocatable.Store(true)
 	pid.dependencies.Reset()
 	pid.passivationPaused.Store(false)
+	pid.restarting.Store(false)
 }
 
 // freeWatchers releases all the actors watching this actor
@@ -1456,16 +1650,51 @@ func (pid *PID) freeWatchers(ctx context.Context) {
 		}
 
 		logger.Debugf("%s successfully frees all watcher actors...", pid.Name())
+	}
+
+	if pid.remoteWatchers.Len() > 0 {
+		remoteWatchers := pid.remoteWatchers.Values()
+		pid.remoteWatchers.Reset()
+
+		// the remote nodes may be slow to reach or gone, hence the watchers are notified
+		// in the background so that they do not hold up the shutdown
+		ctx := context.WithoutCancel(ctx)
+		go func() {
+			for _, watcher := range remoteWatchers {
+				terminated := &goaktpb.Terminated{
+					ActorId: pid.ID(),
+				}
+
+				logger.Debugf("remote watcher=(%s) releasing watched=(%s)", watcher.address.String(), pid.Name())
+				// the remote node may be gone, the error is logged by RemoteTell
+				_ = pid.RemoteTell(ctx, watcher.address, terminated)
+			}
+		}()
+
+		logger.Debugf("%s successfully frees all remote watcher actors...", pid.Name())
 		return
 	}
-	logger.Debugf("%s does not have any watcher actors. Maybe already freed.", pid.Name())
+
+	if len(watchers) == 0 {
+		logger.Debugf("%s does not have any watcher actors. Maybe already freed.", pid.Name())
+	}
 }
 
 // freeWatchees releases all actors that have been watched by this actor
-func (pid *PID) freeWatchees() error {
+func (pid *PID) freeWatchees(ctx context.Context) error {
 	logger := pid.logger
 	logger.Debugf("%s freeing all watched actors...", pid.Name())
 
+	for _, watch := range pid.ActorSystem().getRemoteWatches().removeWatcher(pid.ID()) {
+		logger.Debugf("watcher=(%s) unwatching remote actor=(%s)", pid.Name(), watch.watchee.String())
+		if pid.remoting != nil {
+			// the remote node may be gone
+			if err := pid.RemoteUnWatch(ctx, watch.watchee); err != nil {
+				logger.Warnf("watcher=(%s) failed to unwatch remote actor=(%s): %v", pid.Name(), watch.watchee.String(), err)
+			}
+		}
+	}
+
 	tree := pid.ActorSystem().tree()
 	watchees := tree.watchees(pid)
 	if len(watchees) > 0 {
@@ -1554,6 +1783,7 @@ func (pid *PID) passivationLoop() {
 	}
 
 	clock.Start()
+	haltSig := make(chan registry.Unit, 1)
 
 	go func() {
 		for {
@@ -1561,14 +1791,20 @@ func (pid *PID) passivationLoop() {
 			case <-clock.Ticks:
 				exec()
 			case <-pid.haltPassivationLnr:
-				tickerStopSig <- registry.Unit{}
+				haltSig <- registry.Unit{}
 				return
 			}
 		}
 	}()
 
-	<-tickerStopSig
-	clock.Stop()
+	select {
+	case <-tickerStopSig:
+		clock.Stop()
+	case <-haltSig:
+		// the listener has been halted because the actor is stopping or restarting
+		clock.Stop()
+		return
+	}
 
 	// if the actor system is shutting down it means that the actor stop mode has been triggered
 	if actoryStem := pid.ActorSystem(); actoryStem != nil {
@@ -1598,6 +1834,7 @@ func (pid *PID) passivationLoop() {
 		return
 	}
 
+	pid.metrics.recordPassivation(pid.metricAttributes)
 	if pid.eventsStream != nil {
 		event := &goaktpb.ActorPassivated{
 			Address:      pid.Address().Address,
@@ -1652,25 +1889,31 @@ func (pid *PID) doStop(ctx context.Context) error {
 
 	defer func() {
 		pid.running.Store(false)
+		// the lease of a singleton actor is released once the actor has stopped for good, not while it is restarting
+		if actorSystem := pid.ActorSystem(); actorSystem != nil && pid.IsSingleton() && !pid.isRestarting() {
+			actorSystem.releaseSingletonLease(ctx, pid.Name())
+		}
 		pid.reset()
 	}()
 
 	// stop supervisor loop
 	pid.supervisionStopSignal <- registry.Unit{}
 
+	// the remoting is shared with the actor system, only free its idle connections
 	if pid.remoting != nil {
-		pid.remoting.Close()
+		pid.remoting.HTTPClient().CloseIdleConnections()
 	}
 
 	if err := errorschain.
 		New(errorschain.ReturnFirst()).
-		AddErrorFn(func() error { return pid.freeWatchees() }).
+		AddErrorFn(func() error { return pid.freeWatchees(ctx) }).
 		AddErrorFn(func() error { return pid.freeChildren(ctx) }).
 		Error(); err != nil {
 		return err
 	}
 
 	stopContext := newContext(ctx, pid.Name(), pid.system, pid.Dependencies()...)
+	stopContext.durableState = pid.durableState
 
 	// run the PostStop hook and let watchers know
 	// you are terminated
@@ -1706,27 +1949,19 @@ func (pid *PID) notifyParent(signal *supervisionSignal) {
 		return
 	}
 
-	// find a directive for the given error or check whether there
-	// is a directive for any error type
-	directive, ok := pid.supervisor.Directive(signal.Err())
+	directive, ok := pid.decideDirective(signal.Err())
 	if !ok {
-		// let us check whether we have all errors directive
-		directive, ok = pid.supervisor.Directive(new(anyError))
-		if !ok {
-			pid.logger.Debugf("no supervisor directive found for error: %s", errorType(signal.Err()))
-			pid.suspend(signal.Err().Error())
-			return
-		}
+		pid.suspend(signal.Err().Error())
+		return
 	}
 
 	pid.logger.Debugf("%s supervisor directive %s", pid.Name(), directive.String())
 
 	// create the message to send to the parent
-	actual, _ := anypb.New(signal.Msg())
 	msg := &internalpb.Down{
 		ActorId:      pid.ID(),
 		ErrorMessage: signal.Err().Error(),
-		Message:      actual,
+		Message:      marshalPayload(pid.ActorSystem().getSerializers(), signal.Msg()),
 		Timestamp:    signal.Timestamp(),
 	}
 
@@ -1736,10 +1971,17 @@ func (pid *PID) notifyParent(signal *supervisionSignal) {
 			Stop: new(internalpb.StopDirective),
 		}
 	case RestartDirective:
+		backoff := pid.supervisor.getRestartBackoff()
+		if backoff != nil {
+			// stop processing messages until the supervisor restarts the actor
+			pid.restarting.Store(true)
+		}
+
 		msg.Directive = &internalpb.Down_Restart{
 			Restart: &internalpb.RestartDirective{
 				MaxRetries: pid.supervisor.MaxRetries(),
 				Timeout:    int64(pid.supervisor.Timeout()),
+				Backoff:    backoff.toProto(),
 			},
 		}
 	case ResumeDirective:
@@ -1777,6 +2019,34 @@ func (pid *PID) notifyParent(signal *supervisionSignal) {
 	}
 }
 
+// decideDirective returns the supervisor directive to apply for the given error.
+// The supervisor decider, when set, takes precedence over the error type directives
+func (pid *PID) decideDirective(err error) (directive Directive, ok bool) {
+	if decider := pid.supervisor.getDecider(); decider != nil {
+		defer func() {
+			if r := recover(); r != nil {
+				pid.logger.Errorf("%s supervisor decider panicked: %v", pid.Name(), r)
+				directive, ok = StopDirective, false
+			}
+		}()
+		return decider(err, pid, pid.RestartCount()), true
+	}
+
+	// find a directive for the given error or check whether there
+	// is a directive for any error type
+	if directive, ok = pid.supervisor.Directive(err); ok {
+		return directive, true
+	}
+
+	// let us check whether we have all errors directive
+	if directive, ok = pid.supervisor.Directive(new(anyError)); ok {
+		return directive, true
+	}
+
+	pid.logger.Debugf("no supervisor directive found for error: %s", errorType(err))
+	return StopDirective, false
+}
+
 // toDeadletters sends message to deadletter synthetic actor
 func (pid *PID) toDeadletters(receiveCtx *ReceiveContext, err error) {
 	// the message is lost
@@ -1799,24 +2069,25 @@ func (pid *PID) toDeadletters(receiveCtx *ReceiveContext, err error) {
 
 	ctx := context.Background()
 	receiver := pid.Address()
+	pid.metrics.recordDeadletter(pid.metricAttributes)
 	pid.sendToDeadletter(ctx, sender, receiver, receiveCtx.Message(), err)
 }
 
 // sendToDeadletter sends a message to the deadletter actor
-func (pid *PID) sendToDeadletter(ctx context.Context, from, to *address.Address, message proto.Message, err error) {
+func (pid *PID) sendToDeadletter(ctx context.Context, from, to *address.Address, message any, err error) {
 	deadletter := pid.ActorSystem().getDeadletter()
-	msg, _ := anypb.New(message)
 
 	// send the message to the deadletter actor
 	_ = pid.Tell(ctx,
 		deadletter,
 		&internalpb.EmitDeadletter{
 			Deadletter: &goaktpb.Deadletter{
-				Sender:   from.Address,
-				Receiver: to.Address,
-				Message:  msg,
-				SendTime: timestamppb.Now(),
-				Reason:   err.Error(),
+				Sender:      from.Address,
+				Receiver:    to.Address,
+				Message:     marshalAny(message),
+				SendTime:    timestamppb.Now(),
+				Reason:      err.Error(),
+				MessageType: pid.ActorSystem().getSerializers().Manifest(message),
 			}})
 }
 
@@ -1867,15 +2138,19 @@ func (pid *PID) handleFailure(cid *PID, msg *internalpb.Down) {
 			pid.handleRestartDirective(cid,
 				d.Restart.GetMaxRetries(),
 				time.Duration(d.Restart.GetTimeout()),
+				restartBackoffFromProto(d.Restart.GetBackoff()),
 				includeSiblings)
 		case *internalpb.Down_Resume:
 		// pass
 		case *internalpb.Down_Escalate:
 			// forward the message to the parent and suspend the actor
+			// the message that cannot be deserialized is only described by its type
+			message, _ := pid.ActorSystem().getSerializers().Deserialize(msg.GetMessage())
 			_ = cid.Tell(context.Background(), pid, &goaktpb.Mayday{
-				Reason:    msg.GetErrorMessage(),
-				Message:   msg.GetMessage(),
-				Timestamp: msg.GetTimestamp(),
+				Reason:      msg.GetErrorMessage(),
+				Message:     marshalAny(message),
+				Timestamp:   msg.GetTimestamp(),
+				MessageType: msg.GetMessage().GetManifest(),
 			})
 			cid.suspend(msg.GetErrorMessage())
 		default:
@@ -1920,7 +2195,7 @@ func (pid *PID) handleStopDirective(cid *PID, includeSiblings bool) {
 }
 
 // handleRestartDirective handles the Behavior restart directive
-func (pid *PID) handleRestartDirective(cid *PID, maxRetries uint32, timeout time.Duration, includeSiblings bool) {
+func (pid *PID) handleRestartDirective(cid *PID, maxRetries uint32, timeout time.Duration, backoff *restartBackoff, includeSiblings bool) {
 	ctx := context.Background()
 	tree := pid.ActorSystem().tree()
 	pids := []*PID{cid}
@@ -1933,6 +2208,14 @@ func (pid *PID) handleRestartDirective(cid *PID, maxRetries uint32, timeout time
 		}
 	}
 
+	if backoff != nil {
+		for _, spid := range pids {
+			pid.UnWatch(spid)
+			pid.handleBackoffRestart(spid, maxRetries, timeout, backoff)
+		}
+		return
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 	for _, spid := range pids {
 		spid := spid
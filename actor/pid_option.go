@@ -27,12 +27,15 @@ package actor
 import (
 	"time"
 
+	"google.golang.org/protobuf/types/known/anypb"
+
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/internal/collection"
 	"github.com/tochemey/goakt/v3/internal/eventstream"
 	"github.com/tochemey/goakt/v3/internal/workerpool"
 	"github.com/tochemey/goakt/v3/log"
 	"github.com/tochemey/goakt/v3/passivation"
+	"github.com/tochemey/goakt/v3/persistence"
 )
 
 // pidOption represents the pid
@@ -141,3 +144,62 @@ func withPassivationStrategy(strategy passivation.Strategy) pidOption {
 		pid.passivationStrategy = strategy
 	}
 }
+
+// withPersistenceStores sets the journal and snapshot stores used by persistent actors
+func withPersistenceStores(journal persistence.JournalStore, snapshots persistence.SnapshotStore) pidOption {
+	return func(pid *PID) {
+		if pid.persistenceState == nil {
+			pid.persistenceState = new(persistenceState)
+		}
+		pid.persistenceState.journal = journal
+		pid.persistenceState.snapshots = snapshots
+	}
+}
+
+// withDurableStateStore sets the durable state store used to load and save the actor state
+func withDurableStateStore(store persistence.DurableStateStore) pidOption {
+	return func(pid *PID) {
+		pid.durableState = newDurableState(store, pid.Name())
+	}
+}
+
+// withMetricsRecorder sets the metrics recorder of the actor
+func withMetricsRecorder(recorder *metricsRecorder) pidOption {
+	return func(pid *PID) {
+		pid.metrics = recorder
+		pid.metricAttributes = recorder.actorAttributes(pid.actor)
+	}
+}
+
+// withSnapshotInterval sets the number of persisted events after which
+// a persistent actor snapshot is taken
+func withSnapshotInterval(interval uint64) pidOption {
+	return func(pid *PID) {
+		if pid.persistenceState == nil {
+			pid.persistenceState = new(persistenceState)
+		}
+		pid.persistenceState.snapshotInterval = interval
+	}
+}
+
+// withActorFactory sets the name of the factory the actor is created with and its arguments
+func withActorFactory(name string, args *anypb.Any) pidOption {
+	return func(pid *PID) {
+		pid.factory = name
+		pid.factoryArgs = args
+	}
+}
+
+// withRole sets the role a cluster node must have to host the actor
+func withRole(role string) pidOption {
+	return func(pid *PID) {
+		pid.role = role
+	}
+}
+
+// withHandOffState sets the state handed off by the previous incarnation of the actor
+func withHandOffState(state *anypb.Any) pidOption {
+	return func(pid *PID) {
+		pid.handOffState = state
+	}
+}
@@ -324,6 +324,39 @@ func TestPassivation(t *testing.T) {
 		assert.ErrorIs(t, err, ErrDead)
 		assert.NoError(t, actorSystem.Stop(ctx))
 	})
+	t.Run("With passivation listener halted on restart", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NotNil(t, actorSystem)
+
+		require.NoError(t, actorSystem.Start(ctx))
+
+		consumer, err := actorSystem.Subscribe()
+		require.NoError(t, err)
+		require.NotNil(t, consumer)
+
+		pause.For(500 * time.Millisecond)
+
+		pid, err := actorSystem.Spawn(ctx, "test", NewMockActor(),
+			WithPassivationStrategy(passivation.NewTimeBasedStrategy(10*time.Second)))
+		require.NoError(t, err)
+		assert.NotNil(t, pid)
+
+		require.NoError(t, pid.Restart(ctx))
+
+		pause.For(time.Second)
+		require.True(t, pid.IsRunning())
+
+		// the restart halts the passivation listener, it must not passivate the actor
+		for message := range consumer.Iterator() {
+			_, passivated := message.Payload().(*goaktpb.ActorPassivated)
+			require.False(t, passivated)
+		}
+
+		require.NoError(t, Tell(ctx, pid, new(testpb.TestSend)))
+		assert.NoError(t, actorSystem.Stop(ctx))
+	})
 }
 func TestReply(t *testing.T) {
 	t.Run("With happy path", func(t *testing.T) {
@@ -349,7 +382,7 @@ func TestReply(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, actual)
 		expected := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expected, actual))
+		assert.True(t, proto.Equal(expected, actual.(proto.Message)))
 		// stop the actor
 		err = pid.Shutdown(ctx)
 		assert.NoError(t, err)
@@ -1293,7 +1326,7 @@ func TestSupervisorStrategy(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expected := new(testpb.Reply)
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 
 		//stop the actor
 		err = parent.Shutdown(ctx)
@@ -1676,7 +1709,7 @@ func TestMessaging(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expected := new(testpb.Reply)
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 
 		// wait a while because exchange is ongoing
 		pause.For(time.Second)
@@ -1973,11 +2006,9 @@ func TestRemoting(t *testing.T) {
 		// perform some assertions
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		require.True(t, reply.MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), reply)
 
-		actual := new(testpb.Reply)
-		err = reply.UnmarshalTo(actual)
-		require.NoError(t, err)
+		actual := reply.(*testpb.Reply)
 
 		expected := new(testpb.Reply)
 		assert.True(t, proto.Equal(expected, actual))
@@ -2895,13 +2926,13 @@ func TestBatchAsk(t *testing.T) {
 		require.NotNil(t, pid)
 
 		// batch ask
-		responses, err := pid.BatchAsk(ctx, pid, []proto.Message{new(testpb.TestReply), new(testpb.TestReply)}, replyTimeout)
+		responses, err := pid.BatchAsk(ctx, pid, []any{new(testpb.TestReply), new(testpb.TestReply)}, replyTimeout)
 		require.NoError(t, err)
 		for reply := range responses {
 			require.NoError(t, err)
 			require.NotNil(t, reply)
 			expected := new(testpb.Reply)
-			assert.True(t, proto.Equal(expected, reply))
+			assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 		}
 
 		// wait a while because exchange is ongoing
@@ -2938,7 +2969,7 @@ func TestBatchAsk(t *testing.T) {
 		pause.For(time.Second)
 
 		// batch ask
-		responses, err := pid.BatchAsk(ctx, pid, []proto.Message{new(testpb.TestReply), new(testpb.TestReply)}, replyTimeout)
+		responses, err := pid.BatchAsk(ctx, pid, []any{new(testpb.TestReply), new(testpb.TestReply)}, replyTimeout)
 		require.Error(t, err)
 		require.Nil(t, responses)
 		assert.NoError(t, actorSystem.Stop(ctx))
@@ -2967,7 +2998,7 @@ func TestBatchAsk(t *testing.T) {
 		require.NotNil(t, pid)
 
 		// batch ask
-		responses, err := pid.BatchAsk(ctx, pid, []proto.Message{new(testpb.TestTimeout), new(testpb.TestReply)}, replyTimeout)
+		responses, err := pid.BatchAsk(ctx, pid, []any{new(testpb.TestTimeout), new(testpb.TestReply)}, replyTimeout)
 		require.Error(t, err)
 		require.Empty(t, responses)
 
@@ -3333,11 +3364,9 @@ func TestRemoteSpawn(t *testing.T) {
 
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		require.True(t, reply.MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), reply)
 
-		actual := new(testpb.Reply)
-		err = reply.UnmarshalTo(actual)
-		require.NoError(t, err)
+		actual := reply.(*testpb.Reply)
 
 		expected := new(testpb.Reply)
 		assert.True(t, proto.Equal(expected, actual))
@@ -3549,7 +3578,7 @@ func TestPipeTo(t *testing.T) {
 		require.Zero(t, pid1.ProcessedCount()-1)
 		require.Zero(t, pid2.ProcessedCount()-1)
 
-		task := func() (proto.Message, error) {
+		task := func() (any, error) {
 			// simulate a long-running task
 			pause.For(time.Second)
 			return new(testpb.TaskComplete), nil
@@ -3612,7 +3641,7 @@ func TestPipeTo(t *testing.T) {
 		pause.For(time.Second)
 		assert.NoError(t, pid2.Shutdown(ctx))
 
-		task := func() (proto.Message, error) {
+		task := func() (any, error) {
 			// simulate a long-running task
 			pause.For(time.Second)
 			return new(testpb.TaskComplete), nil
@@ -3707,7 +3736,7 @@ func TestPipeTo(t *testing.T) {
 		require.Zero(t, pid1.ProcessedCount()-1)
 		require.Zero(t, pid2.ProcessedCount()-1)
 
-		task := func() (proto.Message, error) {
+		task := func() (any, error) {
 			// simulate a long-running task
 			pause.For(time.Second)
 			return nil, assert.AnError
@@ -3924,7 +3953,7 @@ func TestSendSync(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, response)
 		expected := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expected, response))
+		assert.True(t, proto.Equal(expected, response.(proto.Message)))
 
 		t.Cleanup(func() {
 			assert.NoError(t, actorSystem.Stop(ctx))
@@ -3995,7 +4024,7 @@ func TestSendSync(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, response)
 		expected := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expected, response))
+		assert.True(t, proto.Equal(expected, response.(proto.Message)))
 
 		t.Cleanup(func() {
 			assert.NoError(t, node1.Stop(ctx))
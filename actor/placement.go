@@ -0,0 +1,310 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/tochemey/goakt/v3/internal/cluster"
+	"github.com/tochemey/goakt/v3/internal/collection"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/memory"
+)
+
+// PlacementStrategy defines a user-defined algorithm used, in cluster mode, to choose the node
+// an actor is spawned on with SpawnOn or a grain is activated on.
+//
+// This makes it possible to write affinity rules based upon the load of the cluster nodes.
+// Set it with WithPlacementStrategy for actors and WithGrainPlacementStrategy for grains.
+type PlacementStrategy interface {
+	// Place returns the node, among the given candidate nodes, the actor or the grain is placed on.
+	// The candidates are never empty, are sorted by peer address and include the local node
+	// when it can host the actor or the grain.
+	Place(ctx context.Context, nodes []*NodeMetric) (*NodeMetric, error)
+}
+
+// NodeMetric defines the load of a cluster node at the time of a placement
+type NodeMetric struct {
+	// host is the node host
+	host string
+	// peersPort is the node cluster port
+	peersPort int
+	// remotingPort is the node remoting port
+	remotingPort int
+	// roles are the node roles
+	roles []string
+	// local states whether the node is the local node
+	local bool
+	// actorsCount is the number of actors on the node
+	actorsCount uint64
+	// grainsCount is the number of active grains on the node
+	grainsCount uint64
+	// memSize is the total memory of the node in bytes
+	memSize uint64
+	// memAvail is the free memory of the node in bytes
+	memAvail uint64
+	// memUsed is the memory used by the node in bytes
+	memUsed uint64
+}
+
+// Host returns the node host
+func (m NodeMetric) Host() string {
+	return m.host
+}
+
+// PeersPort returns the node cluster port
+func (m NodeMetric) PeersPort() int {
+	return m.peersPort
+}
+
+// RemotingPort returns the node remoting port
+func (m NodeMetric) RemotingPort() int {
+	return m.remotingPort
+}
+
+// PeerAddress returns the address the cluster members use to connect to the node
+func (m NodeMetric) PeerAddress() string {
+	return net.JoinHostPort(m.host, strconv.Itoa(m.peersPort))
+}
+
+// Roles returns the node roles
+func (m NodeMetric) Roles() []string {
+	return m.roles
+}
+
+// IsLocal states whether the node is the local node
+func (m NodeMetric) IsLocal() bool {
+	return m.local
+}
+
+// ActorsCount returns the number of actors on the node
+func (m NodeMetric) ActorsCount() uint64 {
+	return m.actorsCount
+}
+
+// GrainsCount returns the number of active grains on the node
+func (m NodeMetric) GrainsCount() uint64 {
+	return m.grainsCount
+}
+
+// MemorySize returns the total memory of the node in bytes
+func (m NodeMetric) MemorySize() uint64 {
+	return m.memSize
+}
+
+// MemoryAvailable returns the free memory of the node in bytes
+func (m NodeMetric) MemoryAvailable() uint64 {
+	return m.memAvail
+}
+
+// MemoryUsed returns the memory used by the node in bytes
+func (m NodeMetric) MemoryUsed() uint64 {
+	return m.memUsed
+}
+
+// leastLoadPlacement places actors and grains on the node with the fewest actors
+type leastLoadPlacement struct{}
+
+// enforce compilation error
+var _ PlacementStrategy = (*leastLoadPlacement)(nil)
+
+// NewLeastLoadPlacement creates a PlacementStrategy that places actors and grains
+// on the node with the fewest actors. This is the strategy used by the LeastLoad placement.
+func NewLeastLoadPlacement() PlacementStrategy {
+	return new(leastLoadPlacement)
+}
+
+// Place implements PlacementStrategy
+func (*leastLoadPlacement) Place(_ context.Context, nodes []*NodeMetric) (*NodeMetric, error) {
+	return slices.MinFunc(nodes, func(a, b *NodeMetric) int {
+		return compareUint64(a.ActorsCount(), b.ActorsCount())
+	}), nil
+}
+
+// memoryAwarePlacement places actors and grains on the node with the most free memory
+type memoryAwarePlacement struct{}
+
+// enforce compilation error
+var _ PlacementStrategy = (*memoryAwarePlacement)(nil)
+
+// NewMemoryAwarePlacement creates a PlacementStrategy that places actors and grains
+// on the node with the most free memory, the node with the fewest actors being chosen among equals.
+// This is the strategy used by the MemoryAware placement.
+func NewMemoryAwarePlacement() PlacementStrategy {
+	return new(memoryAwarePlacement)
+}
+
+// Place implements PlacementStrategy
+func (*memoryAwarePlacement) Place(_ context.Context, nodes []*NodeMetric) (*NodeMetric, error) {
+	return slices.MinFunc(nodes, func(a, b *NodeMetric) int {
+		if c := compareUint64(b.MemoryAvailable(), a.MemoryAvailable()); c != 0 {
+			return c
+		}
+		return compareUint64(a.ActorsCount(), b.ActorsCount())
+	}), nil
+}
+
+// compareUint64 compares two unsigned integers the way cmp.Compare does
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// place chooses with the given strategy the node an actor or a grain requiring the given role is placed on
+// among the given peers having that role and, when it has the role, the local node. It returns a nil peer
+// when the local node is chosen. The peers that cannot report their load are left out.
+func (x *actorSystem) place(ctx context.Context, strategy PlacementStrategy, peers []*cluster.Peer, role string) (*cluster.Peer, error) {
+	// a draining node is never a placement candidate
+	nodes, err := x.nodeMetrics(ctx, peers, x.hasRole(role) && !x.draining.Load())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 0 {
+		if role != "" && len(peers) == 0 {
+			return nil, ErrRoleNotFound
+		}
+		return nil, ErrNoPlacementCandidates
+	}
+
+	node, err := strategy.Place(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if node == nil {
+		return nil, ErrInvalidPlacement
+	}
+
+	if node.IsLocal() {
+		return nil, nil
+	}
+
+	for _, peer := range peers {
+		if peer.PeerAddress() == node.PeerAddress() {
+			return peer, nil
+		}
+	}
+	return nil, ErrInvalidPlacement
+}
+
+// nodeMetrics fetches the load of the given peers and, when includeLocal is set, of the local node
+func (x *actorSystem) nodeMetrics(ctx context.Context, peers []*cluster.Peer, includeLocal bool) ([]*NodeMetric, error) {
+	metrics := make([]*NodeMetric, len(peers))
+	eg, egCtx := errgroup.WithContext(ctx)
+	for index, peer := range peers {
+		eg.Go(func() error {
+			client := x.remoting.clusterServiceClient(peer.Host, peer.RemotingPort)
+			request := connect.NewRequest(&internalpb.GetNodeMetricRequest{
+				NodeAddress: fmt.Sprintf("%s:%d", peer.Host, peer.RemotingPort),
+			})
+
+			response, err := client.GetNodeMetric(egCtx, request)
+			if err != nil {
+				// here the peer may not be available
+				code := connect.CodeOf(err)
+				if code == connect.CodeUnavailable ||
+					code == connect.CodeCanceled ||
+					code == connect.CodeDeadlineExceeded {
+					x.logger.Warnf("failed to fetch node=(%s) metric: %v", peer.PeerAddress(), err)
+					return nil
+				}
+				return fmt.Errorf("failed to fetch node=(%s) metric: %w", peer.PeerAddress(), err)
+			}
+
+			metrics[index] = toNodeMetric(peer, response.Msg, false)
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	nodes := collection.Filter(metrics, func(node *NodeMetric) bool {
+		return node != nil
+	})
+
+	if includeLocal {
+		local := &cluster.Peer{
+			Host:         x.clusterNode.Host,
+			PeersPort:    x.clusterNode.PeersPort,
+			RemotingPort: x.clusterNode.RemotingPort,
+			Roles:        x.clusterNode.Roles,
+		}
+		nodes = append(nodes, toNodeMetric(local, x.nodeMetric(), true))
+	}
+
+	slices.SortFunc(nodes, func(a, b *NodeMetric) int {
+		return strings.Compare(a.PeerAddress(), b.PeerAddress())
+	})
+	return nodes, nil
+}
+
+// nodeMetric returns the load of the actor system
+func (x *actorSystem) nodeMetric() *internalpb.GetNodeMetricResponse {
+	// we ignore the error here
+	memSize, _ := memory.Size()
+	memAvail, _ := memory.Free()
+
+	return &internalpb.GetNodeMetricResponse{
+		NodeRemoteAddress: fmt.Sprintf("%s:%d", x.remoteConfig.BindAddr(), x.remoteConfig.BindPort()),
+		ActorsCount:       uint64(x.actors.count()),
+		GrainsCount:       uint64(x.grains.Len()),
+		MemorySize:        memSize,
+		MemoryAvailable:   memAvail,
+		MemoryUsed:        memory.Used(),
+	}
+}
+
+// toNodeMetric creates the NodeMetric of the given peer from its reported load
+func toNodeMetric(peer *cluster.Peer, metric *internalpb.GetNodeMetricResponse, local bool) *NodeMetric {
+	return &NodeMetric{
+		host:         peer.Host,
+		peersPort:    peer.PeersPort,
+		remotingPort: peer.RemotingPort,
+		roles:        peer.Roles,
+		local:        local,
+		actorsCount:  metric.GetActorsCount(),
+		grainsCount:  metric.GetGrainsCount(),
+		memSize:      metric.GetMemorySize(),
+		memAvail:     metric.GetMemoryAvailable(),
+		memUsed:      metric.GetMemoryUsed(),
+	}
+}
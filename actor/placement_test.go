@@ -0,0 +1,121 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+
+	"github.com/tochemey/goakt/v3/internal/cluster"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	clustermock "github.com/tochemey/goakt/v3/mocks/cluster"
+	"github.com/tochemey/goakt/v3/remote"
+)
+
+func TestPlacement(t *testing.T) {
+	t.Run("With least load placement", func(t *testing.T) {
+		nodes := []*NodeMetric{
+			{host: "127.0.0.1", peersPort: 1, actorsCount: 10},
+			{host: "127.0.0.1", peersPort: 2, actorsCount: 3},
+			{host: "127.0.0.1", peersPort: 3, actorsCount: 3},
+		}
+
+		node, err := NewLeastLoadPlacement().Place(context.TODO(), nodes)
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:2", node.PeerAddress())
+	})
+	t.Run("With memory aware placement", func(t *testing.T) {
+		nodes := []*NodeMetric{
+			{host: "127.0.0.1", peersPort: 1, memAvail: 100, actorsCount: 1},
+			{host: "127.0.0.1", peersPort: 2, memAvail: 300, actorsCount: 5},
+			{host: "127.0.0.1", peersPort: 3, memAvail: 300, actorsCount: 2},
+		}
+
+		node, err := NewMemoryAwarePlacement().Place(context.TODO(), nodes)
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:3", node.PeerAddress())
+	})
+	t.Run("With no placement candidates", func(t *testing.T) {
+		ctx := context.TODO()
+		ports := dynaport.Get(2)
+		sys, err := NewActorSystem("testSys",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", ports[0])))
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		// the local node is part of a cluster and has no role
+		system := sys.(*actorSystem)
+		system.cluster = new(clustermock.Interface)
+		system.clusterConfig = NewClusterConfig()
+		system.clusterEnabled.Store(true)
+
+		// no node has the role
+		_, err = system.place(ctx, NewLeastLoadPlacement(), nil, "payments")
+		require.ErrorIs(t, err, ErrRoleNotFound)
+
+		// the only node having the role cannot report its load
+		peers := []*cluster.Peer{{Host: "127.0.0.1", PeersPort: ports[1], RemotingPort: ports[1], Roles: []string{"payments"}}}
+		_, err = system.place(ctx, NewLeastLoadPlacement(), peers, "payments")
+		require.ErrorIs(t, err, ErrNoPlacementCandidates)
+
+		system.clusterEnabled.Store(false)
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With node metric", func(t *testing.T) {
+		node := &NodeMetric{
+			host:         "127.0.0.1",
+			peersPort:    3222,
+			remotingPort: 3223,
+			roles:        []string{"payments"},
+			local:        true,
+			actorsCount:  4,
+			grainsCount:  2,
+			memSize:      300,
+			memAvail:     200,
+			memUsed:      100,
+		}
+
+		assert.Equal(t, "127.0.0.1", node.Host())
+		assert.Equal(t, 3222, node.PeersPort())
+		assert.Equal(t, 3223, node.RemotingPort())
+		assert.Equal(t, "127.0.0.1:3222", node.PeerAddress())
+		assert.Equal(t, []string{"payments"}, node.Roles())
+		assert.True(t, node.IsLocal())
+		assert.EqualValues(t, 4, node.ActorsCount())
+		assert.EqualValues(t, 2, node.GrainsCount())
+		assert.EqualValues(t, 300, node.MemorySize())
+		assert.EqualValues(t, 200, node.MemoryAvailable())
+		assert.EqualValues(t, 100, node.MemoryUsed())
+	})
+}
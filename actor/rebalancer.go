@@ -26,11 +26,15 @@ package actor
 
 import (
 	"context"
+	"errors"
 	"net"
+	"slices"
 	"strconv"
+	"strings"
 
 	"connectrpc.com/connect"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/tochemey/goakt/v3/goaktpb"
 	"github.com/tochemey/goakt/v3/internal/cluster"
@@ -81,19 +85,29 @@ func (r *rebalancer) Rebalance(ctx *ReceiveContext) {
 	switch msg := ctx.Message().(type) {
 	case *internalpb.Rebalance:
 		rctx := context.WithoutCancel(ctx.Context())
-		peerState := msg.GetPeerState()
 
-		peers, err := r.pid.ActorSystem().getCluster().Peers(rctx)
+		// the actors and grains handed off while the node was drained are no longer its own
+		peerState, err := r.withoutHandedOff(rctx, msg.GetPeerState())
+		if err != nil {
+			ctx.Err(err)
+			return
+		}
+
+		peers, err := r.pid.ActorSystem().placementPeers(rctx)
 		if err != nil {
 			ctx.Err(NewInternalError(err))
 			return
 		}
 
+		// the actors and grains requiring a role are only relocated to the nodes having that role
+		roleActors, roleGrains := r.extractRoleBound(peerState)
+
 		leaderShares, peersShares := r.allocateActors(len(peers)+1, peerState)
 		eg, egCtx := errgroup.WithContext(rctx)
 		logger := r.pid.Logger()
 
 		r.rebalanceActors(egCtx, eg, leaderShares, peersShares, peers)
+		r.rebalanceByRole(egCtx, eg, roleActors, roleGrains, peers)
 
 		if len(peerState.GetGrains()) > 0 {
 			leaderGrains, peersGrains := r.allocateGrains(len(peers)+1, peerState)
@@ -101,7 +115,8 @@ func (r *rebalancer) Rebalance(ctx *ReceiveContext) {
 		}
 
 		// only block when there are go routines running
-		if len(leaderShares) > 0 || len(peersShares) > 0 || len(peerState.GetGrains()) > 0 {
+		if len(leaderShares) > 0 || len(peersShares) > 0 || len(peerState.GetGrains()) > 0 ||
+			len(roleActors) > 0 || len(roleGrains) > 0 {
 			if err := eg.Wait(); err != nil {
 				logger.Errorf("cluster rebalancing failed: %v", err)
 				ctx.Err(err)
@@ -180,6 +195,10 @@ func (r *rebalancer) spawnRemoteActor(ctx context.Context, actor *internalpb.Act
 		Dependencies:        dependencies,
 		PassivationStrategy: unmarshalPassivationStrategy(actor.GetPassivationStrategy()),
 		EnableStashing:      actor.GetEnableStash(),
+		SnapshotInterval:    actor.GetSnapshotInterval(),
+		Factory:             actor.GetFactory(),
+		Args:                actor.GetArgs(),
+		Role:                actor.GetRole(),
 	}
 
 	if err := r.remoting.RemoteSpawn(ctx, remoteHost, remotingPort, spawnRequest); err != nil {
@@ -254,9 +273,224 @@ func (r *rebalancer) activateRemoteGrain(ctx context.Context, grain *internalpb.
 	return nil
 }
 
+// rebalanceByRole distributes the actors and grains requiring a role, in a round-robin fashion,
+// among the leader and the peers having that role. The actors and grains whose role no node has are not relocated.
+func (r *rebalancer) rebalanceByRole(ctx context.Context, eg *errgroup.Group, actors []*internalpb.Actor, grains []*internalpb.Grain, peers []*cluster.Peer) {
+	if len(actors) == 0 && len(grains) == 0 {
+		return
+	}
+
+	eg.Go(func() error {
+		next := make(map[string]int)
+		for _, actor := range actors {
+			if isReservedName(actor.GetAddress().GetName()) || !actor.GetRelocatable() {
+				continue
+			}
+
+			peer, local, ok := r.nodeWithRole(actor.GetRole(), peers, next)
+			if !ok {
+				r.logger.Warnf("no cluster node with role=(%s) to relocate actor=(%s)", actor.GetRole(), actor.GetAddress().GetName())
+				continue
+			}
+
+			if local {
+				if err := r.recreateLocally(ctx, actor, true); err != nil {
+					return NewSpawnError(err)
+				}
+				continue
+			}
+
+			if err := r.spawnRemoteActor(ctx, actor, peer); err != nil {
+				return err
+			}
+		}
+
+		for _, grain := range grains {
+			if isReservedName(grain.GetGrainId().GetName()) {
+				continue
+			}
+
+			peer, local, ok := r.nodeWithRole(grain.GetRole(), peers, next)
+			if !ok {
+				r.logger.Warnf("no cluster node with role=(%s) to relocate grain=(%s)", grain.GetRole(), grain.GetGrainId().GetValue())
+				continue
+			}
+
+			if local {
+				grain.Host = r.pid.ActorSystem().Host()
+				grain.Port = int32(r.pid.ActorSystem().Port())
+				if err := r.pid.ActorSystem().recreateGrain(ctx, grain); err != nil {
+					return NewSpawnError(err)
+				}
+				continue
+			}
+
+			if err := r.activateRemoteGrain(ctx, grain, peer); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// nodeWithRole picks the next node having the given role, local being true when it is the leader itself.
+// The next map keeps the round-robin position per role
+func (r *rebalancer) nodeWithRole(role string, peers []*cluster.Peer, next map[string]int) (peer *cluster.Peer, local bool, ok bool) {
+	candidates := collection.Filter(peers, func(peer *cluster.Peer) bool {
+		return peer.HasRole(role)
+	})
+
+	total := len(candidates)
+	if r.pid.ActorSystem().hasRole(role) {
+		total++
+	}
+
+	if total == 0 {
+		return nil, false, false
+	}
+
+	index := next[role] % total
+	next[role]++
+
+	if index == len(candidates) {
+		return nil, true, true
+	}
+	return candidates[index], false, true
+}
+
+// extractRoleBound removes the actors and grains requiring a role from the given peer state and returns them.
+// Singleton actors are left in the peer state since their recreation already accounts for their role
+func (r *rebalancer) extractRoleBound(peerState *internalpb.PeerState) (actors []*internalpb.Actor, grains []*internalpb.Grain) {
+	for name, actor := range peerState.GetActors() {
+		if actor.GetRole() != "" && !actor.GetIsSingleton() {
+			actors = append(actors, actor)
+			delete(peerState.Actors, name)
+		}
+	}
+
+	for id, grain := range peerState.GetGrains() {
+		if grain.GetRole() != "" {
+			grains = append(grains, grain)
+			delete(peerState.Grains, id)
+		}
+	}
+
+	// keep the relocation order deterministic
+	slices.SortFunc(actors, func(a, b *internalpb.Actor) int {
+		return strings.Compare(a.GetAddress().GetName(), b.GetAddress().GetName())
+	})
+	slices.SortFunc(grains, func(a, b *internalpb.Grain) int {
+		return strings.Compare(a.GetGrainId().GetValue(), b.GetGrainId().GetValue())
+	})
+	return actors, grains
+}
+
+// withoutHandedOff returns the given peer state without the actors and grains that have already been
+// handed off to another node, which is the case when the node has been drained before leaving the cluster.
+// The records held by the partitions of the left node are lost with it until the nodes hosting the actors
+// and grains register them again, hence a missing record is checked against the actors and grains known
+// to run on this node and on the other peers.
+func (r *rebalancer) withoutHandedOff(ctx context.Context, peerState *internalpb.PeerState) (*internalpb.PeerState, error) {
+	system := r.pid.ActorSystem()
+	cl := system.getCluster()
+	state := proto.Clone(peerState).(*internalpb.PeerState)
+
+	peers, err := system.placementPeers(ctx)
+	if err != nil {
+		return nil, NewInternalError(err)
+	}
+
+	leftAddress := net.JoinHostPort(peerState.GetHost(), strconv.Itoa(int(peerState.GetPeersPort())))
+	peerStates := make([]*internalpb.PeerState, 0, len(peers))
+	for _, peer := range peers {
+		if peer.PeerAddress() == leftAddress {
+			continue
+		}
+
+		// the cached peer states are only refreshed periodically
+		hosted, err := cl.GetState(ctx, peer.PeerAddress())
+		if err != nil {
+			if hosted, err = system.getPeerStateFromStore(peer.PeerAddress()); err != nil {
+				continue
+			}
+		}
+		peerStates = append(peerStates, hosted)
+	}
+
+	for name, actor := range state.GetActors() {
+		current, err := cl.GetActor(ctx, name)
+		if err != nil {
+			if !errors.Is(err, cluster.ErrActorNotFound) {
+				return nil, NewInternalError(err)
+			}
+
+			if r.actorHostedElsewhere(name, peerStates) {
+				delete(state.Actors, name)
+			}
+			continue
+		}
+
+		if current.GetAddress().GetHost() != actor.GetAddress().GetHost() ||
+			current.GetAddress().GetPort() != actor.GetAddress().GetPort() {
+			delete(state.Actors, name)
+		}
+	}
+
+	for id, grain := range state.GetGrains() {
+		current, err := cl.GetGrain(ctx, id)
+		if err != nil {
+			if !errors.Is(err, cluster.ErrGrainNotFound) {
+				return nil, NewInternalError(err)
+			}
+
+			if r.grainHostedElsewhere(id, peerStates) {
+				delete(state.Grains, id)
+			}
+			continue
+		}
+
+		if current.GetHost() != grain.GetHost() || current.GetPort() != grain.GetPort() {
+			delete(state.Grains, id)
+		}
+	}
+
+	return state, nil
+}
+
+// actorHostedElsewhere returns true when the given actor runs on this node or on one of the given peers
+func (r *rebalancer) actorHostedElsewhere(name string, peerStates []*internalpb.PeerState) bool {
+	if pid, err := r.pid.ActorSystem().LocalActor(name); err == nil && pid.IsRunning() {
+		return true
+	}
+
+	for _, peerState := range peerStates {
+		if _, ok := peerState.GetActors()[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// grainHostedElsewhere returns true when the given grain is activated on this node or on one of the given peers
+func (r *rebalancer) grainHostedElsewhere(id string, peerStates []*internalpb.PeerState) bool {
+	if identity, err := toIdentity(id); err == nil {
+		if pid, ok := r.pid.ActorSystem().getGrains().Get(*identity); ok && pid.isActive() {
+			return true
+		}
+	}
+
+	for _, peerState := range peerStates {
+		if _, ok := peerState.GetGrains()[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // PostStop is executed when the actor is shutting down.
 func (r *rebalancer) PostStop(*Context) error {
-	r.remoting.Close()
+	// the remoting is shared with the actor system, only free its idle connections
+	r.remoting.HTTPClient().CloseIdleConnections()
 	r.logger.Infof("%s stopped successfully", r.pid.Name())
 	return nil
 }
@@ -308,14 +542,19 @@ func (r *rebalancer) recreateLocally(ctx context.Context, props *internalpb.Acto
 		return NewInternalError(err)
 	}
 
-	actor, err := r.pid.ActorSystem().getReflection().NewActor(props.GetType())
+	actor, err := r.newActor(ctx, props)
 	if err != nil {
 		return err
 	}
 
 	if enforceSingleton && props.GetIsSingleton() {
+		singletonOpts := []SpawnOption{WithRole(props.GetRole())}
+		if props.GetFactory() != "" {
+			singletonOpts = append(singletonOpts, withFactory(props.GetFactory(), props.GetArgs()))
+		}
+
 		// spawn the singleton actor
-		return r.pid.ActorSystem().SpawnSingleton(ctx, props.GetAddress().GetName(), actor)
+		return r.pid.ActorSystem().SpawnSingleton(ctx, props.GetAddress().GetName(), actor, singletonOpts...)
 	}
 
 	if !props.GetRelocatable() {
@@ -324,12 +563,21 @@ func (r *rebalancer) recreateLocally(ctx context.Context, props *internalpb.Acto
 
 	spawnOpts := []SpawnOption{
 		WithPassivationStrategy(unmarshalPassivationStrategy(props.GetPassivationStrategy())),
+		WithRole(props.GetRole()),
 	}
 
 	if props.GetEnableStash() {
 		spawnOpts = append(spawnOpts, WithStashing())
 	}
 
+	if props.GetSnapshotInterval() > 0 {
+		spawnOpts = append(spawnOpts, WithSnapshotInterval(props.GetSnapshotInterval()))
+	}
+
+	if props.GetFactory() != "" {
+		spawnOpts = append(spawnOpts, withFactory(props.GetFactory(), props.GetArgs()))
+	}
+
 	if len(props.GetDependencies()) > 0 {
 		dependencies, err := r.pid.ActorSystem().getReflection().NewDependencies(props.GetDependencies()...)
 		if err != nil {
@@ -342,6 +590,16 @@ func (r *rebalancer) recreateLocally(ctx context.Context, props *internalpb.Acto
 	return err
 }
 
+// newActor creates the given relocated actor, with the factory it was created with when set,
+// otherwise from its type
+func (r *rebalancer) newActor(ctx context.Context, props *internalpb.Actor) (Actor, error) {
+	reflection := r.pid.ActorSystem().getReflection()
+	if props.GetFactory() != "" {
+		return reflection.NewActorFromFactory(ctx, props.GetFactory(), props.GetArgs())
+	}
+	return reflection.NewActor(props.GetType())
+}
+
 // allocateGrains distributes grains among the leader and peers for rebalancing.
 //
 // It returns two values:
@@ -36,6 +36,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/persistence"
+	"github.com/tochemey/goakt/v3/remote"
 	"github.com/tochemey/goakt/v3/test/data/testpb"
 )
 
@@ -111,6 +113,82 @@ func TestRebalancing(t *testing.T) {
 	srv.Shutdown()
 }
 
+func TestRebalancingWithRoles(t *testing.T) {
+	// create a context
+	ctx := context.TODO()
+	// start the NATS server
+	srv := startNatsServer(t)
+
+	// create and start a system cluster
+	node1, sd1 := testCluster(t, srv.Addr().String())
+	require.NotNil(t, node1)
+	require.NotNil(t, sd1)
+
+	// create and start a system cluster with the payments role
+	node2, sd2 := testCluster(t, srv.Addr().String(), withTestRoles("payments"))
+	require.NotNil(t, node2)
+	require.NotNil(t, sd2)
+
+	// create and start a system cluster with the payments role
+	node3, sd3 := testCluster(t, srv.Addr().String(), withTestRoles("payments"))
+	require.NotNil(t, node3)
+	require.NotNil(t, sd3)
+
+	for j := 1; j <= 2; j++ {
+		actorName := fmt.Sprintf("Node2-Actor-%d", j)
+		pid, err := node2.Spawn(ctx, actorName, NewMockActor(), WithRole("payments"))
+		require.NoError(t, err)
+		require.NotNil(t, pid)
+	}
+
+	grainFactory := func(context.Context) (Grain, error) {
+		return NewMockGrain(), nil
+	}
+
+	identity, err := node2.GrainIdentity(ctx, "Node2-Grain", grainFactory, WithGrainRole("payments"))
+	require.NoError(t, err)
+
+	// the grain is activated on a node having the role
+	remoteIdentity, err := node1.GrainIdentity(ctx, "Node1-Grain", grainFactory, WithGrainRole("payments"))
+	require.NoError(t, err)
+
+	// the singleton is created on the oldest node having the role
+	require.NoError(t, node1.SpawnSingleton(ctx, "singleton", NewMockGrainActor(), WithRole("payments")))
+
+	pause.For(time.Second)
+
+	grain, err := node1.getCluster().GetGrain(ctx, remoteIdentity.String())
+	require.NoError(t, err)
+	assert.NotEqual(t, node1.Port(), int(grain.GetPort()))
+
+	addr, err := node1.RemoteActor(ctx, "singleton")
+	require.NoError(t, err)
+	assert.Equal(t, node2.Port(), addr.Port())
+
+	// take down node2
+	require.NoError(t, node2.Stop(ctx))
+	require.NoError(t, sd2.Close())
+
+	// the actors, the grain and the singleton are only relocated to node3
+	require.Eventually(t, func() bool {
+		for _, actorName := range []string{"Node2-Actor-1", "Node2-Actor-2", "singleton"} {
+			addr, err := node1.RemoteActor(ctx, actorName)
+			if err != nil || addr.Port() != node3.Port() {
+				return false
+			}
+		}
+
+		grain, err := node1.getCluster().GetGrain(ctx, identity.String())
+		return err == nil && int(grain.GetPort()) == node3.Port()
+	}, time.Minute, time.Second)
+
+	assert.NoError(t, node1.Stop(ctx))
+	assert.NoError(t, node3.Stop(ctx))
+	assert.NoError(t, sd1.Close())
+	assert.NoError(t, sd3.Close())
+	srv.Shutdown()
+}
+
 func TestRebalancingWithTLSEnabled(t *testing.T) {
 	t.SkipNow()
 	// create a context
@@ -225,7 +303,7 @@ func TestRebalancingWithSingletonActor(t *testing.T) {
 
 	pause.For(2 * time.Minute)
 
-	_, _, err = node2.ActorOf(ctx, "actorName")
+	_, err = node2.ActorOf(ctx, "actorName")
 	require.NoError(t, err)
 
 	assert.NoError(t, node2.Stop(ctx))
@@ -552,6 +630,192 @@ func TestRebalancingWithDependency(t *testing.T) {
 	srv.Shutdown()
 }
 
+func TestRebalancingWithActorFactory(t *testing.T) {
+	// create a context
+	ctx := context.TODO()
+	// start the NATS server
+	srv := startNatsServer(t)
+
+	// create and start a system cluster
+	node1, sd1 := testCluster(t, srv.Addr().String())
+	require.NotNil(t, node1)
+	require.NotNil(t, sd1)
+
+	// create and start a system cluster
+	node2, sd2 := testCluster(t, srv.Addr().String())
+	require.NotNil(t, node2)
+	require.NotNil(t, sd2)
+
+	// create and start a system cluster
+	node3, sd3 := testCluster(t, srv.Addr().String())
+	require.NotNil(t, node3)
+	require.NotNil(t, sd3)
+
+	// register the actor factory on every node
+	for _, node := range []ActorSystem{node1, node2, node3} {
+		require.NoError(t, node.RegisterFactory(ctx, "factory", mockActorFactory))
+	}
+
+	sender, err := node1.Spawn(ctx, "sender", NewMockActor())
+	require.NoError(t, err)
+	require.NotNil(t, sender)
+
+	// let us create 4 actors on node2 with the factory
+	for j := 1; j <= 4; j++ {
+		actorName := fmt.Sprintf("Node2-Actor-%d", j)
+		pid, err := node2.SpawnFromFactory(ctx, actorName, "factory", &testpb.TestLog{Text: actorName})
+		require.NoError(t, err)
+		require.NotNil(t, pid)
+	}
+
+	pause.For(time.Second)
+
+	// take down node2
+	require.NoError(t, node2.Stop(ctx))
+	require.NoError(t, sd2.Close())
+
+	// Wait for cluster rebalancing
+	pause.For(time.Minute)
+
+	// the relocated actors are recreated with their factory arguments
+	for j := 1; j <= 4; j++ {
+		actorName := fmt.Sprintf("Node2-Actor-%d", j)
+		reply, err := sender.SendSync(ctx, actorName, new(testpb.TestReply), time.Minute)
+		require.NoError(t, err)
+		require.IsType(t, new(testpb.Reply), reply)
+		assert.Equal(t, actorName, reply.(*testpb.Reply).GetContent())
+	}
+
+	assert.NoError(t, node1.Stop(ctx))
+	assert.NoError(t, node3.Stop(ctx))
+	assert.NoError(t, sd1.Close())
+	assert.NoError(t, sd3.Close())
+	srv.Shutdown()
+}
+
+func TestRebalancingWithSingletonActorFactory(t *testing.T) {
+	// create a context
+	ctx := context.TODO()
+	// start the NATS server
+	srv := startNatsServer(t)
+
+	// create and start a system cluster
+	node1, sd1 := testCluster(t, srv.Addr().String())
+	require.NotNil(t, node1)
+	require.NotNil(t, sd1)
+
+	// create and start a system cluster
+	node2, sd2 := testCluster(t, srv.Addr().String())
+	require.NotNil(t, node2)
+	require.NotNil(t, sd2)
+
+	// create and start a system cluster
+	node3, sd3 := testCluster(t, srv.Addr().String())
+	require.NotNil(t, node3)
+	require.NotNil(t, sd3)
+
+	// register the actor factory on every node
+	for _, node := range []ActorSystem{node1, node2, node3} {
+		require.NoError(t, node.RegisterFactory(ctx, "factory", mockActorFactory))
+	}
+
+	pause.For(time.Second)
+
+	// the singleton actor is requested on node3 and created on node1, the oldest node
+	actorName := "singleton"
+	remoting := NewRemoting()
+	err := remoting.RemoteSpawn(ctx, node3.Host(), node3.Port(), &remote.SpawnRequest{
+		Name:      actorName,
+		Factory:   "factory",
+		Args:      &testpb.TestLog{Text: actorName},
+		Singleton: true,
+	})
+	require.NoError(t, err)
+
+	pid, err := node1.LocalActor(actorName)
+	require.NoError(t, err)
+	assert.Equal(t, "factory", pid.factory)
+
+	pause.For(time.Second)
+
+	// take down node1 since it is the first node created in the cluster
+	require.NoError(t, node1.Stop(ctx))
+	require.NoError(t, sd1.Close())
+
+	pause.For(2 * time.Minute)
+
+	sender, err := node2.Spawn(ctx, "sender", NewMockActor())
+	require.NoError(t, err)
+	require.NotNil(t, sender)
+
+	// the singleton actor is recreated with its factory arguments
+	reply, err := sender.SendSync(ctx, actorName, new(testpb.TestReply), time.Minute)
+	require.NoError(t, err)
+	require.IsType(t, new(testpb.Reply), reply)
+	assert.Equal(t, actorName, reply.(*testpb.Reply).GetContent())
+
+	remoting.Close()
+	assert.NoError(t, node2.Stop(ctx))
+	assert.NoError(t, node3.Stop(ctx))
+	assert.NoError(t, sd2.Close())
+	assert.NoError(t, sd3.Close())
+	srv.Shutdown()
+}
+
+func TestRebalancingWithPersistentActor(t *testing.T) {
+	// create a context
+	ctx := context.TODO()
+	// start the NATS server
+	srv := startNatsServer(t)
+
+	// the journal store is shared by the nodes
+	journal := persistence.NewMemoryJournalStore()
+
+	// create and start a system cluster
+	node1, sd1 := testCluster(t, srv.Addr().String(), withTestJournalStore(journal))
+	require.NotNil(t, node1)
+	require.NotNil(t, sd1)
+
+	// create and start a system cluster
+	node2, sd2 := testCluster(t, srv.Addr().String(), withTestJournalStore(journal))
+	require.NotNil(t, node2)
+	require.NotNil(t, sd2)
+
+	actorName := "account"
+	pid, err := node2.Spawn(ctx, actorName, &MockPersistentActor{})
+	require.NoError(t, err)
+	require.NotNil(t, pid)
+
+	for range 3 {
+		_, err := Ask(ctx, pid, &testpb.CreditAccount{Balance: 100}, time.Second)
+		require.NoError(t, err)
+	}
+
+	pause.For(time.Second)
+
+	// take down node2
+	require.NoError(t, node2.Stop(ctx))
+	require.NoError(t, sd2.Close())
+
+	// Wait for cluster rebalancing
+	pause.For(time.Minute)
+
+	// the actor is relocated to node1 with its state rebuilt from the journal
+	pid, err = node1.LocalActor(actorName)
+	require.NoError(t, err)
+	require.NotNil(t, pid)
+
+	reply, err := Ask(ctx, pid, new(testpb.GetAccount), time.Second)
+	require.NoError(t, err)
+	account, ok := reply.(*testpb.Account)
+	require.True(t, ok)
+	require.EqualValues(t, 300, account.GetAccountBalance())
+
+	assert.NoError(t, node1.Stop(ctx))
+	assert.NoError(t, sd1.Close())
+	srv.Shutdown()
+}
+
 func TestIssue781(t *testing.T) {
 	// reference: https://github.com/Tochemey/goakt/issues/781
 	// create a context
@@ -29,7 +29,6 @@ import (
 	"time"
 
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/tochemey/goakt/v3/address"
 	"github.com/tochemey/goakt/v3/extension"
@@ -59,12 +58,14 @@ import (
 //	}
 type ReceiveContext struct {
 	ctx          context.Context
-	message      proto.Message
+	message      any
 	sender       *PID
 	remoteSender *address.Address
-	response     chan proto.Message
+	response     chan any
 	self         *PID
 	err          error
+	// states whether the sender awaits a response, as with Ask
+	awaitingReply bool
 }
 
 // Self returns the receiver PID of the message
@@ -78,7 +79,7 @@ func (rctx *ReceiveContext) Err(err error) {
 }
 
 // Response sets the message response
-func (rctx *ReceiveContext) Response(resp proto.Message) {
+func (rctx *ReceiveContext) Response(resp any) {
 	rctx.response <- resp
 	close(rctx.response)
 }
@@ -88,6 +89,18 @@ func (rctx *ReceiveContext) Context() context.Context {
 	return rctx.ctx
 }
 
+// Metadata returns the metadata sent along with the message.
+// Changes made to the returned metadata are carried by the messages sent from this context,
+// e.g. with Tell, Ask, Forward or RemoteForward.
+func (rctx *ReceiveContext) Metadata() *Metadata {
+	md := MetadataFromContext(rctx.ctx)
+	if md == nil {
+		md = NewMetadata()
+		rctx.ctx = context.WithValue(rctx.ctx, metadataKey{}, md)
+	}
+	return md
+}
+
 // Sender of the message
 func (rctx *ReceiveContext) Sender() *PID {
 	return rctx.sender
@@ -95,12 +108,15 @@ func (rctx *ReceiveContext) Sender() *PID {
 
 // RemoteSender defines the remote sender of the message if it is a remote message
 // This is set to NoSender when the message is not a remote message
+//
+// When mutual TLS is enabled, the identity stated by the verified client certificate of the sending node
+// is available in the message Metadata under the PeerCommonNameKey and PeerSANKey keys.
 func (rctx *ReceiveContext) RemoteSender() *address.Address {
 	return rctx.remoteSender
 }
 
 // Message is the actual message sent
-func (rctx *ReceiveContext) Message() proto.Message {
+func (rctx *ReceiveContext) Message() any {
 	return rctx.message
 }
 
@@ -154,7 +170,7 @@ func (rctx *ReceiveContext) UnstashAll() {
 }
 
 // Tell sends an asynchronous message to another PID
-func (rctx *ReceiveContext) Tell(to *PID, message proto.Message) {
+func (rctx *ReceiveContext) Tell(to *PID, message any) {
 	recipient := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	if err := recipient.Tell(ctx, to, message); err != nil {
@@ -166,7 +182,7 @@ func (rctx *ReceiveContext) Tell(to *PID, message proto.Message) {
 // The messages will be processed one after the other in the order they are sent
 // This is a design choice to follow the simple principle of one message at a time processing by actors.
 // When BatchTell encounter a single message it will fall back to a Tell call.
-func (rctx *ReceiveContext) BatchTell(to *PID, messages ...proto.Message) {
+func (rctx *ReceiveContext) BatchTell(to *PID, messages ...any) {
 	recipient := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	if err := recipient.BatchTell(ctx, to, messages...); err != nil {
@@ -177,7 +193,7 @@ func (rctx *ReceiveContext) BatchTell(to *PID, messages ...proto.Message) {
 // Ask sends a synchronous message to another actor and expect a response. This method is good when interacting with a child actor.
 // Ask has a timeout which can cause the sender to set the context error. When ask times out, the receiving actor does not know and may still process the message.
 // It is recommended to set a good timeout to quickly receive response and try to avoid false positives
-func (rctx *ReceiveContext) Ask(to *PID, message proto.Message, timeout time.Duration) (response proto.Message) {
+func (rctx *ReceiveContext) Ask(to *PID, message any, timeout time.Duration) (response any) {
 	self := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	reply, err := self.Ask(ctx, to, message, timeout)
@@ -189,7 +205,7 @@ func (rctx *ReceiveContext) Ask(to *PID, message proto.Message, timeout time.Dur
 
 // SendAsync sends an asynchronous message to a given actor.
 // The location of the given actor is transparent to the caller.
-func (rctx *ReceiveContext) SendAsync(actorName string, message proto.Message) {
+func (rctx *ReceiveContext) SendAsync(actorName string, message any) {
 	self := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	if err := self.SendAsync(ctx, actorName, message); err != nil {
@@ -200,7 +216,7 @@ func (rctx *ReceiveContext) SendAsync(actorName string, message proto.Message) {
 // SendSync sends a synchronous message to another actor and expect a response.
 // The location of the given actor is transparent to the caller.
 // This block until a response is received or timed out.
-func (rctx *ReceiveContext) SendSync(actorName string, message proto.Message, timeout time.Duration) (response proto.Message) {
+func (rctx *ReceiveContext) SendSync(actorName string, message any, timeout time.Duration) (response any) {
 	self := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	reply, err := self.SendSync(ctx, actorName, message, timeout)
@@ -213,7 +229,7 @@ func (rctx *ReceiveContext) SendSync(actorName string, message proto.Message, ti
 // BatchAsk sends a synchronous bunch of messages to the given PID and expect responses in the same order as the messages.
 // The messages will be processed one after the other in the order they are sent
 // This is a design choice to follow the simple principle of one message at a time processing by actors.
-func (rctx *ReceiveContext) BatchAsk(to *PID, messages []proto.Message, timeout time.Duration) (responses chan proto.Message) {
+func (rctx *ReceiveContext) BatchAsk(to *PID, messages []any, timeout time.Duration) (responses chan any) {
 	recipient := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	reply, err := recipient.BatchAsk(ctx, to, messages, timeout)
@@ -224,7 +240,7 @@ func (rctx *ReceiveContext) BatchAsk(to *PID, messages []proto.Message, timeout
 }
 
 // RemoteTell sends a message to an actor remotely without expecting any reply
-func (rctx *ReceiveContext) RemoteTell(to *address.Address, message proto.Message) {
+func (rctx *ReceiveContext) RemoteTell(to *address.Address, message any) {
 	recipient := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	if err := recipient.RemoteTell(ctx, to, message); err != nil {
@@ -234,7 +250,7 @@ func (rctx *ReceiveContext) RemoteTell(to *address.Address, message proto.Messag
 
 // RemoteAsk is used to send a message to an actor remotely and expect a response
 // immediately.
-func (rctx *ReceiveContext) RemoteAsk(to *address.Address, message proto.Message, timeout time.Duration) (response *anypb.Any) {
+func (rctx *ReceiveContext) RemoteAsk(to *address.Address, message any, timeout time.Duration) (response any) {
 	recipient := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	reply, err := recipient.RemoteAsk(ctx, to, message, timeout)
@@ -246,7 +262,7 @@ func (rctx *ReceiveContext) RemoteAsk(to *address.Address, message proto.Message
 
 // RemoteBatchTell sends a batch of messages to a remote actor in a way fire-and-forget manner
 // Messages are processed one after the other in the order they are sent.
-func (rctx *ReceiveContext) RemoteBatchTell(to *address.Address, messages []proto.Message) {
+func (rctx *ReceiveContext) RemoteBatchTell(to *address.Address, messages []any) {
 	recipient := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	if err := recipient.RemoteBatchTell(ctx, to, messages); err != nil {
@@ -257,7 +273,7 @@ func (rctx *ReceiveContext) RemoteBatchTell(to *address.Address, messages []prot
 // RemoteBatchAsk sends a synchronous bunch of messages to a remote actor and expect responses in the same order as the messages.
 // Messages are processed one after the other in the order they are sent.
 // This can hinder performance if it is not properly used.
-func (rctx *ReceiveContext) RemoteBatchAsk(to *address.Address, messages []proto.Message, timeout time.Duration) (responses []*anypb.Any) {
+func (rctx *ReceiveContext) RemoteBatchAsk(to *address.Address, messages []any, timeout time.Duration) (responses []any) {
 	recipient := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	replies, err := recipient.RemoteBatchAsk(ctx, to, messages, timeout)
@@ -405,7 +421,7 @@ func (rctx *ReceiveContext) RemoteReSpawn(host string, port int, name string) {
 // The successful result of the task will be put onto the provided actor mailbox.
 // This is useful when interacting with external services.
 // It’s common that you would like to use the value of the response in the actor when the long-running task is completed
-func (rctx *ReceiveContext) PipeTo(to *PID, task func() (proto.Message, error)) {
+func (rctx *ReceiveContext) PipeTo(to *PID, task func() (any, error)) {
 	recipient := rctx.self
 	ctx := context.WithoutCancel(rctx.ctx)
 	if err := recipient.PipeTo(ctx, to, task); err != nil {
@@ -429,6 +445,23 @@ func (rctx *ReceiveContext) UnWatch(cid *PID) {
 	rctx.self.UnWatch(cid)
 }
 
+// RemoteWatch watches an actor located on a remote node for a Terminated message
+// when the watched actor stops or its node leaves the cluster
+func (rctx *ReceiveContext) RemoteWatch(to *address.Address) {
+	ctx := context.WithoutCancel(rctx.ctx)
+	if err := rctx.self.RemoteWatch(ctx, to); err != nil {
+		rctx.Err(err)
+	}
+}
+
+// RemoteUnWatch stops watching an actor located on a remote node
+func (rctx *ReceiveContext) RemoteUnWatch(to *address.Address) {
+	ctx := context.WithoutCancel(rctx.ctx)
+	if err := rctx.self.RemoteUnWatch(ctx, to); err != nil {
+		rctx.Err(err)
+	}
+}
+
 // ActorSystem returns the actor system
 func (rctx *ReceiveContext) ActorSystem() ActorSystem {
 	return rctx.self.ActorSystem()
@@ -498,36 +531,106 @@ func (rctx *ReceiveContext) ReinstateNamed(actorName string) {
 	}
 }
 
+// Persist writes the given event to the journal store and applies it to the actor state
+// through the PersistentActor HandleEvent method.
+//
+// This method must only be called by a PersistentActor while handling a command. When the event
+// cannot be persisted, the error is reported to the supervisor and the actor state is left untouched.
+func (rctx *ReceiveContext) Persist(event proto.Message) {
+	rctx.PersistAll(event)
+}
+
+// PersistAll atomically writes the given events to the journal store and applies them, in order,
+// to the actor state through the PersistentActor HandleEvent method.
+//
+// This method must only be called by a PersistentActor while handling a command. When the events
+// cannot be persisted, the error is reported to the supervisor and the actor state is left untouched.
+// When the events are persisted but one of them cannot be applied, the error is reported to the supervisor
+// and the actor state is rebuilt from the journal on restart.
+func (rctx *ReceiveContext) PersistAll(events ...proto.Message) {
+	recipient := rctx.self
+	ctx := context.WithoutCancel(rctx.ctx)
+	if err := recipient.persist(ctx, events...); err != nil {
+		rctx.Err(err)
+	}
+}
+
+// LastSequenceNumber returns the sequence number of the last event persisted by a PersistentActor.
+// It returns zero for non-persistent actors or when no event has been persisted yet.
+func (rctx *ReceiveContext) LastSequenceNumber() uint64 {
+	return rctx.self.lastSequenceNumber()
+}
+
+// LoadState returns the latest state of the actor from the durable state store
+// configured on the actor system using WithDurableStateStore.
+// It returns nil when no state has been saved yet.
+//
+// When the state cannot be loaded, the error is reported to the supervisor.
+func (rctx *ReceiveContext) LoadState() proto.Message {
+	ctx := context.WithoutCancel(rctx.ctx)
+	state, err := rctx.self.durableState.load(ctx)
+	if err != nil {
+		rctx.Err(err)
+		return nil
+	}
+	return state
+}
+
+// SaveState writes the given state of the actor to the durable state store
+// configured on the actor system using WithDurableStateStore.
+//
+// When the state cannot be saved, for instance when another instance of the actor has saved its
+// state in the meantime, the error is reported to the supervisor.
+func (rctx *ReceiveContext) SaveState(state proto.Message) {
+	ctx := context.WithoutCancel(rctx.ctx)
+	if err := rctx.self.durableState.save(ctx, state); err != nil {
+		rctx.Err(err)
+	}
+}
+
+// DeleteState removes the state of the actor from the durable state store
+// configured on the actor system using WithDurableStateStore.
+//
+// When the state cannot be deleted, the error is reported to the supervisor.
+func (rctx *ReceiveContext) DeleteState() {
+	ctx := context.WithoutCancel(rctx.ctx)
+	if err := rctx.self.durableState.remove(ctx); err != nil {
+		rctx.Err(err)
+	}
+}
+
 // getError returns any error during message processing
 func (rctx *ReceiveContext) getError() error {
 	return rctx.err
 }
 
 // newReceiveContext creates an instance of ReceiveContext
-func newReceiveContext(ctx context.Context, from, to *PID, message proto.Message) *ReceiveContext {
+func newReceiveContext(ctx context.Context, from, to *PID, message any) *ReceiveContext {
 	// create a message receiveContext
 	return &ReceiveContext{
-		ctx:      ctx,
+		ctx:      copyMetadata(ctx),
 		message:  message,
 		sender:   from,
-		response: make(chan proto.Message, 1),
+		response: make(chan any, 1),
 		self:     to,
 	}
 }
 
 // build sets the necessary fields of ReceiveContext
-func (rctx *ReceiveContext) build(ctx context.Context, from, to *PID, message proto.Message, async bool) *ReceiveContext {
+func (rctx *ReceiveContext) build(ctx context.Context, from, to *PID, message any, async bool) *ReceiveContext {
 	rctx.sender = from
 	rctx.self = to
 	rctx.message = message
+	rctx.awaitingReply = !async
 
+	ctx = copyMetadata(ctx)
 	if async {
 		rctx.ctx = context.WithoutCancel(ctx)
 		return rctx
 	}
 
 	rctx.ctx = ctx
-	rctx.response = make(chan proto.Message, 1)
+	rctx.response = make(chan any, 1)
 	return rctx
 }
 
@@ -69,35 +69,35 @@ func TestReceiveContext(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, success)
 		expected = &testpb.TestLoginSuccess{}
-		require.True(t, proto.Equal(expected, success))
+		require.True(t, proto.Equal(expected, success.(proto.Message)))
 
 		// ask for readiness
 		ready, err := Ask(ctx, pid, new(testpb.TestReadiness), replyTimeout)
 		require.NoError(t, err)
 		require.NotNil(t, ready)
 		expected = &testpb.TestReady{}
-		require.True(t, proto.Equal(expected, ready))
+		require.True(t, proto.Equal(expected, ready.(proto.Message)))
 
 		// send a message to create account
 		created, err := Ask(ctx, pid, new(testpb.CreateAccount), replyTimeout)
 		require.NoError(t, err)
 		require.NotNil(t, created)
 		expected = &testpb.AccountCreated{}
-		require.True(t, proto.Equal(expected, created))
+		require.True(t, proto.Equal(expected, created.(proto.Message)))
 
 		// credit account
 		credited, err := Ask(ctx, pid, new(testpb.CreditAccount), replyTimeout)
 		require.NoError(t, err)
 		require.NotNil(t, credited)
 		expected = &testpb.AccountCredited{}
-		require.True(t, proto.Equal(expected, credited))
+		require.True(t, proto.Equal(expected, credited.(proto.Message)))
 
 		// debit account
 		debited, err := Ask(ctx, pid, new(testpb.DebitAccount), replyTimeout)
 		require.NoError(t, err)
 		require.NotNil(t, debited)
 		expected = &testpb.AccountDebited{}
-		require.True(t, proto.Equal(expected, debited))
+		require.True(t, proto.Equal(expected, debited.(proto.Message)))
 
 		// send bye
 		err = Tell(ctx, pid, new(testpb.TestBye))
@@ -223,7 +223,7 @@ func TestReceiveContext(t *testing.T) {
 		reply := context.Ask(pid2, new(testpb.TestReply), time.Minute)
 		require.NotNil(t, reply)
 		expected := new(testpb.Reply)
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 
 		pause.For(time.Second)
 		assert.NoError(t, actorSystem.Stop(ctx))
@@ -319,7 +319,7 @@ func TestReceiveContext(t *testing.T) {
 		reply := context.RemoteAsk(address.From(addr1), new(testpb.TestReply), time.Minute)
 		// perform some assertions
 		require.NotNil(t, reply)
-		require.True(t, reply.MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), reply)
 
 		pause.For(time.Second)
 
@@ -1545,12 +1545,12 @@ func TestReceiveContext(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, pid2)
 
-		replies := context.BatchAsk(pid2, []proto.Message{new(testpb.TestReply), new(testpb.TestReply)}, time.Minute)
+		replies := context.BatchAsk(pid2, []any{new(testpb.TestReply), new(testpb.TestReply)}, time.Minute)
 		require.NotNil(t, replies)
 		require.Len(t, replies, 2)
 		for reply := range replies {
 			expected := new(testpb.Reply)
-			assert.True(t, proto.Equal(expected, reply))
+			assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 		}
 
 		pause.For(time.Second)
@@ -1596,7 +1596,7 @@ func TestReceiveContext(t *testing.T) {
 		pause.For(time.Second)
 		assert.NoError(t, pid2.Shutdown(ctx))
 
-		context.BatchAsk(pid2, []proto.Message{new(testpb.TestReply), new(testpb.TestReply)}, time.Minute)
+		context.BatchAsk(pid2, []any{new(testpb.TestReply), new(testpb.TestReply)}, time.Minute)
 		require.Error(t, context.getError())
 
 		pause.For(time.Second)
@@ -1643,7 +1643,7 @@ func TestReceiveContext(t *testing.T) {
 		// get the address of the exchanger actor one
 		testerAddr := context.RemoteLookup(host, remotingPort, tester)
 		// send the message to t exchanger actor one using remote messaging
-		messages := []proto.Message{new(testpb.TestSend), new(testpb.TestSend), new(testpb.TestSend)}
+		messages := []any{new(testpb.TestSend), new(testpb.TestSend), new(testpb.TestSend)}
 		context.RemoteBatchTell(address.From(testerAddr), messages)
 		require.NoError(t, context.getError())
 		// wait for processing to complete on the actor side
@@ -1699,7 +1699,7 @@ func TestReceiveContext(t *testing.T) {
 		// get the address of the exchanger actor one
 		testerAddr := context.RemoteLookup(host, remotingPort, tester)
 		// send the message to t exchanger actor one using remote messaging
-		messages := []proto.Message{new(testpb.TestReply), new(testpb.TestReply), new(testpb.TestReply)}
+		messages := []any{new(testpb.TestReply), new(testpb.TestReply), new(testpb.TestReply)}
 		replies := context.RemoteBatchAsk(address.From(testerAddr), messages, time.Minute)
 		require.NoError(t, context.getError())
 		require.Len(t, replies, 3)
@@ -1753,7 +1753,7 @@ func TestReceiveContext(t *testing.T) {
 		// get the address of the exchanger actor one
 		testerAddr := context.RemoteLookup(host, remotingPort, tester)
 		// send the message to t exchanger actor one using remote messaging
-		messages := []proto.Message{new(testpb.TestReply), new(testpb.TestReply), new(testpb.TestReply)}
+		messages := []any{new(testpb.TestReply), new(testpb.TestReply), new(testpb.TestReply)}
 		replies := context.RemoteBatchAsk(address.From(testerAddr), messages, time.Minute)
 		err = context.getError()
 		require.Error(t, err)
@@ -1816,7 +1816,7 @@ func TestReceiveContext(t *testing.T) {
 				Name: actorName2,
 				Id:   "",
 			},
-		), []proto.Message{new(testpb.TestRemoteSend)})
+		), []any{new(testpb.TestRemoteSend)})
 		require.Error(t, context.getError())
 		pause.For(time.Second)
 
@@ -1870,7 +1870,7 @@ func TestReceiveContext(t *testing.T) {
 		testerRef.remoting = nil
 
 		// send the message to t exchanger actor one using remote messaging
-		messages := []proto.Message{new(testpb.TestSend), new(testpb.TestSend), new(testpb.TestSend)}
+		messages := []any{new(testpb.TestSend), new(testpb.TestSend), new(testpb.TestSend)}
 		context.RemoteBatchTell(address.From(testerAddr), messages)
 		err = context.getError()
 		require.Error(t, err)
@@ -1932,7 +1932,7 @@ func TestReceiveContext(t *testing.T) {
 				Name: actorName2,
 				Id:   "",
 			},
-		), []proto.Message{new(testpb.TestReply)}, time.Minute)
+		), []any{new(testpb.TestReply)}, time.Minute)
 		require.Error(t, context.getError())
 		pause.For(time.Second)
 
@@ -2087,7 +2087,7 @@ func TestReceiveContext(t *testing.T) {
 			self:    pid1,
 		}
 
-		task := func() (proto.Message, error) {
+		task := func() (any, error) {
 			// simulate a long-running task
 			pause.For(500 * time.Millisecond)
 			return new(testpb.TaskComplete), nil
@@ -2201,7 +2201,7 @@ func TestReceiveContext(t *testing.T) {
 		require.Zero(t, pid1.ProcessedCount()-1)
 		require.Zero(t, pid2.ProcessedCount()-1)
 
-		task := func() (proto.Message, error) {
+		task := func() (any, error) {
 			// simulate a long-running task
 			pause.For(500 * time.Millisecond)
 			return nil, assert.AnError
@@ -2349,7 +2349,7 @@ func TestReceiveContext(t *testing.T) {
 		reply := context.SendSync(pid2.Name(), new(testpb.TestReply), time.Minute)
 		require.NotNil(t, reply)
 		expected := new(testpb.Reply)
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 		require.NoError(t, actorSystem.Stop(ctx))
 	})
 	t.Run("With failed SendSync", func(t *testing.T) {
@@ -25,21 +25,64 @@
 package actor
 
 import (
+	"context"
 	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/tochemey/goakt/v3/extension"
+	"github.com/tochemey/goakt/v3/internal/collection"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/registry"
 )
 
 // reflection helps create an instance dynamically
 type reflection struct {
-	registry registry.Registry
+	registry  registry.Registry
+	factories *collection.Map[string, ActorFactory]
 }
 
 // newReflection creates an instance of Reflection
 func newReflection(registry registry.Registry) *reflection {
-	return &reflection{registry: registry}
+	return &reflection{
+		registry:  registry,
+		factories: collection.NewMap[string, ActorFactory](),
+	}
+}
+
+// RegisterFactory registers the given actor factory under the given name
+func (r *reflection) RegisterFactory(name string, factory ActorFactory) {
+	r.factories.Set(strings.TrimSpace(name), factory)
+}
+
+// NewActorFromFactory creates a new instance of Actor with the factory registered under the given name
+// and the given packed arguments
+func (r *reflection) NewActorFromFactory(ctx context.Context, name string, args *anypb.Any) (Actor, error) {
+	factory, ok := r.factories.Get(strings.TrimSpace(name))
+	if !ok {
+		return nil, ErrFactoryNotRegistered
+	}
+
+	var message proto.Message
+	if args != nil {
+		var err error
+		message, err = args.UnmarshalNew()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	actor, err := factory(ctx, message)
+	if err != nil {
+		return nil, err
+	}
+
+	if actor == nil {
+		return nil, ErrInvalidInstance
+	}
+	return actor, nil
 }
 
 // NewActor creates a new instance of Actor from its FQN
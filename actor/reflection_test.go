@@ -25,14 +25,18 @@
 package actor
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/registry"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
 )
 
 func TestReflection(t *testing.T) {
@@ -195,4 +199,37 @@ func TestReflection(t *testing.T) {
 		assert.ErrorIs(t, err, ErrInstanceNotAnGrain)
 		assert.Nil(t, actual)
 	})
+	t.Run("With NewActorFromFactory happy path", func(t *testing.T) {
+		reflection := newReflection(registry.NewRegistry())
+		reflection.RegisterFactory("greeter", mockActorFactory)
+		args, err := anypb.New(&testpb.TestLog{Text: "hello"})
+		require.NoError(t, err)
+
+		actual, err := reflection.NewActorFromFactory(context.TODO(), " greeter ", args)
+		require.NoError(t, err)
+		require.IsType(t, new(MockFactoryActor), actual)
+		assert.Equal(t, "hello", actual.(*MockFactoryActor).greeting)
+	})
+	t.Run("With unregistered actor factory", func(t *testing.T) {
+		reflection := newReflection(registry.NewRegistry())
+		actual, err := reflection.NewActorFromFactory(context.TODO(), "greeter", nil)
+		assert.ErrorIs(t, err, ErrFactoryNotRegistered)
+		assert.Nil(t, actual)
+	})
+	t.Run("With NewActorFromFactory factory failure", func(t *testing.T) {
+		reflection := newReflection(registry.NewRegistry())
+		reflection.RegisterFactory("greeter", mockActorFactory)
+		actual, err := reflection.NewActorFromFactory(context.TODO(), "greeter", nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+	t.Run("With NewActorFromFactory nil actor", func(t *testing.T) {
+		reflection := newReflection(registry.NewRegistry())
+		reflection.RegisterFactory("nil", func(context.Context, proto.Message) (Actor, error) {
+			return nil, nil
+		})
+		actual, err := reflection.NewActorFromFactory(context.TODO(), "nil", nil)
+		assert.ErrorIs(t, err, ErrInvalidInstance)
+		assert.Nil(t, actual)
+	})
 }
@@ -0,0 +1,235 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"path"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/remote"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// errMissingToken is returned when a remoting request does not carry a bearer token
+var errMissingToken = errors.New("missing bearer token")
+
+// authInterceptor authenticates and authorizes the incoming remoting requests
+type authInterceptor struct {
+	authenticator remote.Authenticator
+	authorizer    remote.Authorizer
+}
+
+// enforce compilation error
+var _ connect.Interceptor = (*authInterceptor)(nil)
+
+// newAuthInterceptor creates an instance of authInterceptor
+func newAuthInterceptor(authenticator remote.Authenticator, authorizer remote.Authorizer) *authInterceptor {
+	return &authInterceptor{
+		authenticator: authenticator,
+		authorizer:    authorizer,
+	}
+}
+
+// WrapUnary authenticates and authorizes the unary requests
+func (x *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		principal, err := x.authenticate(ctx, request.Header())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := x.authorize(ctx, principal, request.Spec().Procedure, request.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	}
+}
+
+// WrapStreamingClient is a no-op on the server side
+func (x *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler authenticates the streams when they are opened and authorizes every message received
+func (x *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		principal, err := x.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+
+		if x.authorizer != nil {
+			conn = &authorizedStreamingHandlerConn{
+				StreamingHandlerConn: conn,
+				ctx:                  ctx,
+				interceptor:          x,
+				principal:            principal,
+			}
+		}
+		return next(ctx, conn)
+	}
+}
+
+// authenticate verifies the bearer token found in the given request headers
+func (x *authInterceptor) authenticate(ctx context.Context, header nethttp.Header) (*remote.Principal, error) {
+	authorization := header.Get(authorizationHeader)
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errMissingToken)
+	}
+
+	principal, err := x.authenticator.Authenticate(ctx, strings.TrimPrefix(authorization, bearerPrefix))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return principal, nil
+}
+
+// authorize checks the given request message against the authorization policy
+func (x *authInterceptor) authorize(ctx context.Context, principal *remote.Principal, procedure string, message any) error {
+	if x.authorizer == nil {
+		return nil
+	}
+
+	call := &remote.RemoteCall{
+		Procedure: path.Base(procedure),
+		Actors:    remoteCallActors(message),
+	}
+
+	if err := x.authorizer(ctx, principal, call); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return nil
+}
+
+// authorizedStreamingHandlerConn authorizes every message received over a stream
+type authorizedStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	ctx         context.Context
+	interceptor *authInterceptor
+	principal   *remote.Principal
+}
+
+// Receive receives a message and checks it against the authorization policy
+func (x *authorizedStreamingHandlerConn) Receive(message any) error {
+	if err := x.StreamingHandlerConn.Receive(message); err != nil {
+		return err
+	}
+	return x.interceptor.authorize(x.ctx, x.principal, x.Spec().Procedure, message)
+}
+
+// remoteCallActors returns the names of the actors or the identities of the grains targeted by a remoting request
+func remoteCallActors(message any) []string {
+	switch request := message.(type) {
+	case *internalpb.RemoteTellRequest:
+		return receiverNames(request.GetRemoteMessages())
+	case *internalpb.RemoteAskRequest:
+		return receiverNames(request.GetRemoteMessages())
+	case *internalpb.RemoteStreamTellRequest:
+		return receiverNames(request.GetRemoteMessages())
+	case *internalpb.RemoteLookupRequest:
+		return []string{request.GetName()}
+	case *internalpb.RemoteWatchRequest:
+		return []string{request.GetWatchee().GetName()}
+	case *internalpb.RemoteUnWatchRequest:
+		return []string{request.GetWatchee().GetName()}
+	case *internalpb.RemoteReSpawnRequest:
+		return []string{request.GetName()}
+	case *internalpb.RemoteStopRequest:
+		return []string{request.GetName()}
+	case *internalpb.RemoteSpawnRequest:
+		return []string{request.GetActorName()}
+	case *internalpb.RemoteReinstateRequest:
+		return []string{request.GetName()}
+	case *internalpb.RemoteAskGrainRequest:
+		return []string{request.GetGrain().GetGrainId().GetValue()}
+	case *internalpb.RemoteTellGrainRequest:
+		return []string{request.GetGrain().GetGrainId().GetValue()}
+	case *internalpb.RemoteActivateGrainRequest:
+		return []string{request.GetGrain().GetGrainId().GetValue()}
+	default:
+		return nil
+	}
+}
+
+// receiverNames returns the distinct names of the receivers of the given messages
+func receiverNames(messages []*internalpb.RemoteMessage) []string {
+	names := make([]string, 0, len(messages))
+	seen := make(map[string]struct{}, len(messages))
+	for _, message := range messages {
+		name := message.GetReceiver().GetName()
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names
+}
+
+// credentialsTransport attaches the bearer token of the given credentials to the outgoing requests
+type credentialsTransport struct {
+	base        nethttp.RoundTripper
+	credentials remote.Credentials
+}
+
+// enforce compilation error
+var _ nethttp.RoundTripper = (*credentialsTransport)(nil)
+
+// RoundTrip sets the authorization header and sends the request
+func (x *credentialsTransport) RoundTrip(request *nethttp.Request) (*nethttp.Response, error) {
+	token, err := x.credentials.Token(request.Context())
+	if err != nil {
+		if request.Body != nil {
+			_ = request.Body.Close()
+		}
+		return nil, err
+	}
+
+	// a RoundTripper must not modify the given request
+	request = request.Clone(request.Context())
+	request.Header.Set(authorizationHeader, bearerPrefix+token)
+	return x.base.RoundTrip(request)
+}
+
+// CloseIdleConnections closes the idle connections of the underlying transport
+func (x *credentialsTransport) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+
+	if transport, ok := x.base.(closeIdler); ok {
+		transport.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestRemoteAuth(t *testing.T) {
+	ctx := context.TODO()
+	host := "127.0.0.1"
+
+	errProtected := errors.New("protected actor")
+	authorizer := func(_ context.Context, principal *remote.Principal, call *remote.RemoteCall) error {
+		assert.NotNil(t, principal)
+		if call.Procedure == "RemoteStop" && slices.Contains(call.Actors, "protected") {
+			return errProtected
+		}
+		return nil
+	}
+
+	sys, err := NewActorSystem(
+		"test",
+		WithLogger(log.DiscardLogger),
+		WithRemote(remote.NewConfig(host, dynaport.Get(1)[0],
+			remote.WithStreaming(),
+			remote.WithAuthenticator(remote.NewTokenAuthenticator("secret")),
+			remote.WithAuthorizer(authorizer),
+		)),
+	)
+	require.NoError(t, err)
+	require.NoError(t, sys.Start(ctx))
+
+	pause.For(time.Second)
+
+	counter := NewMockCounter(10)
+	_, err = sys.Spawn(ctx, "counter", counter)
+	require.NoError(t, err)
+
+	_, err = sys.Spawn(ctx, "protected", NewMockActor())
+	require.NoError(t, err)
+
+	_, err = sys.Spawn(ctx, "other", NewMockActor())
+	require.NoError(t, err)
+
+	t.Run("Without credentials", func(t *testing.T) {
+		remoting := NewRemoting()
+		_, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+		remoting.Close()
+	})
+	t.Run("With invalid credentials", func(t *testing.T) {
+		remoting := NewRemoting(WithRemotingCredentials(remote.NewStaticCredentials("wrong")))
+		_, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+		remoting.Close()
+	})
+	t.Run("With valid credentials", func(t *testing.T) {
+		remoting := NewRemoting(WithRemotingCredentials(remote.NewStaticCredentials("secret")))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestCount{Value: 1}))
+		select {
+		case value := <-counter.received:
+			assert.EqualValues(t, 1, value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		err = remoting.RemoteStop(ctx, sys.Host(), int(sys.Port()), "protected")
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		require.NoError(t, remoting.RemoteStop(ctx, sys.Host(), int(sys.Port()), "other"))
+		remoting.Close()
+	})
+	t.Run("With streamed messages", func(t *testing.T) {
+		remoting := NewRemoting(
+			WithRemotingStreaming(),
+			WithRemotingCredentials(remote.NewStaticCredentials("secret")),
+		)
+
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestCount{Value: 2}))
+		select {
+		case value := <-counter.received:
+			assert.EqualValues(t, 2, value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+		remoting.Close()
+	})
+	t.Run("With actor systems", func(t *testing.T) {
+		client, err := NewActorSystem(
+			"client",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithCredentials(remote.NewStaticCredentials("secret")))),
+		)
+		require.NoError(t, err)
+		require.NoError(t, client.Start(ctx))
+
+		pause.For(time.Second)
+
+		pid, err := client.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		addr, err := client.getRemoting().RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		require.NoError(t, pid.RemoteTell(ctx, addr, &testpb.TestCount{Value: 3}))
+		select {
+		case value := <-counter.received:
+			assert.EqualValues(t, 3, value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		require.NoError(t, client.Stop(ctx))
+	})
+
+	require.NoError(t, sys.Stop(ctx))
+}
+
+func TestRemoteCallActors(t *testing.T) {
+	messages := []*internalpb.RemoteMessage{
+		{Receiver: address.New("actor1", "sys", "127.0.0.1", 9000).Address},
+		{Receiver: address.New("actor2", "sys", "127.0.0.1", 9000).Address},
+		{Receiver: address.New("actor1", "sys", "127.0.0.1", 9000).Address},
+	}
+
+	assert.Equal(t, []string{"actor1", "actor2"}, remoteCallActors(&internalpb.RemoteTellRequest{RemoteMessages: messages}))
+	assert.Equal(t, []string{"actor1", "actor2"}, remoteCallActors(&internalpb.RemoteStreamTellRequest{RemoteMessages: messages}))
+	assert.Equal(t, []string{"actor"}, remoteCallActors(&internalpb.RemoteSpawnRequest{ActorName: "actor"}))
+	assert.Equal(t, []string{"actor"}, remoteCallActors(&internalpb.RemoteStopRequest{Name: "actor"}))
+	assert.Equal(t, []string{"kind/grain"}, remoteCallActors(&internalpb.RemoteTellGrainRequest{
+		Grain: &internalpb.Grain{GrainId: &internalpb.GrainId{Value: "kind/grain"}},
+	}))
+	assert.Nil(t, remoteCallActors(new(internalpb.GetKindsRequest)))
+}
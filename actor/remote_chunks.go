@@ -0,0 +1,180 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/tochemey/goakt/v3/internal/chunking"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+)
+
+// RemoteChunk records a chunk of a message too large to be sent in a single request.
+// The message is reassembled when the request carrying its description is received.
+func (x *actorSystem) RemoteChunk(_ context.Context, request *connect.Request[internalpb.RemoteChunkRequest]) (*connect.Response[internalpb.RemoteChunkResponse], error) {
+	if !x.remotingEnabled.Load() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrRemotingDisabled)
+	}
+
+	req := request.Msg
+	if err := x.receivedChunks.Add(req.GetTransferId(), req.GetIndex(), req.GetData()); err != nil {
+		if errors.Is(err, chunking.ErrBufferFull) {
+			return nil, connect.NewError(connect.CodeResourceExhausted, err)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(new(internalpb.RemoteChunkResponse)), nil
+}
+
+// RemoteFetchChunk returns a chunk of a reply too large to be sent in a single response
+func (x *actorSystem) RemoteFetchChunk(_ context.Context, request *connect.Request[internalpb.RemoteFetchChunkRequest]) (*connect.Response[internalpb.RemoteFetchChunkResponse], error) {
+	if !x.remotingEnabled.Load() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrRemotingDisabled)
+	}
+
+	req := request.Msg
+	data, err := x.sentChunks.Get(req.GetTransferId(), req.GetIndex())
+	if err != nil {
+		if errors.Is(err, chunking.ErrTransferNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(&internalpb.RemoteFetchChunkResponse{Data: data}), nil
+}
+
+// assembleChunks replaces the description of a message received in chunks by the reassembled message
+func (x *actorSystem) assembleChunks(payload *internalpb.Payload) error {
+	chunked := payload.GetChunked()
+	if chunked == nil {
+		return nil
+	}
+
+	data, err := x.receivedChunks.Assemble(chunked.GetTransferId(), chunked.GetChunks(), chunked.GetSize(), chunked.GetChecksum())
+	if err != nil {
+		return err
+	}
+
+	payload.Data = data
+	payload.Chunked = nil
+	return nil
+}
+
+// storeChunks keeps a reply larger than the chunk size in chunks, to be fetched by the requester,
+// and replaces it by its description
+func (x *actorSystem) storeChunks(payload *internalpb.Payload) error {
+	chunkSize := x.remoteConfig.ChunkSize()
+	if chunkSize <= 0 || len(payload.GetData()) <= chunkSize {
+		return nil
+	}
+
+	transferID := uuid.NewString()
+	chunks := chunking.Split(payload.GetData(), chunkSize)
+	if err := x.sentChunks.Put(transferID, chunks); err != nil {
+		return err
+	}
+
+	payload.Chunked = describeChunks(transferID, chunks, payload.GetData())
+	payload.Data = nil
+	return nil
+}
+
+// sendChunks uploads a message larger than the chunk size to the given remote node in chunks
+// and replaces it by its description
+func (r *Remoting) sendChunks(ctx context.Context, host string, port int, payload *internalpb.Payload) error {
+	if r.chunkSize <= 0 || len(payload.GetData()) <= r.chunkSize {
+		return nil
+	}
+
+	transferID := uuid.NewString()
+	chunks := chunking.Split(payload.GetData(), r.chunkSize)
+	remoteClient := r.remotingServiceClient(host, port)
+	for index, chunk := range chunks {
+		request := connect.NewRequest(&internalpb.RemoteChunkRequest{
+			TransferId: transferID,
+			Index:      int32(index), // nolint
+			Data:       chunk,
+		})
+
+		if _, err := remoteClient.RemoteChunk(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	payload.Chunked = describeChunks(transferID, chunks, payload.GetData())
+	payload.Data = nil
+	return nil
+}
+
+// fetchChunks downloads the chunks of a reply described by the given payload from the given remote node
+// and replaces the description by the reassembled reply
+func (r *Remoting) fetchChunks(ctx context.Context, host string, port int, payload *internalpb.Payload) error {
+	chunked := payload.GetChunked()
+	if chunked == nil {
+		return nil
+	}
+
+	if chunked.GetChunks() <= 0 {
+		return chunking.ErrInvalidChunk
+	}
+
+	remoteClient := r.remotingServiceClient(host, port)
+	chunks := make([][]byte, 0, chunked.GetChunks())
+	for index := range chunked.GetChunks() {
+		request := connect.NewRequest(&internalpb.RemoteFetchChunkRequest{
+			TransferId: chunked.GetTransferId(),
+			Index:      index,
+		})
+
+		response, err := remoteClient.RemoteFetchChunk(ctx, request)
+		if err != nil {
+			return err
+		}
+		chunks = append(chunks, response.Msg.GetData())
+	}
+
+	data, err := chunking.Join(chunks, chunked.GetSize(), chunked.GetChecksum())
+	if err != nil {
+		return err
+	}
+
+	payload.Data = data
+	payload.Chunked = nil
+	return nil
+}
+
+// describeChunks returns the description of a message split into the given chunks
+func describeChunks(transferID string, chunks [][]byte, data []byte) *internalpb.ChunkedPayload {
+	return &internalpb.ChunkedPayload{
+		TransferId: transferID,
+		Chunks:     int32(len(chunks)), // nolint
+		Size:       int64(len(data)),
+		Checksum:   chunking.Checksum(data),
+	}
+}
@@ -0,0 +1,317 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/internal/chunking"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/internal/size"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+// startChunkingSystem starts an actor system accepting frames of at most 16KB and chunking its replies in 4KB chunks
+func startChunkingSystem(t *testing.T, opts ...remote.Option) ActorSystem {
+	ctx := context.TODO()
+	opts = append([]remote.Option{remote.WithMaxFrameSize(16 * size.KB), remote.WithChunkSize(4 * size.KB)}, opts...)
+	sys, err := NewActorSystem(
+		"test",
+		WithLogger(log.DiscardLogger),
+		WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0], opts...)),
+	)
+	require.NoError(t, err)
+	require.NoError(t, sys.Start(ctx))
+	pause.For(time.Second)
+	return sys
+}
+
+// sendChunk uploads a chunk of the given transfer to the given actor system
+func sendChunk(t *testing.T, ctx context.Context, sys ActorSystem, transferID string, index int, data []byte) {
+	remoteClient := NewRemoting().remotingServiceClient(sys.Host(), sys.Port())
+	_, err := remoteClient.RemoteChunk(ctx, connect.NewRequest(&internalpb.RemoteChunkRequest{
+		TransferId: transferID,
+		Index:      int32(index),
+		Data:       data,
+	}))
+	require.NoError(t, err)
+}
+
+func TestRemoteChunks(t *testing.T) {
+	text := strings.Repeat("goakt", 16*size.KB)
+
+	t.Run("With large messages", func(t *testing.T) {
+		ctx := context.TODO()
+		sys := startChunkingSystem(t, remote.WithChunkTimeout(3*time.Second))
+
+		echo := NewMockEcho()
+		_, err := sys.Spawn(ctx, "echo", echo)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingMaxReadFameSize(16*size.KB), WithRemotingChunkSize(4*size.KB))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), sys.Port(), "echo")
+		require.NoError(t, err)
+
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestLog{Text: text}))
+		select {
+		case received := <-echo.received:
+			assert.Equal(t, text, received)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		reply, err := remoting.RemoteAsk(ctx, address.NoSender(), addr, &testpb.Reply{Content: text}, time.Minute)
+		require.NoError(t, err)
+		require.IsType(t, &testpb.Reply{}, reply)
+		assert.Equal(t, text, reply.(*testpb.Reply).GetContent())
+
+		replies, err := remoting.RemoteBatchAsk(ctx, address.NoSender(), addr, []any{&testpb.Reply{Content: text}, &testpb.Reply{Content: "small"}}, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, replies, 2)
+		assert.Equal(t, text, replies[0].(*testpb.Reply).GetContent())
+		assert.Equal(t, "small", replies[1].(*testpb.Reply).GetContent())
+
+		// the chunks received are released once the messages are reassembled and
+		// the chunks of the replies are kept until they time out, in case a fetch is retried
+		assert.Zero(t, sys.(*actorSystem).receivedChunks.Len())
+		assert.Equal(t, 2, sys.(*actorSystem).sentChunks.Len())
+		require.Eventually(t, func() bool { return sys.(*actorSystem).sentChunks.Len() == 0 }, 10*time.Second, 100*time.Millisecond)
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With large messages over streams", func(t *testing.T) {
+		ctx := context.TODO()
+		sys := startChunkingSystem(t, remote.WithStreaming())
+
+		echo := NewMockEcho()
+		_, err := sys.Spawn(ctx, "echo", echo)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(
+			WithRemotingMaxReadFameSize(16*size.KB),
+			WithRemotingChunkSize(4*size.KB),
+			WithRemotingStreaming(),
+		)
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), sys.Port(), "echo")
+		require.NoError(t, err)
+
+		require.NoError(t, remoting.RemoteBatchTell(ctx, address.NoSender(), addr, []any{&testpb.TestLog{Text: text}, &testpb.TestLog{Text: "small"}}))
+		for _, expected := range []string{text, "small"} {
+			select {
+			case received := <-echo.received:
+				assert.Equal(t, expected, received)
+			case <-time.After(5 * time.Second):
+				t.Fatal("message not received")
+			}
+		}
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With large messages between actors", func(t *testing.T) {
+		ctx := context.TODO()
+		sys := startChunkingSystem(t)
+		sender := startChunkingSystem(t)
+
+		echo := NewMockEcho()
+		_, err := sys.Spawn(ctx, "echo", echo)
+		require.NoError(t, err)
+
+		pid, err := sender.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		addr, err := pid.RemoteLookup(ctx, sys.Host(), sys.Port(), "echo")
+		require.NoError(t, err)
+
+		require.NoError(t, pid.RemoteTell(ctx, address.From(addr), &testpb.TestLog{Text: text}))
+		select {
+		case received := <-echo.received:
+			assert.Equal(t, text, received)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		reply, err := pid.RemoteAsk(ctx, address.From(addr), &testpb.Reply{Content: text}, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, text, reply.(*testpb.Reply).GetContent())
+
+		replies, err := pid.RemoteBatchAsk(ctx, address.From(addr), []any{&testpb.Reply{Content: text}}, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, replies, 1)
+		assert.Equal(t, text, replies[0].(*testpb.Reply).GetContent())
+
+		require.NoError(t, sender.Stop(ctx))
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With large messages to grains", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		remoteOptions := withTestRemoteOptions(remote.WithMaxFrameSize(16*size.KB), remote.WithChunkSize(4*size.KB))
+		node1, sd1 := testCluster(t, srv.Addr().String(), remoteOptions)
+		node2, sd2 := testCluster(t, srv.Addr().String(), remoteOptions)
+
+		identity, err := node1.GrainIdentity(ctx, "grain", func(_ context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		})
+		require.NoError(t, err)
+
+		pause.For(time.Second)
+
+		response, err := node2.AskGrain(ctx, identity, &testpb.Reply{Content: text}, time.Minute)
+		require.NoError(t, err)
+		require.IsType(t, &testpb.Reply{}, response)
+		assert.Equal(t, text, response.(*testpb.Reply).GetContent())
+
+		require.NoError(t, node2.TellGrain(ctx, identity, &testpb.TestLog{Text: text}))
+
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, node1.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("Without chunking", func(t *testing.T) {
+		ctx := context.TODO()
+		sys := startChunkingSystem(t)
+
+		_, err := sys.Spawn(ctx, "echo", NewMockEcho())
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingMaxReadFameSize(16 * size.KB))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), sys.Port(), "echo")
+		require.NoError(t, err)
+
+		err = remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestLog{Text: text})
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With corrupted or incomplete messages", func(t *testing.T) {
+		ctx := context.TODO()
+		sys := startChunkingSystem(t, remote.WithChunkTimeout(200*time.Millisecond))
+
+		echo := NewMockEcho()
+		_, err := sys.Spawn(ctx, "echo", echo)
+		require.NoError(t, err)
+
+		remoting := NewRemoting()
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), sys.Port(), "echo")
+		require.NoError(t, err)
+
+		payload, err := remoting.serializers.Serialize(&testpb.TestLog{Text: text})
+		require.NoError(t, err)
+		data := payload.GetData()
+		chunks := chunking.Split(data, 4*size.KB)
+
+		tell := func(transferID string, checksum []byte) error {
+			return remoting.unaryTell(ctx, sys.Host(), sys.Port(), []*internalpb.RemoteMessage{
+				{
+					Sender:   address.NoSender().Address,
+					Receiver: addr.Address,
+					Payload: &internalpb.Payload{
+						SerializerId: payload.GetSerializerId(),
+						Manifest:     payload.GetManifest(),
+						Chunked: &internalpb.ChunkedPayload{
+							TransferId: transferID,
+							Chunks:     int32(len(chunks)),
+							Size:       int64(len(data)),
+							Checksum:   checksum,
+						},
+					},
+				},
+			})
+		}
+
+		// missing chunks
+		sendChunk(t, ctx, sys, "incomplete", 0, chunks[0])
+		require.Error(t, tell("incomplete", chunking.Checksum(data)))
+
+		// corrupted chunks
+		for index, chunk := range chunks {
+			sendChunk(t, ctx, sys, "corrupted", index, chunk)
+		}
+		require.Error(t, tell("corrupted", chunking.Checksum([]byte("corrupted"))))
+
+		// timed out chunks
+		for index, chunk := range chunks {
+			sendChunk(t, ctx, sys, "timeout", index, chunk)
+		}
+		pause.For(500 * time.Millisecond)
+		require.Error(t, tell("timeout", chunking.Checksum(data)))
+
+		// none of the messages reaches the actor
+		select {
+		case <-echo.received:
+			t.Fatal("unexpected message received")
+		case <-time.After(100 * time.Millisecond):
+		}
+		assert.Zero(t, sys.(*actorSystem).receivedChunks.Len())
+
+		// unknown reply
+		remoteClient := remoting.remotingServiceClient(sys.Host(), sys.Port())
+		_, err = remoteClient.RemoteFetchChunk(ctx, connect.NewRequest(&internalpb.RemoteFetchChunkRequest{TransferId: "unknown"}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With chunks buffer full", func(t *testing.T) {
+		ctx := context.TODO()
+		sys := startChunkingSystem(t, remote.WithChunkBuffer(8*size.KB, 1))
+
+		data := []byte(text)
+		sendChunk(t, ctx, sys, "transfer", 0, data[:4*size.KB])
+
+		remoteClient := NewRemoting().remotingServiceClient(sys.Host(), sys.Port())
+		for _, transferID := range []string{"transfer", "other"} {
+			_, err := remoteClient.RemoteChunk(ctx, connect.NewRequest(&internalpb.RemoteChunkRequest{
+				TransferId: transferID,
+				Index:      1,
+				Data:       data[:4*size.KB],
+			}))
+			require.Error(t, err)
+			assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+		}
+
+		require.NoError(t, sys.Stop(ctx))
+	})
+}
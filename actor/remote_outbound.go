@@ -0,0 +1,177 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"go.uber.org/atomic"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/remote"
+)
+
+// OutboundQueueStats defines the state of the outbound queue of a remote node
+type OutboundQueueStats struct {
+	// Peer is the remote node address in the form host:port
+	Peer string
+	// Queued is the number of messages waiting to be sent
+	Queued int
+	// Sent is the total number of messages sent
+	Sent int64
+	// Dropped is the total number of messages dropped because the queue was full
+	Dropped int64
+	// Failed is the total number of messages that could not be delivered
+	Failed int64
+}
+
+// outboundQueue sends the messages to the actors of a remote node asynchronously.
+//
+// The messages are queued in the order they are sent and a single worker sends them in batches.
+// A slow remote node only fills its own queue, the overflow policy then decides whether the senders
+// wait, get an error or have their messages dropped to the deadletter.
+// The queue is evicted when no message has been sent to the remote node for the idle timeout,
+// so that the queues of the remote nodes that have left do not accumulate.
+type outboundQueue struct {
+	*remoteQueue
+
+	remoting *Remoting
+	host     string
+	port     int
+	policy   remote.OverflowPolicy
+
+	sent    *atomic.Int64
+	dropped *atomic.Int64
+	failed  *atomic.Int64
+}
+
+// ensure outboundQueue writes the batches of its queue
+var _ batchWriter = (*outboundQueue)(nil)
+
+// newOutboundQueue creates an instance of outboundQueue and starts its worker
+func newOutboundQueue(remoting *Remoting, host string, port int) *outboundQueue {
+	q := &outboundQueue{
+		remoteQueue: newRemoteQueue(remoting.outboundQueueSize, remoting.streamBatchSize, remoting.streamIdleTimeout),
+		remoting:    remoting,
+		host:        host,
+		port:        port,
+		policy:      remoting.overflowPolicy,
+		sent:        atomic.NewInt64(0),
+		dropped:     atomic.NewInt64(0),
+		failed:      atomic.NewInt64(0),
+	}
+	go q.run(q)
+	return q
+}
+
+// send queues the given messages and applies the overflow policy when the queue is full.
+// With the fail fast policy either all the messages are queued or none of them is.
+// The messages sent once the queue is stopped are sent synchronously.
+func (q *outboundQueue) send(ctx context.Context, messages []*internalpb.RemoteMessage) error {
+	// with the fail fast policy, the senders queue their messages one at a time
+	// so that the room checked is not taken by another sender
+	queued, err := q.enqueue(q.policy == remote.FailFast, func() error {
+		if q.policy == remote.FailFast {
+			return q.pushAll(messages)
+		}
+		return q.push(ctx, messages)
+	})
+
+	if !queued {
+		return q.remoting.deliver(ctx, q.host, q.port, messages)
+	}
+	return err
+}
+
+// push queues the given messages one by one and applies the overflow policy to each of them
+func (q *outboundQueue) push(ctx context.Context, messages []*internalpb.RemoteMessage) error {
+	for _, message := range messages {
+		select {
+		case q.queue <- message:
+			continue
+		default:
+		}
+
+		if q.policy == remote.BlockOnOverflow {
+			select {
+			case q.queue <- message:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+
+		q.dropped.Inc()
+		q.remoting.toDeadletter(message, ErrOutboundQueueFull)
+	}
+	return nil
+}
+
+// pushAll queues all the given messages when the queue has room for them and drops them otherwise
+func (q *outboundQueue) pushAll(messages []*internalpb.RemoteMessage) error {
+	// only the worker takes messages off the queue, so the room can only grow
+	if cap(q.queue)-len(q.queue) < len(messages) {
+		q.dropped.Add(int64(len(messages)))
+		return ErrOutboundQueueFull
+	}
+
+	for _, message := range messages {
+		q.queue <- message
+	}
+	return nil
+}
+
+// stats returns the state of the queue
+func (q *outboundQueue) stats() OutboundQueueStats {
+	return OutboundQueueStats{
+		Peer:    net.JoinHostPort(q.host, strconv.Itoa(q.port)),
+		Queued:  len(q.queue),
+		Sent:    q.sent.Load(),
+		Dropped: q.dropped.Load(),
+		Failed:  q.failed.Load(),
+	}
+}
+
+// evict evicts the idle queue, the next message sent to the remote node creates a new queue
+func (q *outboundQueue) evict() {
+	q.remoting.evictOutboundQueue(q)
+}
+
+// close implements batchWriter. The queue does not hold any connection to the remote node
+func (q *outboundQueue) close() {}
+
+// write sends the given batch and sends its messages to the deadletter when the delivery fails
+func (q *outboundQueue) write(batch []*internalpb.RemoteMessage) {
+	if err := q.remoting.deliver(context.Background(), q.host, q.port, batch); err != nil {
+		q.failed.Add(int64(len(batch)))
+		for _, message := range batch {
+			q.remoting.toDeadletter(message, err)
+		}
+		return
+	}
+	q.sent.Add(int64(len(batch)))
+}
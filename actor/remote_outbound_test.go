@@ -0,0 +1,375 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb/internalpbconnect"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+// slowTellHandler holds the RemoteTell requests until it is released
+type slowTellHandler struct {
+	internalpbconnect.UnimplementedRemotingServiceHandler
+	release chan struct{}
+}
+
+func (h *slowTellHandler) RemoteTell(ctx context.Context, _ *connect.Request[internalpb.RemoteTellRequest]) (*connect.Response[internalpb.RemoteTellResponse], error) {
+	select {
+	case <-h.release:
+	case <-ctx.Done():
+	}
+	return connect.NewResponse(new(internalpb.RemoteTellResponse)), nil
+}
+
+// startSlowPeer starts a remote node that holds the messages sent to it until the returned channel is closed
+func startSlowPeer(t *testing.T) (*address.Address, chan struct{}) {
+	handler := &slowTellHandler{release: make(chan struct{})}
+	mux := http.NewServeMux()
+	mux.Handle(internalpbconnect.NewRemotingServiceHandler(handler))
+	server := httptest.NewUnstartedServer(h2c.NewHandler(mux, new(http2.Server)))
+	server.Start()
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+	return address.New("actor", "remote", serverURL.Hostname(), port), handler.release
+}
+
+// recordingDeadletter records the messages that could not be delivered
+type recordingDeadletter struct {
+	mu     sync.Mutex
+	errors []error
+}
+
+func (x *recordingDeadletter) record(_ *internalpb.RemoteMessage, err error) {
+	x.mu.Lock()
+	x.errors = append(x.errors, err)
+	x.mu.Unlock()
+}
+
+func (x *recordingDeadletter) count() int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return len(x.errors)
+}
+
+// fillOutboundQueue sends a message held by the remote node then fills the outbound queue
+func fillOutboundQueue(t *testing.T, ctx context.Context, remoting *Remoting, to *address.Address, size int) {
+	require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), to, new(testpb.TestSend)))
+	require.Eventually(t, func() bool {
+		stats := remoting.OutboundQueues()
+		return len(stats) == 1 && stats[0].Queued == 0
+	}, time.Second, 10*time.Millisecond)
+
+	for i := 0; i < size; i++ {
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), to, new(testpb.TestSend)))
+	}
+}
+
+func TestRemoteOutboundQueue(t *testing.T) {
+	t.Run("With messages delivered in order", func(t *testing.T) {
+		ctx := context.TODO()
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0])),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		const count = 100
+		counter := NewMockCounter(count)
+		_, err = sys.Spawn(ctx, "counter", counter)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingOutboundQueue(10, remote.BlockOnOverflow))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		for i := 0; i < count; i++ {
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		for i := 0; i < count; i++ {
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+		}
+
+		// the messages are counted once the remote node acknowledges them
+		require.Eventually(t, func() bool {
+			return remoting.OutboundQueues()[0].Sent == count
+		}, time.Second, 10*time.Millisecond)
+
+		stats := remoting.OutboundQueues()
+		require.Len(t, stats, 1)
+		assert.Equal(t, net.JoinHostPort(sys.Host(), strconv.Itoa(sys.Port())), stats[0].Peer)
+		assert.Zero(t, stats[0].Dropped)
+		assert.Zero(t, stats[0].Failed)
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With fail fast policy", func(t *testing.T) {
+		ctx := context.TODO()
+		to, release := startSlowPeer(t)
+
+		remoting := NewRemoting(WithRemotingOutboundQueue(2, remote.FailFast))
+		fillOutboundQueue(t, ctx, remoting, to, 2)
+
+		err := remoting.RemoteTell(ctx, address.NoSender(), to, new(testpb.TestSend))
+		require.ErrorIs(t, err, ErrOutboundQueueFull)
+
+		stats := remoting.OutboundQueues()
+		require.Len(t, stats, 1)
+		assert.Equal(t, 2, stats[0].Queued)
+		assert.EqualValues(t, 1, stats[0].Dropped)
+
+		close(release)
+		require.Eventually(t, func() bool {
+			return remoting.OutboundQueues()[0].Sent == 3
+		}, 5*time.Second, 10*time.Millisecond)
+		remoting.Close()
+	})
+	t.Run("With fail fast policy and messages sent together", func(t *testing.T) {
+		ctx := context.TODO()
+		to, release := startSlowPeer(t)
+
+		remoting := NewRemoting(WithRemotingOutboundQueue(3, remote.FailFast))
+		fillOutboundQueue(t, ctx, remoting, to, 2)
+
+		// the queue only has room for one of the messages, none of them is queued
+		err := remoting.RemoteBatchTell(ctx, address.NoSender(), to, []any{new(testpb.TestSend), new(testpb.TestSend)})
+		require.ErrorIs(t, err, ErrOutboundQueueFull)
+
+		stats := remoting.OutboundQueues()
+		require.Len(t, stats, 1)
+		assert.Equal(t, 2, stats[0].Queued)
+		assert.EqualValues(t, 2, stats[0].Dropped)
+
+		close(release)
+		remoting.Close()
+	})
+	t.Run("With messages sent while the queue stops", func(t *testing.T) {
+		ctx := context.TODO()
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0])),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		const senders, count = 10, 50
+		counter := NewMockCounter(senders * count)
+		_, err = sys.Spawn(ctx, "counter", counter)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingOutboundQueue(10, remote.BlockOnOverflow))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		queue := remoting.outboundQueue(addr.Host(), addr.Port())
+
+		var wg sync.WaitGroup
+		for i := 0; i < senders; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < count; j++ {
+					message, err := remoting.serializers.Serialize(&testpb.TestCount{Value: int32(j)})
+					assert.NoError(t, err)
+					assert.NoError(t, queue.send(ctx, []*internalpb.RemoteMessage{{
+						Sender:   address.NoSender().Address,
+						Receiver: addr.Address,
+						Payload:  message,
+					}}))
+				}
+			}()
+		}
+
+		pause.For(10 * time.Millisecond)
+		queue.stop()
+		wg.Wait()
+
+		// the messages sent after the stop are sent synchronously, none of them is lost
+		for i := 0; i < senders*count; i++ {
+			select {
+			case <-counter.received:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+		}
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With idle queue evicted", func(t *testing.T) {
+		ctx := context.TODO()
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0])),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		counter := NewMockCounter(2)
+		_, err = sys.Spawn(ctx, "counter", counter)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingOutboundQueue(10, remote.BlockOnOverflow), WithRemotingStreamIdleTimeout(200*time.Millisecond))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestCount{Value: int32(i)}))
+			require.Len(t, remoting.OutboundQueues(), 1)
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+
+			// the queue is evicted once idle and created again with the next message
+			require.Eventually(t, func() bool { return len(remoting.OutboundQueues()) == 0 }, 5*time.Second, 10*time.Millisecond)
+		}
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With drop to deadletters policy", func(t *testing.T) {
+		ctx := context.TODO()
+		to, release := startSlowPeer(t)
+
+		deadletter := new(recordingDeadletter)
+		remoting := NewRemoting(
+			WithRemotingOutboundQueue(2, remote.DropToDeadletters),
+			withRemotingDeadletter(deadletter.record),
+		)
+		fillOutboundQueue(t, ctx, remoting, to, 2)
+
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), to, new(testpb.TestSend)))
+		require.Equal(t, 1, deadletter.count())
+		assert.ErrorIs(t, deadletter.errors[0], ErrOutboundQueueFull)
+		assert.EqualValues(t, 1, remoting.OutboundQueues()[0].Dropped)
+
+		close(release)
+		remoting.Close()
+	})
+	t.Run("With block policy", func(t *testing.T) {
+		ctx := context.TODO()
+		to, release := startSlowPeer(t)
+
+		remoting := NewRemoting(WithRemotingOutboundQueue(2, remote.BlockOnOverflow))
+		fillOutboundQueue(t, ctx, remoting, to, 2)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		err := remoting.RemoteTell(timeoutCtx, address.NoSender(), to, new(testpb.TestSend))
+		cancel()
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// the sender resumes once the remote node catches up
+		go func() {
+			pause.For(100 * time.Millisecond)
+			close(release)
+		}()
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), to, new(testpb.TestSend)))
+		remoting.Close()
+	})
+	t.Run("With unreachable peer", func(t *testing.T) {
+		ctx := context.TODO()
+		ports := dynaport.Get(2)
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", ports[0], remote.WithOutboundQueue(10, remote.DropToDeadletters))),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		consumer, err := sys.Subscribe()
+		require.NoError(t, err)
+
+		pid, err := sys.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		to := address.New("actor", "remote", "127.0.0.1", ports[1])
+		require.NoError(t, pid.RemoteTell(ctx, to, new(testpb.TestSend)))
+
+		require.Eventually(t, func() bool {
+			for message := range consumer.Iterator() {
+				if deadletter, ok := message.Payload().(*goaktpb.Deadletter); ok {
+					return to.Equals(address.From(deadletter.GetReceiver()))
+				}
+			}
+			return false
+		}, 5*time.Second, 100*time.Millisecond)
+
+		stats := sys.getRemoting().OutboundQueues()
+		require.Len(t, stats, 1)
+		assert.EqualValues(t, 1, stats[0].Failed)
+
+		require.NoError(t, sys.Unsubscribe(consumer))
+		require.NoError(t, sys.Stop(ctx))
+	})
+}
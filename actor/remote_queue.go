@@ -0,0 +1,149 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+)
+
+// batchWriter writes the batches of messages taken off a remoteQueue
+type batchWriter interface {
+	// write sends the given batch to the remote node
+	write(batch []*internalpb.RemoteMessage)
+	// evict releases the queue once no message has been written for the idle timeout
+	evict()
+	// close is called once the messages queued before the stop have been written
+	close()
+}
+
+// remoteQueue queues the messages sent to the actors of a remote node.
+//
+// Messages are queued in the order they are sent and a single worker takes them off the queue in batches,
+// which keeps the ordering between a sender and a receiver. Once stopped, the queue writes the messages
+// queued before the stop and holds the senders until they are written, so that the messages sent
+// afterwards do not overtake them.
+type remoteQueue struct {
+	batchSize int
+	idle      time.Duration
+	queue     chan *internalpb.RemoteMessage
+	stopCh    chan struct{}
+	done      chan struct{}
+	stopOnce  sync.Once
+
+	// sendLock keeps the messages from being queued once the queue is stopped
+	sendLock sync.RWMutex
+	stopped  bool
+}
+
+// newRemoteQueue creates an instance of remoteQueue holding up to size messages
+func newRemoteQueue(size, batchSize int, idle time.Duration) *remoteQueue {
+	return &remoteQueue{
+		batchSize: batchSize,
+		idle:      idle,
+		queue:     make(chan *internalpb.RemoteMessage, size),
+		stopCh:    make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// enqueue runs the given function, which queues messages, while the queue accepts messages.
+// When exclusive is set no other sender queues messages meanwhile.
+// It returns false without running the function once the queue is stopped and its queued messages are written.
+func (q *remoteQueue) enqueue(exclusive bool, push func() error) (bool, error) {
+	lock, unlock := q.sendLock.RLock, q.sendLock.RUnlock
+	if exclusive {
+		lock, unlock = q.sendLock.Lock, q.sendLock.Unlock
+	}
+
+	lock()
+	if q.stopped {
+		unlock()
+		<-q.done
+		return false, nil
+	}
+
+	defer unlock()
+	return true, push()
+}
+
+// stop stops accepting messages and waits for the queued messages to be written
+func (q *remoteQueue) stop() {
+	q.stopOnce.Do(func() {
+		q.sendLock.Lock()
+		q.stopped = true
+		close(q.stopCh)
+		q.sendLock.Unlock()
+	})
+	<-q.done
+}
+
+// run takes the queued messages off the queue in batches and hands them to the given writer until the queue is stopped.
+// The writer evicts the queue when no message has been written for the idle timeout
+func (q *remoteQueue) run(writer batchWriter) {
+	defer close(q.done)
+	defer writer.close()
+
+	idle := time.NewTimer(q.idle)
+	defer idle.Stop()
+
+	for {
+		select {
+		case message := <-q.queue:
+			writer.write(q.batch(message))
+			idle.Reset(q.idle)
+		case <-idle.C:
+			// the eviction stops the queue, hence it cannot wait here
+			go writer.evict()
+		case <-q.stopCh:
+			// flush the messages queued before the stop
+			for {
+				select {
+				case message := <-q.queue:
+					writer.write(q.batch(message))
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// batch returns the given message followed by the queued messages, up to the batch size
+func (q *remoteQueue) batch(first *internalpb.RemoteMessage) []*internalpb.RemoteMessage {
+	batch := make([]*internalpb.RemoteMessage, 1, q.batchSize)
+	batch[0] = first
+	for len(batch) < q.batchSize {
+		select {
+		case message := <-q.queue:
+			batch = append(batch, message)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
@@ -0,0 +1,285 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"errors"
+	"io"
+	nethttp "net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb/internalpbconnect"
+)
+
+// tellStream sends the messages to the actors of a remote node over a long-lived bidirectional stream.
+//
+// Messages are queued in the order they are sent and a single writer batches them on the wire,
+// which keeps the ordering between a sender and a receiver. The remote node grants credits, one per
+// batch it is willing to receive, and the writer waits for credits before sending a batch.
+// When the remote node does not support streaming, the stream fails or the remote node does not grant
+// credits within the stream timeout, the messages are sent with the unary RemoteTell.
+type tellStream struct {
+	*remoteQueue
+
+	remoting *Remoting
+	host     string
+	port     int
+	timeout  time.Duration
+
+	// the fields below are owned by the writer
+	conn        *streamConn
+	unsupported bool
+}
+
+// streamConn is a stream opened by the writer of a tellStream.
+// Its credits and state are kept per stream so that a stream that ended cannot affect the next one.
+type streamConn struct {
+	stream  *connect.BidiStreamForClient[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse]
+	cancel  context.CancelFunc
+	timeout time.Duration
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	credits int
+	broken  bool
+}
+
+// ensure tellStream writes the batches of its queue
+var _ batchWriter = (*tellStream)(nil)
+
+// newTellStream creates an instance of tellStream and starts its writer
+func newTellStream(remoting *Remoting, host string, port int) *tellStream {
+	s := &tellStream{
+		remoteQueue: newRemoteQueue(remoting.streamBatchSize*remoting.streamWindow, remoting.streamBatchSize, remoting.streamIdleTimeout),
+		remoting:    remoting,
+		host:        host,
+		port:        port,
+		timeout:     remoting.streamTimeout,
+	}
+	go s.run(s)
+	return s
+}
+
+// send queues the given messages. It blocks when the queue is full until there is room
+// or the context is canceled. The messages are sent with the unary RemoteTell when the stream is stopped,
+// once the messages queued before the stop are written so that they are not overtaken.
+func (s *tellStream) send(ctx context.Context, messages []*internalpb.RemoteMessage) error {
+	queued, err := s.enqueue(false, func() error {
+		for _, message := range messages {
+			select {
+			case s.queue <- message:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+
+	if !queued {
+		return s.remoting.unaryTell(ctx, s.host, s.port, messages)
+	}
+	return err
+}
+
+// evict evicts the idle stream, the next message sent to the remote node opens a new stream
+func (s *tellStream) evict() {
+	s.remoting.evictStream(s)
+}
+
+// write sends the given batch over the stream, opening it when needed.
+// The batch is sent with the unary RemoteTell when the stream cannot be used
+// and its messages are sent to the deadletter when that fails as well.
+func (s *tellStream) write(batch []*internalpb.RemoteMessage) {
+	if s.conn == nil && !s.unsupported {
+		s.open()
+	}
+
+	if s.conn != nil {
+		if s.conn.acquire() {
+			err := s.conn.stream.Send(&internalpb.RemoteStreamTellRequest{RemoteMessages: batch})
+			if err == nil {
+				return
+			}
+		}
+		// the stream is broken, it is reopened with the next batch
+		s.close()
+	}
+
+	// the messages of a broken stream are sent one request at a time
+	if err := s.remoting.unaryTell(context.Background(), s.host, s.port, batch); err != nil {
+		for _, message := range batch {
+			s.remoting.toDeadletter(message, err)
+		}
+	}
+}
+
+// open opens the stream and waits for the initial credits granted by the remote node.
+// The stream is canceled when the remote node does not grant them within the stream timeout.
+func (s *tellStream) open() {
+	ctx, cancel := context.WithCancel(context.Background())
+	handshake := time.AfterFunc(s.timeout, cancel)
+	stream := s.remoting.remotingServiceClient(s.host, s.port).RemoteStreamTell(ctx)
+
+	// send the request headers to start the stream
+	if err := stream.Send(nil); err != nil {
+		handshake.Stop()
+		cancel()
+		return
+	}
+
+	response, err := stream.Receive()
+	if !handshake.Stop() {
+		// the remote node is stalled, the stream has been canceled
+		cancel()
+		return
+	}
+
+	if err != nil {
+		cancel()
+		if connect.CodeOf(err) == connect.CodeUnimplemented {
+			s.unsupported = true
+		}
+		return
+	}
+
+	conn := &streamConn{
+		stream:  stream,
+		cancel:  cancel,
+		timeout: s.timeout,
+		credits: int(response.GetCredits()),
+	}
+	conn.cond = sync.NewCond(&conn.mu)
+
+	s.conn = conn
+	go conn.receiveCredits()
+}
+
+// close closes the stream, when opened
+func (s *tellStream) close() {
+	if s.conn == nil {
+		return
+	}
+
+	s.conn.close()
+	s.conn = nil
+}
+
+// receiveCredits adds the credits granted by the remote node until the stream ends
+func (c *streamConn) receiveCredits() {
+	for {
+		response, err := c.stream.Receive()
+		c.mu.Lock()
+		if err != nil {
+			c.broken = true
+			c.cond.Broadcast()
+			c.mu.Unlock()
+			return
+		}
+		c.credits += int(response.GetCredits())
+		c.cond.Broadcast()
+		c.mu.Unlock()
+	}
+}
+
+// acquire waits for a credit. It returns false when the stream is broken
+// or when the remote node does not grant a credit within the stream timeout.
+func (c *streamConn) acquire() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.wait(func() bool { return c.credits > 0 || c.broken }) {
+		// the remote node is stalled, the stream is canceled
+		c.broken = true
+		c.cancel()
+		return false
+	}
+
+	if c.broken {
+		return false
+	}
+
+	c.credits--
+	return true
+}
+
+// close closes the stream after the remote node has processed the batches sent.
+// The stream is canceled when the remote node does not end it within the stream timeout.
+func (c *streamConn) close() {
+	// the remote node ends the stream once it has processed all the batches
+	if err := c.stream.CloseRequest(); err == nil {
+		c.mu.Lock()
+		if !c.wait(func() bool { return c.broken }) {
+			c.cancel()
+		}
+		c.mu.Unlock()
+	}
+
+	_ = c.stream.CloseResponse()
+	c.cancel()
+}
+
+// wait waits until the given condition is met or the stream timeout elapses.
+// It returns false when the timeout elapses. It must be called with the lock held.
+func (c *streamConn) wait(ready func() bool) bool {
+	if ready() {
+		return true
+	}
+
+	expired := false
+	timer := time.AfterFunc(c.timeout, func() {
+		c.mu.Lock()
+		expired = true
+		c.cond.Broadcast()
+		c.mu.Unlock()
+	})
+	defer timer.Stop()
+
+	for !ready() && !expired {
+		c.cond.Wait()
+	}
+	return ready()
+}
+
+// longLivedStreamHandler lifts the server read and write timeouts of the tell streams
+// which stay open for the lifetime of the connection between two nodes
+func longLivedStreamHandler(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path == internalpbconnect.RemotingServiceRemoteStreamTellProcedure {
+			controller := nethttp.NewResponseController(w)
+			_ = controller.SetReadDeadline(time.Time{})
+			_ = controller.SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isStreamEnd returns true when the given error marks the end of a stream
+func isStreamEnd(err error) bool {
+	return errors.Is(err, io.EOF)
+}
@@ -0,0 +1,508 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb/internalpbconnect"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+// unaryTellHandler only implements the unary RemoteTell and records the messages received
+type unaryTellHandler struct {
+	internalpbconnect.UnimplementedRemotingServiceHandler
+	mu       sync.Mutex
+	messages []*internalpb.RemoteMessage
+}
+
+func (h *unaryTellHandler) RemoteTell(_ context.Context, request *connect.Request[internalpb.RemoteTellRequest]) (*connect.Response[internalpb.RemoteTellResponse], error) {
+	h.mu.Lock()
+	h.messages = append(h.messages, request.Msg.GetRemoteMessages()...)
+	h.mu.Unlock()
+	return connect.NewResponse(new(internalpb.RemoteTellResponse)), nil
+}
+
+func (h *unaryTellHandler) count() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.messages)
+}
+
+// stalledStreamHandler grants the initial credit of a stream and then never grants another one nor ends the stream
+type stalledStreamHandler struct {
+	unaryTellHandler
+}
+
+func (h *stalledStreamHandler) RemoteStreamTell(ctx context.Context, stream *connect.BidiStream[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse]) error {
+	if err := stream.Send(&internalpb.RemoteStreamTellResponse{Credits: 1}); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return nil
+}
+
+// silentStreamHandler accepts the stream but never grants any credit
+type silentStreamHandler struct {
+	unaryTellHandler
+}
+
+func (h *silentStreamHandler) RemoteStreamTell(ctx context.Context, _ *connect.BidiStream[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse]) error {
+	<-ctx.Done()
+	return nil
+}
+
+func TestRemoteStreamTell(t *testing.T) {
+	t.Run("With messages delivered in order", func(t *testing.T) {
+		ctx := context.TODO()
+		host := "127.0.0.1"
+
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithStreaming(), remote.WithStreamWindow(2))),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		const count = 1000
+		actorName := "counter"
+		counter := NewMockCounter(count)
+		_, err = sys.Spawn(ctx, actorName, counter)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingStreaming(), WithRemotingStreamBatchSize(10), WithRemotingStreamWindow(2))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), actorName)
+		require.NoError(t, err)
+
+		for i := 0; i < count/2; i++ {
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		batch := make([]any, 0, count/2)
+		for i := count / 2; i < count; i++ {
+			batch = append(batch, &testpb.TestCount{Value: int32(i)})
+		}
+		require.NoError(t, remoting.RemoteBatchTell(ctx, address.NoSender(), addr, batch))
+
+		for i := 0; i < count; i++ {
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+		}
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With actor to actor messages", func(t *testing.T) {
+		ctx := context.TODO()
+		host := "127.0.0.1"
+
+		sender, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithStreaming())),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sender.Start(ctx))
+
+		receiver, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithStreaming())),
+		)
+		require.NoError(t, err)
+		require.NoError(t, receiver.Start(ctx))
+
+		pause.For(time.Second)
+
+		const count = 100
+		counter := NewMockCounter(count)
+		_, err = receiver.Spawn(ctx, "counter", counter)
+		require.NoError(t, err)
+
+		pid, err := sender.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		addr, err := pid.RemoteLookup(ctx, receiver.Host(), int(receiver.Port()), "counter")
+		require.NoError(t, err)
+
+		for i := 0; i < count; i++ {
+			require.NoError(t, pid.RemoteTell(ctx, address.From(addr), &testpb.TestCount{Value: int32(i)}))
+		}
+
+		for i := 0; i < count; i++ {
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+		}
+
+		require.NoError(t, sender.Stop(ctx))
+		require.NoError(t, receiver.Stop(ctx))
+	})
+	t.Run("With unary fallback", func(t *testing.T) {
+		handler := new(unaryTellHandler)
+		mux := http.NewServeMux()
+		mux.Handle(internalpbconnect.NewRemotingServiceHandler(handler))
+		server := httptest.NewUnstartedServer(h2c.NewHandler(mux, new(http2.Server)))
+		server.Start()
+		defer server.Close()
+
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		port, err := strconv.Atoi(serverURL.Port())
+		require.NoError(t, err)
+
+		ctx := context.TODO()
+		remoting := NewRemoting(WithRemotingStreaming())
+		to := address.New("counter", "test", serverURL.Hostname(), port)
+		for i := 0; i < 10; i++ {
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), to, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		require.Eventually(t, func() bool { return handler.count() == 10 }, 5*time.Second, 10*time.Millisecond)
+		remoting.Close()
+	})
+	t.Run("With unreachable remote node", func(t *testing.T) {
+		var (
+			mu          sync.Mutex
+			deadletters []*internalpb.RemoteMessage
+		)
+
+		ctx := context.TODO()
+		remoting := NewRemoting(
+			WithRemotingStreaming(),
+			withRemotingDeadletter(func(message *internalpb.RemoteMessage, _ error) {
+				mu.Lock()
+				deadletters = append(deadletters, message)
+				mu.Unlock()
+			}))
+
+		// no remote node listens on the port
+		port := dynaport.Get(1)[0]
+		to := address.New("counter", "test", "127.0.0.1", port)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), to, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		// the messages that could not be sent with the unary fallback are sent to the deadletter
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(deadletters) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+		remoting.Close()
+	})
+	t.Run("With stalled remote node", func(t *testing.T) {
+		handler := new(stalledStreamHandler)
+		mux := http.NewServeMux()
+		mux.Handle(internalpbconnect.NewRemotingServiceHandler(handler))
+		server := httptest.NewUnstartedServer(h2c.NewHandler(mux, new(http2.Server)))
+		server.Start()
+		defer server.Close()
+
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		port, err := strconv.Atoi(serverURL.Port())
+		require.NoError(t, err)
+
+		ctx := context.TODO()
+		remoting := NewRemoting(
+			WithRemotingStreaming(),
+			WithRemotingStreamBatchSize(1),
+			WithRemotingStreamTimeout(200*time.Millisecond))
+		to := address.New("counter", "test", serverURL.Hostname(), port)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), to, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		// the batches waiting for a credit are sent one request at a time
+		require.Eventually(t, func() bool { return handler.count() > 0 }, 5*time.Second, 10*time.Millisecond)
+
+		// closing does not wait for the stalled remote node to end the stream
+		closed := make(chan struct{})
+		go func() {
+			remoting.Close()
+			close(closed)
+		}()
+
+		select {
+		case <-closed:
+		case <-time.After(5 * time.Second):
+			t.Fatal("remoting close is blocked by the stalled stream")
+		}
+	})
+	t.Run("With remote node never granting credits", func(t *testing.T) {
+		handler := new(silentStreamHandler)
+		mux := http.NewServeMux()
+		mux.Handle(internalpbconnect.NewRemotingServiceHandler(handler))
+		server := httptest.NewUnstartedServer(h2c.NewHandler(mux, new(http2.Server)))
+		server.Start()
+		defer server.Close()
+
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		port, err := strconv.Atoi(serverURL.Port())
+		require.NoError(t, err)
+
+		ctx := context.TODO()
+		remoting := NewRemoting(WithRemotingStreaming(), WithRemotingStreamTimeout(200*time.Millisecond))
+		to := address.New("counter", "test", serverURL.Hostname(), port)
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), to, &testpb.TestCount{Value: 1}))
+
+		// the stream is canceled once the stream timeout elapses and the message is sent in a single request
+		require.Eventually(t, func() bool { return handler.count() == 1 }, 5*time.Second, 10*time.Millisecond)
+		remoting.Close()
+	})
+	t.Run("With idle stream evicted", func(t *testing.T) {
+		ctx := context.TODO()
+		host := "127.0.0.1"
+
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithStreaming())),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		counter := NewMockCounter(2)
+		_, err = sys.Spawn(ctx, "counter", counter)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingStreaming(), WithRemotingStreamIdleTimeout(200*time.Millisecond))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		streams := func() int {
+			remoting.streamsLock.Lock()
+			defer remoting.streamsLock.Unlock()
+			return len(remoting.streams)
+		}
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestCount{Value: int32(i)}))
+			require.Equal(t, 1, streams())
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+
+			// the stream is evicted once idle and reopened with the next message
+			require.Eventually(t, func() bool { return streams() == 0 }, 5*time.Second, 10*time.Millisecond)
+		}
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With messages sent while the stream is evicted delivered in order", func(t *testing.T) {
+		ctx := context.TODO()
+		host := "127.0.0.1"
+
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithStreaming())),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		const count = 500
+		counter := NewMockCounter(count)
+		_, err = sys.Spawn(ctx, "counter", counter)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingStreaming(), WithRemotingStreamBatchSize(10), WithRemotingStreamIdleTimeout(5*time.Millisecond))
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		// the stream is evicted and reopened many times while the messages are sent
+		for i := 0; i < count; i++ {
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestCount{Value: int32(i)}))
+			if i%25 == 0 {
+				pause.For(10 * time.Millisecond)
+			}
+		}
+
+		for i := 0; i < count; i++ {
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+		}
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With remote node shutdown", func(t *testing.T) {
+		ctx := context.TODO()
+		host := "127.0.0.1"
+
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithStreaming())),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		counter := NewMockCounter(1)
+		_, err = sys.Spawn(ctx, "counter", counter)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(WithRemotingStreaming())
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), "counter")
+		require.NoError(t, err)
+
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, &testpb.TestCount{Value: 1}))
+		select {
+		case value := <-counter.received:
+			assert.EqualValues(t, 1, value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		// the open stream does not hold the remote node shutdown
+		stopCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		require.NoError(t, sys.Stop(stopCtx))
+
+		remoting.Close()
+	})
+	t.Run("With stream kept open on grain deactivation", func(t *testing.T) {
+		ctx := context.TODO()
+		host := "127.0.0.1"
+
+		sender, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithStreaming())),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sender.Start(ctx))
+
+		receiver, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, dynaport.Get(1)[0], remote.WithStreaming())),
+		)
+		require.NoError(t, err)
+		require.NoError(t, receiver.Start(ctx))
+
+		pause.For(time.Second)
+
+		counter := NewMockCounter(2)
+		_, err = receiver.Spawn(ctx, "counter", counter)
+		require.NoError(t, err)
+
+		pid, err := sender.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		addr, err := pid.RemoteLookup(ctx, receiver.Host(), int(receiver.Port()), "counter")
+		require.NoError(t, err)
+
+		require.NoError(t, pid.RemoteTell(ctx, address.From(addr), &testpb.TestCount{Value: 0}))
+		select {
+		case value := <-counter.received:
+			require.EqualValues(t, 0, value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		remoting := sender.(*actorSystem).getRemoting()
+		key := net.JoinHostPort(receiver.Host(), strconv.Itoa(int(receiver.Port())))
+		remoting.streamsLock.Lock()
+		stream, ok := remoting.streams[key]
+		remoting.streamsLock.Unlock()
+		require.True(t, ok)
+
+		identity, err := sender.GrainIdentity(ctx, "grain", func(context.Context) (Grain, error) {
+			return NewMockGrain(), nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, sender.TellGrain(ctx, identity, new(goaktpb.PoisonPill)))
+		require.Eventually(t, func() bool {
+			_, ok := sender.(*actorSystem).getGrains().Get(*identity)
+			return !ok
+		}, 5*time.Second, 10*time.Millisecond)
+
+		// the grain deactivation does not close the streams of the actor system
+		remoting.streamsLock.Lock()
+		current, ok := remoting.streams[key]
+		remoting.streamsLock.Unlock()
+		require.True(t, ok)
+		require.Same(t, stream, current)
+
+		require.NoError(t, pid.RemoteTell(ctx, address.From(addr), &testpb.TestCount{Value: 1}))
+		select {
+		case value := <-counter.received:
+			require.EqualValues(t, 1, value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		require.NoError(t, sender.Stop(ctx))
+		require.NoError(t, receiver.Stop(ctx))
+	})
+}
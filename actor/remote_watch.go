@@ -0,0 +1,134 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"sync"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/collection"
+)
+
+// terminatedManifest is the manifest of the Terminated messages sent by the remote nodes
+var terminatedManifest = string(new(goaktpb.Terminated).ProtoReflect().Descriptor().FullName())
+
+// remoteWatch is an actor watching another actor located on a remote node
+type remoteWatch struct {
+	watcher *PID
+	watchee *address.Address
+	// node is the peers address of the node hosting the watched actor.
+	// It is only set when both nodes are in the same cluster.
+	node string
+}
+
+// remoteWatcher is an actor located on a remote node watching a local actor
+type remoteWatcher struct {
+	address *address.Address
+	// node is the peers address of the node hosting the watcher.
+	// It is only set when both nodes are in the same cluster.
+	node string
+}
+
+// remoteWatchKey identifies a remote watch
+type remoteWatchKey struct {
+	watcher string
+	watchee string
+}
+
+// remoteWatches keeps track of the actors watched on remote nodes so that
+// their Terminated message is synthesized when their node leaves the cluster
+type remoteWatches struct {
+	mu      sync.Mutex
+	watches map[remoteWatchKey]*remoteWatch
+}
+
+// newRemoteWatches creates an instance of remoteWatches
+func newRemoteWatches() *remoteWatches {
+	return &remoteWatches{
+		watches: make(map[remoteWatchKey]*remoteWatch),
+	}
+}
+
+// newRemoteWatchers creates the set of remote actors watching a local actor, keyed by address
+func newRemoteWatchers() *collection.Map[string, *remoteWatcher] {
+	return collection.NewMap[string, *remoteWatcher]()
+}
+
+// removeRemoteWatchers removes the remote watchers hosted on the given node
+func removeRemoteWatchers(watchers *collection.Map[string, *remoteWatcher], node string) {
+	var left []string
+	watchers.Range(func(key string, watcher *remoteWatcher) {
+		if watcher.node != "" && watcher.node == node {
+			left = append(left, key)
+		}
+	})
+
+	for _, key := range left {
+		watchers.Delete(key)
+	}
+}
+
+// add records the given remote watch
+func (w *remoteWatches) add(watch *remoteWatch) {
+	w.mu.Lock()
+	w.watches[remoteWatchKey{watcher: watch.watcher.ID(), watchee: watch.watchee.String()}] = watch
+	w.mu.Unlock()
+}
+
+// remove removes the remote watch of the given watcher and watchee
+func (w *remoteWatches) remove(watcher, watchee string) {
+	w.mu.Lock()
+	delete(w.watches, remoteWatchKey{watcher: watcher, watchee: watchee})
+	w.mu.Unlock()
+}
+
+// removeWatcher removes and returns the remote watches of the given watcher
+func (w *remoteWatches) removeWatcher(watcher string) []*remoteWatch {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var watches []*remoteWatch
+	for key, watch := range w.watches {
+		if key.watcher == watcher {
+			watches = append(watches, watch)
+			delete(w.watches, key)
+		}
+	}
+	return watches
+}
+
+// removeNode removes and returns the remote watches of the actors hosted on the given node
+func (w *remoteWatches) removeNode(node string) []*remoteWatch {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var watches []*remoteWatch
+	for key, watch := range w.watches {
+		if watch.node != "" && watch.node == node {
+			watches = append(watches, watch)
+			delete(w.watches, key)
+		}
+	}
+	return watches
+}
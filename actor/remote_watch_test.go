@@ -0,0 +1,233 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+)
+
+func TestRemoteWatch(t *testing.T) {
+	newSystem := func(t *testing.T, name string) ActorSystem {
+		sys, err := NewActorSystem(
+			name,
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0])),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(context.TODO()))
+		return sys
+	}
+
+	t.Run("With remote actor stopped", func(t *testing.T) {
+		ctx := context.TODO()
+		sys1 := newSystem(t, "sys1")
+		sys2 := newSystem(t, "sys2")
+		pause.For(time.Second)
+
+		watcher := NewMockWatcher()
+		watcherPID, err := sys1.Spawn(ctx, "watcher", watcher)
+		require.NoError(t, err)
+
+		watchedPID, err := sys2.Spawn(ctx, "watched", NewMockActor())
+		require.NoError(t, err)
+
+		require.NoError(t, watcherPID.RemoteWatch(ctx, watchedPID.Address()))
+		assert.EqualValues(t, 1, watchedPID.remoteWatchers.Len())
+
+		require.NoError(t, watchedPID.Shutdown(ctx))
+
+		select {
+		case terminated := <-watcher.terminated:
+			assert.Equal(t, watchedPID.ID(), terminated.GetActorId())
+			assert.Equal(t, goaktpb.TerminationReason_TERMINATION_REASON_STOPPED, terminated.GetReason())
+		case <-time.After(5 * time.Second):
+			t.Fatal("terminated message not received")
+		}
+
+		require.Eventually(t, func() bool {
+			return len(sys1.getRemoteWatches().removeWatcher(watcherPID.ID())) == 0
+		}, time.Second, 10*time.Millisecond)
+
+		require.NoError(t, sys1.Stop(ctx))
+		require.NoError(t, sys2.Stop(ctx))
+	})
+	t.Run("With remote actor not found", func(t *testing.T) {
+		ctx := context.TODO()
+		sys1 := newSystem(t, "sys1")
+		sys2 := newSystem(t, "sys2")
+		pause.For(time.Second)
+
+		watcherPID, err := sys1.Spawn(ctx, "watcher", NewMockWatcher())
+		require.NoError(t, err)
+
+		to := address.New("missing", sys2.Name(), sys2.Host(), sys2.Port())
+		err = watcherPID.RemoteWatch(ctx, to)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrActorNotFound)
+		assert.Empty(t, sys1.getRemoteWatches().removeWatcher(watcherPID.ID()))
+
+		require.NoError(t, sys1.Stop(ctx))
+		require.NoError(t, sys2.Stop(ctx))
+	})
+	t.Run("With remote actor unwatched", func(t *testing.T) {
+		ctx := context.TODO()
+		sys1 := newSystem(t, "sys1")
+		sys2 := newSystem(t, "sys2")
+		pause.For(time.Second)
+
+		watcher := NewMockWatcher()
+		watcherPID, err := sys1.Spawn(ctx, "watcher", watcher)
+		require.NoError(t, err)
+
+		watchedPID, err := sys2.Spawn(ctx, "watched", NewMockActor())
+		require.NoError(t, err)
+
+		require.NoError(t, watcherPID.RemoteWatch(ctx, watchedPID.Address()))
+		require.NoError(t, watcherPID.RemoteUnWatch(ctx, watchedPID.Address()))
+		assert.Zero(t, watchedPID.remoteWatchers.Len())
+
+		require.NoError(t, watchedPID.Shutdown(ctx))
+
+		select {
+		case <-watcher.terminated:
+			t.Fatal("unexpected terminated message")
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		require.NoError(t, sys1.Stop(ctx))
+		require.NoError(t, sys2.Stop(ctx))
+	})
+	t.Run("With watcher stopped", func(t *testing.T) {
+		ctx := context.TODO()
+		sys1 := newSystem(t, "sys1")
+		sys2 := newSystem(t, "sys2")
+		pause.For(time.Second)
+
+		watcherPID, err := sys1.Spawn(ctx, "watcher", NewMockWatcher())
+		require.NoError(t, err)
+
+		watchedPID, err := sys2.Spawn(ctx, "watched", NewMockActor())
+		require.NoError(t, err)
+
+		require.NoError(t, watcherPID.RemoteWatch(ctx, watchedPID.Address()))
+		assert.EqualValues(t, 1, watchedPID.remoteWatchers.Len())
+
+		require.NoError(t, watcherPID.Shutdown(ctx))
+		assert.Zero(t, watchedPID.remoteWatchers.Len())
+
+		require.NoError(t, sys1.Stop(ctx))
+		require.NoError(t, sys2.Stop(ctx))
+	})
+	t.Run("With remoting disabled", func(t *testing.T) {
+		ctx := context.TODO()
+		sys, err := NewActorSystem("test", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pid, err := sys.Spawn(ctx, "watcher", NewMockWatcher())
+		require.NoError(t, err)
+
+		to := address.New("watched", "remote", "127.0.0.1", 9000)
+		assert.ErrorIs(t, pid.RemoteWatch(ctx, to), ErrRemotingDisabled)
+		assert.ErrorIs(t, pid.RemoteUnWatch(ctx, to), ErrRemotingDisabled)
+
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With node left", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String(), withoutTestRelocation())
+		node2, sd2 := testCluster(t, srv.Addr().String(), withoutTestRelocation())
+		pause.For(time.Second)
+
+		watcher := NewMockWatcher()
+		watcherPID, err := node1.Spawn(ctx, "watcher", watcher)
+		require.NoError(t, err)
+
+		watchedPID, err := node2.Spawn(ctx, "watched", NewMockActor())
+		require.NoError(t, err)
+
+		require.NoError(t, watcherPID.RemoteWatch(ctx, watchedPID.Address()))
+
+		// simulate a node crash: the watched actor does not get the chance to notify its watchers
+		watchedPID.remoteWatchers.Reset()
+		require.NoError(t, node2.Stop(ctx))
+
+		select {
+		case terminated := <-watcher.terminated:
+			assert.Equal(t, watchedPID.ID(), terminated.GetActorId())
+			assert.Equal(t, goaktpb.TerminationReason_TERMINATION_REASON_NODE_LEFT, terminated.GetReason())
+		case <-time.After(10 * time.Second):
+			t.Fatal("terminated message not received")
+		}
+
+		require.NoError(t, node1.Stop(ctx))
+		assert.NoError(t, sd2.Close())
+		assert.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With watcher node left", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		node1, sd1 := testCluster(t, srv.Addr().String(), withoutTestRelocation())
+		node2, sd2 := testCluster(t, srv.Addr().String(), withoutTestRelocation())
+		pause.For(time.Second)
+
+		watcherPID, err := node1.Spawn(ctx, "watcher", NewMockWatcher())
+		require.NoError(t, err)
+
+		watchedPID, err := node2.Spawn(ctx, "watched", NewMockActor())
+		require.NoError(t, err)
+
+		require.NoError(t, watcherPID.RemoteWatch(ctx, watchedPID.Address()))
+		require.EqualValues(t, 1, watchedPID.remoteWatchers.Len())
+
+		// simulate a node crash: the watcher does not get the chance to stop watching
+		watcherPID.ActorSystem().(*actorSystem).remoteWatches.removeWatcher(watcherPID.ID())
+		require.NoError(t, node1.Stop(ctx))
+
+		// the watchers hosted on the left node are dropped
+		require.Eventually(t, func() bool { return watchedPID.remoteWatchers.Len() == 0 }, 10*time.Second, 100*time.Millisecond)
+
+		require.NoError(t, node2.Stop(ctx))
+		assert.NoError(t, sd1.Close())
+		assert.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
+}
@@ -29,20 +29,26 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"net"
 	nethttp "net/http"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"connectrpc.com/connect"
 	"go.akshayshah.org/connectproto"
+	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/internal/compression"
 	"github.com/tochemey/goakt/v3/internal/http"
 	"github.com/tochemey/goakt/v3/internal/internalpb"
 	"github.com/tochemey/goakt/v3/internal/internalpb/internalpbconnect"
+	"github.com/tochemey/goakt/v3/internal/serialization"
 	"github.com/tochemey/goakt/v3/remote"
 )
 
@@ -70,12 +76,154 @@ func WithRemotingMaxReadFameSize(size int) RemotingOption {
 	}
 }
 
+// WithRemotingContextPropagator sets the propagator used to carry the sender's context
+// (e.g. the W3C trace context) along with the remote messages.
+// When not set the globally registered OpenTelemetry propagator is used.
+func WithRemotingContextPropagator(propagator propagation.TextMapPropagator) RemotingOption {
+	return func(r *Remoting) {
+		r.propagator = propagator
+	}
+}
+
+// WithRemotingCompression sets the compression algorithm used to compress the outgoing requests.
+// Compressed responses are accepted regardless of this setting.
+func WithRemotingCompression(compression remote.Compression) RemotingOption {
+	return func(r *Remoting) {
+		r.compression = compression
+	}
+}
+
+// WithRemotingCompressMinSize sets the minimum size in bytes of a request to be compressed.
+// Smaller requests are sent uncompressed.
+func WithRemotingCompressMinSize(size int) RemotingOption {
+	return func(r *Remoting) {
+		r.compressMinSize = size
+	}
+}
+
+// WithRemotingSerializer registers the serializer used to serialize the messages of the same type
+// as the given message. It must match the serializer registered on the remote actor system with remote.WithSerializer.
+// Protocol buffers messages without a registered serializer are serialized with remote.ProtoSerializer.
+func WithRemotingSerializer(message any, serializer remote.Serializer) RemotingOption {
+	return func(r *Remoting) {
+		if r.serializerTypes == nil {
+			r.serializerTypes = make(map[reflect.Type]remote.Serializer)
+		}
+		r.serializerTypes[reflect.TypeOf(message)] = serializer
+	}
+}
+
+// WithRemotingStreaming sends the messages to remote actors without expecting a reply over a long-lived
+// bidirectional stream per remote node instead of one request per message.
+// Messages are batched on the wire and delivered in the order they are sent. When the remote node does not support
+// streaming or the stream fails, messages are sent one request at a time. Delivery failures on the remote node,
+// e.g. an actor not found, are not reported to the sender.
+func WithRemotingStreaming() RemotingOption {
+	return func(r *Remoting) {
+		r.streaming = true
+	}
+}
+
+// WithRemotingStreamBatchSize sets the maximum number of messages sent in a single batch over a stream
+func WithRemotingStreamBatchSize(size int) RemotingOption {
+	return func(r *Remoting) {
+		r.streamBatchSize = size
+	}
+}
+
+// WithRemotingStreamWindow sets the number of batches queued per remote node before the senders wait.
+// The number of in-flight batches is granted by the remote node.
+func WithRemotingStreamWindow(window int) RemotingOption {
+	return func(r *Remoting) {
+		r.streamWindow = window
+	}
+}
+
+// WithRemotingStreamTimeout sets how long a stream waits for the remote node to grant credits or to acknowledge
+// the end of the stream. The stream is canceled when the timeout elapses and the pending messages are sent one request at a time.
+func WithRemotingStreamTimeout(timeout time.Duration) RemotingOption {
+	return func(r *Remoting) {
+		r.streamTimeout = timeout
+	}
+}
+
+// WithRemotingStreamIdleTimeout sets how long a stream or an outbound queue stays open without any message sent to the remote node.
+// The idle stream or queue is closed, which frees the ones of the remote nodes that have left, and a new one is opened
+// with the next message sent to the remote node.
+func WithRemotingStreamIdleTimeout(timeout time.Duration) RemotingOption {
+	return func(r *Remoting) {
+		r.streamIdleTimeout = timeout
+	}
+}
+
+// WithRemotingCredentials sets the credentials attached to the requests sent to the remote actor systems.
+// They are required when the remote actor system verifies its callers with remote.WithAuthenticator.
+func WithRemotingCredentials(credentials remote.Credentials) RemotingOption {
+	return func(r *Remoting) {
+		r.credentials = credentials
+	}
+}
+
+// WithRemotingOutboundQueue sends the messages to remote actors without expecting a reply asynchronously.
+// The messages are queued per remote node, up to the given size, and the given policy decides what happens
+// to a message sent to a remote node whose queue is full. The queue of a remote node is released once idle,
+// see WithRemotingStreamIdleTimeout. See remote.WithOutboundQueue.
+func WithRemotingOutboundQueue(size int, policy remote.OverflowPolicy) RemotingOption {
+	return func(r *Remoting) {
+		r.outboundQueueSize = size
+		r.overflowPolicy = policy
+	}
+}
+
+// WithRemotingChunkSize splits the messages whose serialized size exceeds the given size into chunks of that size.
+// The remote actor system reassembles them before they reach the remote actor. See remote.WithChunkSize.
+func WithRemotingChunkSize(size int) RemotingOption {
+	return func(r *Remoting) {
+		r.chunkSize = size
+	}
+}
+
+// withRemotingDeadletter sets the function receiving the messages that could not be delivered
+func withRemotingDeadletter(deadletter func(message *internalpb.RemoteMessage, err error)) RemotingOption {
+	return func(r *Remoting) {
+		r.deadletter = deadletter
+	}
+}
+
+// withRemotingSerializers sets the serializers shared with the actor system
+func withRemotingSerializers(serializers *serialization.Registry) RemotingOption {
+	return func(r *Remoting) {
+		r.serializers = serializers
+	}
+}
+
 // Remoting defines the Remoting APIs
 // This requires Remoting is enabled on the connected actor system
 type Remoting struct {
 	client           *nethttp.Client
 	clientTLS        *tls.Config
 	maxReadFrameSize int
+	propagator       propagation.TextMapPropagator
+	compression      remote.Compression
+	compressMinSize  int
+	serializerTypes  map[reflect.Type]remote.Serializer
+	serializers      *serialization.Registry
+	streaming        bool
+	streamBatchSize  int
+	streamWindow     int
+	streamTimeout    time.Duration
+	credentials      remote.Credentials
+	chunkSize        int
+	streams          map[string]*tellStream
+	streamsLock      sync.Mutex
+
+	streamIdleTimeout time.Duration
+
+	outboundQueueSize int
+	overflowPolicy    remote.OverflowPolicy
+	outboundQueues    map[string]*outboundQueue
+	outboundLock      sync.Mutex
+	deadletter        func(message *internalpb.RemoteMessage, err error)
 }
 
 // NewRemoting creates an instance Remoting with an insecure connection. To use a secure connection
@@ -87,6 +235,15 @@ type Remoting struct {
 func NewRemoting(opts ...RemotingOption) *Remoting {
 	r := &Remoting{
 		maxReadFrameSize: DefaultMaxReadFrameSize,
+		compression:      remote.NoCompression,
+		compressMinSize:  DefaultCompressMinSize,
+		streamBatchSize:  DefaultStreamBatchSize,
+		streamWindow:     DefaultStreamWindow,
+		streamTimeout:    DefaultStreamTimeout,
+		streams:          make(map[string]*tellStream),
+		outboundQueues:   make(map[string]*outboundQueue),
+
+		streamIdleTimeout: DefaultStreamIdleTimeout,
 	}
 
 	// apply the options
@@ -94,49 +251,66 @@ func NewRemoting(opts ...RemotingOption) *Remoting {
 		opt(r)
 	}
 
+	if r.serializers == nil {
+		r.serializers = serialization.NewRegistry(r.serializerTypes)
+	}
+
 	r.client = http.NewClient(uint32(DefaultMaxReadFrameSize))
 	if r.clientTLS != nil {
 		r.client = http.NewTLSClient(r.clientTLS, uint32(r.maxReadFrameSize)) // nolint
 	}
+
+	if r.credentials != nil {
+		r.client.Transport = &credentialsTransport{
+			base:        r.client.Transport,
+			credentials: r.credentials,
+		}
+	}
 	return r
 }
 
 // RemoteTell sends a message to an actor remotely without expecting any reply
-func (r *Remoting) RemoteTell(ctx context.Context, from, to *address.Address, message proto.Message) error {
-	marshaled, err := anypb.New(message)
+func (r *Remoting) RemoteTell(ctx context.Context, from, to *address.Address, message any) error {
+	marshaled, err := r.serializers.Serialize(message)
 	if err != nil {
 		return NewErrInvalidMessage(err)
 	}
 
-	remoteClient := r.remotingServiceClient(to.GetHost(), int(to.GetPort()))
-	request := connect.NewRequest(&internalpb.RemoteTellRequest{
-		RemoteMessages: []*internalpb.RemoteMessage{
-			{
-				Sender:   from.Address,
-				Receiver: to.Address,
-				Message:  marshaled,
-			},
+	if err := r.sendChunks(ctx, to.GetHost(), int(to.GetPort()), marshaled); err != nil {
+		return err
+	}
+
+	return r.tell(ctx, to.GetHost(), int(to.GetPort()), []*internalpb.RemoteMessage{
+		{
+			Sender:   from.Address,
+			Receiver: to.Address,
+			Payload:  marshaled,
+			Headers:  injectContext(ctx, r.propagator),
+			Metadata: metadataValues(ctx),
 		},
 	})
-
-	_, err = remoteClient.RemoteTell(ctx, request)
-	return err
 }
 
 // RemoteAsk sends a synchronous message to another actor remotely and expect a response.
-func (r *Remoting) RemoteAsk(ctx context.Context, from, to *address.Address, message proto.Message, timeout time.Duration) (response *anypb.Any, err error) {
-	marshaled, err := anypb.New(message)
+func (r *Remoting) RemoteAsk(ctx context.Context, from, to *address.Address, message any, timeout time.Duration) (response any, err error) {
+	marshaled, err := r.serializers.Serialize(message)
 	if err != nil {
 		return nil, NewErrInvalidMessage(err)
 	}
 
+	if err := r.sendChunks(ctx, to.GetHost(), int(to.GetPort()), marshaled); err != nil {
+		return nil, err
+	}
+
 	remoteClient := r.remotingServiceClient(to.GetHost(), int(to.GetPort()))
 	request := connect.NewRequest(&internalpb.RemoteAskRequest{
 		RemoteMessages: []*internalpb.RemoteMessage{
 			{
 				Sender:   from.Address,
 				Receiver: to.Address,
-				Message:  marshaled,
+				Payload:  marshaled,
+				Headers:  injectContext(ctx, r.propagator),
+				Metadata: metadataValues(ctx),
 			},
 		},
 		Timeout: durationpb.New(timeout),
@@ -147,11 +321,12 @@ func (r *Remoting) RemoteAsk(ctx context.Context, from, to *address.Address, mes
 		return nil, err
 	}
 
-	if resp != nil {
-		for _, msg := range resp.Msg.GetMessages() {
-			response = msg
-			break
+	if resp != nil && len(resp.Msg.GetPayloads()) > 0 {
+		reply := resp.Msg.GetPayloads()[0]
+		if err := r.fetchChunks(ctx, to.GetHost(), int(to.GetPort()), reply); err != nil {
+			return nil, err
 		}
+		return r.serializers.Deserialize(reply)
 	}
 
 	return
@@ -181,41 +356,58 @@ func (r *Remoting) RemoteLookup(ctx context.Context, host string, port int, name
 }
 
 // RemoteBatchTell sends bulk asynchronous messages to an actor
-func (r *Remoting) RemoteBatchTell(ctx context.Context, from, to *address.Address, messages []proto.Message) error {
-	remoteClient := r.remotingServiceClient(to.GetHost(), int(to.GetPort()))
+func (r *Remoting) RemoteBatchTell(ctx context.Context, from, to *address.Address, messages []any) error {
 	remoteMessages := make([]*internalpb.RemoteMessage, 0, len(messages))
+	headers := injectContext(ctx, r.propagator)
+	metadata := metadataValues(ctx)
 	for _, message := range messages {
 		if message != nil {
-			packed, _ := anypb.New(message)
+			packed, err := r.serializers.Serialize(message)
+			if err != nil {
+				return NewErrInvalidMessage(err)
+			}
+
+			if err := r.sendChunks(ctx, to.GetHost(), int(to.GetPort()), packed); err != nil {
+				return err
+			}
+
 			remoteMessages = append(remoteMessages, &internalpb.RemoteMessage{
 				Sender:   from.Address,
 				Receiver: to.Address,
-				Message:  packed,
+				Payload:  packed,
+				Headers:  headers,
+				Metadata: metadata,
 			})
 		}
 	}
 
-	_, err := remoteClient.RemoteTell(ctx, connect.NewRequest(&internalpb.RemoteTellRequest{
-		RemoteMessages: remoteMessages,
-	}))
-	return err
+	return r.tell(ctx, to.GetHost(), int(to.GetPort()), remoteMessages)
 }
 
 // RemoteBatchAsk sends bulk messages to an actor with responses expected
-func (r *Remoting) RemoteBatchAsk(ctx context.Context, from, to *address.Address, messages []proto.Message, timeout time.Duration) (responses []*anypb.Any, err error) {
+func (r *Remoting) RemoteBatchAsk(ctx context.Context, from, to *address.Address, messages []any, timeout time.Duration) (responses []any, err error) {
 	remoteClient := r.remotingServiceClient(to.GetHost(), int(to.GetPort()))
 
 	remoteMessages := make([]*internalpb.RemoteMessage, 0, len(messages))
+	headers := injectContext(ctx, r.propagator)
+	metadata := metadataValues(ctx)
 	for _, message := range messages {
 		if message != nil {
-			packed, err := anypb.New(message)
+			packed, err := r.serializers.Serialize(message)
 			if err != nil {
 				return nil, NewErrInvalidMessage(err)
 			}
+
+			if err := r.sendChunks(ctx, to.GetHost(), int(to.GetPort()), packed); err != nil {
+				return nil, err
+			}
+
 			remoteMessages = append(remoteMessages, &internalpb.RemoteMessage{
 				Sender:   from.Address,
 				Receiver: to.Address,
-				Message:  packed,
+				Payload:  packed,
+				Headers:  headers,
+				Metadata: metadata,
 			})
 		}
 	}
@@ -230,13 +422,24 @@ func (r *Remoting) RemoteBatchAsk(ctx context.Context, from, to *address.Address
 	}
 
 	if resp != nil {
-		responses = append(responses, resp.Msg.GetMessages()...)
+		for _, msg := range resp.Msg.GetPayloads() {
+			if err := r.fetchChunks(ctx, to.GetHost(), int(to.GetPort()), msg); err != nil {
+				return nil, err
+			}
+
+			response, err := r.serializers.Deserialize(msg)
+			if err != nil {
+				return nil, err
+			}
+			responses = append(responses, response)
+		}
 	}
 
 	return
 }
 
-// RemoteSpawn creates an actor on a remote node. The given actor needs to be registered on the remote node using the Register method of ActorSystem
+// RemoteSpawn creates an actor on a remote node. The given actor needs to be registered on the remote node using the Register method of ActorSystem,
+// or its factory using the RegisterFactory method of ActorSystem when the request names a factory
 func (r *Remoting) RemoteSpawn(ctx context.Context, host string, port int, spawnRequest *remote.SpawnRequest) error {
 	if err := spawnRequest.Validate(); err != nil {
 		return fmt.Errorf("invalid spawn option: %w", err)
@@ -256,6 +459,11 @@ func (r *Remoting) RemoteSpawn(ctx context.Context, host string, port int, spawn
 		}
 	}
 
+	args, err := marshalFactoryArgs(spawnRequest.Args)
+	if err != nil {
+		return err
+	}
+
 	remoteClient := r.remotingServiceClient(host, port)
 	request := connect.NewRequest(
 		&internalpb.RemoteSpawnRequest{
@@ -268,6 +476,10 @@ func (r *Remoting) RemoteSpawn(ctx context.Context, host string, port int, spawn
 			PassivationStrategy: marshalPassivationStrategy(spawnRequest.PassivationStrategy),
 			Dependencies:        dependencies,
 			EnableStash:         spawnRequest.EnableStashing,
+			SnapshotInterval:    spawnRequest.SnapshotInterval,
+			Factory:             spawnRequest.Factory,
+			Args:                args,
+			Role:                spawnRequest.Role,
 		},
 	)
 
@@ -280,6 +492,16 @@ func (r *Remoting) RemoteSpawn(ctx context.Context, host string, port int, spawn
 			if strings.Contains(e.Error(), ErrTypeNotRegistered.Error()) {
 				return ErrTypeNotRegistered
 			}
+			if strings.Contains(e.Error(), ErrFactoryNotRegistered.Error()) {
+				return ErrFactoryNotRegistered
+			}
+		}
+		if code == connect.CodeAlreadyExists {
+			var connectErr *connect.Error
+			errors.As(err, &connectErr)
+			if strings.Contains(connectErr.Unwrap().Error(), ErrSingletonAlreadyExists.Error()) {
+				return ErrSingletonAlreadyExists
+			}
 		}
 		return err
 	}
@@ -362,11 +584,137 @@ func (r *Remoting) MaxReadFrameSize() int {
 	return r.maxReadFrameSize
 }
 
-// Close closes the serviceClient connection
+// OutboundQueues returns the state of the outbound queue of every remote node messages were recently sent to.
+// The queues of the remote nodes no message has been sent to for the stream idle timeout are evicted.
+// It is empty when the outbound queues are not enabled.
+func (r *Remoting) OutboundQueues() []OutboundQueueStats {
+	r.outboundLock.Lock()
+	defer r.outboundLock.Unlock()
+	stats := make([]OutboundQueueStats, 0, len(r.outboundQueues))
+	for _, queue := range r.outboundQueues {
+		stats = append(stats, queue.stats())
+	}
+	return stats
+}
+
+// Close closes the serviceClient connection.
+// The messages queued on the outbound queues and the streams are sent before they are closed.
 func (r *Remoting) Close() {
+	r.outboundLock.Lock()
+	queues := make([]*outboundQueue, 0, len(r.outboundQueues))
+	for _, queue := range r.outboundQueues {
+		queues = append(queues, queue)
+	}
+	r.outboundLock.Unlock()
+
+	for _, queue := range queues {
+		r.evictOutboundQueue(queue)
+	}
+
+	r.streamsLock.Lock()
+	streams := make([]*tellStream, 0, len(r.streams))
+	for _, stream := range r.streams {
+		streams = append(streams, stream)
+	}
+	r.streamsLock.Unlock()
+
+	for _, stream := range streams {
+		r.evictStream(stream)
+	}
+
 	r.client.CloseIdleConnections()
 }
 
+// tell sends the given messages to a remote node without expecting any reply,
+// through the outbound queue of the remote node when the outbound queues are enabled
+func (r *Remoting) tell(ctx context.Context, host string, port int, messages []*internalpb.RemoteMessage) error {
+	if r.outboundQueueSize > 0 {
+		return r.outboundQueue(host, port).send(ctx, messages)
+	}
+	return r.deliver(ctx, host, port, messages)
+}
+
+// deliver sends the given messages to a remote node,
+// over the stream of the remote node when streaming is enabled
+func (r *Remoting) deliver(ctx context.Context, host string, port int, messages []*internalpb.RemoteMessage) error {
+	if !r.streaming {
+		return r.unaryTell(ctx, host, port, messages)
+	}
+	return r.tellStream(host, port).send(ctx, messages)
+}
+
+// unaryTell sends the given messages to a remote node in a single request
+func (r *Remoting) unaryTell(ctx context.Context, host string, port int, messages []*internalpb.RemoteMessage) error {
+	remoteClient := r.remotingServiceClient(host, port)
+	_, err := remoteClient.RemoteTell(ctx, connect.NewRequest(&internalpb.RemoteTellRequest{
+		RemoteMessages: messages,
+	}))
+	return err
+}
+
+// tellStream returns the stream of the given remote node, creating it when needed
+func (r *Remoting) tellStream(host string, port int) *tellStream {
+	key := net.JoinHostPort(host, strconv.Itoa(port))
+
+	r.streamsLock.Lock()
+	defer r.streamsLock.Unlock()
+	stream, ok := r.streams[key]
+	if !ok {
+		stream = newTellStream(r, host, port)
+		r.streams[key] = stream
+	}
+	return stream
+}
+
+// evictStream stops the given stream and removes it from the streams of the remote nodes.
+// The stream is only removed once its queued messages are written, so that a new stream
+// opened to the remote node cannot send messages overtaking them
+func (r *Remoting) evictStream(stream *tellStream) {
+	stream.stop()
+
+	key := net.JoinHostPort(stream.host, strconv.Itoa(stream.port))
+	r.streamsLock.Lock()
+	if r.streams[key] == stream {
+		delete(r.streams, key)
+	}
+	r.streamsLock.Unlock()
+}
+
+// outboundQueue returns the outbound queue of the given remote node, creating it when needed
+func (r *Remoting) outboundQueue(host string, port int) *outboundQueue {
+	key := net.JoinHostPort(host, strconv.Itoa(port))
+
+	r.outboundLock.Lock()
+	defer r.outboundLock.Unlock()
+	queue, ok := r.outboundQueues[key]
+	if !ok {
+		queue = newOutboundQueue(r, host, port)
+		r.outboundQueues[key] = queue
+	}
+	return queue
+}
+
+// evictOutboundQueue stops the given outbound queue and removes it from the outbound queues of the remote nodes.
+// The queue is only removed once its queued messages are sent, so that a new queue
+// created for the remote node cannot send messages overtaking them
+func (r *Remoting) evictOutboundQueue(queue *outboundQueue) {
+	queue.stop()
+
+	key := net.JoinHostPort(queue.host, strconv.Itoa(queue.port))
+	r.outboundLock.Lock()
+	if r.outboundQueues[key] == queue {
+		delete(r.outboundQueues, key)
+	}
+	r.outboundLock.Unlock()
+}
+
+// toDeadletter hands over a message that could not be delivered to the deadletter, when set
+func (r *Remoting) toDeadletter(message *internalpb.RemoteMessage, err error) {
+	if r.deadletter != nil {
+		r.deadletter(message, err)
+	}
+}
+
 // remotingServiceClient returns a Remoting service client instance
 func (r *Remoting) remotingServiceClient(host string, port int) internalpbconnect.RemotingServiceClient {
 	endpoint := http.URL(host, port)
@@ -374,14 +722,35 @@ func (r *Remoting) remotingServiceClient(host string, port int) internalpbconnec
 		endpoint = http.URLs(host, port)
 	}
 
-	return internalpbconnect.NewRemotingServiceClient(
-		r.client,
-		endpoint,
+	opts := []connect.ClientOption{
 		connect.WithSendMaxBytes(r.maxReadFrameSize),
 		connect.WithReadMaxBytes(r.maxReadFrameSize),
 		connectproto.WithBinary(
 			proto.MarshalOptions{},
 			proto.UnmarshalOptions{DiscardUnknown: true},
 		),
-	)
+	}
+
+	opts = append(opts, compression.ClientOptions(r.compression, r.compressMinSize)...)
+	return internalpbconnect.NewRemotingServiceClient(r.client, endpoint, opts...)
+}
+
+// clusterServiceClient returns a cluster service client instance
+func (r *Remoting) clusterServiceClient(host string, port int) internalpbconnect.ClusterServiceClient {
+	endpoint := http.URL(host, port)
+	if r.clientTLS != nil {
+		endpoint = http.URLs(host, port)
+	}
+
+	opts := []connect.ClientOption{
+		connect.WithSendMaxBytes(r.maxReadFrameSize),
+		connect.WithReadMaxBytes(r.maxReadFrameSize),
+		connectproto.WithBinary(
+			proto.MarshalOptions{},
+			proto.UnmarshalOptions{DiscardUnknown: true},
+		),
+	}
+
+	opts = append(opts, compression.ClientOptions(r.compression, r.compressMinSize)...)
+	return internalpbconnect.NewClusterServiceClient(r.client, endpoint, opts...)
 }
@@ -27,6 +27,7 @@ package actor
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"testing"
 	"time"
 
@@ -298,7 +299,7 @@ func TestRemoteTell(t *testing.T) {
 		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), actorName)
 		require.NoError(t, err)
 		// create a message to send to the test actor
-		messages := make([]proto.Message, 10)
+		messages := make([]any, 10)
 		// send the message to the actor
 		for i := 0; i < 10; i++ {
 			messages[i] = new(testpb.TestSend)
@@ -364,7 +365,7 @@ func TestRemoteTell(t *testing.T) {
 		// create a message to send to the test actor
 		message := new(testpb.TestSend)
 		// send the message to the actor
-		err = remoting.RemoteBatchTell(ctx, from, address.From(addr), []proto.Message{message})
+		err = remoting.RemoteBatchTell(ctx, from, address.From(addr), []any{message})
 		// perform some assertions
 		require.Error(t, err)
 
@@ -418,7 +419,7 @@ func TestRemoteTell(t *testing.T) {
 		// create a message to send to the test actor
 		message := new(testpb.TestSend)
 		// send the message to the actor
-		err = remoting.RemoteBatchTell(ctx, from, addr, []proto.Message{message})
+		err = remoting.RemoteBatchTell(ctx, from, addr, []any{message})
 		// perform some assertions
 		require.Error(t, err)
 
@@ -531,7 +532,7 @@ func TestRemoteTell(t *testing.T) {
 		// create a message to send to the test actor
 		message := new(testpb.TestSend)
 		// send the message to the actor
-		err = remoting.RemoteBatchTell(ctx, from, addr, []proto.Message{message})
+		err = remoting.RemoteBatchTell(ctx, from, addr, []any{message})
 		// perform some assertions
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "not found")
@@ -658,11 +659,9 @@ func TestRemoteAsk(t *testing.T) {
 		// perform some assertions
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		require.True(t, reply.MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), reply)
 
-		actual := new(testpb.Reply)
-		err = reply.UnmarshalTo(actual)
-		require.NoError(t, err)
+		actual := reply.(*testpb.Reply)
 
 		expected := &testpb.Reply{Content: "received message"}
 		assert.True(t, proto.Equal(expected, actual))
@@ -880,16 +879,14 @@ func TestRemoteAsk(t *testing.T) {
 		// create a message to send to the test actor
 		message := new(testpb.TestReply)
 		// send the message to the actor
-		replies, err := remoting.RemoteBatchAsk(ctx, from, addr, []proto.Message{message}, time.Minute)
+		replies, err := remoting.RemoteBatchAsk(ctx, from, addr, []any{message}, time.Minute)
 		// perform some assertions
 		require.NoError(t, err)
 		require.Len(t, replies, 1)
 		require.NotNil(t, replies[0])
-		require.True(t, replies[0].MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), replies[0])
 
-		actual := new(testpb.Reply)
-		err = replies[0].UnmarshalTo(actual)
-		require.NoError(t, err)
+		actual := replies[0].(*testpb.Reply)
 
 		expected := &testpb.Reply{Content: "received message"}
 		assert.True(t, proto.Equal(expected, actual))
@@ -946,7 +943,7 @@ func TestRemoteAsk(t *testing.T) {
 		// create a message to send to the test actor
 		message := new(testpb.TestReply)
 		// send the message to the actor
-		reply, err := remoting.RemoteBatchAsk(ctx, from, address.From(addr), []proto.Message{message}, time.Minute)
+		reply, err := remoting.RemoteBatchAsk(ctx, from, address.From(addr), []any{message}, time.Minute)
 		// perform some assertions
 		require.Error(t, err)
 		require.Nil(t, reply)
@@ -1005,7 +1002,7 @@ func TestRemoteAsk(t *testing.T) {
 		// create a message to send to the test actor
 		message := new(testpb.TestReply)
 		// send the message to the actor
-		reply, err := remoting.RemoteBatchAsk(ctx, from, addr, []proto.Message{message}, time.Minute)
+		reply, err := remoting.RemoteBatchAsk(ctx, from, addr, []any{message}, time.Minute)
 		// perform some assertions
 		require.Error(t, err)
 		require.Nil(t, reply)
@@ -1122,7 +1119,7 @@ func TestRemoteAsk(t *testing.T) {
 		// create a message to send to the test actor
 		message := new(testpb.TestReply)
 		// send the message to the actor
-		reply, err := remoting.RemoteBatchAsk(ctx, from, addr, []proto.Message{message}, time.Minute)
+		reply, err := remoting.RemoteBatchAsk(ctx, from, addr, []any{message}, time.Minute)
 		// perform some assertions
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "not found")
@@ -1194,11 +1191,9 @@ func TestRemoteAsk(t *testing.T) {
 		// perform some assertions
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		require.True(t, reply.MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), reply)
 
-		actual := new(testpb.Reply)
-		err = reply.UnmarshalTo(actual)
-		require.NoError(t, err)
+		actual := reply.(*testpb.Reply)
 
 		expected := &testpb.Reply{Content: "received message"}
 		assert.True(t, proto.Equal(expected, actual))
@@ -1212,6 +1207,58 @@ func TestRemoteAsk(t *testing.T) {
 	})
 }
 
+func TestRemotingCompression(t *testing.T) {
+	testCases := []struct {
+		name   string
+		server remote.Compression
+		client remote.Compression
+	}{
+		{name: "With gzip compression", server: remote.GzipCompression, client: remote.GzipCompression},
+		{name: "With zstd compression", server: remote.ZstdCompression, client: remote.ZstdCompression},
+		{name: "With compression negotiated", server: remote.NoCompression, client: remote.ZstdCompression},
+		{name: "With uncompressed client", server: remote.ZstdCompression, client: remote.NoCompression},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.TODO()
+			remotingPort := dynaport.Get(1)[0]
+			host := "127.0.0.1"
+
+			sys, err := NewActorSystem(
+				"test",
+				WithLogger(log.DiscardLogger),
+				WithRemote(remote.NewConfig(host, remotingPort,
+					remote.WithCompression(tc.server),
+					remote.WithCompressMinSize(0))),
+			)
+			require.NoError(t, err)
+			require.NoError(t, sys.Start(ctx))
+
+			pause.For(time.Second)
+
+			actorName := "test"
+			_, err = sys.Spawn(ctx, actorName, NewMockActor())
+			require.NoError(t, err)
+
+			remoting := NewRemoting(WithRemotingCompression(tc.client), WithRemotingCompressMinSize(0))
+			addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), actorName)
+			require.NoError(t, err)
+
+			reply, err := remoting.RemoteAsk(ctx, address.NoSender(), addr, new(testpb.TestReply), time.Minute)
+			require.NoError(t, err)
+
+			actual := reply.(*testpb.Reply)
+			assert.True(t, proto.Equal(&testpb.Reply{Content: "received message"}, actual))
+
+			require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, new(testpb.TestSend)))
+
+			remoting.Close()
+			require.NoError(t, sys.Stop(ctx))
+		})
+	}
+}
+
 func TestRemotingLookup(t *testing.T) {
 	t.Run("When remoting is not enabled", func(t *testing.T) {
 		// create the context
@@ -1757,11 +1804,9 @@ func TestRemotingSpawn(t *testing.T) {
 
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		require.True(t, reply.MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), reply)
 
-		actual := new(testpb.Reply)
-		err = reply.UnmarshalTo(actual)
-		require.NoError(t, err)
+		actual := reply.(*testpb.Reply)
 
 		expected := new(testpb.Reply)
 		assert.True(t, proto.Equal(expected, actual))
@@ -2058,11 +2103,9 @@ func TestRemotingSpawn(t *testing.T) {
 
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		require.True(t, reply.MessageIs(new(testpb.Reply)))
+		require.IsType(t, new(testpb.Reply), reply)
 
-		actual := new(testpb.Reply)
-		err = reply.UnmarshalTo(actual)
-		require.NoError(t, err)
+		actual := reply.(*testpb.Reply)
 
 		expected := new(testpb.Reply)
 		assert.True(t, proto.Equal(expected, actual))
@@ -2169,6 +2212,97 @@ func TestRemotingSpawn(t *testing.T) {
 		err = remoting.RemoteSpawn(ctx, host, remotingPort, request)
 		require.Error(t, err)
 
+		remoting.Close()
+		err = sys.Stop(ctx)
+		require.NoError(t, err)
+	})
+	t.Run("With actor factory", func(t *testing.T) {
+		// create the context
+		ctx := context.TODO()
+		// define the logger to use
+		logger := log.DiscardLogger
+		// generate the remoting port
+		ports := dynaport.Get(1)
+		remotingPort := ports[0]
+		host := "127.0.0.1"
+
+		// create the actor system with the actor factory
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(logger),
+			WithRemote(remote.NewConfig(host, remotingPort)),
+			WithActorFactory("factory", mockActorFactory),
+		)
+		// assert there are no error
+		require.NoError(t, err)
+
+		// start the actor system
+		err = sys.Start(ctx)
+		assert.NoError(t, err)
+
+		actorName := uuid.NewString()
+		remoting := NewRemoting()
+
+		// spawn the remote actor with the factory arguments
+		request := &remote.SpawnRequest{
+			Name:    actorName,
+			Factory: "factory",
+			Args:    &testpb.TestLog{Text: "hello"},
+		}
+		err = remoting.RemoteSpawn(ctx, host, remotingPort, request)
+		require.NoError(t, err)
+
+		addr, err := remoting.RemoteLookup(ctx, host, remotingPort, actorName)
+		require.NoError(t, err)
+		require.False(t, addr.Equals(address.NoSender()))
+
+		// the actor replies with the greeting received by the factory
+		reply, err := remoting.RemoteAsk(ctx, address.NoSender(), addr, new(testpb.TestReply), time.Minute)
+		require.NoError(t, err)
+		require.IsType(t, new(testpb.Reply), reply)
+		assert.Equal(t, "hello", reply.(*testpb.Reply).GetContent())
+
+		pid, err := sys.LocalActor(actorName)
+		require.NoError(t, err)
+		assert.Equal(t, "factory", pid.factory)
+
+		remoting.Close()
+		err = sys.Stop(ctx)
+		require.NoError(t, err)
+	})
+	t.Run("When actor factory not registered", func(t *testing.T) {
+		// create the context
+		ctx := context.TODO()
+		// define the logger to use
+		logger := log.DiscardLogger
+		// generate the remoting port
+		ports := dynaport.Get(1)
+		remotingPort := ports[0]
+		host := "127.0.0.1"
+
+		// create the actor system
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(logger),
+			WithRemote(remote.NewConfig(host, remotingPort)),
+		)
+		// assert there are no error
+		require.NoError(t, err)
+
+		// start the actor system
+		err = sys.Start(ctx)
+		assert.NoError(t, err)
+
+		remoting := NewRemoting()
+		request := &remote.SpawnRequest{
+			Name:    uuid.NewString(),
+			Factory: "factory",
+			Args:    &testpb.TestLog{Text: "hello"},
+		}
+		err = remoting.RemoteSpawn(ctx, host, remotingPort, request)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrFactoryNotRegistered)
+
 		remoting.Close()
 		err = sys.Stop(ctx)
 		require.NoError(t, err)
@@ -2306,3 +2440,124 @@ func TestRemotingReinstate(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestRemotingSerializer(t *testing.T) {
+	t.Run("With plain Go messages", func(t *testing.T) {
+		ctx := context.TODO()
+		remotingPort := dynaport.Get(1)[0]
+		host := "127.0.0.1"
+
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, remotingPort,
+				remote.WithSerializer(MockGreeting{}, remote.NewJSONSerializer()),
+				remote.WithSerializer(new(MockGreeting), remote.NewJSONSerializer()),
+				remote.WithSerializer(new(MockGreetingReply), remote.NewJSONSerializer()))),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		actorName := "greeter"
+		greeter := NewMockGreeter()
+		_, err = sys.Spawn(ctx, actorName, greeter)
+		require.NoError(t, err)
+
+		remoting := NewRemoting(
+			WithRemotingSerializer(MockGreeting{}, remote.NewJSONSerializer()),
+			WithRemotingSerializer(new(MockGreeting), remote.NewJSONSerializer()),
+			WithRemotingSerializer(new(MockGreetingReply), remote.NewJSONSerializer()),
+		)
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), actorName)
+		require.NoError(t, err)
+
+		require.NoError(t, remoting.RemoteTell(ctx, address.NoSender(), addr, MockGreeting{Name: "remote"}))
+		select {
+		case name := <-greeter.received:
+			assert.Equal(t, "remote", name)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		reply, err := remoting.RemoteAsk(ctx, address.NoSender(), addr, &MockGreeting{Name: "remote"}, time.Minute)
+		require.NoError(t, err)
+		require.IsType(t, new(MockGreetingReply), reply)
+		assert.Equal(t, "hello remote", reply.(*MockGreetingReply).Text)
+
+		replies, err := remoting.RemoteBatchAsk(ctx, address.NoSender(), addr, []any{&MockGreeting{Name: "batch"}}, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, replies, 1)
+		assert.Equal(t, "hello batch", replies[0].(*MockGreetingReply).Text)
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With local plain Go messages", func(t *testing.T) {
+		ctx := context.TODO()
+		sys, err := NewActorSystem("test", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		greeter := NewMockGreeter()
+		pid, err := sys.Spawn(ctx, "greeter", greeter)
+		require.NoError(t, err)
+
+		require.NoError(t, Tell(ctx, pid, MockGreeting{Name: "local"}))
+		select {
+		case name := <-greeter.received:
+			assert.Equal(t, "local", name)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		reply, err := Ask(ctx, pid, &MockGreeting{Name: "local"}, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, &MockGreetingReply{Text: "hello local"}, reply)
+
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With unregistered plain Go message", func(t *testing.T) {
+		ctx := context.TODO()
+		remotingPort := dynaport.Get(1)[0]
+		host := "127.0.0.1"
+
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig(host, remotingPort)),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		pause.For(time.Second)
+
+		actorName := "greeter"
+		_, err = sys.Spawn(ctx, actorName, NewMockGreeter())
+		require.NoError(t, err)
+
+		remoting := NewRemoting()
+		addr, err := remoting.RemoteLookup(ctx, sys.Host(), int(sys.Port()), actorName)
+		require.NoError(t, err)
+
+		err = remoting.RemoteTell(ctx, address.NoSender(), addr, MockGreeting{Name: "remote"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, remote.ErrSerializerNotFound))
+
+		remoting.Close()
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("With invalid serializers", func(t *testing.T) {
+		sys, err := NewActorSystem(
+			"test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0],
+				remote.WithSerializer(MockGreeting{}, nil))),
+		)
+		require.Error(t, err)
+		require.Nil(t, sys)
+	})
+}
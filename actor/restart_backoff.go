@@ -0,0 +1,177 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+)
+
+// restartBackoff defines the exponential backoff applied between
+// the consecutive restarts of a faulty actor
+type restartBackoff struct {
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	randomFactor float64
+	resetAfter   time.Duration
+}
+
+// newRestartBackoff creates an instance of restartBackoff
+func newRestartBackoff(minBackoff, maxBackoff time.Duration, randomFactor float64, resetAfter time.Duration) *restartBackoff {
+	return &restartBackoff{
+		minBackoff:   max(minBackoff, 0),
+		maxBackoff:   max(maxBackoff, minBackoff, 0),
+		randomFactor: max(randomFactor, 0),
+		resetAfter:   max(resetAfter, 0),
+	}
+}
+
+// restartBackoffFromProto creates an instance of restartBackoff from its wire representation.
+// It returns nil when no backoff is set
+func restartBackoffFromProto(backoff *internalpb.RestartBackoff) *restartBackoff {
+	if backoff == nil {
+		return nil
+	}
+	return newRestartBackoff(
+		time.Duration(backoff.GetMinBackoff()),
+		time.Duration(backoff.GetMaxBackoff()),
+		backoff.GetRandomFactor(),
+		time.Duration(backoff.GetResetAfter()))
+}
+
+// toProto returns the wire representation of the backoff
+func (x *restartBackoff) toProto() *internalpb.RestartBackoff {
+	if x == nil {
+		return nil
+	}
+	return &internalpb.RestartBackoff{
+		MinBackoff:   int64(x.minBackoff),
+		MaxBackoff:   int64(x.maxBackoff),
+		RandomFactor: x.randomFactor,
+		ResetAfter:   int64(x.resetAfter),
+	}
+}
+
+// delay returns the delay to wait before the given restart attempt. The first attempt is 1.
+func (x *restartBackoff) delay(attempt uint32) time.Duration {
+	backoff := float64(x.minBackoff) * math.Pow(2, float64(max(attempt, 1)-1))
+	backoff = min(backoff, float64(x.maxBackoff))
+	return time.Duration(backoff * (1 + rand.Float64()*x.randomFactor)) // nolint
+}
+
+// nextRestartAttempt returns the number of the next restart attempt of the actor.
+// The attempts are reset when the actor has been running without failing for the backoff reset duration
+// since its last restart. A zero reset duration never resets the attempts.
+func (pid *PID) nextRestartAttempt(resetAfter time.Duration) uint32 {
+	restartedAt := pid.restartedAt.Load()
+	if resetAfter > 0 && !restartedAt.IsZero() && time.Since(restartedAt) >= resetAfter {
+		pid.restartAttempts.Store(0)
+	}
+	return pid.restartAttempts.Inc()
+}
+
+// nextRestartRetry returns the number of restarts of the actor within the given retry window, the next one
+// included. The window opens with the first restart following the previous window.
+func (pid *PID) nextRestartRetry(window time.Duration) uint32 {
+	windowStart := pid.retryWindowStart.Load()
+	if windowStart.IsZero() || time.Since(windowStart) > window {
+		pid.retryWindowStart.Store(time.Now())
+		pid.restartRetries.Store(0)
+	}
+	return pid.restartRetries.Inc()
+}
+
+// resetRestarts forgets the restarts of the actor
+func (pid *PID) resetRestarts() {
+	pid.restartAttempts.Store(0)
+	pid.restartRetries.Store(0)
+	pid.retryWindowStart.Store(time.Time{})
+	pid.restartedAt.Store(time.Time{})
+}
+
+// handleBackoffRestart restarts the given faulty child actor once the backoff delay has elapsed.
+// When the restart fails, another restart is scheduled after the next backoff delay.
+// The child actor is stopped when it is restarted more than maxRetries times within the retry timeout window,
+// or more than maxRetries consecutive times when no timeout is set.
+func (pid *PID) handleBackoffRestart(cid *PID, maxRetries uint32, timeout time.Duration, backoff *restartBackoff) {
+	ctx := context.Background()
+	cid.restarting.Store(true)
+
+	attempt := cid.nextRestartAttempt(backoff.resetAfter)
+	// the actor is no longer running since its last restart
+	cid.restartedAt.Store(time.Time{})
+
+	if maxRetries > 0 {
+		retries := attempt
+		if timeout > 0 {
+			retries = cid.nextRestartRetry(timeout)
+		}
+
+		if retries > maxRetries {
+			pid.logger.Warnf("actor=(%s) exceeded the maximum number of restarts (%d)", cid.Name(), maxRetries)
+			cid.restarting.Store(false)
+			cid.resetRestarts()
+			if err := cid.Shutdown(ctx); err != nil {
+				pid.logger.Error(err)
+				// we need to suspend the actor since it is faulty
+				cid.suspend(err.Error())
+			}
+			return
+		}
+	}
+
+	delay := backoff.delay(attempt)
+	pid.logger.Infof("actor=(%s) restart attempt=%d scheduled in %s", cid.Name(), attempt, delay)
+	if cid.eventsStream != nil {
+		cid.eventsStream.Publish(eventsTopic, &goaktpb.ActorRestartScheduled{
+			Address:     cid.Address().Address,
+			Attempt:     attempt,
+			Delay:       durationpb.New(delay),
+			ScheduledAt: timestamppb.Now(),
+		})
+	}
+
+	time.AfterFunc(delay, func() {
+		// the actor has been stopped while waiting to be restarted
+		if !cid.restarting.Load() {
+			return
+		}
+
+		if err := cid.Restart(ctx); err != nil {
+			pid.logger.Errorf("failed to restart actor=(%s): %v", cid.Name(), err)
+			pid.handleBackoffRestart(cid, maxRetries, timeout, backoff)
+			return
+		}
+		cid.restartedAt.Store(time.Now())
+	})
+}
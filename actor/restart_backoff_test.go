@@ -0,0 +1,269 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestRestartBackoff(t *testing.T) {
+	t.Run("With exponential delay", func(t *testing.T) {
+		backoff := newRestartBackoff(100*time.Millisecond, time.Second, 0, time.Minute)
+		assert.Equal(t, 100*time.Millisecond, backoff.delay(1))
+		assert.Equal(t, 200*time.Millisecond, backoff.delay(2))
+		assert.Equal(t, 400*time.Millisecond, backoff.delay(3))
+		assert.Equal(t, 800*time.Millisecond, backoff.delay(4))
+		assert.Equal(t, time.Second, backoff.delay(5))
+		assert.Equal(t, time.Second, backoff.delay(20))
+	})
+	t.Run("With random factor", func(t *testing.T) {
+		backoff := newRestartBackoff(100*time.Millisecond, time.Second, 0.5, time.Minute)
+		for range 10 {
+			delay := backoff.delay(2)
+			assert.GreaterOrEqual(t, delay, 200*time.Millisecond)
+			assert.LessOrEqual(t, delay, 300*time.Millisecond)
+		}
+	})
+	t.Run("With invalid settings", func(t *testing.T) {
+		backoff := newRestartBackoff(time.Second, time.Millisecond, -1, -1)
+		assert.Equal(t, time.Second, backoff.maxBackoff)
+		assert.Zero(t, backoff.randomFactor)
+		assert.Zero(t, backoff.resetAfter)
+	})
+	t.Run("With no reset duration", func(t *testing.T) {
+		pid := new(PID)
+
+		for attempt := range uint32(3) {
+			assert.EqualValues(t, attempt+1, pid.nextRestartAttempt(0))
+			pid.restartedAt.Store(time.Now().Add(-time.Hour))
+		}
+
+		// a reset duration resets the attempts once elapsed
+		assert.EqualValues(t, 1, pid.nextRestartAttempt(time.Minute))
+	})
+	t.Run("With reset duration elapsed without a successful restart", func(t *testing.T) {
+		pid := new(PID)
+		assert.EqualValues(t, 1, pid.nextRestartAttempt(time.Millisecond))
+
+		// the actor has not been running since it failed, the attempts are not reset
+		pause.For(10 * time.Millisecond)
+		assert.EqualValues(t, 2, pid.nextRestartAttempt(time.Millisecond))
+
+		// the actor has been running for the reset duration since its last restart
+		pid.restartedAt.Store(time.Now().Add(-time.Second))
+		assert.EqualValues(t, 1, pid.nextRestartAttempt(time.Millisecond))
+	})
+	t.Run("With retry window", func(t *testing.T) {
+		pid := new(PID)
+		assert.EqualValues(t, 1, pid.nextRestartRetry(time.Minute))
+		assert.EqualValues(t, 2, pid.nextRestartRetry(time.Minute))
+
+		// the restarts before the window are not counted
+		pid.retryWindowStart.Store(time.Now().Add(-time.Hour))
+		assert.EqualValues(t, 1, pid.nextRestartRetry(time.Minute))
+	})
+	t.Run("With proto conversion", func(t *testing.T) {
+		backoff := newRestartBackoff(100*time.Millisecond, time.Second, 0.2, time.Minute)
+		assert.Equal(t, backoff, restartBackoffFromProto(backoff.toProto()))
+
+		var empty *restartBackoff
+		assert.Nil(t, empty.toProto())
+		assert.Nil(t, restartBackoffFromProto(nil))
+	})
+	t.Run("With OneForOneStrategy", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		subscriber, err := actorSystem.Subscribe()
+		require.NoError(t, err)
+
+		supervisor := NewSupervisor(
+			WithDirective(&PanicError{}, RestartDirective),
+			WithRestartBackoff(300*time.Millisecond, time.Second, 0, time.Minute))
+
+		parent, err := actorSystem.Spawn(ctx, "parent", NewMockSupervisor())
+		require.NoError(t, err)
+
+		child, err := parent.SpawnChild(ctx, "child", NewMockSupervised(), WithSupervisor(supervisor))
+		require.NoError(t, err)
+
+		pause.For(500 * time.Millisecond)
+
+		require.NoError(t, Tell(ctx, child, new(testpb.TestPanic)))
+		pause.For(100 * time.Millisecond)
+
+		// the actor is waiting to be restarted
+		assert.True(t, child.restarting.Load())
+		_, err = Ask(ctx, child, new(testpb.TestReply), 100*time.Millisecond)
+		require.Error(t, err)
+
+		pause.For(time.Second)
+		assert.False(t, child.restarting.Load())
+		assert.True(t, child.IsRunning())
+		assert.EqualValues(t, 1, child.restartAttempts.Load())
+
+		// a second failure doubles the delay
+		require.NoError(t, Tell(ctx, child, new(testpb.TestPanic)))
+		pause.For(time.Second)
+		assert.True(t, child.IsRunning())
+		assert.EqualValues(t, 2, child.restartAttempts.Load())
+
+		_, err = Ask(ctx, child, new(testpb.TestReply), time.Second)
+		require.NoError(t, err)
+
+		var events []*goaktpb.ActorRestartScheduled
+		for message := range subscriber.Iterator() {
+			if event, ok := message.Payload().(*goaktpb.ActorRestartScheduled); ok {
+				events = append(events, event)
+			}
+		}
+
+		require.Len(t, events, 2)
+		assert.Equal(t, child.Name(), events[0].GetAddress().GetName())
+		assert.EqualValues(t, 1, events[0].GetAttempt())
+		assert.Equal(t, 300*time.Millisecond, events[0].GetDelay().AsDuration())
+		assert.EqualValues(t, 2, events[1].GetAttempt())
+		assert.Equal(t, 600*time.Millisecond, events[1].GetDelay().AsDuration())
+
+		require.NoError(t, actorSystem.Unsubscribe(subscriber))
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With maximum retries exceeded", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		supervisor := NewSupervisor(
+			WithDirective(&PanicError{}, RestartDirective),
+			WithRetry(1, time.Second),
+			WithRestartBackoff(100*time.Millisecond, time.Second, 0, time.Minute))
+
+		parent, err := actorSystem.Spawn(ctx, "parent", NewMockSupervisor())
+		require.NoError(t, err)
+
+		child, err := parent.SpawnChild(ctx, "child", NewMockSupervised(), WithSupervisor(supervisor))
+		require.NoError(t, err)
+
+		pause.For(500 * time.Millisecond)
+
+		require.NoError(t, Tell(ctx, child, new(testpb.TestPanic)))
+		pause.For(500 * time.Millisecond)
+		require.True(t, child.IsRunning())
+
+		require.NoError(t, Tell(ctx, child, new(testpb.TestPanic)))
+		pause.For(500 * time.Millisecond)
+		assert.False(t, child.IsRunning())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With restarts spread beyond the retry window", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		supervisor := NewSupervisor(
+			WithDirective(&PanicError{}, RestartDirective),
+			WithRetry(1, 300*time.Millisecond),
+			WithRestartBackoff(100*time.Millisecond, time.Second, 0, time.Minute))
+
+		parent, err := actorSystem.Spawn(ctx, "parent", NewMockSupervisor())
+		require.NoError(t, err)
+
+		child, err := parent.SpawnChild(ctx, "child", NewMockSupervised(), WithSupervisor(supervisor))
+		require.NoError(t, err)
+
+		pause.For(500 * time.Millisecond)
+
+		// every failure happens in a new retry window, the actor keeps being restarted
+		for range 2 {
+			require.NoError(t, Tell(ctx, child, new(testpb.TestPanic)))
+			pause.For(500 * time.Millisecond)
+			require.True(t, child.IsRunning())
+		}
+
+		// the backoff is not reset, the delay keeps growing
+		assert.EqualValues(t, 2, child.restartAttempts.Load())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With OneForAllStrategy", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		supervisor := NewSupervisor(
+			WithStrategy(OneForAllStrategy),
+			WithDirective(&PanicError{}, RestartDirective),
+			WithRestartBackoff(300*time.Millisecond, time.Second, 0, time.Minute))
+
+		parent, err := actorSystem.Spawn(ctx, "parent", NewMockSupervisor())
+		require.NoError(t, err)
+
+		child1, err := parent.SpawnChild(ctx, "child1", NewMockSupervised(), WithSupervisor(supervisor))
+		require.NoError(t, err)
+		child2, err := parent.SpawnChild(ctx, "child2", NewMockSupervised(), WithSupervisor(supervisor))
+		require.NoError(t, err)
+
+		pause.For(500 * time.Millisecond)
+
+		require.NoError(t, Tell(ctx, child1, new(testpb.TestPanic)))
+		pause.For(100 * time.Millisecond)
+
+		assert.True(t, child1.restarting.Load())
+		assert.True(t, child2.restarting.Load())
+
+		pause.For(time.Second)
+		for _, child := range []*PID{child1, child2} {
+			assert.False(t, child.restarting.Load())
+			assert.True(t, child.IsRunning())
+			assert.EqualValues(t, 1, child.restartAttempts.Load())
+		}
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+}
@@ -89,12 +89,12 @@ func TestRouter(t *testing.T) {
 		reply, err := Ask(ctx, workerOneRef, new(testpb.TestGetCount), time.Minute)
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 
 		reply, err = Ask(ctx, workerTwoRef, new(testpb.TestGetCount), time.Minute)
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 
 		t.Cleanup(func() {
 			assert.NoError(t, system.Stop(ctx))
@@ -196,7 +196,7 @@ func TestRouter(t *testing.T) {
 		reply, err := Ask(ctx, workerOneRef, new(testpb.TestGetCount), time.Minute)
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 
 		t.Cleanup(func() {
 			assert.NoError(t, system.Stop(ctx))
@@ -309,7 +309,7 @@ func TestRouter(t *testing.T) {
 		reply, err := Ask(ctx, workerOneRef, new(testpb.TestGetCount), time.Minute)
 		require.NoError(t, err)
 		require.NotNil(t, reply)
-		assert.True(t, proto.Equal(expected, reply))
+		assert.True(t, proto.Equal(expected, reply.(proto.Message)))
 
 		t.Cleanup(func() {
 			assert.NoError(t, system.Stop(ctx))
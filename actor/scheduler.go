@@ -33,8 +33,8 @@ import (
 	"github.com/reugn/go-quartz/job"
 	quartzlogger "github.com/reugn/go-quartz/logger"
 	"github.com/reugn/go-quartz/quartz"
+	"go.opentelemetry.io/otel/propagation"
 	"go.uber.org/atomic"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/tochemey/goakt/v3/address"
 	"github.com/tochemey/goakt/v3/internal/collection"
@@ -52,6 +52,14 @@ func withSchedulerRemoting(remoting *Remoting) schedulerOption {
 	}
 }
 
+// withSchedulerContextPropagator sets the propagator used to carry
+// the scheduling context to the scheduled messages
+func withSchedulerContextPropagator(propagator propagation.TextMapPropagator) schedulerOption {
+	return func(scheduler *scheduler) {
+		scheduler.propagator = propagator
+	}
+}
+
 // scheduler defines the Go-Akt scheduler.
 // Its job is to help stack messages that will be delivered in the future to actors.
 type scheduler struct {
@@ -67,6 +75,8 @@ type scheduler struct {
 	shutdownTimeout time.Duration
 	// remoting engine
 	remoting *Remoting
+	// propagator carries the scheduling context to the scheduled messages
+	propagator propagation.TextMapPropagator
 	// specifies the job keys mapping
 	scheduledKeys *collection.Map[string, *quartz.JobKey]
 }
@@ -132,7 +142,8 @@ func (x *scheduler) Stop(ctx context.Context) {
 // This is a fire-and-forget scheduling mechanism — once delivered, the message will not be retried or repeated.
 //
 // Parameters:
-//   - message: The proto.Message to be sent.
+//   - ctx: The scheduling context. Its propagated values (e.g. the trace context) are carried to each delivery.
+//   - message: The message to be sent.
 //   - pid: The PID of the actor that will receive the message.
 //   - delay: The duration to wait before delivering the message.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -143,7 +154,7 @@ func (x *scheduler) Stop(ctx context.Context) {
 // Note:
 //   - It's strongly recommended to set a unique reference ID using WithReference if you intend to cancel, pause, or resume the message later.
 //   - If no reference is set, an automatic one will be generated, which may not be easily retrievable.
-func (x *scheduler) ScheduleOnce(message proto.Message, pid *PID, delay time.Duration, opts ...ScheduleOption) error {
+func (x *scheduler) ScheduleOnce(ctx context.Context, message any, pid *PID, delay time.Duration, opts ...ScheduleOption) error {
 	x.mu.Lock()
 	defer x.mu.Unlock()
 
@@ -154,8 +165,10 @@ func (x *scheduler) ScheduleOnce(message proto.Message, pid *PID, delay time.Dur
 	senderConfig := newScheduleConfig(opts...)
 	sender := senderConfig.Sender()
 
+	headers := injectContext(ctx, x.propagator)
 	job := job.NewFunctionJob(
 		func(ctx context.Context) (bool, error) {
+			ctx = extractContext(ctx, x.propagator, headers)
 			var err error
 			if !sender.Equals(NoSender) {
 				err = sender.Tell(ctx, pid, message)
@@ -180,7 +193,8 @@ func (x *scheduler) ScheduleOnce(message proto.Message, pid *PID, delay time.Dur
 // after the specified interval. The scheduling continues until explicitly canceled or if the actor is no longer available.
 //
 // Parameters:
-//   - message: The proto.Message to be delivered at regular intervals.
+//   - ctx: The scheduling context. Its propagated values (e.g. the trace context) are carried to each delivery.
+//   - message: The message to be delivered at regular intervals.
 //   - pid: The PID of the actor that will receive the message.
 //   - interval: The time duration between each delivery of the message.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -192,7 +206,7 @@ func (x *scheduler) ScheduleOnce(message proto.Message, pid *PID, delay time.Dur
 //   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 //   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for later operations.
 //   - This function does not provide built-in delivery guarantees such as at-least-once or exactly-once semantics; ensure idempotency where needed.
-func (x *scheduler) Schedule(message proto.Message, pid *PID, interval time.Duration, opts ...ScheduleOption) error {
+func (x *scheduler) Schedule(ctx context.Context, message any, pid *PID, interval time.Duration, opts ...ScheduleOption) error {
 	x.mu.Lock()
 	defer x.mu.Unlock()
 
@@ -203,8 +217,10 @@ func (x *scheduler) Schedule(message proto.Message, pid *PID, interval time.Dura
 	senderConfig := newScheduleConfig(opts...)
 	sender := senderConfig.Sender()
 
+	headers := injectContext(ctx, x.propagator)
 	job := job.NewFunctionJob(
 		func(ctx context.Context) (bool, error) {
+			ctx = extractContext(ctx, x.propagator, headers)
 			var err error
 			if !sender.Equals(NoSender) {
 				err = sender.Tell(ctx, pid, message)
@@ -229,7 +245,8 @@ func (x *scheduler) Schedule(message proto.Message, pid *PID, interval time.Dura
 // The message will be sent to the target actor according to the schedule defined by the cron expression.
 //
 // Parameters:
-//   - message: The proto.Message to be delivered.
+//   - ctx: The scheduling context. Its propagated values (e.g. the trace context) are carried to each delivery.
+//   - message: The message to be delivered.
 //   - pid: The PID of the actor that will receive the message.
 //   - cronExpression: A standard cron-formatted string (e.g., "0 */5 * * * *") representing the schedule.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -241,7 +258,7 @@ func (x *scheduler) Schedule(message proto.Message, pid *PID, interval time.Dura
 //   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 //   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for future operations.
 //   - The cron expression must follow the format supported by the scheduler (typically 6 or 5 fields depending on implementation).
-func (x *scheduler) ScheduleWithCron(message proto.Message, pid *PID, cronExpression string, opts ...ScheduleOption) error {
+func (x *scheduler) ScheduleWithCron(ctx context.Context, message any, pid *PID, cronExpression string, opts ...ScheduleOption) error {
 	x.mu.Lock()
 	defer x.mu.Unlock()
 	if !x.started.Load() {
@@ -251,8 +268,10 @@ func (x *scheduler) ScheduleWithCron(message proto.Message, pid *PID, cronExpres
 	senderConfig := newScheduleConfig(opts...)
 	sender := senderConfig.Sender()
 
+	headers := injectContext(ctx, x.propagator)
 	job := job.NewFunctionJob(
 		func(ctx context.Context) (bool, error) {
+			ctx = extractContext(ctx, x.propagator, headers)
 			var err error
 			if !sender.Equals(NoSender) {
 				err = sender.Tell(ctx, pid, message)
@@ -284,7 +303,8 @@ func (x *scheduler) ScheduleWithCron(message proto.Message, pid *PID, cronExpres
 // It requires that remoting is enabled in the actor system configuration.
 //
 // Parameters:
-//   - message: The proto.Message to be delivered.
+//   - ctx: The scheduling context. Its propagated values (e.g. the trace context) are carried to each delivery.
+//   - message: The message to be delivered.
 //   - to: The address.Address of the remote actor that will receive the message.
 //   - delay: The time duration to wait before delivering the message.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -296,7 +316,7 @@ func (x *scheduler) ScheduleWithCron(message proto.Message, pid *PID, cronExpres
 //   - Remoting must be enabled in the actor system for this function to work.
 //   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the message later.
 //   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable.
-func (x *scheduler) RemoteScheduleOnce(message proto.Message, to *address.Address, delay time.Duration, opts ...ScheduleOption) error {
+func (x *scheduler) RemoteScheduleOnce(ctx context.Context, message any, to *address.Address, delay time.Duration, opts ...ScheduleOption) error {
 	x.mu.Lock()
 	defer x.mu.Unlock()
 
@@ -310,8 +330,10 @@ func (x *scheduler) RemoteScheduleOnce(message proto.Message, to *address.Addres
 
 	senderConfig := newScheduleConfig(opts...)
 	from := senderConfig.SenderAddr()
+	headers := injectContext(ctx, x.propagator)
 	job := job.NewFunctionJob(
 		func(ctx context.Context) (bool, error) {
+			ctx = extractContext(ctx, x.propagator, headers)
 			err := x.remoting.RemoteTell(ctx, from, to, message)
 			return err == nil, err
 		},
@@ -333,7 +355,8 @@ func (x *scheduler) RemoteScheduleOnce(message proto.Message, to *address.Addres
 // Remoting must be enabled in the actor system for this functionality to work.
 //
 // Parameters:
-//   - message: The proto.Message to be delivered periodically.
+//   - ctx: The scheduling context. Its propagated values (e.g. the trace context) are carried to each delivery.
+//   - message: The message to be delivered periodically.
 //   - to: The address.Address of the remote actor that will receive the message.
 //   - interval: The time duration between each message delivery.
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -345,7 +368,7 @@ func (x *scheduler) RemoteScheduleOnce(message proto.Message, to *address.Addres
 //   - Remoting must be enabled in the actor system for this method to function correctly.
 //   - It's strongly recommended to set a unique reference ID using WithReference if you plan to cancel, pause, or resume the scheduled message.
 //   - If no reference is set, an automatic one will be generated internally, which may not be easily retrievable for later operations.
-func (x *scheduler) RemoteSchedule(message proto.Message, to *address.Address, interval time.Duration, opts ...ScheduleOption) error {
+func (x *scheduler) RemoteSchedule(ctx context.Context, message any, to *address.Address, interval time.Duration, opts ...ScheduleOption) error {
 	x.mu.Lock()
 	defer x.mu.Unlock()
 
@@ -359,8 +382,10 @@ func (x *scheduler) RemoteSchedule(message proto.Message, to *address.Address, i
 
 	senderConfig := newScheduleConfig(opts...)
 	from := senderConfig.SenderAddr()
+	headers := injectContext(ctx, x.propagator)
 	job := job.NewFunctionJob(
 		func(ctx context.Context) (bool, error) {
+			ctx = extractContext(ctx, x.propagator, headers)
 			err := x.remoting.RemoteTell(ctx, from, to, message)
 			return err == nil, err
 		},
@@ -382,7 +407,8 @@ func (x *scheduler) RemoteSchedule(message proto.Message, to *address.Address, i
 // Remoting must be enabled in the actor system for this method to work.
 //
 // Parameters:
-//   - message: The proto.Message to be delivered according to the cron schedule.
+//   - ctx: The scheduling context. Its propagated values (e.g. the trace context) are carried to each delivery.
+//   - message: The message to be delivered according to the cron schedule.
 //   - to: The address.Address of the remote actor that will receive the message.
 //   - cronExpression: A standard cron-formatted string defining the schedule (e.g., "0 0 * * *").
 //   - opts: Optional ScheduleOption values such as WithReference to control scheduling behavior.
@@ -395,7 +421,7 @@ func (x *scheduler) RemoteSchedule(message proto.Message, to *address.Address, i
 //   - It's strongly recommended to set a unique reference ID using WithReference if you intend to cancel, pause, or resume the scheduled message.
 //   - If no reference is set, an automatic one will be generated internally and may not be easily retrievable.
 //   - The cron expression must conform to the scheduler’s supported format (usually 5 or 6 fields).
-func (x *scheduler) RemoteScheduleWithCron(message proto.Message, to *address.Address, cronExpression string, opts ...ScheduleOption) error {
+func (x *scheduler) RemoteScheduleWithCron(ctx context.Context, message any, to *address.Address, cronExpression string, opts ...ScheduleOption) error {
 	x.mu.Lock()
 	defer x.mu.Unlock()
 
@@ -409,8 +435,10 @@ func (x *scheduler) RemoteScheduleWithCron(message proto.Message, to *address.Ad
 
 	senderConfig := newScheduleConfig(opts...)
 	from := senderConfig.SenderAddr()
+	headers := injectContext(ctx, x.propagator)
 	job := job.NewFunctionJob(
 		func(ctx context.Context) (bool, error) {
+			ctx = extractContext(ctx, x.propagator, headers)
 			err := x.remoting.RemoteTell(ctx, from, to, message)
 			return err == nil, err
 		},
@@ -233,7 +233,7 @@ func TestScheduler(t *testing.T) {
 		assert.NotNil(t, pid)
 
 		message := new(testpb.TestSend)
-		err = scheduler.ScheduleOnce(message, pid, 100*time.Millisecond)
+		err = scheduler.ScheduleOnce(ctx, message, pid, 100*time.Millisecond)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, ErrSchedulerNotStarted)
 
@@ -1249,7 +1249,7 @@ func TestScheduler(t *testing.T) {
 		message := new(testpb.TestSend)
 		// set cron expression to run every second
 		const expr = "* * * ? * *"
-		err := scheduler.RemoteScheduleWithCron(message, addr, expr)
+		err := scheduler.RemoteScheduleWithCron(ctx, message, addr, expr)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, ErrRemotingDisabled)
 
@@ -1272,7 +1272,7 @@ func TestScheduler(t *testing.T) {
 
 		// send a message to the actor after 100 ms
 		message := new(testpb.TestSend)
-		err := scheduler.RemoteSchedule(message, addr, time.Second)
+		err := scheduler.RemoteSchedule(ctx, message, addr, time.Second)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, ErrRemotingDisabled)
 
@@ -1294,7 +1294,7 @@ func TestScheduler(t *testing.T) {
 		addr := address.New("test", "test", host, remotingPort)
 		// send a message to the actor after 100 ms
 		message := new(testpb.TestSend)
-		err := scheduler.RemoteScheduleOnce(message, addr, time.Second)
+		err := scheduler.RemoteScheduleOnce(ctx, message, addr, time.Second)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, ErrRemotingDisabled)
 		scheduler.Stop(ctx)
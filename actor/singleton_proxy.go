@@ -0,0 +1,322 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/log"
+)
+
+// SingletonProxyOption defines the various options to apply to a singleton proxy
+type SingletonProxyOption func(*singletonProxy)
+
+// WithSingletonProxyBufferSize sets the maximum number of messages buffered by the singleton proxy
+// while the singleton actor cannot be reached. Once reached, the incoming messages are sent to the deadletter.
+// The default value is DefaultSingletonProxyBufferSize
+func WithSingletonProxyBufferSize(size int) SingletonProxyOption {
+	return func(x *singletonProxy) {
+		x.bufferSize = size
+	}
+}
+
+// WithSingletonProxyRetryInterval sets the interval between two attempts of the singleton proxy
+// to deliver its buffered messages. The default value is DefaultSingletonProxyRetryInterval
+func WithSingletonProxyRetryInterval(interval time.Duration) SingletonProxyOption {
+	return func(x *singletonProxy) {
+		x.retryInterval = interval
+	}
+}
+
+// singletonProxy is a system actor that routes messages to a singleton actor wherever it runs in the cluster.
+// The messages are buffered while the singleton actor cannot be reached and delivered in order once it is reachable again.
+type singletonProxy struct {
+	singletonName string
+	bufferSize    int
+	retryInterval time.Duration
+
+	pid       *PID
+	logger    log.Logger
+	buffer    []*proxiedMessage
+	scheduled bool
+}
+
+// proxiedMessage defines a message waiting to be delivered to the singleton actor
+type proxiedMessage struct {
+	ctx          context.Context
+	message      any
+	sender       *PID
+	remoteSender *address.Address
+}
+
+// ensure singletonProxy implements the Actor interface
+var _ Actor = (*singletonProxy)(nil)
+
+// newSingletonProxy creates a new singleton proxy actor
+func newSingletonProxy(singletonName string, opts ...SingletonProxyOption) *singletonProxy {
+	x := &singletonProxy{
+		singletonName: singletonName,
+		bufferSize:    DefaultSingletonProxyBufferSize,
+		retryInterval: DefaultSingletonProxyRetryInterval,
+	}
+
+	for _, opt := range opts {
+		opt(x)
+	}
+
+	return x
+}
+
+// PreStart implements the pre-start hook.
+func (x *singletonProxy) PreStart(*Context) error {
+	// a tick scheduled before a restart may be lost
+	x.scheduled = false
+	return nil
+}
+
+// Receive handles messages received by the singleton proxy.
+func (x *singletonProxy) Receive(ctx *ReceiveContext) {
+	switch ctx.Message().(type) {
+	case *goaktpb.PostStart:
+		x.pid = ctx.Self()
+		x.logger = ctx.Logger()
+		x.logger.Infof("%s started successfully", x.pid.Name())
+	case *internalpb.SingletonProxyTick:
+		x.scheduled = false
+		x.flush(ctx)
+	default:
+		x.route(ctx)
+	}
+}
+
+// PostStop implements the post-stop hook.
+func (x *singletonProxy) PostStop(*Context) error {
+	if len(x.buffer) > 0 {
+		x.logger.Warnf("%s stopped with (%d) undelivered messages", x.pid.Name(), len(x.buffer))
+	}
+	x.logger.Infof("%s stopped successfully", x.pid.Name())
+	return nil
+}
+
+// route delivers the received message to the singleton actor or buffers it
+// when the singleton actor cannot be reached
+func (x *singletonProxy) route(ctx *ReceiveContext) {
+	message := &proxiedMessage{
+		ctx:          context.WithoutCancel(ctx.Context()),
+		message:      ctx.Message(),
+		sender:       ctx.Sender(),
+		remoteSender: ctx.RemoteSender(),
+	}
+
+	// the messages awaiting a reply are not buffered since their sender would time out waiting
+	if ctx.awaitingReply {
+		x.relay(ctx, message)
+		return
+	}
+
+	// the buffered messages are delivered first to preserve the ordering
+	if len(x.buffer) == 0 {
+		err := x.deliver(message)
+		if err == nil {
+			return
+		}
+		x.logger.Debugf("%s failed to deliver message to singleton actor=(%s): %v", x.pid.Name(), x.singletonName, err)
+	}
+
+	if len(x.buffer) >= x.bufferSize {
+		ctx.Self().toDeadletters(ctx, ErrSingletonProxyBufferFull)
+		return
+	}
+
+	x.buffer = append(x.buffer, message)
+	x.scheduleTick(ctx)
+}
+
+// relay sends the given message awaiting a reply to the singleton actor in the background and passes the response
+// along to its sender, so that the proxy keeps processing its other messages while waiting for the response.
+// The message is sent to the deadletter when the singleton actor cannot be reached
+func (x *singletonProxy) relay(ctx *ReceiveContext, message *proxiedMessage) {
+	// the buffered messages are delivered first to preserve the ordering
+	if len(x.buffer) > 0 {
+		ctx.Self().toDeadletters(ctx, ErrSingletonUnreachable)
+		return
+	}
+
+	timeout := DefaultAskTimeout
+	if deadline, ok := ctx.Context().Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	// the receive context is released once the message is handled, hence what the response needs is captured
+	requestCtx := ctx.Context()
+	responses := ctx.response
+	sender := address.NoSender()
+	if message.sender != nil && !message.sender.Equals(NoSender) {
+		sender = message.sender.Address()
+	}
+
+	go func() {
+		response, err := x.ask(requestCtx, message, timeout)
+		if err != nil {
+			x.logger.Debugf("%s failed to relay message to singleton actor=(%s): %v", x.pid.Name(), x.singletonName, err)
+			x.pid.metrics.recordDeadletter(x.pid.metricAttributes)
+			x.pid.sendToDeadletter(context.Background(), sender, x.pid.Address(), message.message, errors.Join(ErrSingletonUnreachable, err))
+			return
+		}
+
+		responses <- response
+		close(responses)
+	}()
+}
+
+// ask sends the given message to the singleton actor on behalf of its original sender and returns the response
+func (x *singletonProxy) ask(ctx context.Context, message *proxiedMessage, timeout time.Duration) (any, error) {
+	actorRef, err := x.pid.ActorSystem().ActorOf(ctx, x.singletonName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the messages sent with the package-level Ask do not have any sender actor
+	noSender := message.sender == nil || message.sender.Equals(NoSender)
+
+	if cid := actorRef.localPID(); cid != nil {
+		if noSender {
+			return Ask(ctx, cid, message.message, timeout)
+		}
+		return message.sender.Ask(ctx, cid, message.message, timeout)
+	}
+
+	from := address.NoSender()
+	switch {
+	case !noSender:
+		from = message.sender.Address()
+	case message.remoteSender != nil:
+		from = message.remoteSender
+	}
+	return x.pid.remoting.RemoteAsk(ctx, from, actorRef.Address(), message.message, timeout)
+}
+
+// flush delivers the buffered messages in order until the singleton actor cannot be reached
+func (x *singletonProxy) flush(ctx *ReceiveContext) {
+	for len(x.buffer) > 0 {
+		if err := x.deliver(x.buffer[0]); err != nil {
+			x.logger.Debugf("%s failed to deliver message to singleton actor=(%s): %v", x.pid.Name(), x.singletonName, err)
+			break
+		}
+		x.buffer[0] = nil
+		x.buffer = x.buffer[1:]
+	}
+
+	x.scheduleTick(ctx)
+}
+
+// deliver sends the given message to the singleton actor on behalf of its original sender
+func (x *singletonProxy) deliver(message *proxiedMessage) error {
+	actorRef, err := x.pid.ActorSystem().ActorOf(message.ctx, x.singletonName)
+	if err != nil {
+		return err
+	}
+
+	remoteSender := message.remoteSender
+	if remoteSender == nil {
+		remoteSender = address.NoSender()
+	}
+
+	// the messages sent with the package-level Tell do not have any sender actor
+	noSender := message.sender == nil || message.sender.Equals(NoSender)
+
+	if cid := actorRef.localPID(); cid != nil {
+		if !noSender {
+			return message.sender.Tell(message.ctx, cid, message.message)
+		}
+
+		if !cid.IsRunning() {
+			return ErrDead
+		}
+
+		// the remote sender, if any, is kept for the singleton actor to reply to
+		receiveContext := getContext()
+		receiveContext.build(message.ctx, NoSender, cid, message.message, true)
+		cid.doReceive(receiveContext.withRemoteSender(remoteSender))
+		return nil
+	}
+
+	// the messages without any sender actor are sent on behalf of their remote sender, if any
+	if noSender {
+		return x.pid.remoting.RemoteTell(message.ctx, remoteSender, actorRef.Address(), message.message)
+	}
+
+	return message.sender.RemoteTell(message.ctx, actorRef.Address(), message.message)
+}
+
+// scheduleTick schedules the next delivery attempt of the buffered messages
+func (x *singletonProxy) scheduleTick(ctx *ReceiveContext) {
+	if len(x.buffer) == 0 || x.scheduled {
+		return
+	}
+
+	if err := ctx.ActorSystem().ScheduleOnce(context.WithoutCancel(ctx.Context()), new(internalpb.SingletonProxyTick), ctx.Self(), x.retryInterval); err != nil {
+		x.logger.Errorf("%s failed to schedule the delivery of the buffered messages: %v", x.pid.Name(), err)
+		return
+	}
+	x.scheduled = true
+}
+
+// spawnSingletonProxy creates the proxy of the given singleton actor
+// as a child actor of the system guardian
+func (x *actorSystem) spawnSingletonProxy(ctx context.Context, name string, opts ...SingletonProxyOption) (*PID, error) {
+	actorName := fmt.Sprintf("%s-%s", x.reservedName(singletonProxyType), name)
+	if pidNode, ok := x.actors.node(x.actorAddress(actorName).String()); ok {
+		if pid := pidNode.value(); pid.IsRunning() {
+			return pid, nil
+		}
+	}
+
+	pid, err := x.configPID(ctx,
+		actorName,
+		newSingletonProxy(name, opts...),
+		asSystem(),
+		WithLongLived(),
+		WithSupervisor(
+			NewSupervisor(
+				WithStrategy(OneForOneStrategy),
+				WithAnyErrorDirective(RestartDirective),
+			),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("actor=%s failed to start the singleton proxy: %w", actorName, err)
+	}
+
+	// the singleton proxy is a child actor of the system guardian
+	_ = x.actors.addNode(x.systemGuardian, pid)
+	return pid, nil
+}
@@ -0,0 +1,417 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestSingletonProxy(t *testing.T) {
+	t.Run("With messages buffered until the singleton actor is reachable", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+		cl2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl2)
+
+		pause.For(time.Second)
+
+		// the proxies are created before the singleton actor
+		localProxy, err := cl1.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+		remoteProxy, err := cl2.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+
+		localCounter := NewMockCounter(10)
+		localSender, err := cl1.Spawn(ctx, "localSender", localCounter)
+		require.NoError(t, err)
+		remoteCounter := NewMockCounter(10)
+		remoteSender, err := cl2.Spawn(ctx, "remoteSender", remoteCounter)
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, localSender.Tell(ctx, localProxy, &testpb.TestCount{Value: int32(i)}))
+			require.NoError(t, remoteSender.Tell(ctx, remoteProxy, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		pause.For(500 * time.Millisecond)
+		require.Empty(t, localCounter.received)
+		require.Empty(t, remoteCounter.received)
+
+		require.NoError(t, cl1.SpawnSingleton(ctx, "singleton", NewMockBouncer()))
+
+		for i := 3; i < 5; i++ {
+			require.NoError(t, localSender.Tell(ctx, localProxy, &testpb.TestCount{Value: int32(i)}))
+			require.NoError(t, remoteSender.Tell(ctx, remoteProxy, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		// the messages are bounced back to their original sender in order
+		for _, counter := range []*MockCounter{localCounter, remoteCounter} {
+			for i := 0; i < 5; i++ {
+				select {
+				case value := <-counter.received:
+					require.EqualValues(t, i, value)
+				case <-time.After(5 * time.Second):
+					t.Fatalf("message %d not received", i)
+				}
+			}
+		}
+
+		require.NoError(t, cl2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With the singleton actor moving to the new leader", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+		cl2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl2)
+		cl3, sd3 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl3)
+
+		pause.For(time.Second)
+
+		require.NoError(t, cl1.SpawnSingleton(ctx, "singleton", NewMockBouncer()))
+
+		// wait for the peers state to be synchronized
+		pause.For(time.Second)
+
+		proxy, err := cl3.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+
+		counter := NewMockCounter(10)
+		sender, err := cl3.Spawn(ctx, "sender", counter)
+		require.NoError(t, err)
+
+		require.NoError(t, sender.Tell(ctx, proxy, &testpb.TestCount{Value: 0}))
+		select {
+		case value := <-counter.received:
+			require.EqualValues(t, 0, value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		// take down the leader hosting the singleton actor
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+
+		for i := 1; i < 5; i++ {
+			require.NoError(t, sender.Tell(ctx, proxy, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		// the buffered messages are delivered once the singleton actor has moved
+		for i := 1; i < 5; i++ {
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(time.Minute):
+				t.Fatalf("message %d not received", i)
+			}
+		}
+
+		require.NoError(t, cl3.Stop(ctx))
+		require.NoError(t, sd3.Close())
+		require.NoError(t, cl2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		srv.Shutdown()
+	})
+	t.Run("With buffer full", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+
+		proxy, err := cl1.SpawnSingletonProxy(ctx, "singleton", WithSingletonProxyBufferSize(2))
+		require.NoError(t, err)
+
+		counter := NewMockCounter(10)
+		sender, err := cl1.Spawn(ctx, "sender", counter)
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, sender.Tell(ctx, proxy, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		pause.For(500 * time.Millisecond)
+		require.NoError(t, cl1.SpawnSingleton(ctx, "singleton", NewMockBouncer()))
+
+		// the message received once the buffer was full is lost
+		for i := 0; i < 2; i++ {
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+		}
+
+		pause.For(500 * time.Millisecond)
+		require.Empty(t, counter.received)
+
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With an existing proxy", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+
+		proxy, err := cl1.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+
+		existing, err := cl1.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+		require.True(t, proxy.Equals(existing))
+
+		other, err := cl1.SpawnSingletonProxy(ctx, "other")
+		require.NoError(t, err)
+		require.False(t, proxy.Equals(other))
+
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With a proxy created by SpawnSingleton on every node", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+		cl2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl2)
+
+		pause.For(time.Second)
+
+		require.NoError(t, cl1.SpawnSingleton(ctx, "singleton", NewMockBouncer()))
+		err := cl2.SpawnSingleton(ctx, "singleton", NewMockBouncer())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), ErrSingletonAlreadyExists.Error())
+
+		// both nodes have a proxy to the singleton actor
+		for _, node := range []ActorSystem{cl1, cl2} {
+			sys := node.(*actorSystem)
+			proxyName := fmt.Sprintf("%s-%s", sys.reservedName(singletonProxyType), "singleton")
+			proxyNode, ok := sys.actors.node(sys.actorAddress(proxyName).String())
+			require.True(t, ok)
+
+			proxy, err := node.SpawnSingletonProxy(ctx, "singleton")
+			require.NoError(t, err)
+			require.True(t, proxy.Equals(proxyNode.value()))
+		}
+
+		proxy, err := cl2.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+
+		counter := NewMockCounter(10)
+		sender, err := cl2.Spawn(ctx, "sender", counter)
+		require.NoError(t, err)
+
+		require.NoError(t, sender.Tell(ctx, proxy, &testpb.TestCount{Value: 1}))
+		select {
+		case value := <-counter.received:
+			require.EqualValues(t, 1, value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("message not received")
+		}
+
+		require.NoError(t, cl2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With messages sent without sender to a remote singleton actor", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+		cl2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl2)
+
+		pause.For(time.Second)
+
+		counter := NewMockCounter(10)
+		require.NoError(t, cl1.SpawnSingleton(ctx, "singleton", counter))
+
+		// wait for the peers state to be synchronized
+		pause.For(time.Second)
+
+		proxy, err := cl2.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, Tell(ctx, proxy, &testpb.TestCount{Value: int32(i)}))
+		}
+
+		// a message without any sender actor at all
+		receiveContext := getContext()
+		receiveContext.build(ctx, nil, proxy, &testpb.TestCount{Value: 3}, true)
+		proxy.doReceive(receiveContext.withRemoteSender(address.NoSender()))
+
+		for i := 0; i < 4; i++ {
+			select {
+			case value := <-counter.received:
+				require.EqualValues(t, i, value)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d not received", i)
+			}
+		}
+
+		require.NoError(t, cl2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With messages awaiting a reply", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+		cl2, sd2 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl2)
+
+		pause.For(time.Second)
+
+		require.NoError(t, cl1.SpawnSingleton(ctx, "singleton", NewMockActor()))
+
+		// wait for the peers state to be synchronized
+		pause.For(time.Second)
+
+		localProxy, err := cl1.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+		remoteProxy, err := cl2.SpawnSingletonProxy(ctx, "singleton")
+		require.NoError(t, err)
+		sender, err := cl2.Spawn(ctx, "sender", NewMockActor())
+		require.NoError(t, err)
+
+		// the response of the singleton actor is passed along to the sender
+		response, err := Ask(ctx, localProxy, new(testpb.TestReply), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, "received message", response.(*testpb.Reply).GetContent())
+
+		response, err = sender.Ask(ctx, remoteProxy, new(testpb.TestReply), 5*time.Second)
+		require.NoError(t, err)
+		require.Equal(t, "received message", response.(*testpb.Reply).GetContent())
+
+		// the proxy keeps processing its messages while a reply is awaited
+		processed := localProxy.ProcessedCount()
+		go func() { _, _ = Ask(ctx, localProxy, new(testpb.TestTimeout), 2*time.Second) }()
+		require.NoError(t, Tell(ctx, localProxy, new(testpb.TestSend)))
+		require.Eventually(t, func() bool { return localProxy.ProcessedCount() >= processed+2 }, receivingDelay/2, 10*time.Millisecond)
+
+		// the messages awaiting a reply are not buffered while the singleton actor cannot be reached
+		missingProxy, err := cl2.SpawnSingletonProxy(ctx, "missing")
+		require.NoError(t, err)
+		_, err = Ask(ctx, missingProxy, new(testpb.TestReply), 500*time.Millisecond)
+		require.ErrorIs(t, err, ErrRequestTimeout)
+
+		proxy := missingProxy.Actor().(*singletonProxy)
+		require.Empty(t, proxy.buffer)
+
+		require.NoError(t, cl2.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With reserved name returns error", func(t *testing.T) {
+		ctx := context.TODO()
+		srv := startNatsServer(t)
+
+		cl1, sd1 := testCluster(t, srv.Addr().String())
+		require.NotNil(t, cl1)
+
+		proxy, err := cl1.SpawnSingletonProxy(ctx, reservedNamesPrefix+"Singleton")
+		require.ErrorIs(t, err, ErrReservedName)
+		require.Nil(t, proxy)
+
+		require.NoError(t, cl1.Stop(ctx))
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("When cluster is not enabled returns error", func(t *testing.T) {
+		ctx := context.TODO()
+		remotingPort := dynaport.Get(1)[0]
+
+		sys, err := NewActorSystem("test",
+			WithLogger(log.DiscardLogger),
+			WithRemote(remote.NewConfig("127.0.0.1", remotingPort)),
+		)
+		require.NoError(t, err)
+		require.NoError(t, sys.Start(ctx))
+
+		proxy, err := sys.SpawnSingletonProxy(ctx, "singleton")
+		require.ErrorIs(t, err, ErrClusterDisabled)
+		require.Nil(t, proxy)
+
+		require.NoError(t, sys.Stop(ctx))
+	})
+	t.Run("When not started returns error", func(t *testing.T) {
+		sys, err := NewActorSystem("test", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+
+		proxy, err := sys.SpawnSingletonProxy(context.TODO(), "singleton")
+		require.ErrorIs(t, err, ErrActorSystemNotStarted)
+		require.Nil(t, proxy)
+	})
+}
+
+func TestSingletonProxyOption(t *testing.T) {
+	proxy := newSingletonProxy("singleton")
+	require.Equal(t, DefaultSingletonProxyBufferSize, proxy.bufferSize)
+	require.Equal(t, DefaultSingletonProxyRetryInterval, proxy.retryInterval)
+
+	proxy = newSingletonProxy("singleton",
+		WithSingletonProxyBufferSize(10),
+		WithSingletonProxyRetryInterval(time.Second))
+	require.Equal(t, 10, proxy.bufferSize)
+	require.Equal(t, time.Second, proxy.retryInterval)
+}
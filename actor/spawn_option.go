@@ -27,6 +27,8 @@ package actor
 import (
 	"time"
 
+	"google.golang.org/protobuf/types/known/anypb"
+
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/internal/validation"
 	"github.com/tochemey/goakt/v3/passivation"
@@ -54,6 +56,14 @@ const (
 	// regardless of the cluster configuration.
 	// Useful when locality is important (e.g., accessing local resources).
 	Local
+
+	// LeastLoad selects the node hosting the fewest actors, including the local node.
+	// This strategy queries the load of every node at spawn time.
+	LeastLoad
+
+	// MemoryAware selects the node with the most free memory, including the local node.
+	// This strategy queries the load of every node at spawn time.
+	MemoryAware
 )
 
 // spawnConfig defines the configuration options applied when creating an actor.
@@ -78,8 +88,22 @@ type spawnConfig struct {
 	isSystem bool
 	// placement specifies the placement strategy for spawning the actor in a cluster.
 	placement SpawnPlacement
+	// placementStrategy is the user-defined placement strategy, which takes precedence over placement.
+	placementStrategy PlacementStrategy
 	// passivationStrategy defines the strategy used for actor passivation.
 	passivationStrategy passivation.Strategy
+	// snapshotInterval defines the number of persisted events after which a persistent actor snapshot is taken.
+	snapshotInterval uint64
+	// factory is the name of the factory the actor is created with, used internally to relocate the actor.
+	factory string
+	// factoryArgs are the arguments passed to the factory.
+	factoryArgs *anypb.Any
+	// handedOff states whether the actor is moved from a draining node, used internally when draining a node.
+	handedOff bool
+	// handOffState is the state handed off by the previous incarnation of the actor, used internally when draining a node.
+	handOffState *anypb.Any
+	// role specifies the role a cluster node must have to host the actor.
+	role string
 }
 
 var _ validation.Validator = (*spawnConfig)(nil)
@@ -124,6 +148,20 @@ func newSpawnConfig(opts ...SpawnOption) *spawnConfig {
 	return config
 }
 
+// strategy returns the placement strategy choosing the node based upon the nodes load, if any
+func (s *spawnConfig) strategy() PlacementStrategy {
+	switch {
+	case s.placementStrategy != nil:
+		return s.placementStrategy
+	case s.placement == LeastLoad:
+		return NewLeastLoadPlacement()
+	case s.placement == MemoryAware:
+		return NewMemoryAwarePlacement()
+	default:
+		return nil
+	}
+}
+
 // SpawnOption defines the interface for configuring actor spawn behavior.
 //
 // Implementations of this interface can be passed to actor spawning functions
@@ -289,6 +327,26 @@ func WithPlacement(strategy SpawnPlacement) SpawnOption {
 	})
 }
 
+// WithPlacementStrategy returns a SpawnOption that sets a user-defined placement strategy
+// used by SpawnOn to choose, in cluster mode, the node the actor is spawned on.
+//
+// The strategy receives the load of the candidate nodes, including the local node,
+// which makes it possible to implement custom affinity rules. It takes precedence over WithPlacement.
+//
+// Note: This option only has an effect when used with SpawnOn in a cluster-enabled
+// actor system. If cluster mode is disabled, the actor will be spawned locally.
+//
+// Parameters:
+//   - strategy: the PlacementStrategy choosing the node.
+//
+// Returns:
+//   - SpawnOption that sets the placement strategy in the spawn configuration.
+func WithPlacementStrategy(strategy PlacementStrategy) SpawnOption {
+	return spawnOption(func(config *spawnConfig) {
+		config.placementStrategy = strategy
+	})
+}
+
 // WithPassivationStrategy returns a SpawnOption that sets the passivation strategy to be used when spawning an actor.
 //
 // This option allows you to define how and when the actor should be passivated,
@@ -310,6 +368,73 @@ func WithPassivationStrategy(strategy passivation.Strategy) SpawnOption {
 	})
 }
 
+// WithSnapshotInterval returns a SpawnOption that sets the number of persisted events after which
+// the state of a persistent actor is saved in the snapshot store.
+//
+// Snapshots speed up the recovery of persistent actors since only the events persisted after the
+// latest snapshot need to be replayed. This option requires a SnapshotStore to be configured on the
+// actor system using WithSnapshotStore and is ignored for actors that do not implement PersistentActor.
+// A zero interval, the default, disables snapshots.
+//
+// Parameters:
+//   - interval: the number of events between two snapshots.
+//
+// Returns:
+//   - SpawnOption that sets the snapshot interval in the spawn configuration.
+func WithSnapshotInterval(interval uint64) SpawnOption {
+	return spawnOption(func(config *spawnConfig) {
+		config.snapshotInterval = interval
+	})
+}
+
+// WithRole returns a SpawnOption that restricts the placement of the actor to the cluster nodes having the given role.
+//
+// Roles are set on the cluster nodes with the WithRoles method of ClusterConfig. In cluster mode:
+//   - Spawn fails with ErrRoleNotFound when the local node does not have the role.
+//   - SpawnOn only places the actor on the nodes having the role.
+//   - SpawnSingleton creates the singleton actor on the oldest node having the role.
+//   - The actor is only relocated to the nodes having the role when its node leaves the cluster.
+//
+// The option has no effect when the actor system is not in cluster mode.
+//
+// Parameters:
+//   - role: the role a cluster node must have to host the actor.
+//
+// Returns:
+//   - SpawnOption that sets the role in the spawn configuration.
+func WithRole(role string) SpawnOption {
+	return spawnOption(func(config *spawnConfig) {
+		config.role = role
+	})
+}
+
+// withFactory returns a SpawnOption that records the factory the actor is created with and its arguments.
+//
+// This is an internal method used to create relocated actors with the same factory and should not be used directly by end users.
+//
+// Returns:
+//   - SpawnOption that sets the factory and its arguments.
+func withFactory(name string, args *anypb.Any) SpawnOption {
+	return spawnOption(func(config *spawnConfig) {
+		config.factory = name
+		config.factoryArgs = args
+	})
+}
+
+// withHandOff returns a SpawnOption that marks the actor as moved from a draining node
+// and sets the state handed off by its previous incarnation, if any.
+//
+// This is an internal method used to move actors off a draining node and should not be used directly by end users.
+//
+// Returns:
+//   - SpawnOption that sets the handed off state.
+func withHandOff(state *anypb.Any) SpawnOption {
+	return spawnOption(func(config *spawnConfig) {
+		config.handedOff = true
+		config.handOffState = state
+	})
+}
+
 // withSingleton returns a SpawnOption that ensures the actor is a singleton within the system.
 //
 // This is an internal method to set the singleton flag and should not be used directly by end users.
@@ -73,6 +73,31 @@ func TestSpawnOption(t *testing.T) {
 		option.Apply(config)
 		require.Equal(t, &spawnConfig{placement: RoundRobin}, config)
 	})
+	t.Run("spawn option with snapshot interval", func(t *testing.T) {
+		config := &spawnConfig{}
+		option := WithSnapshotInterval(10)
+		option.Apply(config)
+		require.Equal(t, &spawnConfig{snapshotInterval: 10}, config)
+	})
+	t.Run("spawn option with role", func(t *testing.T) {
+		config := &spawnConfig{}
+		option := WithRole("payments")
+		option.Apply(config)
+		require.Equal(t, &spawnConfig{role: "payments"}, config)
+	})
+	t.Run("spawn option with placement strategy", func(t *testing.T) {
+		config := &spawnConfig{}
+		strategy := NewLeastLoadPlacement()
+		option := WithPlacementStrategy(strategy)
+		option.Apply(config)
+		require.Equal(t, &spawnConfig{placementStrategy: strategy}, config)
+		require.Equal(t, strategy, config.strategy())
+	})
+	t.Run("spawn option with load-aware placement", func(t *testing.T) {
+		require.Nil(t, newSpawnConfig(WithPlacement(Random)).strategy())
+		require.IsType(t, new(leastLoadPlacement), newSpawnConfig(WithPlacement(LeastLoad)).strategy())
+		require.IsType(t, new(memoryAwarePlacement), newSpawnConfig(WithPlacement(MemoryAware)).strategy())
+	})
 }
 
 func TestNewSpawnConfig(t *testing.T) {
@@ -0,0 +1,458 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/cluster"
+	"github.com/tochemey/goakt/v3/internal/registry"
+	"github.com/tochemey/goakt/v3/internal/validation"
+)
+
+// SplitBrainStrategy defines how the split brain resolver decides
+// which side of a network partition survives
+type SplitBrainStrategy int
+
+const (
+	// KeepMajority keeps the side of the partition that can reach more than half of the members
+	// the cluster had before the partition. When both sides have the same size, the side
+	// holding the member with the lowest address survives.
+	KeepMajority SplitBrainStrategy = iota
+	// KeepOldest keeps the side of the partition that holds the oldest member of the cluster
+	KeepOldest
+	// StaticQuorum keeps the side of the partition that holds at least the configured quorum size of members.
+	// The quorum size must be set to more than half of the cluster size to make sure only one side survives.
+	StaticQuorum
+	// KeepReferee keeps the side of the partition that can reach the configured referee member
+	KeepReferee
+)
+
+// String returns the string representation of the strategy
+func (s SplitBrainStrategy) String() string {
+	switch s {
+	case KeepMajority:
+		return "keep-majority"
+	case KeepOldest:
+		return "keep-oldest"
+	case StaticQuorum:
+		return "static-quorum"
+	case KeepReferee:
+		return "keep-referee"
+	default:
+		return "unknown"
+	}
+}
+
+// SplitBrainAction defines what the members on the losing side of a network partition do
+type SplitBrainAction int
+
+const (
+	// ShutdownAction shuts the losing members down
+	ShutdownAction SplitBrainAction = iota
+	// ReadOnlyAction switches the losing members to read-only.
+	// A read-only member keeps processing the messages of its actors but stops its singleton actors,
+	// refuses to spawn new actors and no longer alters the cluster state.
+	ReadOnlyAction
+)
+
+// SplitBrainResolver defines the settings of the split brain resolver.
+//
+// When a network partition splits the cluster, the members of each side see the members of the other side
+// leave the cluster and would otherwise keep running on their own, duplicating the singleton and relocatable actors.
+// The split brain resolver waits for the cluster membership to be stable for the stable-after period and then
+// uses its strategy to decide which side survives. The members of the losing side either shut down or switch to read-only.
+// The members leaving the cluster gracefully are not considered unreachable.
+//
+// When the resolver is set, the actors of the unreachable members are only relocated once the resolver has decided
+// that the given side of the partition survives. Every decision is published to the events stream as a
+// goaktpb.SplitBrainResolved event.
+type SplitBrainResolver struct {
+	strategy    SplitBrainStrategy
+	action      SplitBrainAction
+	stableAfter time.Duration
+	quorumSize  int
+	referee     string
+}
+
+// enforce compilation error
+var _ validation.Validator = (*SplitBrainResolver)(nil)
+
+// NewSplitBrainResolver creates an instance of SplitBrainResolver with the given strategy.
+// The losing side shuts down by default and the default stable-after period is DefaultSplitBrainStableAfter.
+func NewSplitBrainResolver(strategy SplitBrainStrategy) *SplitBrainResolver {
+	return &SplitBrainResolver{
+		strategy:    strategy,
+		action:      ShutdownAction,
+		stableAfter: DefaultSplitBrainStableAfter,
+	}
+}
+
+// WithStableAfter sets the period the cluster membership must be stable for before the resolver takes a decision.
+// It should be long enough for the failure detector to mark all the unreachable members.
+func (x *SplitBrainResolver) WithStableAfter(stableAfter time.Duration) *SplitBrainResolver {
+	x.stableAfter = stableAfter
+	return x
+}
+
+// WithAction sets the action of the members on the losing side of a network partition
+func (x *SplitBrainResolver) WithAction(action SplitBrainAction) *SplitBrainResolver {
+	x.action = action
+	return x
+}
+
+// WithQuorumSize sets the minimum number of members a side of a network partition must hold to survive.
+// It is required by the StaticQuorum strategy.
+func (x *SplitBrainResolver) WithQuorumSize(size int) *SplitBrainResolver {
+	x.quorumSize = size
+	return x
+}
+
+// WithReferee sets the peers address (host:port) of the referee member.
+// It is required by the KeepReferee strategy.
+func (x *SplitBrainResolver) WithReferee(address string) *SplitBrainResolver {
+	x.referee = address
+	return x
+}
+
+// Strategy returns the strategy of the resolver
+func (x *SplitBrainResolver) Strategy() SplitBrainStrategy {
+	return x.strategy
+}
+
+// Action returns the action of the members on the losing side of a network partition
+func (x *SplitBrainResolver) Action() SplitBrainAction {
+	return x.action
+}
+
+// StableAfter returns the period the cluster membership must be stable for before the resolver takes a decision
+func (x *SplitBrainResolver) StableAfter() time.Duration {
+	return x.stableAfter
+}
+
+// Validate validates the split brain resolver settings
+func (x *SplitBrainResolver) Validate() error {
+	return validation.
+		New(validation.AllErrors()).
+		AddAssertion(x.strategy >= KeepMajority && x.strategy <= KeepReferee, "invalid split brain strategy").
+		AddAssertion(x.action == ShutdownAction || x.action == ReadOnlyAction, "invalid split brain action").
+		AddAssertion(x.stableAfter > 0, "split brain stable-after period must be positive").
+		AddValidator(validation.NewConditionalValidator(x.strategy == StaticQuorum,
+			validation.NewBooleanValidator(x.quorumSize > 0, "split brain quorum size must be positive"))).
+		AddValidator(validation.NewConditionalValidator(x.strategy == KeepReferee,
+			validation.NewTCPAddressValidator(x.referee))).
+		Validate()
+}
+
+// survives states whether the members that can still be reached survive the network partition.
+// members are the members of the cluster before the partition and reachable the members that can still be reached.
+func (x *SplitBrainResolver) survives(members, reachable []*cluster.Peer) bool {
+	reached := make(map[string]struct{}, len(reachable))
+	for _, peer := range reachable {
+		reached[peer.PeerAddress()] = struct{}{}
+	}
+
+	isReachable := func(peer *cluster.Peer) bool {
+		_, ok := reached[peer.PeerAddress()]
+		return ok
+	}
+
+	switch x.strategy {
+	case KeepMajority:
+		count := 0
+		for _, peer := range members {
+			if isReachable(peer) {
+				count++
+			}
+		}
+
+		switch {
+		case 2*count > len(members):
+			return true
+		case 2*count < len(members):
+			return false
+		default:
+			// on a tie the side holding the member with the lowest address survives
+			lowest := slices.MinFunc(members, func(a, b *cluster.Peer) int {
+				return compareAddresses(a.PeerAddress(), b.PeerAddress())
+			})
+			return isReachable(lowest)
+		}
+	case KeepOldest:
+		oldest := slices.MinFunc(members, func(a, b *cluster.Peer) int {
+			if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+				return c
+			}
+			return compareAddresses(a.PeerAddress(), b.PeerAddress())
+		})
+		return isReachable(oldest)
+	case StaticQuorum:
+		return len(reachable) >= x.quorumSize
+	case KeepReferee:
+		_, ok := reached[x.referee]
+		return ok
+	default:
+		return false
+	}
+}
+
+func compareAddresses(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// splitBrainState tracks the cluster membership observed by the split brain resolver
+type splitBrainState struct {
+	mu sync.Mutex
+	// members are the members of the cluster before a partition
+	members map[string]*cluster.Peer
+	// observed are the members of the cluster at the last check
+	observed map[string]struct{}
+	// changedAt is the last time the observed membership has changed
+	changedAt time.Time
+	// resolved are the unreachable members the resolver has decided to let go
+	resolved map[string]struct{}
+	// stopSig stops the resolver
+	stopSig  chan registry.Unit
+	stopOnce sync.Once
+}
+
+func newSplitBrainState() *splitBrainState {
+	return &splitBrainState{
+		members:  make(map[string]*cluster.Peer),
+		observed: make(map[string]struct{}),
+		resolved: make(map[string]struct{}),
+		stopSig:  make(chan registry.Unit),
+	}
+}
+
+// stop stops the resolver
+func (x *splitBrainState) stop() {
+	x.stopOnce.Do(func() { close(x.stopSig) })
+}
+
+// isResolved states whether the resolver has decided to let go the given unreachable member
+func (x *splitBrainState) isResolved(address string) bool {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	_, ok := x.resolved[address]
+	return ok
+}
+
+// splitBrainLoop watches the cluster membership and resolves the network partitions
+func (x *actorSystem) splitBrainLoop() {
+	resolver := x.clusterConfig.SplitBrainResolver()
+	interval := min(time.Second, max(resolver.StableAfter()/4, time.Millisecond))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	x.logger.Debugf("(%s) split brain resolver has started...", x.name)
+	defer x.logger.Debugf("(%s) split brain resolver has stopped...", x.name)
+	for {
+		select {
+		case <-ticker.C:
+			if !x.InCluster() || x.isShuttingDown() {
+				continue
+			}
+
+			if !x.checkSplitBrain(resolver) {
+				return
+			}
+		case <-x.splitBrain.stopSig:
+			return
+		}
+	}
+}
+
+// checkSplitBrain checks the cluster membership and takes a decision when some members
+// have been unreachable for the stable-after period. It returns false when the node is on the losing side.
+func (x *actorSystem) checkSplitBrain(resolver *SplitBrainResolver) bool {
+	ctx := context.Background()
+	current, err := x.cluster.Members(ctx)
+	if err != nil {
+		x.logger.Warnf("(%s) split brain resolver failed to fetch the cluster members: %v", x.name, err)
+		return true
+	}
+
+	state := x.splitBrain
+	state.mu.Lock()
+
+	now := time.Now()
+	observed := make(map[string]struct{}, len(current))
+	for _, peer := range current {
+		observed[peer.PeerAddress()] = struct{}{}
+	}
+
+	if !sameMembers(observed, state.observed) {
+		state.observed = observed
+		state.changedAt = now
+	}
+
+	// the members leaving gracefully are not unreachable
+	var left []string
+	for address := range state.members {
+		if _, ok := observed[address]; !ok && x.cluster.HasLeft(address) {
+			delete(state.members, address)
+			left = append(left, address)
+		}
+	}
+
+	var unreachable []string
+	for address := range state.members {
+		if _, ok := observed[address]; !ok {
+			unreachable = append(unreachable, address)
+		}
+	}
+
+	if len(unreachable) == 0 {
+		// the membership is healthy, so it becomes the reference for the next partition
+		for _, peer := range current {
+			state.members[peer.PeerAddress()] = peer
+		}
+		state.mu.Unlock()
+		x.relocateLeftNodes(left)
+		return true
+	}
+
+	if now.Sub(state.changedAt) < resolver.StableAfter() {
+		state.mu.Unlock()
+		x.relocateLeftNodes(left)
+		return true
+	}
+
+	members := make([]*cluster.Peer, 0, len(state.members))
+	for _, peer := range state.members {
+		members = append(members, peer)
+	}
+
+	reachable := make([]string, 0, len(current))
+	for _, peer := range current {
+		reachable = append(reachable, peer.PeerAddress())
+	}
+
+	sort.Strings(reachable)
+	sort.Strings(unreachable)
+
+	survives := resolver.survives(members, current)
+	if survives {
+		for _, address := range unreachable {
+			delete(state.members, address)
+			state.resolved[address] = struct{}{}
+		}
+		for _, peer := range current {
+			state.members[peer.PeerAddress()] = peer
+		}
+	}
+	state.mu.Unlock()
+
+	x.relocateLeftNodes(left)
+
+	decision := goaktpb.SplitBrainDecision_SPLIT_BRAIN_DECISION_KEEP
+	switch {
+	case survives:
+		x.logger.Infof("(%s) split brain resolver=(%s) keeps node=(%s); unreachable members=%v",
+			x.name, resolver.Strategy(), x.clusterNode.PeersAddress(), unreachable)
+	case resolver.Action() == ReadOnlyAction:
+		decision = goaktpb.SplitBrainDecision_SPLIT_BRAIN_DECISION_READ_ONLY
+		x.logger.Warnf("(%s) split brain resolver=(%s) switches node=(%s) to read-only; reachable members=%v",
+			x.name, resolver.Strategy(), x.clusterNode.PeersAddress(), reachable)
+	default:
+		decision = goaktpb.SplitBrainDecision_SPLIT_BRAIN_DECISION_DOWN
+		x.logger.Warnf("(%s) split brain resolver=(%s) shuts node=(%s) down; reachable members=%v",
+			x.name, resolver.Strategy(), x.clusterNode.PeersAddress(), reachable)
+	}
+
+	if x.eventsStream != nil {
+		x.eventsStream.Publish(eventsTopic, &goaktpb.SplitBrainResolved{
+			Address:     x.clusterNode.PeersAddress(),
+			Strategy:    resolver.Strategy().String(),
+			Decision:    decision,
+			Reachable:   reachable,
+			Unreachable: unreachable,
+			Timestamp:   timestamppb.New(now),
+		})
+	}
+
+	if survives {
+		x.relocateLeftNodes(unreachable)
+		return true
+	}
+
+	x.switchToReadOnly(ctx)
+	if decision == goaktpb.SplitBrainDecision_SPLIT_BRAIN_DECISION_DOWN {
+		go func() {
+			if err := x.Stop(context.Background()); err != nil {
+				x.logger.Errorf("(%s) failed to shut down after split brain resolution: %v", x.name, err)
+			}
+		}()
+	}
+	return false
+}
+
+// relocateLeftNodes relocates the actors of the given members that have left the cluster
+func (x *actorSystem) relocateLeftNodes(addresses []string) {
+	for _, address := range addresses {
+		// the partitions the members owned are not known once the resolution is reached
+		x.handleNodeLeft(address, nil)
+	}
+}
+
+// switchToReadOnly stops the singleton actors of the node and prevents it from altering the cluster state
+func (x *actorSystem) switchToReadOnly(ctx context.Context) {
+	x.readOnly.Store(true)
+	for _, pid := range x.Actors() {
+		if pid.IsSingleton() {
+			if err := pid.Shutdown(ctx); err != nil {
+				x.logger.Warnf("(%s) failed to stop singleton actor=(%s): %v", x.name, pid.Name(), err)
+			}
+		}
+	}
+}
+
+// sameMembers states whether the given sets of members are the same
+func sameMembers(a, b map[string]struct{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for address := range a {
+		if _, ok := b[address]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package actor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tochemey/goakt/v3/internal/cluster"
+)
+
+func TestSplitBrainResolver(t *testing.T) {
+	now := time.Now()
+	node1 := &cluster.Peer{Host: "127.0.0.1", PeersPort: 3001, CreatedAt: now.Add(time.Minute)}
+	node2 := &cluster.Peer{Host: "127.0.0.1", PeersPort: 3002, CreatedAt: now}
+	node3 := &cluster.Peer{Host: "127.0.0.1", PeersPort: 3003, CreatedAt: now.Add(2 * time.Minute)}
+	node4 := &cluster.Peer{Host: "127.0.0.1", PeersPort: 3004, CreatedAt: now.Add(3 * time.Minute)}
+	members := []*cluster.Peer{node1, node2, node3}
+
+	t.Run("With default settings", func(t *testing.T) {
+		resolver := NewSplitBrainResolver(KeepMajority)
+		assert.Equal(t, KeepMajority, resolver.Strategy())
+		assert.Equal(t, ShutdownAction, resolver.Action())
+		assert.Equal(t, DefaultSplitBrainStableAfter, resolver.StableAfter())
+		assert.NoError(t, resolver.Validate())
+	})
+	t.Run("With strategy names", func(t *testing.T) {
+		assert.Equal(t, "keep-majority", KeepMajority.String())
+		assert.Equal(t, "keep-oldest", KeepOldest.String())
+		assert.Equal(t, "static-quorum", StaticQuorum.String())
+		assert.Equal(t, "keep-referee", KeepReferee.String())
+	})
+	t.Run("With invalid settings", func(t *testing.T) {
+		assert.Error(t, NewSplitBrainResolver(SplitBrainStrategy(10)).Validate())
+		assert.Error(t, NewSplitBrainResolver(KeepMajority).WithAction(SplitBrainAction(10)).Validate())
+		assert.Error(t, NewSplitBrainResolver(KeepMajority).WithStableAfter(0).Validate())
+		assert.Error(t, NewSplitBrainResolver(StaticQuorum).Validate())
+		assert.NoError(t, NewSplitBrainResolver(StaticQuorum).WithQuorumSize(2).Validate())
+		assert.Error(t, NewSplitBrainResolver(KeepReferee).Validate())
+		assert.NoError(t, NewSplitBrainResolver(KeepReferee).WithReferee(node1.PeerAddress()).Validate())
+	})
+	t.Run("With KeepMajority strategy", func(t *testing.T) {
+		resolver := NewSplitBrainResolver(KeepMajority)
+		assert.True(t, resolver.survives(members, []*cluster.Peer{node1, node3}))
+		assert.False(t, resolver.survives(members, []*cluster.Peer{node2}))
+
+		// on a tie the side holding the lowest address survives
+		members := []*cluster.Peer{node1, node2, node3, node4}
+		assert.True(t, resolver.survives(members, []*cluster.Peer{node1, node4}))
+		assert.False(t, resolver.survives(members, []*cluster.Peer{node2, node3}))
+	})
+	t.Run("With KeepOldest strategy", func(t *testing.T) {
+		resolver := NewSplitBrainResolver(KeepOldest)
+		assert.True(t, resolver.survives(members, []*cluster.Peer{node2}))
+		assert.False(t, resolver.survives(members, []*cluster.Peer{node1, node3}))
+	})
+	t.Run("With StaticQuorum strategy", func(t *testing.T) {
+		resolver := NewSplitBrainResolver(StaticQuorum).WithQuorumSize(2)
+		assert.True(t, resolver.survives(members, []*cluster.Peer{node1, node2}))
+		assert.False(t, resolver.survives(members, []*cluster.Peer{node3}))
+	})
+	t.Run("With KeepReferee strategy", func(t *testing.T) {
+		resolver := NewSplitBrainResolver(KeepReferee).WithReferee(node3.PeerAddress())
+		assert.True(t, resolver.survives(members, []*cluster.Peer{node3}))
+		assert.False(t, resolver.survives(members, []*cluster.Peer{node1, node2}))
+	})
+}
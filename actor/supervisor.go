@@ -30,7 +30,6 @@ import (
 	"sync"
 	"time"
 
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/tochemey/goakt/v3/internal/collection"
@@ -121,6 +120,13 @@ func (d Directive) String() string {
 	}
 }
 
+// Decider defines the function used by the supervisor to choose the directive to apply to a faulty actor.
+//
+// It receives the error that caused the failure, the faulty actor and the number of times the faulty
+// actor has been restarted. The error is handed over as-is, which means errors.Is and errors.As can be used
+// to classify wrapped errors. Errors raised by a panic are wrapped in a PanicError.
+type Decider func(err error, child *PID, restarts int) Directive
+
 // SupervisorOption defines the various options to apply to a given Supervisor
 type SupervisorOption func(*Supervisor)
 
@@ -162,6 +168,59 @@ func WithRetry(maxRetries uint32, timeout time.Duration) SupervisorOption {
 	}
 }
 
+// WithRestartBackoff configures the RestartDirective to restart the faulty actor after an exponential
+// backoff instead of restarting it right away.
+//
+// Parameters:
+//   - minBackoff: The delay before the first restart.
+//   - maxBackoff: The upper bound of the delay. The delay doubles after every consecutive restart until it reaches maxBackoff.
+//   - randomFactor: The random jitter added to the delay, e.g. 0.2 adds up to 20% to the delay.
+//     This helps avoid actors failing for the same reason to be restarted at the same time.
+//   - resetAfter: The duration the actor must run without failing after a restart for the backoff to be reset.
+//     A zero or negative value never resets the backoff.
+//
+// While waiting to be restarted, the messages sent to the actor are sent to the dead letters.
+// An ActorRestartScheduled event is published to the events stream for every delayed restart.
+// When a maximum number of retries is set with WithRetry, the faulty actor is stopped once it is restarted
+// more than that number of times within the WithRetry timeout, or more than that number of consecutive
+// times when no timeout is set.
+//
+// The backoff applies to both OneForOneStrategy and OneForAllStrategy.
+func WithRestartBackoff(minBackoff, maxBackoff time.Duration, randomFactor float64, resetAfter time.Duration) SupervisorOption {
+	return func(s *Supervisor) {
+		s.Lock()
+		s.backoff = newRestartBackoff(minBackoff, maxBackoff, randomFactor, resetAfter)
+		s.Unlock()
+	}
+}
+
+// WithDecider sets the function used to choose the directive to apply to a faulty actor.
+//
+// The decider takes precedence over the directives set with WithDirective and WithAnyErrorDirective.
+// It allows to classify errors by their chain rather than their exact type, and to change the directive
+// based on the restart history of the faulty actor, e.g. escalating the failure after a given number of restarts:
+//
+//	supervisor := NewSupervisor(WithDecider(func(err error, child *PID, restarts int) Directive {
+//	    switch {
+//	    case errors.Is(err, ErrTransient) && restarts < 3:
+//	        return RestartDirective
+//	    case errors.Is(err, ErrTransient):
+//	        return EscalateDirective
+//	    default:
+//	        return StopDirective
+//	    }
+//	}))
+//
+// The decider is called within the faulty actor supervision loop and must not block.
+// A panicking decider suspends the faulty actor.
+func WithDecider(decider Decider) SupervisorOption {
+	return func(s *Supervisor) {
+		s.Lock()
+		s.decider = decider
+		s.Unlock()
+	}
+}
+
 // WithAnyErrorDirective sets the directive to apply to any error
 //
 // Parameters:
@@ -194,6 +253,10 @@ type Supervisor struct {
 	maxRetries uint32
 	// Specifies the time range to restart the faulty actor
 	timeout time.Duration
+	// Specifies the restart backoff
+	backoff *restartBackoff
+	// Specifies the function that decides the directive
+	decider Decider
 
 	directives *collection.Map[string, Directive]
 }
@@ -264,6 +327,24 @@ func (s *Supervisor) Timeout() time.Duration {
 	return s.timeout
 }
 
+// getRestartBackoff returns the restart backoff.
+// It returns nil when the faulty actor is restarted right away
+func (s *Supervisor) getRestartBackoff() *restartBackoff {
+	s.Lock()
+	backoff := s.backoff
+	s.Unlock()
+	return backoff
+}
+
+// getDecider returns the decider.
+// It returns nil when the directives are looked up by error type
+func (s *Supervisor) getDecider() Decider {
+	s.Lock()
+	decider := s.decider
+	s.Unlock()
+	return decider
+}
+
 // Reset resets the strategy
 func (s *Supervisor) Reset() {
 	s.Lock()
@@ -289,11 +370,11 @@ func errorType(err error) string {
 
 type supervisionSignal struct {
 	err       error
-	msg       proto.Message
+	msg       any
 	timestamp *timestamppb.Timestamp
 }
 
-func newSupervisionSignal(err error, msg proto.Message) *supervisionSignal {
+func newSupervisionSignal(err error, msg any) *supervisionSignal {
 	return &supervisionSignal{
 		err:       err,
 		msg:       msg,
@@ -304,7 +385,7 @@ func newSupervisionSignal(err error, msg proto.Message) *supervisionSignal {
 func (s *supervisionSignal) Err() error {
 	return s.err
 }
-func (s *supervisionSignal) Msg() proto.Message {
+func (s *supervisionSignal) Msg() any {
 	return s.msg
 }
 func (s *supervisionSignal) Timestamp() *timestamppb.Timestamp {
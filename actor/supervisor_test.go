@@ -25,11 +25,17 @@
 package actor
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
 )
 
 func TestSupervisorOption(t *testing.T) {
@@ -48,6 +54,16 @@ func TestSupervisorOption(t *testing.T) {
 			option:   WithRetry(2, time.Second),
 			expected: &Supervisor{timeout: time.Second, maxRetries: 2},
 		},
+		{
+			name:   "WithRestartBackoff",
+			option: WithRestartBackoff(time.Second, time.Minute, 0.2, time.Hour),
+			expected: &Supervisor{backoff: &restartBackoff{
+				minBackoff:   time.Second,
+				maxBackoff:   time.Minute,
+				randomFactor: 0.2,
+				resetAfter:   time.Hour,
+			}},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -72,3 +88,78 @@ func TestSupervisorWithDirective(t *testing.T) {
 	require.True(t, ok)
 	require.Exactly(t, RestartDirective, directive)
 }
+
+func TestSupervisorWithDecider(t *testing.T) {
+	t.Run("With decider set", func(t *testing.T) {
+		supervisor := NewSupervisor(WithDecider(func(error, *PID, int) Directive {
+			return ResumeDirective
+		}))
+		decider := supervisor.getDecider()
+		require.NotNil(t, decider)
+		require.Exactly(t, ResumeDirective, decider(errors.New("oops"), nil, 0))
+	})
+	t.Run("With restart history and wrapped errors", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		supervisor := NewSupervisor(
+			// the decider takes precedence over the error type directives
+			WithDirective(&PanicError{}, ResumeDirective),
+			WithDecider(func(err error, _ *PID, restarts int) Directive {
+				if errors.Is(err, ErrUnhandled) && restarts < 1 {
+					return RestartDirective
+				}
+				return StopDirective
+			}))
+
+		parent, err := actorSystem.Spawn(ctx, "parent", NewMockSupervisor())
+		require.NoError(t, err)
+
+		child, err := parent.SpawnChild(ctx, "child", NewMockSupervised(), WithSupervisor(supervisor))
+		require.NoError(t, err)
+
+		pause.For(500 * time.Millisecond)
+
+		// an unhandled message panics with a wrapped ErrUnhandled
+		require.NoError(t, Tell(ctx, child, new(testpb.TestBye)))
+		pause.For(500 * time.Millisecond)
+
+		require.True(t, child.IsRunning())
+		require.EqualValues(t, 1, child.RestartCount())
+
+		require.NoError(t, Tell(ctx, child, new(testpb.TestBye)))
+		pause.For(500 * time.Millisecond)
+
+		require.False(t, child.IsRunning())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+	t.Run("With panicking decider", func(t *testing.T) {
+		ctx := context.TODO()
+		actorSystem, err := NewActorSystem("testSys", WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+		require.NoError(t, actorSystem.Start(ctx))
+
+		pause.For(500 * time.Millisecond)
+
+		supervisor := NewSupervisor(WithDecider(func(error, *PID, int) Directive {
+			panic("boom")
+		}))
+
+		pid, err := actorSystem.Spawn(ctx, "test", NewMockSupervised(), WithSupervisor(supervisor))
+		require.NoError(t, err)
+
+		pause.For(500 * time.Millisecond)
+
+		require.NoError(t, Tell(ctx, pid, new(testpb.TestPanic)))
+		pause.For(500 * time.Millisecond)
+
+		require.True(t, pid.IsSuspended())
+
+		require.NoError(t, actorSystem.Stop(ctx))
+	})
+}
@@ -35,4 +35,9 @@ type TLSInfo struct {
 	ClientTLS *tls.Config
 	// ServerTLS defines the server TLS config
 	ServerTLS *tls.Config
+	// CertificateProvider defines the optional provider of the node certificate.
+	// When set, the certificate is fetched from the provider on every handshake, for both
+	// the server and the client side, instead of using the certificates of ServerTLS and ClientTLS.
+	// This allows certificates to be rotated without restarting the node.
+	CertificateProvider CertificateProvider
 }
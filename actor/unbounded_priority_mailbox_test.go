@@ -28,14 +28,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/tochemey/goakt/v3/test/data/testpb"
 )
 
 func TestUnboundedPriorityMailBox(t *testing.T) {
 	t.Run("With highest priority mailbox", func(t *testing.T) {
-		priorityFunc := func(msg1, msg2 proto.Message) bool {
+		priorityFunc := func(msg1, msg2 any) bool {
 			p1 := msg1.(*testpb.TestMessage)
 			p2 := msg2.(*testpb.TestMessage)
 			return p1.Priority > p2.Priority
@@ -72,7 +71,7 @@ func TestUnboundedPriorityMailBox(t *testing.T) {
 		mailbox.Dispose()
 	})
 	t.Run("With lowest priority mailbox", func(t *testing.T) {
-		priorityFunc := func(msg1, msg2 proto.Message) bool {
+		priorityFunc := func(msg1, msg2 any) bool {
 			p1 := msg1.(*testpb.TestMessage)
 			p2 := msg2.(*testpb.TestMessage)
 			return p1.Priority <= p2.Priority
@@ -108,7 +107,7 @@ func TestUnboundedPriorityMailBox(t *testing.T) {
 		require.True(t, mailbox.IsEmpty())
 	})
 	t.Run("With dequeue when queue is empty", func(t *testing.T) {
-		priorityFunc := func(msg1, msg2 proto.Message) bool {
+		priorityFunc := func(msg1, msg2 any) bool {
 			p1 := msg1.(*testpb.TestMessage)
 			p2 := msg2.(*testpb.TestMessage)
 			return p1.Priority <= p2.Priority
@@ -30,8 +30,6 @@ import (
 	"testing"
 	"time"
 
-	"google.golang.org/protobuf/proto"
-
 	actors "github.com/tochemey/goakt/v3/actor"
 	"github.com/tochemey/goakt/v3/bench/benchpb"
 	"github.com/tochemey/goakt/v3/internal/pause"
@@ -162,7 +160,7 @@ func BenchmarkActor(b *testing.B) {
 	b.Run("Tell(priority mailbox)", func(b *testing.B) {
 		ctx := context.TODO()
 
-		priorityFunc := func(msg1, msg2 proto.Message) bool {
+		priorityFunc := func(msg1, msg2 any) bool {
 			p1 := msg1.(*benchpb.BenchPriorityMailbox)
 			p2 := msg2.(*benchpb.BenchPriorityMailbox)
 			return p1.Priority > p2.Priority
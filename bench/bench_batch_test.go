@@ -32,7 +32,6 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
-	"google.golang.org/protobuf/proto"
 
 	actors "github.com/tochemey/goakt/v3/actor"
 	"github.com/tochemey/goakt/v3/bench/benchpb"
@@ -98,16 +97,16 @@ type Benchmark struct {
 	pid          *actors.PID
 	sender       *actors.PID
 	system       actors.ActorSystem
-	tellMessages []proto.Message
-	askMessages  []proto.Message
+	tellMessages []any
+	askMessages  []any
 }
 
 // NewBenchmark creates an instance of Loader
 func NewBenchmark(messagesCount int) *Benchmark {
 	return &Benchmark{
 		toSend:       messagesCount,
-		tellMessages: make([]proto.Message, messagesCount),
-		askMessages:  make([]proto.Message, messagesCount),
+		tellMessages: make([]any, messagesCount),
+		askMessages:  make([]any, messagesCount),
 	}
 }
 
@@ -54,7 +54,7 @@ func main() {
 
 	total := 1_000_000
 	messageSize := 0
-	toSend := make([]proto.Message, total)
+	toSend := make([]any, total)
 	for i := range total {
 		message := new(testpb.TestPing)
 		messageSize += proto.Size(message)
@@ -106,12 +106,12 @@ type Ping struct {
 	count int
 	start time.Time
 
-	toSend []proto.Message
+	toSend []any
 }
 
 var _ goakt.Actor = (*Ping)(nil)
 
-func NewPing(toSend []proto.Message) *Ping {
+func NewPing(toSend []any) *Ping {
 	return &Ping{
 		toSend: toSend,
 	}
@@ -0,0 +1,107 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package bench
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/travisjeffery/go-dynaport"
+
+	actors "github.com/tochemey/goakt/v3/actor"
+	"github.com/tochemey/goakt/v3/address"
+	"github.com/tochemey/goakt/v3/bench/benchpb"
+	"github.com/tochemey/goakt/v3/internal/pause"
+	"github.com/tochemey/goakt/v3/log"
+	"github.com/tochemey/goakt/v3/remote"
+)
+
+func BenchmarkRemoteTell(b *testing.B) {
+	b.Run("RemoteTell(unary)", func(b *testing.B) {
+		benchmarkRemoteTell(b, false)
+	})
+	b.Run("RemoteTell(stream)", func(b *testing.B) {
+		benchmarkRemoteTell(b, true)
+	})
+}
+
+// benchmarkRemoteTell measures the number of messages sent to a remote actor and processed per second
+func benchmarkRemoteTell(b *testing.B, streaming bool) {
+	ctx := context.TODO()
+
+	remoteOpts := []remote.Option{remote.WithStreamBatchSize(512)}
+	remotingOpts := []actors.RemotingOption{actors.WithRemotingStreamBatchSize(512)}
+	if streaming {
+		remoteOpts = append(remoteOpts, remote.WithStreaming())
+		remotingOpts = append(remotingOpts, actors.WithRemotingStreaming())
+	}
+
+	// create the actor system
+	actorSystem, _ := actors.NewActorSystem("bench",
+		actors.WithLogger(log.DiscardLogger),
+		actors.WithActorInitMaxRetries(1),
+		actors.WithRemote(remote.NewConfig("127.0.0.1", dynaport.Get(1)[0], remoteOpts...)))
+
+	// start the actor system
+	_ = actorSystem.Start(ctx)
+
+	// wait for system to start properly
+	pause.For(1 * time.Second)
+
+	pid, _ := actorSystem.Spawn(ctx, "test", new(Actor))
+
+	// wait for actors to start properly
+	pause.For(1 * time.Second)
+
+	remoting := actors.NewRemoting(remotingOpts...)
+	addr, _ := remoting.RemoteLookup(ctx, actorSystem.Host(), int(actorSystem.Port()), "test")
+
+	var counter int64
+	b.ResetTimer()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if err := remoting.RemoteTell(ctx, address.NoSender(), addr, new(benchpb.BenchTell)); err != nil {
+				b.Fatal(err)
+			}
+			atomic.AddInt64(&counter, 1)
+		}
+	})
+
+	// wait for the messages in flight to be processed
+	for int64(pid.ProcessedCount()) < atomic.LoadInt64(&counter) {
+		pause.For(time.Millisecond)
+	}
+
+	b.StopTimer()
+
+	messagesPerSec := float64(atomic.LoadInt64(&counter)) / b.Elapsed().Seconds()
+	b.ReportMetric(messagesPerSec, "messages/sec")
+
+	remoting.Close()
+	_ = actorSystem.Stop(ctx)
+}
@@ -24,12 +24,16 @@
 
 package client
 
+import "google.golang.org/protobuf/proto"
+
 // Actor defines a given actor name and kind.
 // Kind is a string representation of the type within its package (e.g pkg/User)
 type Actor struct {
 	name string // Name defines the actor name. This will be unique in the Client
 	kind string // Kind specifies the actor kind.
 
+	factory string        // factory specifies the name of the actor factory creating the actor
+	args    proto.Message // args specifies the arguments passed to the actor factory
 }
 
 // NewActor creates an instance of Actor
@@ -45,6 +49,14 @@ func (x *Actor) WithName(name string) *Actor {
 	return x
 }
 
+// WithFactory sets the name of the actor factory creating the actor and the arguments passed to it.
+// The factory must be registered on the actor cluster nodes with the RegisterFactory method of the actor system.
+func (x *Actor) WithFactory(factory string, args proto.Message) *Actor {
+	x.factory = factory
+	x.args = args
+	return x
+}
+
 // Name returns the actor name
 func (x *Actor) Name() string {
 	return x.name
@@ -54,3 +66,13 @@ func (x *Actor) Name() string {
 func (x *Actor) Kind() string {
 	return x.kind
 }
+
+// Factory returns the name of the actor factory
+func (x *Actor) Factory() string {
+	return x.factory
+}
+
+// Args returns the arguments passed to the actor factory
+func (x *Actor) Args() proto.Message {
+	return x.args
+}
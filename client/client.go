@@ -161,6 +161,29 @@ func (x *Client) Kinds(ctx context.Context) ([]string, error) {
 	return response.Msg.GetKinds(), nil
 }
 
+// Drain gracefully removes the given node from the cluster.
+//
+// The node stops accepting new actors, hands off its relocatable actors and its grains
+// to the other cluster members, and then leaves the cluster and stops its actor system.
+//
+// Parameters:
+//   - ctx: Context used for cancellation and timeout control.
+//   - node: The cluster node to drain.
+//
+// Returns:
+//   - error: An error if the node fails to hand off its actors and grains.
+func (x *Client) Drain(ctx context.Context, node *Node) error {
+	service := clusterClient(node)
+	_, err := service.DrainNode(
+		ctx, connect.NewRequest(
+			&internalpb.DrainNodeRequest{
+				NodeAddress: node.Address(),
+			},
+		),
+	)
+	return err
+}
+
 // Spawn creates and starts an actor using the default balancing strategy.
 //
 // This method initializes the provided actor and places it on an available node
@@ -189,6 +212,8 @@ func (x *Client) Spawn(ctx context.Context, actor *Actor, singleton, relocatable
 		&remote.SpawnRequest{
 			Name:        actor.Name(),
 			Kind:        actor.Kind(),
+			Factory:     actor.Factory(),
+			Args:        actor.Args(),
 			Singleton:   singleton,
 			Relocatable: relocatable,
 		})
@@ -227,6 +252,8 @@ func (x *Client) SpawnBalanced(ctx context.Context, actor *Actor, singleton, rel
 	spawnRequest := &remote.SpawnRequest{
 		Name:        actor.Name(),
 		Kind:        actor.Kind(),
+		Factory:     actor.Factory(),
+		Args:        actor.Args(),
 		Singleton:   singleton,
 		Relocatable: relocatable,
 	}
@@ -270,13 +297,14 @@ func (x *Client) ReSpawn(ctx context.Context, actor *Actor) (err error) {
 
 // Tell sends a message to the specified actor.
 //
-// This method delivers the given protobuf message to the target actor. If the actor
+// This method delivers the given message to the target actor. If the actor
 // is not currently running or registered in the system, a NOT_FOUND error is returned.
 //
 // Parameters:
 //   - ctx: Context used for cancellation and timeout control.
 //   - actor: A pointer to the target Actor instance, used to locate the actor by name.
-//   - Message: A protobuf message to send to the actor. This must be a valid, serializable message.
+//   - Message: The message to send to the actor. Protocol buffers messages are serialized by default,
+//     any other message type requires a serializer registered with WithSerializer.
 //
 // Returns:
 //   - error: Returns nil on success. Returns a NOT_FOUND error if the actor is not available.
@@ -284,7 +312,8 @@ func (x *Client) ReSpawn(ctx context.Context, actor *Actor) (err error) {
 // Note:
 //   - This method is asynchronous; it does not wait for a response.
 //   - For request-response patterns, consider using `Ask` instead of `Tell`.
-func (x *Client) Tell(ctx context.Context, actor *Actor, message proto.Message) error {
+//   - Metadata attached to ctx with actor.ContextWithMetadata is sent along with the message.
+func (x *Client) Tell(ctx context.Context, actor *Actor, message any) error {
 	x.locker.Lock()
 	node := nextNode(x.balancer)
 	x.locker.Unlock()
@@ -306,17 +335,18 @@ func (x *Client) Tell(ctx context.Context, actor *Actor, message proto.Message)
 
 // Ask sends a message to the specified actor and waits for a response.
 //
-// This method sends a protobuf message to the given actor and blocks until a reply is received
+// This method sends a message to the given actor and blocks until a reply is received
 // or the timeout is reached. It is intended for request-response communication patterns.
 //
 // Parameters:
 //   - ctx: Context used for cancellation and deadline control.
 //   - actor: A pointer to the target Actor instance, used to locate the actor by name.
-//   - message: A protobuf message to send to the actor. This must be a valid, serializable message.
+//   - message: The message to send to the actor. Protocol buffers messages are serialized by default,
+//     any other message type requires a serializer registered with WithSerializer.
 //   - timeout: The maximum duration to wait for a response from the actor.
 //
 // Returns:
-//   - reply: The protobuf message returned by the actor.
+//   - reply: The message returned by the actor.
 //   - err: Returns an error if the actor is not found, if the timeout is exceeded,
 //     or if message delivery or handling fails.
 //
@@ -324,7 +354,8 @@ func (x *Client) Tell(ctx context.Context, actor *Actor, message proto.Message)
 //   - If the actor does not exist or is unreachable, a NOT_FOUND error is returned.
 //   - Ensure the actor is designed to handle the incoming message and reply appropriately.
 //   - For fire-and-forget messaging, use `Tell` instead of `Ask`.
-func (x *Client) Ask(ctx context.Context, actor *Actor, message proto.Message, timeout time.Duration) (reply proto.Message, err error) {
+//   - Metadata attached to ctx with actor.ContextWithMetadata is sent along with the message.
+func (x *Client) Ask(ctx context.Context, actor *Actor, message any, timeout time.Duration) (reply any, err error) {
 	x.locker.Lock()
 	node := nextNode(x.balancer)
 	x.locker.Unlock()
@@ -341,11 +372,7 @@ func (x *Client) Ask(ctx context.Context, actor *Actor, message proto.Message, t
 	}
 
 	from := address.NoSender()
-	response, err := remoting.RemoteAsk(ctx, from, to, message, timeout)
-	if err != nil {
-		return nil, err
-	}
-	return response.UnmarshalNew()
+	return remoting.RemoteAsk(ctx, from, to, message, timeout)
 }
 
 // Stop gracefully stops or forcefully terminates the specified actor.
@@ -26,6 +26,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -102,7 +103,7 @@ func TestClient(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expectedReply := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expectedReply, reply))
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
 
 		pause.For(time.Second)
 
@@ -186,7 +187,7 @@ func TestClient(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expectedReply := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expectedReply, reply))
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
 
 		pause.For(time.Second)
 
@@ -272,7 +273,7 @@ func TestClient(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expectedReply := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expectedReply, reply))
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
 
 		pause.For(time.Second)
 
@@ -354,7 +355,7 @@ func TestClient(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expectedReply := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expectedReply, reply))
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
 
 		pause.For(time.Second)
 
@@ -437,7 +438,7 @@ func TestClient(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expectedReply := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expectedReply, reply))
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
 
 		pause.For(time.Second)
 
@@ -464,6 +465,146 @@ func TestClient(t *testing.T) {
 			},
 		)
 	})
+	t.Run("With actor factory", func(t *testing.T) {
+		ctx := context.TODO()
+
+		logger := log.DiscardLogger
+
+		// start the NATS server
+		srv := startNatsServer(t)
+		addr := srv.Addr().String()
+
+		sys1, node1Host, node1Port, sd1 := startNode(t, logger, "node1", addr)
+		sys2, node2Host, node2Port, sd2 := startNode(t, logger, "node2", addr)
+		sys3, node3Host, node3Port, sd3 := startNode(t, logger, "node3", addr)
+
+		// register the actor factory on every node
+		factory := func(_ context.Context, args proto.Message) (actors.Actor, error) {
+			if _, ok := args.(*testpb.TestLog); !ok {
+				return nil, errors.New("invalid arguments")
+			}
+			return &testActor{}, nil
+		}
+		for _, sys := range []actors.ActorSystem{sys1, sys2, sys3} {
+			require.NoError(t, sys.RegisterFactory(ctx, "factory", factory))
+		}
+
+		// wait for a proper and clean setup of the cluster
+		pause.For(time.Second)
+
+		addresses := []string{
+			fmt.Sprintf("%s:%d", node1Host, node1Port),
+			fmt.Sprintf("%s:%d", node2Host, node2Port),
+			fmt.Sprintf("%s:%d", node3Host, node3Port),
+		}
+
+		nodes := make([]*Node, len(addresses))
+		for i, addr := range addresses {
+			nodes[i] = NewNode(addr)
+		}
+
+		client, err := New(ctx, nodes)
+		require.NoError(t, err)
+		require.NotNil(t, client)
+
+		actor := NewActor("").
+			WithName("actorName").
+			WithFactory("factory", &testpb.TestLog{Text: "hello"})
+
+		err = client.SpawnBalanced(ctx, actor, false, true, RoundRobinStrategy)
+		require.NoError(t, err)
+
+		pause.For(time.Second)
+
+		// send a message
+		reply, err := client.Ask(ctx, actor, new(testpb.TestReply), time.Minute)
+		require.NoError(t, err)
+		require.NotNil(t, reply)
+		expectedReply := &testpb.Reply{Content: "received message"}
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
+
+		// spawning with invalid factory arguments fails
+		err = client.Spawn(ctx, NewActor("").WithName("other").WithFactory("factory", new(testpb.TestSend)), false, true)
+		require.Error(t, err)
+
+		t.Cleanup(
+			func() {
+				client.Close()
+
+				require.NoError(t, sys1.Stop(ctx))
+				require.NoError(t, sys2.Stop(ctx))
+				require.NoError(t, sys3.Stop(ctx))
+
+				require.NoError(t, sd1.Close())
+				require.NoError(t, sd2.Close())
+				require.NoError(t, sd3.Close())
+
+				srv.Shutdown()
+				pause.For(time.Second)
+			},
+		)
+	})
+	t.Run("With Drain", func(t *testing.T) {
+		ctx := context.TODO()
+
+		logger := log.DiscardLogger
+
+		// start the NATS server
+		srv := startNatsServer(t)
+		addr := srv.Addr().String()
+
+		sys1, node1Host, node1Port, sd1 := startNode(t, logger, "node1", addr)
+		sys2, node2Host, node2Port, sd2 := startNode(t, logger, "node2", addr)
+		sys3, node3Host, node3Port, sd3 := startNode(t, logger, "node3", addr)
+
+		_, err := sys2.Spawn(ctx, "actorName", &testActor{})
+		require.NoError(t, err)
+
+		// wait for a proper and clean setup of the cluster
+		pause.For(time.Second)
+
+		addresses := []string{
+			fmt.Sprintf("%s:%d", node1Host, node1Port),
+			fmt.Sprintf("%s:%d", node2Host, node2Port),
+			fmt.Sprintf("%s:%d", node3Host, node3Port),
+		}
+
+		nodes := make([]*Node, len(addresses))
+		for i, addr := range addresses {
+			nodes[i] = NewNode(addr)
+		}
+
+		client, err := New(ctx, nodes)
+		require.NoError(t, err)
+		require.NotNil(t, client)
+
+		require.NoError(t, client.Drain(ctx, nodes[1]))
+
+		// the drained node stops once its actors are handed off
+		require.Eventually(t, func() bool { return !sys2.Running() }, time.Minute, 100*time.Millisecond)
+		require.NoError(t, sd2.Close())
+
+		pause.For(time.Second)
+
+		actorAddr, err := sys1.RemoteActor(ctx, "actorName")
+		require.NoError(t, err)
+		assert.NotEqual(t, node2Port, int(actorAddr.GetPort()))
+
+		t.Cleanup(
+			func() {
+				client.Close()
+
+				require.NoError(t, sys1.Stop(ctx))
+				require.NoError(t, sys3.Stop(ctx))
+
+				require.NoError(t, sd1.Close())
+				require.NoError(t, sd3.Close())
+
+				srv.Shutdown()
+				pause.For(time.Second)
+			},
+		)
+	})
 	t.Run("With ReSpawn", func(t *testing.T) {
 		ctx := context.TODO()
 
@@ -519,7 +660,7 @@ func TestClient(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expectedReply := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expectedReply, reply))
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
 
 		pause.For(time.Second)
 
@@ -535,7 +676,7 @@ func TestClient(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expectedReply = &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expectedReply, reply))
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
 
 		err = client.Stop(ctx, actor)
 		require.NoError(t, err)
@@ -612,7 +753,7 @@ func TestClient(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, reply)
 		expectedReply := &testpb.Reply{Content: "received message"}
-		assert.True(t, proto.Equal(expectedReply, reply))
+		assert.True(t, proto.Equal(expectedReply, reply.(proto.Message)))
 
 		pause.For(time.Second)
 
@@ -35,6 +35,7 @@ import (
 	"github.com/tochemey/goakt/v3/internal/http"
 	"github.com/tochemey/goakt/v3/internal/size"
 	"github.com/tochemey/goakt/v3/internal/validation"
+	"github.com/tochemey/goakt/v3/remote"
 )
 
 type NodeOption func(*Node)
@@ -60,44 +61,63 @@ func WithTLS(config *tls.Config) NodeOption {
 	}
 }
 
+// WithSerializer registers the serializer used to serialize the messages of the same type
+// as the given message. It must match the serializer registered on the actor cluster nodes.
+// Protocol buffers messages without a registered serializer are serialized with remote.ProtoSerializer.
+func WithSerializer(message any, serializer remote.Serializer) NodeOption {
+	return func(n *Node) {
+		n.remotingOptions = append(n.remotingOptions, actors.WithRemotingSerializer(message, serializer))
+	}
+}
+
+// WithCredentials sets the credentials attached to the requests sent to the node.
+// They are required when the actor cluster nodes verify their callers with remote.WithAuthenticator.
+func WithCredentials(credentials remote.Credentials) NodeOption {
+	return func(n *Node) {
+		n.remotingOptions = append(n.remotingOptions, actors.WithRemotingCredentials(credentials))
+	}
+}
+
+// WithChunkSize splits the messages whose serialized size exceeds the given size into chunks of that size
+// when they are sent to the node. Replies larger than the chunk size configured on the node are always reassembled.
+func WithChunkSize(size int) NodeOption {
+	return func(n *Node) {
+		n.remotingOptions = append(n.remotingOptions, actors.WithRemotingChunkSize(size))
+	}
+}
+
 // Node represents the node in the cluster
 type Node struct {
 	address string
 	weight  float64
 	mutex   *sync.Mutex
 
-	client    *nethttp.Client
-	remoting  *actors.Remoting
-	tlsConfig *tls.Config
+	client          *nethttp.Client
+	remoting        *actors.Remoting
+	tlsConfig       *tls.Config
+	remotingOptions []actors.RemotingOption
 }
 
 // NewNode creates an instance of Node
 // nolint
 func NewNode(address string, opts ...NodeOption) *Node {
-	remoting := actors.NewRemoting(actors.WithRemotingMaxReadFameSize(16 * size.MB))
 	node := &Node{
-		address:  address,
-		mutex:    &sync.Mutex{},
-		client:   remoting.HTTPClient(),
-		remoting: remoting,
-		weight:   0,
+		address: address,
+		mutex:   &sync.Mutex{},
+		weight:  0,
 	}
 
 	for _, opt := range opts {
 		opt(node)
 	}
 
+	remotingOptions := append([]actors.RemotingOption{actors.WithRemotingMaxReadFameSize(16 * size.MB)}, node.remotingOptions...)
 	if node.tlsConfig != nil {
-		// overwrite the remoting
-		node.remoting = actors.NewRemoting(
-			actors.WithRemotingMaxReadFameSize(16*size.MB),
-			actors.WithRemotingTLS(node.tlsConfig),
-		)
-
-		// reset the client
-		node.client = remoting.HTTPClient()
+		remotingOptions = append(remotingOptions, actors.WithRemotingTLS(node.tlsConfig))
 	}
 
+	node.remoting = actors.NewRemoting(remotingOptions...)
+	node.client = node.remoting.HTTPClient()
 	return node
 }
 
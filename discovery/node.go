@@ -42,6 +42,8 @@ type Node struct {
 	PeersPort int
 	// RemotingPort
 	RemotingPort int
+	// Roles specifies the roles of the discovered node
+	Roles []string
 }
 
 // PeersAddress returns address the node's peers will use to connect to
@@ -27,8 +27,6 @@ package future
 import (
 	"context"
 	"sync"
-
-	"google.golang.org/protobuf/proto"
 )
 
 // Future represents a value which may or may not currently be available,
@@ -38,20 +36,20 @@ import (
 //
 // The Future interface provides two main methods:
 //
-// 1. Await(ctx context.Context) (proto.Message, error):
+// 1. Await(ctx context.Context) (any, error):
 //   - This method blocks until the Future is completed or the provided context
 //     is canceled. It returns either the result of the computation or an error
 //     if the computation failed or the context was canceled.
 //
-// 2. complete(value proto.Message, err error):
+// 2. complete(value any, err error):
 //   - This method completes the Future with either a value or an error. It is
 //     used internally by the completable to set the result of the computation.
 //
 // Example usage:
 //
-//	task := func() (proto.Message, error) {
+//	task := func() (any, error) {
 //	    // Perform some long-running computation
-//	    result := &MyProtoMessage{...}
+//	    result := &MyMessage{...}
 //	    return result, nil
 //	}
 //
@@ -69,15 +67,15 @@ import (
 type Future interface {
 	// Await blocks until the Future is completed or context is canceled and
 	// returns either a result or an error.
-	Await(context.Context) (proto.Message, error)
+	Await(context.Context) (any, error)
 
 	// complete completes the Future with either a value or an error.
 	// It is used by [completable] internally.
-	complete(proto.Message, error)
+	complete(any, error)
 }
 
 // New creates a new Future that executes the given long-running task.
-// The task is a function that returns a proto.Message and an error.
+// The task is a function that returns a value and an error.
 // The Future is completed with the value returned by the task or failed with the error.
 //
 // The task is executed asynchronously in a separate goroutine. The Future can be
@@ -86,9 +84,9 @@ type Future interface {
 //
 // Example usage:
 //
-//	task := func() (proto.Message, error) {
+//	task := func() (any, error) {
 //	    // Perform some long-running computation
-//	    result := &MyProtoMessage{...}
+//	    result := &MyMessage{...}
 //	    return result, nil
 //	}
 //
@@ -103,7 +101,7 @@ type Future interface {
 //	}
 //
 //	log.Printf("Received result: %v", result)
-func New(task func() (proto.Message, error)) Future {
+func New(task func() (any, error)) Future {
 	comp := newCompletable()
 	go func() {
 		result, err := task()
@@ -120,18 +118,24 @@ func New(task func() (proto.Message, error)) Future {
 type future struct {
 	acceptOnce   sync.Once
 	completeOnce sync.Once
-	done         chan any
-	value        proto.Message
+	done         chan result
+	value        any
 	err          error
 }
 
+// result holds the outcome of the computation
+type result struct {
+	value any
+	err   error
+}
+
 // Verify future satisfies the Future interface.
 var _ Future = (*future)(nil)
 
 // newFuture returns a new Future.
 func newFuture() Future {
 	return &future{
-		done: make(chan any, 1),
+		done: make(chan result, 1),
 	}
 }
 
@@ -140,38 +144,28 @@ func newFuture() Future {
 func (x *future) wait(ctx context.Context) {
 	x.acceptOnce.Do(func() {
 		select {
-		case result := <-x.done:
-			x.setResult(result)
+		case res := <-x.done:
+			x.value, x.err = res.value, res.err
 		case <-ctx.Done():
-			x.setResult(ctx.Err())
+			x.err = ctx.Err()
 		}
 	})
 }
 
-// setResult assigns a value to the Future instance.
-func (x *future) setResult(result any) {
-	switch value := result.(type) {
-	case error:
-		x.err = value
-	default:
-		x.value = value.(proto.Message)
-	}
-}
-
 // Await blocks until the Future is completed or context is canceled and
 // returns either a result or an error.
-func (x *future) Await(ctx context.Context) (proto.Message, error) {
+func (x *future) Await(ctx context.Context) (any, error) {
 	x.wait(ctx)
 	return x.value, x.err
 }
 
 // complete completes the Future with either a value or an error.
-func (x *future) complete(value proto.Message, err error) {
+func (x *future) complete(value any, err error) {
 	x.completeOnce.Do(func() {
 		if err != nil {
-			x.done <- err
+			x.done <- result{err: err}
 		} else {
-			x.done <- value
+			x.done <- result{value: value}
 		}
 	})
 }
@@ -180,7 +174,7 @@ func (x *future) complete(value proto.Message, err error) {
 // which completes a Future.
 type completable interface {
 	// Success completes the underlying Future with a value.
-	Success(proto.Message)
+	Success(any)
 
 	// Failure fails the underlying Future with an error.
 	Failure(error)
@@ -206,7 +200,7 @@ func newCompletable() completable {
 }
 
 // Success completes the underlying Future with a given value.
-func (p *completer) Success(value proto.Message) {
+func (p *completer) Success(value any) {
 	p.once.Do(func() {
 		p.future.complete(value, nil)
 	})
@@ -215,8 +209,7 @@ func (p *completer) Success(value proto.Message) {
 // Failure fails the underlying Future with a given error.
 func (p *completer) Failure(err error) {
 	p.once.Do(func() {
-		var zero proto.Message
-		p.future.complete(zero, err)
+		p.future.complete(nil, err)
 	})
 }
 
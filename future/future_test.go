@@ -44,7 +44,7 @@ func TestMain(m *testing.M) {
 
 func TestFuture(t *testing.T) {
 	t.Run("With timeout", func(t *testing.T) {
-		future := New(func() (proto.Message, error) {
+		future := New(func() (any, error) {
 			// simulate a long-running task
 			pause.For(100 * time.Millisecond)
 			return nil, nil
@@ -60,7 +60,7 @@ func TestFuture(t *testing.T) {
 	})
 	t.Run("With success", func(t *testing.T) {
 		expected := new(testpb.TestPing)
-		future := New(func() (proto.Message, error) {
+		future := New(func() (any, error) {
 			// simulate a long-running task
 			pause.For(10 * time.Millisecond)
 			return expected, nil
@@ -71,10 +71,22 @@ func TestFuture(t *testing.T) {
 
 		result, err := future.Await(ctx)
 		require.NoError(t, err)
-		require.True(t, proto.Equal(expected, result))
+		require.True(t, proto.Equal(expected, result.(proto.Message)))
+	})
+	t.Run("With a plain Go value", func(t *testing.T) {
+		future := New(func() (any, error) {
+			return "done", nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		result, err := future.Await(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "done", result)
 	})
 	t.Run("With failure", func(t *testing.T) {
-		future := New(func() (proto.Message, error) {
+		future := New(func() (any, error) {
 			// simulate a long-running task
 			pause.For(10 * time.Millisecond)
 			return nil, assert.AnError
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,6 +24,163 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TerminationReason defines why a watched actor is reported terminated
+type TerminationReason int32
+
+const (
+	// The watched actor has stopped
+	TerminationReason_TERMINATION_REASON_STOPPED TerminationReason = 0
+	// The node hosting the watched actor has left the cluster.
+	// The actor may still be running on a node that is no longer reachable.
+	TerminationReason_TERMINATION_REASON_NODE_LEFT TerminationReason = 1
+)
+
+// Enum value maps for TerminationReason.
+var (
+	TerminationReason_name = map[int32]string{
+		0: "TERMINATION_REASON_STOPPED",
+		1: "TERMINATION_REASON_NODE_LEFT",
+	}
+	TerminationReason_value = map[string]int32{
+		"TERMINATION_REASON_STOPPED":   0,
+		"TERMINATION_REASON_NODE_LEFT": 1,
+	}
+)
+
+func (x TerminationReason) Enum() *TerminationReason {
+	p := new(TerminationReason)
+	*p = x
+	return p
+}
+
+func (x TerminationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TerminationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_goakt_goakt_proto_enumTypes[0].Descriptor()
+}
+
+func (TerminationReason) Type() protoreflect.EnumType {
+	return &file_goakt_goakt_proto_enumTypes[0]
+}
+
+func (x TerminationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TerminationReason.Descriptor instead.
+func (TerminationReason) EnumDescriptor() ([]byte, []int) {
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{0}
+}
+
+// CircuitBreakerState defines the state of a circuit breaker
+type CircuitBreakerState int32
+
+const (
+	// Requests flow through the circuit breaker
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED CircuitBreakerState = 0
+	// Requests are rejected without reaching their target
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN CircuitBreakerState = 1
+	// A limited number of trial requests is let through
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN CircuitBreakerState = 2
+)
+
+// Enum value maps for CircuitBreakerState.
+var (
+	CircuitBreakerState_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_STATE_CLOSED",
+		1: "CIRCUIT_BREAKER_STATE_OPEN",
+		2: "CIRCUIT_BREAKER_STATE_HALF_OPEN",
+	}
+	CircuitBreakerState_value = map[string]int32{
+		"CIRCUIT_BREAKER_STATE_CLOSED":    0,
+		"CIRCUIT_BREAKER_STATE_OPEN":      1,
+		"CIRCUIT_BREAKER_STATE_HALF_OPEN": 2,
+	}
+)
+
+func (x CircuitBreakerState) Enum() *CircuitBreakerState {
+	p := new(CircuitBreakerState)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircuitBreakerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_goakt_goakt_proto_enumTypes[1].Descriptor()
+}
+
+func (CircuitBreakerState) Type() protoreflect.EnumType {
+	return &file_goakt_goakt_proto_enumTypes[1]
+}
+
+func (x CircuitBreakerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerState.Descriptor instead.
+func (CircuitBreakerState) EnumDescriptor() ([]byte, []int) {
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{1}
+}
+
+// SplitBrainDecision defines the decision taken by the split brain resolver
+// for the node it runs on
+type SplitBrainDecision int32
+
+const (
+	// The node is on the surviving side of the network partition
+	SplitBrainDecision_SPLIT_BRAIN_DECISION_KEEP SplitBrainDecision = 0
+	// The node is on the losing side of the network partition and shuts down
+	SplitBrainDecision_SPLIT_BRAIN_DECISION_DOWN SplitBrainDecision = 1
+	// The node is on the losing side of the network partition and switches to read-only
+	SplitBrainDecision_SPLIT_BRAIN_DECISION_READ_ONLY SplitBrainDecision = 2
+)
+
+// Enum value maps for SplitBrainDecision.
+var (
+	SplitBrainDecision_name = map[int32]string{
+		0: "SPLIT_BRAIN_DECISION_KEEP",
+		1: "SPLIT_BRAIN_DECISION_DOWN",
+		2: "SPLIT_BRAIN_DECISION_READ_ONLY",
+	}
+	SplitBrainDecision_value = map[string]int32{
+		"SPLIT_BRAIN_DECISION_KEEP":      0,
+		"SPLIT_BRAIN_DECISION_DOWN":      1,
+		"SPLIT_BRAIN_DECISION_READ_ONLY": 2,
+	}
+)
+
+func (x SplitBrainDecision) Enum() *SplitBrainDecision {
+	p := new(SplitBrainDecision)
+	*p = x
+	return p
+}
+
+func (x SplitBrainDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitBrainDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_goakt_goakt_proto_enumTypes[2].Descriptor()
+}
+
+func (SplitBrainDecision) Type() protoreflect.EnumType {
+	return &file_goakt_goakt_proto_enumTypes[2]
+}
+
+func (x SplitBrainDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitBrainDecision.Descriptor instead.
+func (SplitBrainDecision) EnumDescriptor() ([]byte, []int) {
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{2}
+}
+
 // Address represents an actor address
 type Address struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -122,12 +280,15 @@ type Deadletter struct {
 	// Specifies the actor address
 	Receiver *Address `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// Specifies the message to send to the actor
-	// Any proto message is allowed to be sent
+	// It is only set for protocol buffers messages
 	Message *anypb.Any `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	// Specifies the message send time
 	SendTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=send_time,json=sendTime,proto3" json:"send_time,omitempty"`
 	// Specifies the reason why the deadletter
-	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Specifies the type of the message, the same name the serializers identify it with.
+	// It is set for any message, including the ones that are not protocol buffers messages
+	MessageType   string `protobuf:"bytes,6,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -197,6 +358,13 @@ func (x *Deadletter) GetReason() string {
 	return ""
 }
 
+func (x *Deadletter) GetMessageType() string {
+	if x != nil {
+		return x.MessageType
+	}
+	return ""
+}
+
 // ActorStarted defines the actor started event
 type ActorStarted struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -600,6 +768,80 @@ func (x *ActorReinstated) GetReinstatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// ActorRestartScheduled is triggered when the restart of a faulty actor
+// is delayed by the supervisor restart backoff
+type ActorRestartScheduled struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the actor address
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Specifies the restart attempt
+	Attempt uint32 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Specifies the delay before the restart
+	Delay *durationpb.Duration `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	// Specifies the scheduled time
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActorRestartScheduled) Reset() {
+	*x = ActorRestartScheduled{}
+	mi := &file_goakt_goakt_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActorRestartScheduled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActorRestartScheduled) ProtoMessage() {}
+
+func (x *ActorRestartScheduled) ProtoReflect() protoreflect.Message {
+	mi := &file_goakt_goakt_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActorRestartScheduled.ProtoReflect.Descriptor instead.
+func (*ActorRestartScheduled) Descriptor() ([]byte, []int) {
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ActorRestartScheduled) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ActorRestartScheduled) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ActorRestartScheduled) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *ActorRestartScheduled) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
 // NodeJoined defines the node joined event
 type NodeJoined struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -613,7 +855,7 @@ type NodeJoined struct {
 
 func (x *NodeJoined) Reset() {
 	*x = NodeJoined{}
-	mi := &file_goakt_goakt_proto_msgTypes[9]
+	mi := &file_goakt_goakt_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -625,7 +867,7 @@ func (x *NodeJoined) String() string {
 func (*NodeJoined) ProtoMessage() {}
 
 func (x *NodeJoined) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[9]
+	mi := &file_goakt_goakt_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +880,7 @@ func (x *NodeJoined) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeJoined.ProtoReflect.Descriptor instead.
 func (*NodeJoined) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{9}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NodeJoined) GetAddress() string {
@@ -668,7 +910,7 @@ type NodeLeft struct {
 
 func (x *NodeLeft) Reset() {
 	*x = NodeLeft{}
-	mi := &file_goakt_goakt_proto_msgTypes[10]
+	mi := &file_goakt_goakt_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +922,7 @@ func (x *NodeLeft) String() string {
 func (*NodeLeft) ProtoMessage() {}
 
 func (x *NodeLeft) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[10]
+	mi := &file_goakt_goakt_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +935,7 @@ func (x *NodeLeft) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeLeft.ProtoReflect.Descriptor instead.
 func (*NodeLeft) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{10}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *NodeLeft) GetAddress() string {
@@ -719,14 +961,16 @@ func (x *NodeLeft) GetTimestamp() *timestamppb.Timestamp {
 type Terminated struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The unique identifier of the actor that has been terminated.
-	ActorId       string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorId string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Specifies why the actor is reported terminated
+	Reason        TerminationReason `protobuf:"varint,2,opt,name=reason,proto3,enum=goaktpb.TerminationReason" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Terminated) Reset() {
 	*x = Terminated{}
-	mi := &file_goakt_goakt_proto_msgTypes[11]
+	mi := &file_goakt_goakt_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -738,7 +982,7 @@ func (x *Terminated) String() string {
 func (*Terminated) ProtoMessage() {}
 
 func (x *Terminated) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[11]
+	mi := &file_goakt_goakt_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +995,7 @@ func (x *Terminated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Terminated.ProtoReflect.Descriptor instead.
 func (*Terminated) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{11}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Terminated) GetActorId() string {
@@ -761,6 +1005,13 @@ func (x *Terminated) GetActorId() string {
 	return ""
 }
 
+func (x *Terminated) GetReason() TerminationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return TerminationReason_TERMINATION_REASON_STOPPED
+}
+
 // PoisonPill is a special control message used to gracefully stop an actor.
 //
 // When an actor receives a PoisonPill, it will initiate a controlled shutdown sequence.
@@ -778,7 +1029,7 @@ type PoisonPill struct {
 
 func (x *PoisonPill) Reset() {
 	*x = PoisonPill{}
-	mi := &file_goakt_goakt_proto_msgTypes[12]
+	mi := &file_goakt_goakt_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -790,7 +1041,7 @@ func (x *PoisonPill) String() string {
 func (*PoisonPill) ProtoMessage() {}
 
 func (x *PoisonPill) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[12]
+	mi := &file_goakt_goakt_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +1054,7 @@ func (x *PoisonPill) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoisonPill.ProtoReflect.Descriptor instead.
 func (*PoisonPill) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{12}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{13}
 }
 
 // PostStart is used when an actor has successfully started
@@ -815,7 +1066,7 @@ type PostStart struct {
 
 func (x *PostStart) Reset() {
 	*x = PostStart{}
-	mi := &file_goakt_goakt_proto_msgTypes[13]
+	mi := &file_goakt_goakt_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +1078,7 @@ func (x *PostStart) String() string {
 func (*PostStart) ProtoMessage() {}
 
 func (x *PostStart) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[13]
+	mi := &file_goakt_goakt_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1091,7 @@ func (x *PostStart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostStart.ProtoReflect.Descriptor instead.
 func (*PostStart) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{13}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{14}
 }
 
 // Broadcast is used to send message to a router
@@ -854,7 +1105,7 @@ type Broadcast struct {
 
 func (x *Broadcast) Reset() {
 	*x = Broadcast{}
-	mi := &file_goakt_goakt_proto_msgTypes[14]
+	mi := &file_goakt_goakt_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -866,7 +1117,7 @@ func (x *Broadcast) String() string {
 func (*Broadcast) ProtoMessage() {}
 
 func (x *Broadcast) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[14]
+	mi := &file_goakt_goakt_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1130,7 @@ func (x *Broadcast) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Broadcast.ProtoReflect.Descriptor instead.
 func (*Broadcast) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{14}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Broadcast) GetMessage() *anypb.Any {
@@ -902,7 +1153,7 @@ type Subscribe struct {
 
 func (x *Subscribe) Reset() {
 	*x = Subscribe{}
-	mi := &file_goakt_goakt_proto_msgTypes[15]
+	mi := &file_goakt_goakt_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -914,7 +1165,7 @@ func (x *Subscribe) String() string {
 func (*Subscribe) ProtoMessage() {}
 
 func (x *Subscribe) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[15]
+	mi := &file_goakt_goakt_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1178,7 @@ func (x *Subscribe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subscribe.ProtoReflect.Descriptor instead.
 func (*Subscribe) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{15}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Subscribe) GetTopic() string {
@@ -950,7 +1201,7 @@ type Unsubscribe struct {
 
 func (x *Unsubscribe) Reset() {
 	*x = Unsubscribe{}
-	mi := &file_goakt_goakt_proto_msgTypes[16]
+	mi := &file_goakt_goakt_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,7 +1213,7 @@ func (x *Unsubscribe) String() string {
 func (*Unsubscribe) ProtoMessage() {}
 
 func (x *Unsubscribe) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[16]
+	mi := &file_goakt_goakt_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1226,7 @@ func (x *Unsubscribe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Unsubscribe.ProtoReflect.Descriptor instead.
 func (*Unsubscribe) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{16}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Unsubscribe) GetTopic() string {
@@ -997,7 +1248,7 @@ type SubscribeAck struct {
 
 func (x *SubscribeAck) Reset() {
 	*x = SubscribeAck{}
-	mi := &file_goakt_goakt_proto_msgTypes[17]
+	mi := &file_goakt_goakt_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1009,7 +1260,7 @@ func (x *SubscribeAck) String() string {
 func (*SubscribeAck) ProtoMessage() {}
 
 func (x *SubscribeAck) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[17]
+	mi := &file_goakt_goakt_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1273,7 @@ func (x *SubscribeAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeAck.ProtoReflect.Descriptor instead.
 func (*SubscribeAck) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{17}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SubscribeAck) GetTopic() string {
@@ -1044,7 +1295,7 @@ type UnsubscribeAck struct {
 
 func (x *UnsubscribeAck) Reset() {
 	*x = UnsubscribeAck{}
-	mi := &file_goakt_goakt_proto_msgTypes[18]
+	mi := &file_goakt_goakt_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1056,7 +1307,7 @@ func (x *UnsubscribeAck) String() string {
 func (*UnsubscribeAck) ProtoMessage() {}
 
 func (x *UnsubscribeAck) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[18]
+	mi := &file_goakt_goakt_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1320,7 @@ func (x *UnsubscribeAck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsubscribeAck.ProtoReflect.Descriptor instead.
 func (*UnsubscribeAck) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{18}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UnsubscribeAck) GetTopic() string {
@@ -1097,7 +1348,7 @@ type Publish struct {
 
 func (x *Publish) Reset() {
 	*x = Publish{}
-	mi := &file_goakt_goakt_proto_msgTypes[19]
+	mi := &file_goakt_goakt_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1109,7 +1360,7 @@ func (x *Publish) String() string {
 func (*Publish) ProtoMessage() {}
 
 func (x *Publish) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[19]
+	mi := &file_goakt_goakt_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,7 +1373,7 @@ func (x *Publish) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Publish.ProtoReflect.Descriptor instead.
 func (*Publish) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{19}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Publish) GetId() string {
@@ -1155,7 +1406,7 @@ type NoMessage struct {
 
 func (x *NoMessage) Reset() {
 	*x = NoMessage{}
-	mi := &file_goakt_goakt_proto_msgTypes[20]
+	mi := &file_goakt_goakt_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1167,7 +1418,7 @@ func (x *NoMessage) String() string {
 func (*NoMessage) ProtoMessage() {}
 
 func (x *NoMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[20]
+	mi := &file_goakt_goakt_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,7 +1431,7 @@ func (x *NoMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoMessage.ProtoReflect.Descriptor instead.
 func (*NoMessage) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{20}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{21}
 }
 
 // Mayday is a system-level message used in actor-based systems to notify a parent actor
@@ -1201,14 +1452,18 @@ type Mayday struct {
 	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	// The UTC timestamp indicating when the Mayday event occurred. This helps in correlating
 	// logs or monitoring data for incident analysis and resolution timelines.
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The type of the original message, the same name the serializers identify it with.
+	// It is set for any message, including the ones that are not protocol buffers messages
+	// for which the message field is not set.
+	MessageType   string `protobuf:"bytes,4,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Mayday) Reset() {
 	*x = Mayday{}
-	mi := &file_goakt_goakt_proto_msgTypes[21]
+	mi := &file_goakt_goakt_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1220,7 +1475,7 @@ func (x *Mayday) String() string {
 func (*Mayday) ProtoMessage() {}
 
 func (x *Mayday) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[21]
+	mi := &file_goakt_goakt_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,7 +1488,7 @@ func (x *Mayday) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mayday.ProtoReflect.Descriptor instead.
 func (*Mayday) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{21}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Mayday) GetMessage() *anypb.Any {
@@ -1257,6 +1512,13 @@ func (x *Mayday) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Mayday) GetMessageType() string {
+	if x != nil {
+		return x.MessageType
+	}
+	return ""
+}
+
 // PausePassivation is a system-level message used to pause the passivation of an actor.
 // One can send this message to an actor to prevent it from being passivated when passivation is enabled.
 // This is useful in scenarios where an actor needs to remain active for a certain period while processing
@@ -1270,7 +1532,7 @@ type PausePassivation struct {
 
 func (x *PausePassivation) Reset() {
 	*x = PausePassivation{}
-	mi := &file_goakt_goakt_proto_msgTypes[22]
+	mi := &file_goakt_goakt_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1282,7 +1544,7 @@ func (x *PausePassivation) String() string {
 func (*PausePassivation) ProtoMessage() {}
 
 func (x *PausePassivation) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[22]
+	mi := &file_goakt_goakt_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1557,7 @@ func (x *PausePassivation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PausePassivation.ProtoReflect.Descriptor instead.
 func (*PausePassivation) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{22}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{23}
 }
 
 // ResumePassivation is a system-level message used to resume the passivation of an actor.
@@ -1314,7 +1576,7 @@ type ResumePassivation struct {
 
 func (x *ResumePassivation) Reset() {
 	*x = ResumePassivation{}
-	mi := &file_goakt_goakt_proto_msgTypes[23]
+	mi := &file_goakt_goakt_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1326,7 +1588,7 @@ func (x *ResumePassivation) String() string {
 func (*ResumePassivation) ProtoMessage() {}
 
 func (x *ResumePassivation) ProtoReflect() protoreflect.Message {
-	mi := &file_goakt_goakt_proto_msgTypes[23]
+	mi := &file_goakt_goakt_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,28 +1601,290 @@ func (x *ResumePassivation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumePassivation.ProtoReflect.Descriptor instead.
 func (*ResumePassivation) Descriptor() ([]byte, []int) {
-	return file_goakt_goakt_proto_rawDescGZIP(), []int{23}
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{24}
+}
+
+// DeliveryFailed is sent to an actor using at-least-once delivery when a message
+// could not be confirmed by its recipient within the maximum number of attempts.
+// The message is no longer redelivered.
+type DeliveryFailed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the producer ID
+	ProducerId string `protobuf:"bytes,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Specifies the sequence number of the delivery
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// Specifies the recipient of the message
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Specifies the message that could not be delivered.
+	// It is only set for protocol buffers messages
+	Message *anypb.Any `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Specifies the number of delivery attempts
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Specifies the type of the message, the same name the serializers identify it with.
+	// It is set for any message, including the ones that are not protocol buffers messages
+	MessageType   string `protobuf:"bytes,6,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryFailed) Reset() {
+	*x = DeliveryFailed{}
+	mi := &file_goakt_goakt_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryFailed) ProtoMessage() {}
+
+func (x *DeliveryFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_goakt_goakt_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryFailed.ProtoReflect.Descriptor instead.
+func (*DeliveryFailed) Descriptor() ([]byte, []int) {
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeliveryFailed) GetProducerId() string {
+	if x != nil {
+		return x.ProducerId
+	}
+	return ""
+}
+
+func (x *DeliveryFailed) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *DeliveryFailed) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *DeliveryFailed) GetMessage() *anypb.Any {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *DeliveryFailed) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeliveryFailed) GetMessageType() string {
+	if x != nil {
+		return x.MessageType
+	}
+	return ""
+}
+
+// CircuitBreakerStateChanged is published to the events stream when the
+// circuit breaker guarding the requests to a given target changes its state.
+type CircuitBreakerStateChanged struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the target guarded by the circuit breaker
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Specifies the previous state
+	From CircuitBreakerState `protobuf:"varint,2,opt,name=from,proto3,enum=goaktpb.CircuitBreakerState" json:"from,omitempty"`
+	// Specifies the new state
+	To CircuitBreakerState `protobuf:"varint,3,opt,name=to,proto3,enum=goaktpb.CircuitBreakerState" json:"to,omitempty"`
+	// Specifies the time the state changed
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CircuitBreakerStateChanged) Reset() {
+	*x = CircuitBreakerStateChanged{}
+	mi := &file_goakt_goakt_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitBreakerStateChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerStateChanged) ProtoMessage() {}
+
+func (x *CircuitBreakerStateChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_goakt_goakt_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreakerStateChanged.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerStateChanged) Descriptor() ([]byte, []int) {
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CircuitBreakerStateChanged) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CircuitBreakerStateChanged) GetFrom() CircuitBreakerState {
+	if x != nil {
+		return x.From
+	}
+	return CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED
+}
+
+func (x *CircuitBreakerStateChanged) GetTo() CircuitBreakerState {
+	if x != nil {
+		return x.To
+	}
+	return CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED
+}
+
+func (x *CircuitBreakerStateChanged) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// SplitBrainResolved is published to the events stream when the split brain
+// resolver has decided which side of a network partition survives
+type SplitBrainResolved struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the address of the node taking the decision
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Specifies the strategy used to take the decision
+	Strategy string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// Specifies the decision
+	Decision SplitBrainDecision `protobuf:"varint,3,opt,name=decision,proto3,enum=goaktpb.SplitBrainDecision" json:"decision,omitempty"`
+	// Specifies the addresses of the members the node can still reach
+	Reachable []string `protobuf:"bytes,4,rep,name=reachable,proto3" json:"reachable,omitempty"`
+	// Specifies the addresses of the members the node can no longer reach
+	Unreachable []string `protobuf:"bytes,5,rep,name=unreachable,proto3" json:"unreachable,omitempty"`
+	// Specifies the time the decision was taken
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitBrainResolved) Reset() {
+	*x = SplitBrainResolved{}
+	mi := &file_goakt_goakt_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitBrainResolved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitBrainResolved) ProtoMessage() {}
+
+func (x *SplitBrainResolved) ProtoReflect() protoreflect.Message {
+	mi := &file_goakt_goakt_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitBrainResolved.ProtoReflect.Descriptor instead.
+func (*SplitBrainResolved) Descriptor() ([]byte, []int) {
+	return file_goakt_goakt_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SplitBrainResolved) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SplitBrainResolved) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *SplitBrainResolved) GetDecision() SplitBrainDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return SplitBrainDecision_SPLIT_BRAIN_DECISION_KEEP
+}
+
+func (x *SplitBrainResolved) GetReachable() []string {
+	if x != nil {
+		return x.Reachable
+	}
+	return nil
+}
+
+func (x *SplitBrainResolved) GetUnreachable() []string {
+	if x != nil {
+		return x.Unreachable
+	}
+	return nil
+}
+
+func (x *SplitBrainResolved) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
 }
 
 var File_goakt_goakt_proto protoreflect.FileDescriptor
 
 const file_goakt_goakt_proto_rawDesc = "" +
 	"\n" +
-	"\x11goakt/goakt.proto\x12\agoaktpb\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x01\n" +
+	"\x11goakt/goakt.proto\x12\agoaktpb\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x01\n" +
 	"\aAddress\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x0e\n" +
 	"\x02id\x18\x04 \x01(\tR\x02id\x12\x16\n" +
 	"\x06system\x18\x05 \x01(\tR\x06system\x12(\n" +
-	"\x06parent\x18\x06 \x01(\v2\x10.goaktpb.AddressR\x06parent\"\xe5\x01\n" +
+	"\x06parent\x18\x06 \x01(\v2\x10.goaktpb.AddressR\x06parent\"\x88\x02\n" +
 	"\n" +
 	"Deadletter\x12(\n" +
 	"\x06sender\x18\x01 \x01(\v2\x10.goaktpb.AddressR\x06sender\x12,\n" +
 	"\breceiver\x18\x02 \x01(\v2\x10.goaktpb.AddressR\breceiver\x12.\n" +
 	"\amessage\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\amessage\x127\n" +
 	"\tsend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bsendTime\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\"u\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\fmessage_type\x18\x06 \x01(\tR\vmessageType\"u\n" +
 	"\fActorStarted\x12*\n" +
 	"\aaddress\x18\x01 \x01(\v2\x10.goaktpb.AddressR\aaddress\x129\n" +
 	"\n" +
@@ -1386,17 +1910,23 @@ const file_goakt_goakt_proto_rawDesc = "" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"~\n" +
 	"\x0fActorReinstated\x12*\n" +
 	"\aaddress\x18\x01 \x01(\v2\x10.goaktpb.AddressR\aaddress\x12?\n" +
-	"\rreinstated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\freinstatedAt\"`\n" +
+	"\rreinstated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\freinstatedAt\"\xcd\x01\n" +
+	"\x15ActorRestartScheduled\x12*\n" +
+	"\aaddress\x18\x01 \x01(\v2\x10.goaktpb.AddressR\aaddress\x12\x18\n" +
+	"\aattempt\x18\x02 \x01(\rR\aattempt\x12/\n" +
+	"\x05delay\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x05delay\x12=\n" +
+	"\fscheduled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"`\n" +
 	"\n" +
 	"NodeJoined\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"^\n" +
 	"\bNodeLeft\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"'\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"[\n" +
 	"\n" +
 	"Terminated\x12\x19\n" +
-	"\bactor_id\x18\x01 \x01(\tR\aactorId\"\f\n" +
+	"\bactor_id\x18\x01 \x01(\tR\aactorId\x122\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1a.goaktpb.TerminationReasonR\x06reason\"\f\n" +
 	"\n" +
 	"PoisonPill\"\v\n" +
 	"\tPostStart\";\n" +
@@ -1414,13 +1944,46 @@ const file_goakt_goakt_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05topic\x18\x02 \x01(\tR\x05topic\x12.\n" +
 	"\amessage\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\amessage\"\v\n" +
-	"\tNoMessage\"\x8a\x01\n" +
+	"\tNoMessage\"\xad\x01\n" +
 	"\x06Mayday\x12.\n" +
 	"\amessage\x18\x01 \x01(\v2\x14.google.protobuf.AnyR\amessage\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x12\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12!\n" +
+	"\fmessage_type\x18\x04 \x01(\tR\vmessageType\"\x12\n" +
 	"\x10PausePassivation\"\x13\n" +
-	"\x11ResumePassivationB\x85\x01\n" +
+	"\x11ResumePassivation\"\xe7\x01\n" +
+	"\x0eDeliveryFailed\x12\x1f\n" +
+	"\vproducer_id\x18\x01 \x01(\tR\n" +
+	"producerId\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x04R\x0esequenceNumber\x12\x1c\n" +
+	"\trecipient\x18\x03 \x01(\tR\trecipient\x12.\n" +
+	"\amessage\x18\x04 \x01(\v2\x14.google.protobuf.AnyR\amessage\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\rR\battempts\x12!\n" +
+	"\fmessage_type\x18\x06 \x01(\tR\vmessageType\"\xcf\x01\n" +
+	"\x1aCircuitBreakerStateChanged\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x120\n" +
+	"\x04from\x18\x02 \x01(\x0e2\x1c.goaktpb.CircuitBreakerStateR\x04from\x12,\n" +
+	"\x02to\x18\x03 \x01(\x0e2\x1c.goaktpb.CircuitBreakerStateR\x02to\x129\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\xfd\x01\n" +
+	"\x12SplitBrainResolved\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x127\n" +
+	"\bdecision\x18\x03 \x01(\x0e2\x1b.goaktpb.SplitBrainDecisionR\bdecision\x12\x1c\n" +
+	"\treachable\x18\x04 \x03(\tR\treachable\x12 \n" +
+	"\vunreachable\x18\x05 \x03(\tR\vunreachable\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*U\n" +
+	"\x11TerminationReason\x12\x1e\n" +
+	"\x1aTERMINATION_REASON_STOPPED\x10\x00\x12 \n" +
+	"\x1cTERMINATION_REASON_NODE_LEFT\x10\x01*|\n" +
+	"\x13CircuitBreakerState\x12 \n" +
+	"\x1cCIRCUIT_BREAKER_STATE_CLOSED\x10\x00\x12\x1e\n" +
+	"\x1aCIRCUIT_BREAKER_STATE_OPEN\x10\x01\x12#\n" +
+	"\x1fCIRCUIT_BREAKER_STATE_HALF_OPEN\x10\x02*v\n" +
+	"\x12SplitBrainDecision\x12\x1d\n" +
+	"\x19SPLIT_BRAIN_DECISION_KEEP\x10\x00\x12\x1d\n" +
+	"\x19SPLIT_BRAIN_DECISION_DOWN\x10\x01\x12\"\n" +
+	"\x1eSPLIT_BRAIN_DECISION_READ_ONLY\x10\x02B\x85\x01\n" +
 	"\vcom.goaktpbB\n" +
 	"GoaktProtoH\x02P\x01Z,github.com/tochemey/goakt/v3/goaktpb;goaktpb\xa2\x02\x03GXX\xaa\x02\aGoaktpb\xca\x02\aGoaktpb\xe2\x02\x13Goaktpb\\GPBMetadata\xea\x02\aGoaktpbb\x06proto3"
 
@@ -1436,67 +1999,86 @@ func file_goakt_goakt_proto_rawDescGZIP() []byte {
 	return file_goakt_goakt_proto_rawDescData
 }
 
-var file_goakt_goakt_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_goakt_goakt_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_goakt_goakt_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_goakt_goakt_proto_goTypes = []any{
-	(*Address)(nil),               // 0: goaktpb.Address
-	(*Deadletter)(nil),            // 1: goaktpb.Deadletter
-	(*ActorStarted)(nil),          // 2: goaktpb.ActorStarted
-	(*ActorStopped)(nil),          // 3: goaktpb.ActorStopped
-	(*ActorPassivated)(nil),       // 4: goaktpb.ActorPassivated
-	(*ActorChildCreated)(nil),     // 5: goaktpb.ActorChildCreated
-	(*ActorRestarted)(nil),        // 6: goaktpb.ActorRestarted
-	(*ActorSuspended)(nil),        // 7: goaktpb.ActorSuspended
-	(*ActorReinstated)(nil),       // 8: goaktpb.ActorReinstated
-	(*NodeJoined)(nil),            // 9: goaktpb.NodeJoined
-	(*NodeLeft)(nil),              // 10: goaktpb.NodeLeft
-	(*Terminated)(nil),            // 11: goaktpb.Terminated
-	(*PoisonPill)(nil),            // 12: goaktpb.PoisonPill
-	(*PostStart)(nil),             // 13: goaktpb.PostStart
-	(*Broadcast)(nil),             // 14: goaktpb.Broadcast
-	(*Subscribe)(nil),             // 15: goaktpb.Subscribe
-	(*Unsubscribe)(nil),           // 16: goaktpb.Unsubscribe
-	(*SubscribeAck)(nil),          // 17: goaktpb.SubscribeAck
-	(*UnsubscribeAck)(nil),        // 18: goaktpb.UnsubscribeAck
-	(*Publish)(nil),               // 19: goaktpb.Publish
-	(*NoMessage)(nil),             // 20: goaktpb.NoMessage
-	(*Mayday)(nil),                // 21: goaktpb.Mayday
-	(*PausePassivation)(nil),      // 22: goaktpb.PausePassivation
-	(*ResumePassivation)(nil),     // 23: goaktpb.ResumePassivation
-	(*anypb.Any)(nil),             // 24: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil), // 25: google.protobuf.Timestamp
+	(TerminationReason)(0),             // 0: goaktpb.TerminationReason
+	(CircuitBreakerState)(0),           // 1: goaktpb.CircuitBreakerState
+	(SplitBrainDecision)(0),            // 2: goaktpb.SplitBrainDecision
+	(*Address)(nil),                    // 3: goaktpb.Address
+	(*Deadletter)(nil),                 // 4: goaktpb.Deadletter
+	(*ActorStarted)(nil),               // 5: goaktpb.ActorStarted
+	(*ActorStopped)(nil),               // 6: goaktpb.ActorStopped
+	(*ActorPassivated)(nil),            // 7: goaktpb.ActorPassivated
+	(*ActorChildCreated)(nil),          // 8: goaktpb.ActorChildCreated
+	(*ActorRestarted)(nil),             // 9: goaktpb.ActorRestarted
+	(*ActorSuspended)(nil),             // 10: goaktpb.ActorSuspended
+	(*ActorReinstated)(nil),            // 11: goaktpb.ActorReinstated
+	(*ActorRestartScheduled)(nil),      // 12: goaktpb.ActorRestartScheduled
+	(*NodeJoined)(nil),                 // 13: goaktpb.NodeJoined
+	(*NodeLeft)(nil),                   // 14: goaktpb.NodeLeft
+	(*Terminated)(nil),                 // 15: goaktpb.Terminated
+	(*PoisonPill)(nil),                 // 16: goaktpb.PoisonPill
+	(*PostStart)(nil),                  // 17: goaktpb.PostStart
+	(*Broadcast)(nil),                  // 18: goaktpb.Broadcast
+	(*Subscribe)(nil),                  // 19: goaktpb.Subscribe
+	(*Unsubscribe)(nil),                // 20: goaktpb.Unsubscribe
+	(*SubscribeAck)(nil),               // 21: goaktpb.SubscribeAck
+	(*UnsubscribeAck)(nil),             // 22: goaktpb.UnsubscribeAck
+	(*Publish)(nil),                    // 23: goaktpb.Publish
+	(*NoMessage)(nil),                  // 24: goaktpb.NoMessage
+	(*Mayday)(nil),                     // 25: goaktpb.Mayday
+	(*PausePassivation)(nil),           // 26: goaktpb.PausePassivation
+	(*ResumePassivation)(nil),          // 27: goaktpb.ResumePassivation
+	(*DeliveryFailed)(nil),             // 28: goaktpb.DeliveryFailed
+	(*CircuitBreakerStateChanged)(nil), // 29: goaktpb.CircuitBreakerStateChanged
+	(*SplitBrainResolved)(nil),         // 30: goaktpb.SplitBrainResolved
+	(*anypb.Any)(nil),                  // 31: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),      // 32: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 33: google.protobuf.Duration
 }
 var file_goakt_goakt_proto_depIdxs = []int32{
-	0,  // 0: goaktpb.Address.parent:type_name -> goaktpb.Address
-	0,  // 1: goaktpb.Deadletter.sender:type_name -> goaktpb.Address
-	0,  // 2: goaktpb.Deadletter.receiver:type_name -> goaktpb.Address
-	24, // 3: goaktpb.Deadletter.message:type_name -> google.protobuf.Any
-	25, // 4: goaktpb.Deadletter.send_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: goaktpb.ActorStarted.address:type_name -> goaktpb.Address
-	25, // 6: goaktpb.ActorStarted.started_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: goaktpb.ActorStopped.address:type_name -> goaktpb.Address
-	25, // 8: goaktpb.ActorStopped.stopped_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: goaktpb.ActorPassivated.address:type_name -> goaktpb.Address
-	25, // 10: goaktpb.ActorPassivated.passivated_at:type_name -> google.protobuf.Timestamp
-	0,  // 11: goaktpb.ActorChildCreated.address:type_name -> goaktpb.Address
-	0,  // 12: goaktpb.ActorChildCreated.parent:type_name -> goaktpb.Address
-	25, // 13: goaktpb.ActorChildCreated.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 14: goaktpb.ActorRestarted.address:type_name -> goaktpb.Address
-	25, // 15: goaktpb.ActorRestarted.restarted_at:type_name -> google.protobuf.Timestamp
-	0,  // 16: goaktpb.ActorSuspended.address:type_name -> goaktpb.Address
-	25, // 17: goaktpb.ActorSuspended.suspended_at:type_name -> google.protobuf.Timestamp
-	0,  // 18: goaktpb.ActorReinstated.address:type_name -> goaktpb.Address
-	25, // 19: goaktpb.ActorReinstated.reinstated_at:type_name -> google.protobuf.Timestamp
-	25, // 20: goaktpb.NodeJoined.timestamp:type_name -> google.protobuf.Timestamp
-	25, // 21: goaktpb.NodeLeft.timestamp:type_name -> google.protobuf.Timestamp
-	24, // 22: goaktpb.Broadcast.message:type_name -> google.protobuf.Any
-	24, // 23: goaktpb.Publish.message:type_name -> google.protobuf.Any
-	24, // 24: goaktpb.Mayday.message:type_name -> google.protobuf.Any
-	25, // 25: goaktpb.Mayday.timestamp:type_name -> google.protobuf.Timestamp
-	26, // [26:26] is the sub-list for method output_type
-	26, // [26:26] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	3,  // 0: goaktpb.Address.parent:type_name -> goaktpb.Address
+	3,  // 1: goaktpb.Deadletter.sender:type_name -> goaktpb.Address
+	3,  // 2: goaktpb.Deadletter.receiver:type_name -> goaktpb.Address
+	31, // 3: goaktpb.Deadletter.message:type_name -> google.protobuf.Any
+	32, // 4: goaktpb.Deadletter.send_time:type_name -> google.protobuf.Timestamp
+	3,  // 5: goaktpb.ActorStarted.address:type_name -> goaktpb.Address
+	32, // 6: goaktpb.ActorStarted.started_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: goaktpb.ActorStopped.address:type_name -> goaktpb.Address
+	32, // 8: goaktpb.ActorStopped.stopped_at:type_name -> google.protobuf.Timestamp
+	3,  // 9: goaktpb.ActorPassivated.address:type_name -> goaktpb.Address
+	32, // 10: goaktpb.ActorPassivated.passivated_at:type_name -> google.protobuf.Timestamp
+	3,  // 11: goaktpb.ActorChildCreated.address:type_name -> goaktpb.Address
+	3,  // 12: goaktpb.ActorChildCreated.parent:type_name -> goaktpb.Address
+	32, // 13: goaktpb.ActorChildCreated.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 14: goaktpb.ActorRestarted.address:type_name -> goaktpb.Address
+	32, // 15: goaktpb.ActorRestarted.restarted_at:type_name -> google.protobuf.Timestamp
+	3,  // 16: goaktpb.ActorSuspended.address:type_name -> goaktpb.Address
+	32, // 17: goaktpb.ActorSuspended.suspended_at:type_name -> google.protobuf.Timestamp
+	3,  // 18: goaktpb.ActorReinstated.address:type_name -> goaktpb.Address
+	32, // 19: goaktpb.ActorReinstated.reinstated_at:type_name -> google.protobuf.Timestamp
+	3,  // 20: goaktpb.ActorRestartScheduled.address:type_name -> goaktpb.Address
+	33, // 21: goaktpb.ActorRestartScheduled.delay:type_name -> google.protobuf.Duration
+	32, // 22: goaktpb.ActorRestartScheduled.scheduled_at:type_name -> google.protobuf.Timestamp
+	32, // 23: goaktpb.NodeJoined.timestamp:type_name -> google.protobuf.Timestamp
+	32, // 24: goaktpb.NodeLeft.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 25: goaktpb.Terminated.reason:type_name -> goaktpb.TerminationReason
+	31, // 26: goaktpb.Broadcast.message:type_name -> google.protobuf.Any
+	31, // 27: goaktpb.Publish.message:type_name -> google.protobuf.Any
+	31, // 28: goaktpb.Mayday.message:type_name -> google.protobuf.Any
+	32, // 29: goaktpb.Mayday.timestamp:type_name -> google.protobuf.Timestamp
+	31, // 30: goaktpb.DeliveryFailed.message:type_name -> google.protobuf.Any
+	1,  // 31: goaktpb.CircuitBreakerStateChanged.from:type_name -> goaktpb.CircuitBreakerState
+	1,  // 32: goaktpb.CircuitBreakerStateChanged.to:type_name -> goaktpb.CircuitBreakerState
+	32, // 33: goaktpb.CircuitBreakerStateChanged.changed_at:type_name -> google.protobuf.Timestamp
+	2,  // 34: goaktpb.SplitBrainResolved.decision:type_name -> goaktpb.SplitBrainDecision
+	32, // 35: goaktpb.SplitBrainResolved.timestamp:type_name -> google.protobuf.Timestamp
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_goakt_goakt_proto_init() }
@@ -1509,13 +2091,14 @@ func file_goakt_goakt_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goakt_goakt_proto_rawDesc), len(file_goakt_goakt_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   24,
+			NumEnums:      3,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_goakt_goakt_proto_goTypes,
 		DependencyIndexes: file_goakt_goakt_proto_depIdxs,
+		EnumInfos:         file_goakt_goakt_proto_enumTypes,
 		MessageInfos:      file_goakt_goakt_proto_msgTypes,
 	}.Build()
 	File_goakt_goakt_proto = out.File
@@ -0,0 +1,346 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package chunking splits the serialized messages too large to be sent in a single remoting call
+// and reassembles them on the receiving end
+package chunking
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInvalidChunk is returned when a chunk does not belong to a valid transfer
+	ErrInvalidChunk = errors.New("invalid chunk")
+	// ErrTransferNotFound is returned when a transfer does not exist or has timed out
+	ErrTransferNotFound = errors.New("chunks transfer not found")
+	// ErrIncompleteTransfer is returned when some chunks of a transfer are missing
+	ErrIncompleteTransfer = errors.New("chunks transfer is incomplete")
+	// ErrChecksumMismatch is returned when the reassembled message does not match its checksum
+	ErrChecksumMismatch = errors.New("chunks transfer checksum mismatch")
+	// ErrBufferFull is returned when a chunk cannot be held without exceeding the assembler limits
+	ErrBufferFull = errors.New("chunks buffer is full")
+)
+
+// chunkOverhead is the size accounted for every chunk held by the assembler on top of its data,
+// so that empty chunks cannot be used to grow the assembler without limit
+const chunkOverhead = 64
+
+// Split splits data into chunks of at most size bytes
+func Split(data []byte, size int) [][]byte {
+	chunks := make([][]byte, 0, (len(data)+size-1)/size)
+	for start := 0; start < len(data); start += size {
+		end := min(start+size, len(data))
+		chunks = append(chunks, data[start:end])
+	}
+	return chunks
+}
+
+// Checksum returns the SHA-256 checksum of data
+func Checksum(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// Join reassembles the given chunks and checks the result against the expected size and checksum
+func Join(chunks [][]byte, size int64, checksum []byte) ([]byte, error) {
+	var length int64
+	for _, chunk := range chunks {
+		if chunk == nil {
+			return nil, ErrIncompleteTransfer
+		}
+		length += int64(len(chunk))
+	}
+
+	if length != size {
+		return nil, ErrChecksumMismatch
+	}
+
+	data := make([]byte, 0, length)
+	for _, chunk := range chunks {
+		data = append(data, chunk...)
+	}
+
+	if !bytes.Equal(Checksum(data), checksum) {
+		return nil, ErrChecksumMismatch
+	}
+	return data, nil
+}
+
+// transfer holds the chunks of a message
+type transfer struct {
+	chunks    map[int32][]byte
+	size      int64
+	expiresAt time.Time
+}
+
+// Assembler collects the chunks received for the messages sent in chunks until they are reassembled.
+// A transfer that does not receive any chunk within the timeout is discarded.
+//
+// The number of pending transfers and the number of bytes held across them are bounded,
+// so that a remote sender cannot exhaust the memory of the node.
+type Assembler struct {
+	mu           sync.Mutex
+	timeout      time.Duration
+	maxBytes     int64
+	maxTransfers int
+	size         int64
+	transfers    map[string]*transfer
+}
+
+// NewAssembler creates an instance of Assembler holding at most maxTransfers pending transfers
+// and maxBytes bytes of chunks
+func NewAssembler(timeout time.Duration, maxBytes int64, maxTransfers int) *Assembler {
+	return &Assembler{
+		timeout:      timeout,
+		maxBytes:     maxBytes,
+		maxTransfers: maxTransfers,
+		transfers:    make(map[string]*transfer),
+	}
+}
+
+// Add records the chunk at the given index of the given transfer.
+// It returns ErrBufferFull when the chunk would exceed the assembler limits.
+func (a *Assembler) Add(id string, index int32, data []byte) error {
+	if id == "" || index < 0 {
+		return ErrInvalidChunk
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	a.evict(now)
+
+	current, ok := a.transfers[id]
+	if !ok && len(a.transfers) >= a.maxTransfers {
+		return ErrBufferFull
+	}
+
+	var replaced int64
+	if ok {
+		if previous, exists := current.chunks[index]; exists {
+			replaced = int64(len(previous)) + chunkOverhead
+		}
+	}
+
+	size := int64(len(data)) + chunkOverhead
+	if a.size-replaced+size > a.maxBytes {
+		return ErrBufferFull
+	}
+
+	if !ok {
+		current = &transfer{chunks: make(map[int32][]byte)}
+		a.transfers[id] = current
+	}
+
+	current.chunks[index] = bytes.Clone(data)
+	current.size += size - replaced
+	current.expiresAt = now.Add(a.timeout)
+	a.size += size - replaced
+	return nil
+}
+
+// Assemble reassembles the message of the given transfer and releases its chunks
+func (a *Assembler) Assemble(id string, chunks int32, size int64, checksum []byte) ([]byte, error) {
+	a.mu.Lock()
+	a.evict(time.Now())
+	current, ok := a.transfers[id]
+	if ok {
+		a.remove(id, current)
+	}
+	a.mu.Unlock()
+
+	if !ok {
+		return nil, ErrTransferNotFound
+	}
+
+	if chunks <= 0 || len(current.chunks) != int(chunks) {
+		return nil, ErrIncompleteTransfer
+	}
+
+	ordered := make([][]byte, chunks)
+	for index := range ordered {
+		ordered[index] = current.chunks[int32(index)]
+	}
+	return Join(ordered, size, checksum)
+}
+
+// Len returns the number of pending transfers
+func (a *Assembler) Len() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.evict(time.Now())
+	return len(a.transfers)
+}
+
+// Size returns the number of bytes held by the pending transfers
+func (a *Assembler) Size() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.size
+}
+
+// evict discards the timed out transfers
+func (a *Assembler) evict(now time.Time) {
+	for id, current := range a.transfers {
+		if now.After(current.expiresAt) {
+			a.remove(id, current)
+		}
+	}
+}
+
+// remove releases the given transfer
+func (a *Assembler) remove(id string, current *transfer) {
+	delete(a.transfers, id)
+	a.size -= current.size
+}
+
+// pending holds the chunks of a message waiting to be fetched
+type pending struct {
+	chunks    [][]byte
+	size      int64
+	expiresAt time.Time
+}
+
+// Store keeps the chunks of the messages too large to be returned in a single response
+// until they are fetched. The chunks of a message are released when they are not fetched within the timeout,
+// so that a fetch retried by the requester, including the fetch of the last chunk, still succeeds.
+//
+// The number of pending transfers and the number of bytes held across them are bounded, and the timed out
+// transfers are swept in the background so that the replies which are never fetched do not stay in memory.
+type Store struct {
+	mu           sync.Mutex
+	timeout      time.Duration
+	maxBytes     int64
+	maxTransfers int
+	size         int64
+	transfers    map[string]*pending
+	sweeper      *time.Timer
+}
+
+// NewStore creates an instance of Store holding at most maxTransfers pending transfers
+// and maxBytes bytes of chunks
+func NewStore(timeout time.Duration, maxBytes int64, maxTransfers int) *Store {
+	return &Store{
+		timeout:      timeout,
+		maxBytes:     maxBytes,
+		maxTransfers: maxTransfers,
+		transfers:    make(map[string]*pending),
+	}
+}
+
+// Put stores the chunks of the given transfer.
+// It returns ErrBufferFull when the chunks would exceed the store limits.
+func (s *Store) Put(id string, chunks [][]byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.evict(now)
+
+	var size int64
+	for _, chunk := range chunks {
+		size += int64(len(chunk)) + chunkOverhead
+	}
+
+	if len(s.transfers) >= s.maxTransfers || s.size+size > s.maxBytes {
+		return ErrBufferFull
+	}
+
+	s.transfers[id] = &pending{
+		chunks:    chunks,
+		size:      size,
+		expiresAt: now.Add(s.timeout),
+	}
+	s.size += size
+
+	if s.sweeper == nil {
+		s.sweeper = time.AfterFunc(s.timeout, s.sweep)
+	}
+	return nil
+}
+
+// Get returns the chunk at the given index of the given transfer
+func (s *Store) Get(id string, index int32) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.evict(now)
+
+	current, ok := s.transfers[id]
+	if !ok {
+		return nil, ErrTransferNotFound
+	}
+
+	if index < 0 || int(index) >= len(current.chunks) {
+		return nil, ErrInvalidChunk
+	}
+
+	current.expiresAt = now.Add(s.timeout)
+	return current.chunks[index], nil
+}
+
+// Len returns the number of pending transfers
+func (s *Store) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evict(time.Now())
+	return len(s.transfers)
+}
+
+// Size returns the number of bytes held by the pending transfers
+func (s *Store) Size() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.size
+}
+
+// sweep discards the timed out transfers and keeps sweeping while transfers are pending
+func (s *Store) sweep() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evict(time.Now())
+	if len(s.transfers) == 0 {
+		s.sweeper = nil
+		return
+	}
+	s.sweeper.Reset(s.timeout)
+}
+
+// evict discards the timed out transfers
+func (s *Store) evict(now time.Time) {
+	for id, current := range s.transfers {
+		if now.After(current.expiresAt) {
+			delete(s.transfers, id)
+			s.size -= current.size
+		}
+	}
+}
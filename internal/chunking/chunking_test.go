@@ -0,0 +1,194 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package chunking
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAndJoin(t *testing.T) {
+	data := bytes.Repeat([]byte("goakt"), 1000)
+
+	chunks := Split(data, 1024)
+	require.Len(t, chunks, 5)
+	for _, chunk := range chunks[:4] {
+		assert.Len(t, chunk, 1024)
+	}
+	assert.Len(t, chunks[4], len(data)-4*1024)
+
+	actual, err := Join(chunks, int64(len(data)), Checksum(data))
+	require.NoError(t, err)
+	assert.Equal(t, data, actual)
+
+	_, err = Join(chunks, int64(len(data))-1, Checksum(data))
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+
+	corrupted := Split(bytes.Clone(data), 1024)
+	corrupted[2][0] ^= 0xff
+	_, err = Join(corrupted, int64(len(data)), Checksum(data))
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+
+	_, err = Join([][]byte{chunks[0], nil}, int64(len(data)), Checksum(data))
+	assert.ErrorIs(t, err, ErrIncompleteTransfer)
+}
+
+func TestAssembler(t *testing.T) {
+	data := bytes.Repeat([]byte("goakt"), 1000)
+	chunks := Split(data, 1024)
+
+	t.Run("With chunks received out of order", func(t *testing.T) {
+		assembler := NewAssembler(time.Minute, 1<<20, 10)
+		for index := len(chunks) - 1; index >= 0; index-- {
+			require.NoError(t, assembler.Add("transfer", int32(index), chunks[index]))
+		}
+
+		actual, err := assembler.Assemble("transfer", int32(len(chunks)), int64(len(data)), Checksum(data))
+		require.NoError(t, err)
+		assert.Equal(t, data, actual)
+		assert.Zero(t, assembler.Len())
+		assert.Zero(t, assembler.Size())
+
+		_, err = assembler.Assemble("transfer", int32(len(chunks)), int64(len(data)), Checksum(data))
+		assert.ErrorIs(t, err, ErrTransferNotFound)
+	})
+	t.Run("With missing chunks", func(t *testing.T) {
+		assembler := NewAssembler(time.Minute, 1<<20, 10)
+		require.NoError(t, assembler.Add("transfer", 0, chunks[0]))
+
+		_, err := assembler.Assemble("transfer", int32(len(chunks)), int64(len(data)), Checksum(data))
+		assert.ErrorIs(t, err, ErrIncompleteTransfer)
+		assert.Zero(t, assembler.Len())
+	})
+	t.Run("With invalid chunk", func(t *testing.T) {
+		assembler := NewAssembler(time.Minute, 1<<20, 10)
+		assert.ErrorIs(t, assembler.Add("", 0, chunks[0]), ErrInvalidChunk)
+		assert.ErrorIs(t, assembler.Add("transfer", -1, chunks[0]), ErrInvalidChunk)
+	})
+	t.Run("With limits exceeded", func(t *testing.T) {
+		assembler := NewAssembler(time.Minute, int64(len(data))+int64(len(chunks))*chunkOverhead, 2)
+		for index, chunk := range chunks {
+			require.NoError(t, assembler.Add("transfer", int32(index), chunk))
+		}
+
+		// the bytes limit is reached
+		assert.ErrorIs(t, assembler.Add("other", 0, chunks[0]), ErrBufferFull)
+		// empty chunks are accounted for as well
+		assert.ErrorIs(t, assembler.Add("other", 0, nil), ErrBufferFull)
+		// replacing a chunk does not count it twice
+		require.NoError(t, assembler.Add("transfer", 0, chunks[0]))
+
+		_, err := assembler.Assemble("transfer", int32(len(chunks)), int64(len(data)), Checksum(data))
+		require.NoError(t, err)
+		assert.Zero(t, assembler.Size())
+
+		// the transfers limit is reached
+		require.NoError(t, assembler.Add("first", 0, chunks[0]))
+		require.NoError(t, assembler.Add("second", 0, chunks[0]))
+		assert.ErrorIs(t, assembler.Add("third", 0, chunks[0]), ErrBufferFull)
+		require.NoError(t, assembler.Add("second", 1, chunks[1]))
+	})
+	t.Run("With timed out transfer", func(t *testing.T) {
+		assembler := NewAssembler(50*time.Millisecond, 1<<20, 10)
+		require.NoError(t, assembler.Add("transfer", 0, chunks[0]))
+		assert.Equal(t, 1, assembler.Len())
+
+		time.Sleep(100 * time.Millisecond)
+
+		_, err := assembler.Assemble("transfer", 1, int64(len(chunks[0])), Checksum(chunks[0]))
+		assert.ErrorIs(t, err, ErrTransferNotFound)
+		assert.Zero(t, assembler.Len())
+		assert.Zero(t, assembler.Size())
+	})
+}
+
+func TestStore(t *testing.T) {
+	data := bytes.Repeat([]byte("goakt"), 1000)
+	chunks := Split(data, 1024)
+
+	t.Run("With chunks fetched", func(t *testing.T) {
+		store := NewStore(time.Minute, 1<<20, 10)
+		require.NoError(t, store.Put("transfer", chunks))
+
+		fetched := make([][]byte, 0, len(chunks))
+		for index := range chunks {
+			chunk, err := store.Get("transfer", int32(index))
+			require.NoError(t, err)
+			fetched = append(fetched, chunk)
+		}
+
+		actual, err := Join(fetched, int64(len(data)), Checksum(data))
+		require.NoError(t, err)
+		assert.Equal(t, data, actual)
+
+		// the last chunk can be fetched again until the transfer times out
+		chunk, err := store.Get("transfer", int32(len(chunks)-1))
+		require.NoError(t, err)
+		assert.Equal(t, chunks[len(chunks)-1], chunk)
+		assert.Equal(t, 1, store.Len())
+	})
+	t.Run("With invalid chunk", func(t *testing.T) {
+		store := NewStore(time.Minute, 1<<20, 10)
+		require.NoError(t, store.Put("transfer", chunks))
+
+		_, err := store.Get("transfer", int32(len(chunks)))
+		assert.ErrorIs(t, err, ErrInvalidChunk)
+		_, err = store.Get("unknown", 0)
+		assert.ErrorIs(t, err, ErrTransferNotFound)
+	})
+	t.Run("With timed out transfer", func(t *testing.T) {
+		store := NewStore(50*time.Millisecond, 1<<20, 10)
+		require.NoError(t, store.Put("transfer", chunks))
+		assert.Positive(t, store.Size())
+
+		// the transfers which are never fetched are swept in the background
+		require.Eventually(t, func() bool { return store.Size() == 0 }, time.Second, 10*time.Millisecond)
+
+		_, err := store.Get("transfer", 0)
+		assert.ErrorIs(t, err, ErrTransferNotFound)
+		assert.Zero(t, store.Len())
+
+		// the sweeping resumes with the next transfer
+		require.NoError(t, store.Put("other", chunks))
+		require.Eventually(t, func() bool { return store.Size() == 0 }, time.Second, 10*time.Millisecond)
+	})
+	t.Run("With limits", func(t *testing.T) {
+		store := NewStore(time.Minute, int64(len(data))+int64(len(chunks))*chunkOverhead, 2)
+		require.NoError(t, store.Put("transfer", chunks))
+
+		// the bytes limit is reached
+		assert.ErrorIs(t, store.Put("other", chunks[:1]), ErrBufferFull)
+
+		// the transfers limit is reached
+		store = NewStore(time.Minute, 1<<20, 2)
+		require.NoError(t, store.Put("first", chunks))
+		require.NoError(t, store.Put("second", chunks))
+		assert.ErrorIs(t, store.Put("third", chunks), ErrBufferFull)
+	})
+}
@@ -31,16 +31,19 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"sync"
 	"time"
 
 	goset "github.com/deckarep/golang-set/v2"
+	hashimemberlist "github.com/hashicorp/memberlist"
 	"github.com/redis/go-redis/v9"
 	"github.com/tochemey/olric"
 	"github.com/tochemey/olric/config"
 	"github.com/tochemey/olric/events"
 	"github.com/tochemey/olric/hasher"
 	"github.com/tochemey/olric/pkg/storage"
+	"github.com/tochemey/olric/stats"
 	"go.uber.org/atomic"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -74,6 +77,21 @@ const (
 	jobKeysMap = "jobKeys"
 	kindsMap   = "actorKinds"
 	grainsMap  = "grains"
+	leasesMap  = "leases"
+
+	// nodeLeavingChannel is the channel on which a node announces
+	// that it is gracefully leaving the cluster
+	nodeLeavingChannel = "goakt.node.leaving"
+	// nodeStayingChannel is the channel on which a node announces
+	// that it is no longer leaving the cluster
+	nodeStayingChannel = "goakt.node.staying"
+
+	// leaseCheckInterval is how often a node waiting for a lease checks
+	// whether the lease has been left without expiry
+	leaseCheckInterval = time.Second
+	// leaseRetryInterval is how long a node waits before setting again
+	// the expiry of a lease it has just acquired
+	leaseRetryInterval = 100 * time.Millisecond
 )
 
 func (x EventType) String() string {
@@ -91,6 +109,9 @@ func (x EventType) String() string {
 type Event struct {
 	Payload *anypb.Any
 	Type    EventType
+	// Partitions are the partitions the node that has left the cluster was the primary owner of.
+	// It is only set for NodeLeft events and is nil when they are not known.
+	Partitions []int
 }
 
 // Interface defines the Node interface
@@ -105,6 +126,10 @@ type Interface interface {
 	GetActor(ctx context.Context, actorName string) (*internalpb.Actor, error)
 	// GetPartition returns the partition where a given actor is stored
 	GetPartition(actorName string) int
+	// ActorPartitions returns the partitions holding the cluster records of the given actor and of its kind, if any
+	ActorPartitions(actorName, kind string) []int
+	// GrainPartitions returns the partitions holding the cluster records of the given grain and of its kind
+	GrainPartitions(grainID, kind string) []int
 	// LookupKind checks the existence of a given actor kind in the cluster
 	// This function is mainly used when creating a singleton actor
 	LookupKind(ctx context.Context, kind string) (string, error)
@@ -137,6 +162,19 @@ type Interface interface {
 	RemoveGrain(ctx context.Context, grainID string) error
 	// GrainExists checks whether a Grain exists in the cluster
 	GrainExists(ctx context.Context, grainID string) (bool, error)
+	// OwnedPartitions returns the partitions the given cluster node is the primary owner of
+	OwnedPartitions(ctx context.Context) ([]int, error)
+	// Members returns the list of cluster members, including the given cluster node, at a given time
+	Members(ctx context.Context) ([]*Peer, error)
+	// HasLeft states whether the given peer has announced that it was gracefully leaving the cluster
+	HasLeft(peerAddress string) bool
+	// AnnounceLeaving lets the cluster members know that the given cluster node is gracefully leaving the cluster
+	AnnounceLeaving(ctx context.Context) error
+	// CancelLeaving lets the cluster members know that the given cluster node is no longer leaving the cluster
+	CancelLeaving(ctx context.Context) error
+	// AcquireLease grants the given cluster node the exclusive ownership of a given key for the ttl duration.
+	// It waits at most the wait duration for the key to be released by its current owner
+	AcquireLease(ctx context.Context, key string, ttl, wait time.Duration) (*Lease, error)
 }
 
 // Engine represents the Engine
@@ -170,6 +208,7 @@ type Engine struct {
 	jobKeysMap olric.DMap
 	kindsMap   olric.DMap
 	grainsMap  olric.DMap
+	leasesMap  olric.DMap
 	tableSize  uint64
 
 	// specifies the discovery node
@@ -187,10 +226,11 @@ type Engine struct {
 	bootstrapTimeout  time.Duration
 	cacheSyncInterval time.Duration
 
-	events     chan *Event
-	eventsLock *sync.Mutex
-	pubSub     *redis.PubSub
-	messages   <-chan *redis.Message
+	events       chan *Event
+	eventsLock   *sync.Mutex
+	eventsClosed bool
+	pubSub       *redis.PubSub
+	messages     <-chan *redis.Message
 
 	// specifies the node state
 	peerState *internalpb.PeerState
@@ -198,6 +238,8 @@ type Engine struct {
 
 	nodeJoinedEventsFilter goset.Set[string]
 	nodeLeftEventsFilter   goset.Set[string]
+	// specifies the peers that have gracefully left the cluster
+	leavingNodes goset.Set[string]
 
 	clientTLS *tls.Config
 	serverTLS *tls.Config
@@ -233,6 +275,7 @@ func NewEngine(name string, disco discovery.Provider, host *discovery.Node, opts
 		Mutex:                  new(sync.Mutex),
 		nodeJoinedEventsFilter: goset.NewSet[string](),
 		nodeLeftEventsFilter:   goset.NewSet[string](),
+		leavingNodes:           goset.NewSet[string](),
 		tableSize:              20 * size.MB,
 		running:                atomic.NewBool(false),
 		syncState:              atomic.NewInt32(int32(IDLE)),
@@ -332,6 +375,20 @@ func (x *Engine) Stop(ctx context.Context) error {
 
 	defer x.running.Store(false)
 
+	// let the other members know that this node is leaving gracefully
+	// so that its departure is not taken for a network partition
+	if err := x.AnnounceLeaving(ctx); err != nil {
+		logger.Warnf("failed to announce node=(%s) leaving: %v", x.node.PeersAddress(), err)
+	}
+
+	// close the events subscription. The server shutdown does not release
+	// the connection the subscription is detached to
+	if x.pubSub != nil {
+		if err := x.pubSub.Close(); err != nil {
+			logger.Warnf("failed to close the events subscription on node=(%s): %v", x.node.PeersAddress(), err)
+		}
+	}
+
 	// close the events listener
 	if err := x.server.Shutdown(ctx); err != nil {
 		logger.Errorf("failed to stop the cluster Engine on node=(%s): %w", x.node.PeersAddress(), err)
@@ -341,6 +398,7 @@ func (x *Engine) Stop(ctx context.Context) error {
 	// close the events queue
 	x.eventsLock.Lock()
 	close(x.events)
+	x.eventsClosed = true
 	x.eventsLock.Unlock()
 
 	logger.Infof("GoAkt cluster Node=(%s) successfully stopped.", x.name)
@@ -458,10 +516,15 @@ func (x *Engine) PutActor(ctx context.Context, actor *internalpb.Actor) error {
 	}
 	cancel2()
 
-	// put the node state into the states map
+	// put the node state into the states map. The node state does not list
+	// the actors moved to another node, e.g. when the node is drained
 	actors := x.peerState.GetActors()
 	actorName := actor.GetAddress().GetName()
-	actors[actorName] = actor
+	if x.hostedElsewhere(actor.GetAddress()) {
+		delete(actors, actorName)
+	} else {
+		actors[actorName] = actor
+	}
 	x.peerState.Actors = actors
 
 	ctx3, cancel3 := context.WithTimeout(ctx, x.writeTimeout)
@@ -476,6 +539,14 @@ func (x *Engine) PutActor(ctx context.Context, actor *internalpb.Actor) error {
 	return nil
 }
 
+// hostedElsewhere states whether the given actor address is located on another node
+func (x *Engine) hostedElsewhere(addr *goaktpb.Address) bool {
+	if addr.GetHost() == "" {
+		return false
+	}
+	return addr.GetHost() != x.node.Host || int(addr.GetPort()) != x.node.RemotingPort
+}
+
 // GetState fetches a given peer state
 func (x *Engine) GetState(ctx context.Context, peerAddress string) (*internalpb.PeerState, error) {
 	// return an error when the engine is not running
@@ -839,6 +910,88 @@ func (x *Engine) LookupKind(ctx context.Context, kind string) (string, error) {
 	return resp.String()
 }
 
+// AcquireLease grants the given cluster node the exclusive ownership of a given key for the ttl duration.
+// It waits at most the wait duration for the key to be released by its current owner.
+// The lease must be renewed before it expires to keep the ownership of the key
+func (x *Engine) AcquireLease(ctx context.Context, key string, ttl, wait time.Duration) (*Lease, error) {
+	// return an error when the engine is not running
+	if !x.IsRunning() {
+		return nil, ErrEngineNotRunning
+	}
+
+	logger := x.logger
+	logger.Infof("node=(%s) acquiring lease (%s)", x.node.PeersAddress(), key)
+
+	deadline := time.Now().Add(wait)
+	var lock olric.LockContext
+	for {
+		// a lease left without expiry by a node that crashed while acquiring it
+		// would otherwise never be released
+		x.expireOrphanLease(ctx, key, ttl)
+
+		var err error
+		lock, err = x.leasesMap.LockWithTimeout(ctx, key, ttl, max(min(time.Until(deadline), leaseCheckInterval), 0))
+		if err == nil {
+			break
+		}
+
+		if !errors.Is(err, olric.ErrLockNotAcquired) {
+			logger.Errorf("node=(%s) failed to acquire lease (%s): %v", x.node.PeersAddress(), key, err)
+			return nil, err
+		}
+
+		if !time.Now().Before(deadline) {
+			logger.Warnf("node=(%s) could not acquire lease (%s)", x.node.PeersAddress(), key)
+			return nil, ErrLeaseNotAcquired
+		}
+	}
+
+	// the expiry of a lock whose partition is owned by another node is dropped by olric,
+	// hence it is set once the lock is acquired. The lock can briefly be unreadable
+	// while its partition moves to another node, in which case setting its expiry is retried
+	lease := &Lease{key: key, lock: lock}
+	for {
+		err := lease.Renew(ctx, ttl)
+		if err == nil {
+			break
+		}
+
+		if !errors.Is(err, ErrLeaseLost) || !time.Now().Before(deadline) {
+			logger.Errorf("node=(%s) failed to set lease (%s) expiry: %v", x.node.PeersAddress(), key, err)
+			_ = lease.Release(ctx)
+			return nil, err
+		}
+
+		time.Sleep(leaseRetryInterval)
+	}
+
+	logger.Infof("node=(%s) successfully acquired lease (%s)", x.node.PeersAddress(), key)
+	return lease, nil
+}
+
+// expireOrphanLease sets the ttl expiry of the lease of the given key when it has none, which happens
+// when its owner has crashed after acquiring it and before setting its expiry.
+//
+// olric only honors the expiry of a key owned by another node when the key is written without condition,
+// hence the lease is written again with the same token. Should the lease be acquired by another node
+// in the meantime, that node loses the lease at its next renewal, which is safe.
+func (x *Engine) expireOrphanLease(ctx context.Context, key string, ttl time.Duration) {
+	entry, err := x.leasesMap.Get(ctx, key)
+	if err != nil || entry.TTL() > 0 {
+		return
+	}
+
+	token, err := entry.Byte()
+	if err != nil {
+		return
+	}
+
+	x.logger.Warnf("node=(%s) found lease (%s) without expiry", x.node.PeersAddress(), key)
+	if err := x.leasesMap.Put(ctx, key, token, olric.PX(ttl)); err != nil {
+		x.logger.Warnf("node=(%s) failed to set lease (%s) expiry: %v", x.node.PeersAddress(), key, err)
+	}
+}
+
 // GetPartition returns the partition where a given actor is stored
 func (x *Engine) GetPartition(actorName string) int {
 	// return -1 when the engine is not running
@@ -853,6 +1006,64 @@ func (x *Engine) GetPartition(actorName string) int {
 	return partition
 }
 
+// ActorPartitions returns the partitions holding the cluster records of the given actor and of its kind, if any
+func (x *Engine) ActorPartitions(actorName, kind string) []int {
+	return x.recordPartitions(actorsMap, actorName, kind)
+}
+
+// GrainPartitions returns the partitions holding the cluster records of the given grain and of its kind
+func (x *Engine) GrainPartitions(grainID, kind string) []int {
+	return x.recordPartitions(grainsMap, grainID, kind)
+}
+
+// recordPartitions returns the partition holding the given key of the given map
+// together with the partition holding the given kind, if any
+func (x *Engine) recordPartitions(dmap, key, kind string) []int {
+	partitions := []int{x.partitionOf(dmap, key)}
+	if kind != "" {
+		partitions = append(partitions, x.partitionOf(kindsMap, kind))
+	}
+	return partitions
+}
+
+// partitionOf returns the partition holding the given key of the given map.
+// Keys are located the same way the cluster storage does, by hashing them along with their map name
+func (x *Engine) partitionOf(dmap, key string) int {
+	return int(x.hasher.HashCode([]byte(dmap+key)) % x.partitionsCount)
+}
+
+// OwnedPartitions returns the partitions the given cluster node is the primary owner of.
+// Partitions the node still holds fragments of after a rebalancing are not returned.
+func (x *Engine) OwnedPartitions(ctx context.Context) ([]int, error) {
+	// return an error when the engine is not running
+	if !x.IsRunning() {
+		return nil, ErrEngineNotRunning
+	}
+
+	x.Lock()
+	defer x.Unlock()
+
+	nodeStats, err := x.client.Stats(ctx, x.node.PeersAddress())
+	if err != nil {
+		x.logger.Errorf("[%s] failed to fetch the owned partitions: %v", x.node.PeersAddress(), err)
+		return nil, err
+	}
+
+	partitions := make([]int, 0, len(nodeStats.Partitions))
+	for partitionID, partition := range nodeStats.Partitions {
+		previousOwner := slices.ContainsFunc(partition.PreviousOwners, func(member stats.Member) bool {
+			return member.ID == nodeStats.Member.ID
+		})
+
+		if !previousOwner {
+			partitions = append(partitions, int(partitionID))
+		}
+	}
+
+	slices.Sort(partitions)
+	return partitions, nil
+}
+
 // Events returns a channel where cluster events are published
 func (x *Engine) Events() <-chan *Event {
 	return x.events
@@ -887,12 +1098,155 @@ func (x *Engine) Peers(ctx context.Context) ([]*Peer, error) {
 				PeersPort:    node.PeersPort,
 				Coordinator:  member.Coordinator,
 				RemotingPort: node.RemotingPort,
+				Roles:        node.Roles,
 			})
 		}
 	}
 	return peers, nil
 }
 
+// Members returns the list of cluster members, including the given cluster node, at a given time
+func (x *Engine) Members(ctx context.Context) ([]*Peer, error) {
+	// return an error when the engine is not running
+	if !x.IsRunning() {
+		return nil, ErrEngineNotRunning
+	}
+
+	x.Lock()
+	defer x.Unlock()
+
+	members, err := x.client.Members(ctx)
+	if err != nil {
+		x.logger.Errorf("failed to read cluster members: %v", err)
+		return nil, err
+	}
+
+	peers := make([]*Peer, 0, len(members))
+	for _, member := range members {
+		node := new(discovery.Node)
+		// unmarshal the member meta information
+		_ = json.Unmarshal([]byte(member.Meta), node)
+		peers = append(peers, &Peer{
+			Host:         node.Host,
+			PeersPort:    node.PeersPort,
+			Coordinator:  member.Coordinator,
+			RemotingPort: node.RemotingPort,
+			CreatedAt:    time.Unix(0, member.Birthdate),
+			Roles:        node.Roles,
+		})
+	}
+	return peers, nil
+}
+
+// HasLeft states whether the given peer has announced that it was gracefully leaving the cluster
+func (x *Engine) HasLeft(peerAddress string) bool {
+	return x.leavingNodes.Contains(peerAddress)
+}
+
+// awaitPartitionsMoved waits, at most for the write timeout, for the partitions owned by the given left peer
+// to be moved to the remaining members, so that the records written when handling its departure are not lost with it.
+// It returns the partitions the left peer owned, or nil when they have already moved and are not known
+func (x *Engine) awaitPartitionsMoved(peerAddress string) []int {
+	ctx, cancel := context.WithTimeout(context.Background(), x.writeTimeout)
+	defer cancel()
+
+	var partitions []int
+	for {
+		table, err := x.client.RoutingTable(ctx)
+		if err == nil {
+			owned := ownedPartitions(table, peerAddress)
+			if len(owned) == 0 {
+				return partitions
+			}
+
+			if partitions == nil {
+				partitions = owned
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			x.logger.Warnf("node=(%s) partitions of left node=(%s) have not moved in time", x.node.PeersAddress(), peerAddress)
+			return partitions
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// ownedPartitions returns the sorted partitions of the given routing table the given peer is a primary owner of
+func ownedPartitions(table olric.RoutingTable, peerAddress string) []int {
+	var partitions []int
+	for partitionID, route := range table {
+		if slices.Contains(route.PrimaryOwners, peerAddress) {
+			partitions = append(partitions, int(partitionID))
+		}
+	}
+
+	slices.Sort(partitions)
+	return partitions
+}
+
+// emitNodeLeft emits the given NodeLeft event once the partitions of the left node have moved
+// to the remaining members. It runs on its own so that the other cluster events are not held up
+func (x *Engine) emitNodeLeft(nodeLeft *events.NodeLeftEvent) {
+	partitions := x.awaitPartitionsMoved(nodeLeft.NodeLeft)
+
+	// the state of the node is written again when it was held by the left node
+	if partitions == nil || slices.Contains(partitions, x.partitionOf(statesMap, x.node.PeersAddress())) {
+		x.restoreState()
+	}
+
+	timeMilli := nodeLeft.Timestamp / int64(1e6)
+	event := &goaktpb.NodeLeft{
+		Address:   nodeLeft.NodeLeft,
+		Timestamp: timestamppb.New(time.UnixMilli(timeMilli)),
+	}
+
+	x.logger.Debugf("%s received (%s):[addr=(%s)] cluster event", x.name, events.KindNodeLeftEvent, event.GetAddress())
+	payload, _ := anypb.New(event)
+
+	x.eventsLock.Lock()
+	defer x.eventsLock.Unlock()
+
+	// the engine may have stopped in the meantime
+	if x.eventsClosed {
+		return
+	}
+	x.events <- &Event{Payload: payload, Type: NodeLeft, Partitions: partitions}
+}
+
+// AnnounceLeaving lets the cluster members know that the given cluster node is gracefully leaving the cluster
+func (x *Engine) AnnounceLeaving(ctx context.Context) error {
+	// return an error when the engine is not running
+	if !x.IsRunning() {
+		return ErrEngineNotRunning
+	}
+
+	ps, err := x.client.NewPubSub(olric.ToAddress(x.node.PeersAddress()))
+	if err != nil {
+		return err
+	}
+
+	_, err = ps.Publish(ctx, nodeLeavingChannel, x.node.PeersAddress())
+	return err
+}
+
+// CancelLeaving lets the cluster members know that the given cluster node is no longer leaving the cluster
+func (x *Engine) CancelLeaving(ctx context.Context) error {
+	// return an error when the engine is not running
+	if !x.IsRunning() {
+		return ErrEngineNotRunning
+	}
+
+	ps, err := x.client.NewPubSub(olric.ToAddress(x.node.PeersAddress()))
+	if err != nil {
+		return err
+	}
+
+	_, err = ps.Publish(ctx, nodeStayingChannel, x.node.PeersAddress())
+	return err
+}
+
 // synchronizeState enqueues the current peer state for synchronization with the cluster.
 //
 // If the engine is running, the peer state is marshaled and added to the peer state queue.
@@ -902,11 +1256,40 @@ func (x *Engine) synchronizeState(ctx context.Context) error {
 	return x.statesMap.Put(ctx, x.node.PeersAddress(), encoded)
 }
 
+// restoreState writes the current peer state again to the cluster
+func (x *Engine) restoreState() {
+	if !x.IsRunning() {
+		return
+	}
+
+	x.Lock()
+	defer x.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), x.writeTimeout)
+	defer cancel()
+
+	if err := x.synchronizeState(ctx); err != nil {
+		x.logger.Warnf("node=(%s) failed to restore its state: %v", x.node.PeersAddress(), err)
+	}
+}
+
 // consume reads to the underlying cluster events
 // and emit the event
 func (x *Engine) consume() {
 	for message := range x.messages {
 		payload := message.Payload
+		if message.Channel == nodeLeavingChannel {
+			x.logger.Debugf("%s received node=(%s) leaving announcement", x.name, payload)
+			x.leavingNodes.Add(payload)
+			continue
+		}
+
+		if message.Channel == nodeStayingChannel {
+			x.logger.Debugf("%s received node=(%s) staying announcement", x.name, payload)
+			x.leavingNodes.Remove(payload)
+			continue
+		}
+
 		var event map[string]any
 		if err := json.Unmarshal([]byte(payload), &event); err != nil {
 			x.logger.Errorf("failed to unmarshal cluster event: %v", err)
@@ -938,6 +1321,8 @@ func (x *Engine) consume() {
 			}
 
 			x.nodeJoinedEventsFilter.Add(nodeJoined.NodeJoin)
+			// a node rejoining with the same address is no longer leaving
+			x.leavingNodes.Remove(nodeJoined.NodeJoin)
 			timeMilli := nodeJoined.Timestamp / int64(1e6)
 			event := &goaktpb.NodeJoined{
 				Address:   nodeJoined.NodeJoin,
@@ -946,7 +1331,7 @@ func (x *Engine) consume() {
 
 			x.logger.Debugf("%s received (%s):[addr=(%s)] cluster event", x.name, kind, event.GetAddress())
 			payload, _ := anypb.New(event)
-			x.events <- &Event{payload, NodeJoined}
+			x.events <- &Event{Payload: payload, Type: NodeJoined}
 			x.eventsLock.Unlock()
 
 		case events.KindNodeLeftEvent:
@@ -965,17 +1350,10 @@ func (x *Engine) consume() {
 			}
 
 			x.nodeLeftEventsFilter.Add(nodeLeft.NodeLeft)
-			timeMilli := nodeLeft.Timestamp / int64(1e6)
-			event := &goaktpb.NodeLeft{
-				Address:   nodeLeft.NodeLeft,
-				Timestamp: timestamppb.New(time.UnixMilli(timeMilli)),
-			}
-
-			x.logger.Debugf("%s received (%s):[addr=(%s)] cluster event", x.name, kind, event.GetAddress())
-			payload, _ := anypb.New(event)
-			x.events <- &Event{payload, NodeLeft}
 			x.eventsLock.Unlock()
 
+			go x.emitNodeLeft(nodeLeft)
+
 		default:
 			// skip
 		}
@@ -1091,6 +1469,25 @@ func (x *Engine) setupMemberlistConfig(conf *config.Config) error {
 		}
 		m.Transport = transport
 	}
+
+	// the testkit can simulate network partitions between the nodes running in the same process
+	if memberlist.PartitionsEnabled() {
+		transport, ok := m.Transport.(hashimemberlist.NodeAwareTransport)
+		if !ok {
+			netTransport, err := hashimemberlist.NewNetTransport(&hashimemberlist.NetTransportConfig{
+				BindAddrs: []string{x.node.Host},
+				BindPort:  x.node.DiscoveryPort,
+				Logger:    x.logger.StdLogger(),
+			})
+			if err != nil {
+				x.logger.Errorf("Failed to create memberlist transport: %v", err)
+				return err
+			}
+			transport = netTransport
+		}
+		m.Transport = memberlist.NewPartitionableTransport(transport)
+	}
+
 	conf.MemberlistConfig = m
 	return nil
 }
@@ -1137,6 +1534,7 @@ func (x *Engine) createMaps() error {
 		AddErrorFn(func() error { x.statesMap, err = x.client.NewDMap(statesMap); return err }).
 		AddErrorFn(func() error { x.jobKeysMap, err = x.client.NewDMap(jobKeysMap); return err }).
 		AddErrorFn(func() error { x.kindsMap, err = x.client.NewDMap(kindsMap); return err }).
+		AddErrorFn(func() error { x.leasesMap, err = x.client.NewDMap(leasesMap); return err }).
 		Error()
 }
 
@@ -1145,7 +1543,7 @@ func (x *Engine) createSubscription(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	x.pubSub = ps.Subscribe(ctx, events.ClusterEventsChannel)
+	x.pubSub = ps.Subscribe(ctx, events.ClusterEventsChannel, nodeLeavingChannel, nodeStayingChannel)
 	x.messages = x.pubSub.Channel()
 	return nil
 }
@@ -1157,5 +1555,6 @@ func (x *Engine) initPeerState() {
 		PeersPort:    int32(x.node.PeersPort),
 		Actors:       map[string]*internalpb.Actor{},
 		Grains:       map[string]*internalpb.Grain{},
+		Roles:        x.node.Roles,
 	}
 }
@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -37,6 +38,7 @@ import (
 	natsserver "github.com/nats-io/nats-server/v2/server"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tochemey/olric"
 	"github.com/travisjeffery/go-dynaport"
 	"google.golang.org/protobuf/proto"
 
@@ -1138,6 +1140,212 @@ func TestMultipleNodes(t *testing.T) {
 	})
 }
 
+func TestOwnedPartitions(t *testing.T) {
+	t.Run("With partitions spread across the nodes", func(t *testing.T) {
+		ctx := context.TODO()
+
+		// start the NATS server
+		srv := startNatsServer(t)
+
+		node1, sd1 := startEngine(t, "node1", srv.Addr().String())
+		require.NotNil(t, node1)
+
+		// wait for the node to start properly
+		pause.For(2 * time.Second)
+
+		// a single node owns all the partitions
+		partitions, err := node1.OwnedPartitions(ctx)
+		require.NoError(t, err)
+		require.Len(t, partitions, int(node1.partitionsCount))
+
+		node2, sd2 := startEngine(t, "node2", srv.Addr().String())
+		require.NotNil(t, node2)
+
+		// wait for the partitions to be rebalanced
+		pause.For(2 * time.Second)
+
+		partitions1, err := node1.OwnedPartitions(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, partitions1)
+
+		partitions2, err := node2.OwnedPartitions(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, partitions2)
+
+		// every partition has a single owner
+		partitions = append(partitions1, partitions2...)
+		slices.Sort(partitions)
+		require.Len(t, slices.Compact(partitions), int(node1.partitionsCount))
+		require.Len(t, partitions1, int(node1.partitionsCount)-len(partitions2))
+
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, node1.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With cluster engine not running", func(t *testing.T) {
+		nodePorts := dynaport.Get(3)
+		host := "127.0.0.1"
+		hostNode := discovery.Node{
+			Name:          host,
+			Host:          host,
+			DiscoveryPort: nodePorts[0],
+			PeersPort:     nodePorts[1],
+			RemotingPort:  nodePorts[2],
+		}
+
+		cluster, err := NewEngine("test", new(testkit.Provider), &hostNode, WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+
+		partitions, err := cluster.OwnedPartitions(t.Context())
+		require.ErrorIs(t, err, ErrEngineNotRunning)
+		require.Empty(t, partitions)
+	})
+	t.Run("With partitions of the cluster records", func(t *testing.T) {
+		nodePorts := dynaport.Get(3)
+		host := "127.0.0.1"
+		hostNode := discovery.Node{
+			Name:          host,
+			Host:          host,
+			DiscoveryPort: nodePorts[0],
+			PeersPort:     nodePorts[1],
+			RemotingPort:  nodePorts[2],
+		}
+
+		cluster, err := NewEngine("test", new(testkit.Provider), &hostNode, WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+
+		// the records are located by their map name along with their key
+		partitionOf := func(dmap, key string) int {
+			return int(cluster.hasher.HashCode([]byte(dmap+key)) % cluster.partitionsCount)
+		}
+
+		require.Equal(t, []int{partitionOf(actorsMap, "actor")}, cluster.ActorPartitions("actor", ""))
+		require.Equal(t, []int{partitionOf(actorsMap, "actor"), partitionOf(kindsMap, "kind")}, cluster.ActorPartitions("actor", "kind"))
+		require.Equal(t, []int{partitionOf(grainsMap, "kind/grain"), partitionOf(kindsMap, "kind")}, cluster.GrainPartitions("kind/grain", "kind"))
+	})
+	t.Run("With partitions of a routing table", func(t *testing.T) {
+		table := olric.RoutingTable{
+			0: {PrimaryOwners: []string{"node1"}},
+			1: {PrimaryOwners: []string{"node2"}, ReplicaOwners: []string{"node1"}},
+			2: {PrimaryOwners: []string{"node2", "node1"}},
+			3: {PrimaryOwners: []string{"node1"}},
+		}
+
+		require.Equal(t, []int{0, 2, 3}, ownedPartitions(table, "node1"))
+		require.Equal(t, []int{1, 2}, ownedPartitions(table, "node2"))
+		require.Empty(t, ownedPartitions(table, "node3"))
+	})
+}
+
+func TestLease(t *testing.T) {
+	t.Run("With exclusive ownership across the nodes", func(t *testing.T) {
+		ctx := context.TODO()
+
+		// start the NATS server
+		srv := startNatsServer(t)
+
+		node1, sd1 := startEngine(t, "node1", srv.Addr().String())
+		require.NotNil(t, node1)
+
+		node2, sd2 := startEngine(t, "node2", srv.Addr().String())
+		require.NotNil(t, node2)
+
+		// wait for the cluster to form
+		pause.For(2 * time.Second)
+
+		key := "lease"
+		lease, err := node1.AcquireLease(ctx, key, time.Second, 0)
+		require.NoError(t, err)
+		require.NotNil(t, lease)
+		require.Equal(t, key, lease.Key())
+
+		// the lease is held by node1
+		other, err := node2.AcquireLease(ctx, key, time.Second, 0)
+		require.ErrorIs(t, err, ErrLeaseNotAcquired)
+		require.Nil(t, other)
+
+		// renew the lease
+		require.NoError(t, lease.Renew(ctx, time.Second))
+
+		// release the lease and acquire it from node2
+		require.NoError(t, lease.Release(ctx))
+		other, err = node2.AcquireLease(ctx, key, 500*time.Millisecond, 0)
+		require.NoError(t, err)
+		require.NotNil(t, other)
+
+		// node1 waits for the lease to expire
+		lease, err = node1.AcquireLease(ctx, key, time.Second, 5*time.Second)
+		require.NoError(t, err)
+		require.NotNil(t, lease)
+		require.NoError(t, lease.Release(ctx))
+
+		// releasing an expired lease is a no-op
+		require.NoError(t, other.Release(ctx))
+
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, node1.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With lease left without expiry by a crashed node", func(t *testing.T) {
+		ctx := context.TODO()
+
+		// start the NATS server
+		srv := startNatsServer(t)
+
+		node1, sd1 := startEngine(t, "node1", srv.Addr().String())
+		require.NotNil(t, node1)
+
+		node2, sd2 := startEngine(t, "node2", srv.Addr().String())
+		require.NotNil(t, node2)
+
+		// wait for the cluster to form
+		pause.For(2 * time.Second)
+
+		// node1 crashes after taking the lock and before setting its expiry
+		key := "lease"
+		_, err := node1.leasesMap.Lock(ctx, key, 0)
+		require.NoError(t, err)
+
+		lease, err := node2.AcquireLease(ctx, key, time.Second, 0)
+		require.ErrorIs(t, err, ErrLeaseNotAcquired)
+		require.Nil(t, lease)
+
+		// the lease is given an expiry by node2, hence node2 acquires it once it has expired
+		lease, err = node2.AcquireLease(ctx, key, time.Second, 3*time.Second)
+		require.NoError(t, err)
+		require.NotNil(t, lease)
+		require.NoError(t, lease.Release(ctx))
+
+		require.NoError(t, node2.Stop(ctx))
+		require.NoError(t, node1.Stop(ctx))
+		require.NoError(t, sd2.Close())
+		require.NoError(t, sd1.Close())
+		srv.Shutdown()
+	})
+	t.Run("With cluster engine not running", func(t *testing.T) {
+		nodePorts := dynaport.Get(3)
+		host := "127.0.0.1"
+		hostNode := discovery.Node{
+			Name:          host,
+			Host:          host,
+			DiscoveryPort: nodePorts[0],
+			PeersPort:     nodePorts[1],
+			RemotingPort:  nodePorts[2],
+		}
+
+		cluster, err := NewEngine("test", new(testkit.Provider), &hostNode, WithLogger(log.DiscardLogger))
+		require.NoError(t, err)
+
+		lease, err := cluster.AcquireLease(t.Context(), "lease", time.Second, 0)
+		require.ErrorIs(t, err, ErrEngineNotRunning)
+		require.Nil(t, lease)
+	})
+}
+
 func startNatsServer(t *testing.T) *natsserver.Server {
 	t.Helper()
 	serv, err := natsserver.NewServer(&natsserver.Options{
@@ -37,4 +37,8 @@ var (
 	ErrEngineNotRunning = errors.New("engine is not running")
 	// ErrGrainNotFound is returned when a grain is not found
 	ErrGrainNotFound = errors.New("grain not found")
+	// ErrLeaseNotAcquired is returned when a lease is held by another cluster node
+	ErrLeaseNotAcquired = errors.New("lease not acquired")
+	// ErrLeaseLost is returned when a lease has expired before being renewed
+	ErrLeaseLost = errors.New("lease lost")
 )
@@ -0,0 +1,66 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tochemey/olric"
+)
+
+// Lease grants a cluster node the exclusive ownership of a key for a limited time.
+// The owner must renew the lease before it expires to keep the ownership of the key
+type Lease struct {
+	key  string
+	lock olric.LockContext
+}
+
+// Key returns the key owned by the lease
+func (l *Lease) Key() string {
+	return l.key
+}
+
+// Renew extends the lease for the ttl duration.
+// It returns ErrLeaseLost when the lease has already expired
+func (l *Lease) Renew(ctx context.Context, ttl time.Duration) error {
+	if err := l.lock.Lease(ctx, ttl); err != nil {
+		if errors.Is(err, olric.ErrNoSuchLock) {
+			return ErrLeaseLost
+		}
+		return err
+	}
+	return nil
+}
+
+// Release gives up the ownership of the key.
+// Releasing an expired lease is a no-op
+func (l *Lease) Release(ctx context.Context) error {
+	if err := l.lock.Unlock(ctx); err != nil && !errors.Is(err, olric.ErrNoSuchLock) {
+		return err
+	}
+	return nil
+}
@@ -26,7 +26,9 @@ package cluster
 
 import (
 	"net"
+	"slices"
 	"strconv"
+	"time"
 )
 
 // Peer defines the peer info
@@ -40,6 +42,16 @@ type Peer struct {
 	Coordinator bool
 	// RemotingPort
 	RemotingPort int
+	// CreatedAt specifies the time the peer was started
+	CreatedAt time.Time
+	// Roles specifies the peer roles
+	Roles []string
+}
+
+// HasRole states whether the peer has the given role.
+// Every peer has the empty role
+func (peer Peer) HasRole(role string) bool {
+	return role == "" || slices.Contains(peer.Roles, role)
 }
 
 // PeerAddress returns address the node's peers will use to connect to
@@ -0,0 +1,86 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package compression provides the connect options used to compress the remoting traffic
+package compression
+
+import (
+	"connectrpc.com/connect"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/tochemey/goakt/v3/remote"
+)
+
+// ClientOptions returns the client options that compress the outgoing requests with the given compression
+// when they are at least minSize bytes. The client accepts both gzip and zstd compressed responses.
+func ClientOptions(compression remote.Compression, minSize int) []connect.ClientOption {
+	opts := []connect.ClientOption{
+		connect.WithAcceptCompression(remote.ZstdCompression.String(), newZstdDecompressor, newZstdCompressor),
+		connect.WithCompressMinBytes(minSize),
+	}
+
+	if compression != remote.NoCompression {
+		opts = append(opts, connect.WithSendCompression(compression.String()))
+	}
+	return opts
+}
+
+// HandlerOptions returns the handler options that accept both gzip and zstd compressed requests.
+// Responses of at least minSize bytes are compressed with the compression used by the request.
+func HandlerOptions(minSize int) []connect.HandlerOption {
+	return []connect.HandlerOption{
+		connect.WithCompression(remote.ZstdCompression.String(), newZstdDecompressor, newZstdCompressor),
+		connect.WithCompressMinBytes(minSize),
+	}
+}
+
+// zstdDecompressor wraps a zstd decoder to implement connect.Decompressor
+type zstdDecompressor struct {
+	*zstd.Decoder
+}
+
+// Close is a no-op since a closed zstd decoder cannot be reset and reused by the pool
+func (d *zstdDecompressor) Close() error {
+	return nil
+}
+
+// newZstdDecompressor creates a zstd decompressor that decodes synchronously
+func newZstdDecompressor() connect.Decompressor {
+	// the error is only returned for invalid options
+	decoder, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	return &zstdDecompressor{Decoder: decoder}
+}
+
+// newZstdCompressor creates a zstd compressor that encodes synchronously
+func newZstdCompressor() connect.Compressor {
+	// the error is only returned for invalid options
+	encoder, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	return encoder
+}
+
+// enforce compilation error
+var (
+	_ connect.Decompressor = (*zstdDecompressor)(nil)
+	_ connect.Compressor   = (*zstd.Encoder)(nil)
+)
@@ -0,0 +1,95 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package compression
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/internal/internalpb/internalpbconnect"
+	"github.com/tochemey/goakt/v3/remote"
+)
+
+// echoHandler echoes the RemoteAsk messages and records the request encoding
+type echoHandler struct {
+	internalpbconnect.UnimplementedRemotingServiceHandler
+	encoding string
+}
+
+func (h *echoHandler) RemoteAsk(_ context.Context, request *connect.Request[internalpb.RemoteAskRequest]) (*connect.Response[internalpb.RemoteAskResponse], error) {
+	h.encoding = request.Header().Get("Content-Encoding")
+	messages := make([]*internalpb.Payload, 0, len(request.Msg.GetRemoteMessages()))
+	for _, message := range request.Msg.GetRemoteMessages() {
+		messages = append(messages, message.GetPayload())
+	}
+	return connect.NewResponse(&internalpb.RemoteAskResponse{Payloads: messages}), nil
+}
+
+func TestCompression(t *testing.T) {
+	testCases := []struct {
+		name        string
+		compression remote.Compression
+		minSize     int
+		size        int
+		encoding    string
+	}{
+		{name: "With no compression", compression: remote.NoCompression, minSize: 0, size: 4096, encoding: ""},
+		{name: "With gzip compression", compression: remote.GzipCompression, minSize: 0, size: 4096, encoding: "gzip"},
+		{name: "With zstd compression", compression: remote.ZstdCompression, minSize: 0, size: 4096, encoding: "zstd"},
+		{name: "With message smaller than the minimum size", compression: remote.ZstdCompression, minSize: 1024, size: 10, encoding: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := new(echoHandler)
+			path, httpHandler := internalpbconnect.NewRemotingServiceHandler(handler, HandlerOptions(tc.minSize)...)
+			mux := http.NewServeMux()
+			mux.Handle(path, httpHandler)
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			client := internalpbconnect.NewRemotingServiceClient(http.DefaultClient, server.URL, ClientOptions(tc.compression, tc.minSize)...)
+
+			message := &internalpb.Payload{Data: []byte(strings.Repeat("a", tc.size))}
+
+			response, err := client.RemoteAsk(context.Background(), connect.NewRequest(&internalpb.RemoteAskRequest{
+				RemoteMessages: []*internalpb.RemoteMessage{{Payload: message}},
+			}))
+			require.NoError(t, err)
+			require.Len(t, response.Msg.GetPayloads(), 1)
+
+			assert.Len(t, response.Msg.GetPayloads()[0].GetData(), tc.size)
+			assert.Equal(t, tc.encoding, handler.encoding)
+		})
+	}
+}
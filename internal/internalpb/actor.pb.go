@@ -10,6 +10,7 @@ import (
 	goaktpb "github.com/tochemey/goakt/v3/goaktpb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -37,8 +38,17 @@ type Actor struct {
 	PassivationStrategy *PassivationStrategy `protobuf:"bytes,5,opt,name=passivation_strategy,json=passivationStrategy,proto3" json:"passivation_strategy,omitempty"`
 	// Specifies the dependencies
 	Dependencies []*Dependency `protobuf:"bytes,6,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
-	// States whether the actor will require a stash buffer
-	EnableStash   bool `protobuf:"varint,7,opt,name=enable_stash,json=enableStash,proto3" json:"enable_stash,omitempty"`
+	//  States whether the actor will require a stash buffer
+	EnableStash bool `protobuf:"varint,7,opt,name=enable_stash,json=enableStash,proto3" json:"enable_stash,omitempty"`
+	// Specifies the number of persisted events after which a snapshot is taken
+	// This is only relevant for persistent actors
+	SnapshotInterval uint64 `protobuf:"varint,8,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// Specifies the name of the registered factory used to create the actor
+	Factory string `protobuf:"bytes,9,opt,name=factory,proto3" json:"factory,omitempty"`
+	// Specifies the arguments passed to the factory
+	Args *anypb.Any `protobuf:"bytes,10,opt,name=args,proto3" json:"args,omitempty"`
+	// Specifies the role a cluster node must have to host the actor
+	Role          string `protobuf:"bytes,11,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,12 +132,40 @@ func (x *Actor) GetEnableStash() bool {
 	return false
 }
 
+func (x *Actor) GetSnapshotInterval() uint64 {
+	if x != nil {
+		return x.SnapshotInterval
+	}
+	return 0
+}
+
+func (x *Actor) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
+}
+
+func (x *Actor) GetArgs() *anypb.Any {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Actor) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 var File_internal_actor_proto protoreflect.FileDescriptor
 
 const file_internal_actor_proto_rawDesc = "" +
 	"\n" +
 	"\x14internal/actor.proto\x12\n" +
-	"internalpb\x1a\x11goakt/goakt.proto\x1a\x19internal/dependency.proto\x1a\x1ainternal/passivation.proto\"\xbf\x02\n" +
+	"internalpb\x1a\x11goakt/goakt.proto\x1a\x19google/protobuf/any.proto\x1a\x19internal/dependency.proto\x1a\x1ainternal/passivation.proto\"\xc4\x03\n" +
 	"\x05Actor\x12*\n" +
 	"\aaddress\x18\x01 \x01(\v2\x10.goaktpb.AddressR\aaddress\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12!\n" +
@@ -135,7 +173,12 @@ const file_internal_actor_proto_rawDesc = "" +
 	"\vrelocatable\x18\x04 \x01(\bR\vrelocatable\x12R\n" +
 	"\x14passivation_strategy\x18\x05 \x01(\v2\x1f.internalpb.PassivationStrategyR\x13passivationStrategy\x12:\n" +
 	"\fdependencies\x18\x06 \x03(\v2\x16.internalpb.DependencyR\fdependencies\x12!\n" +
-	"\fenable_stash\x18\a \x01(\bR\venableStashB\xa3\x01\n" +
+	"\fenable_stash\x18\a \x01(\bR\venableStash\x12+\n" +
+	"\x11snapshot_interval\x18\b \x01(\x04R\x10snapshotInterval\x12\x18\n" +
+	"\afactory\x18\t \x01(\tR\afactory\x12(\n" +
+	"\x04args\x18\n" +
+	" \x01(\v2\x14.google.protobuf.AnyR\x04args\x12\x12\n" +
+	"\x04role\x18\v \x01(\tR\x04roleB\xa3\x01\n" +
 	"\x0ecom.internalpbB\n" +
 	"ActorProtoH\x02P\x01Z;github.com/tochemey/goakt/v3/internal/internalpb;internalpb\xa2\x02\x03IXX\xaa\x02\n" +
 	"Internalpb\xca\x02\n" +
@@ -160,16 +203,18 @@ var file_internal_actor_proto_goTypes = []any{
 	(*goaktpb.Address)(nil),     // 1: goaktpb.Address
 	(*PassivationStrategy)(nil), // 2: internalpb.PassivationStrategy
 	(*Dependency)(nil),          // 3: internalpb.Dependency
+	(*anypb.Any)(nil),           // 4: google.protobuf.Any
 }
 var file_internal_actor_proto_depIdxs = []int32{
 	1, // 0: internalpb.Actor.address:type_name -> goaktpb.Address
 	2, // 1: internalpb.Actor.passivation_strategy:type_name -> internalpb.PassivationStrategy
 	3, // 2: internalpb.Actor.dependencies:type_name -> internalpb.Dependency
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: internalpb.Actor.args:type_name -> google.protobuf.Any
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_internal_actor_proto_init() }
@@ -72,7 +72,15 @@ type GetNodeMetricResponse struct {
 	// Specifies the node address
 	NodeRemoteAddress string `protobuf:"bytes,1,opt,name=node_remote_address,json=nodeRemoteAddress,proto3" json:"node_remote_address,omitempty"`
 	// Specifies the actors count for the given node
-	ActorsCount   uint64 `protobuf:"varint,2,opt,name=actors_count,json=actorsCount,proto3" json:"actors_count,omitempty"`
+	ActorsCount uint64 `protobuf:"varint,2,opt,name=actors_count,json=actorsCount,proto3" json:"actors_count,omitempty"`
+	// Specifies the active grains count for the given node
+	GrainsCount uint64 `protobuf:"varint,3,opt,name=grains_count,json=grainsCount,proto3" json:"grains_count,omitempty"`
+	// Specifies the total memory of the node in bytes
+	MemorySize uint64 `protobuf:"varint,4,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	// Specifies the free memory of the node in bytes
+	MemoryAvailable uint64 `protobuf:"varint,5,opt,name=memory_available,json=memoryAvailable,proto3" json:"memory_available,omitempty"`
+	// Specifies the memory used by the node in bytes
+	MemoryUsed    uint64 `protobuf:"varint,6,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -121,6 +129,34 @@ func (x *GetNodeMetricResponse) GetActorsCount() uint64 {
 	return 0
 }
 
+func (x *GetNodeMetricResponse) GetGrainsCount() uint64 {
+	if x != nil {
+		return x.GrainsCount
+	}
+	return 0
+}
+
+func (x *GetNodeMetricResponse) GetMemorySize() uint64 {
+	if x != nil {
+		return x.MemorySize
+	}
+	return 0
+}
+
+func (x *GetNodeMetricResponse) GetMemoryAvailable() uint64 {
+	if x != nil {
+		return x.MemoryAvailable
+	}
+	return 0
+}
+
+func (x *GetNodeMetricResponse) GetMemoryUsed() uint64 {
+	if x != nil {
+		return x.MemoryUsed
+	}
+	return 0
+}
+
 type GetKindsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Specifies the node address
@@ -211,6 +247,87 @@ func (x *GetKindsResponse) GetKinds() []string {
 	return nil
 }
 
+type DrainNodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the node address
+	NodeAddress   string `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainNodeRequest) Reset() {
+	*x = DrainNodeRequest{}
+	mi := &file_internal_cluster_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainNodeRequest) ProtoMessage() {}
+
+func (x *DrainNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cluster_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainNodeRequest.ProtoReflect.Descriptor instead.
+func (*DrainNodeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cluster_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DrainNodeRequest) GetNodeAddress() string {
+	if x != nil {
+		return x.NodeAddress
+	}
+	return ""
+}
+
+type DrainNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainNodeResponse) Reset() {
+	*x = DrainNodeResponse{}
+	mi := &file_internal_cluster_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainNodeResponse) ProtoMessage() {}
+
+func (x *DrainNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cluster_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainNodeResponse.ProtoReflect.Descriptor instead.
+func (*DrainNodeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cluster_proto_rawDescGZIP(), []int{5}
+}
+
 type Disseminate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Specifies the message unique id
@@ -225,7 +342,7 @@ type Disseminate struct {
 
 func (x *Disseminate) Reset() {
 	*x = Disseminate{}
-	mi := &file_internal_cluster_proto_msgTypes[4]
+	mi := &file_internal_cluster_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -237,7 +354,7 @@ func (x *Disseminate) String() string {
 func (*Disseminate) ProtoMessage() {}
 
 func (x *Disseminate) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_cluster_proto_msgTypes[4]
+	mi := &file_internal_cluster_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +367,7 @@ func (x *Disseminate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Disseminate.ProtoReflect.Descriptor instead.
 func (*Disseminate) Descriptor() ([]byte, []int) {
-	return file_internal_cluster_proto_rawDescGZIP(), []int{4}
+	return file_internal_cluster_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Disseminate) GetId() string {
@@ -281,21 +398,31 @@ const file_internal_cluster_proto_rawDesc = "" +
 	"\x16internal/cluster.proto\x12\n" +
 	"internalpb\x1a\x19google/protobuf/any.proto\"9\n" +
 	"\x14GetNodeMetricRequest\x12!\n" +
-	"\fnode_address\x18\x01 \x01(\tR\vnodeAddress\"j\n" +
+	"\fnode_address\x18\x01 \x01(\tR\vnodeAddress\"\xfa\x01\n" +
 	"\x15GetNodeMetricResponse\x12.\n" +
 	"\x13node_remote_address\x18\x01 \x01(\tR\x11nodeRemoteAddress\x12!\n" +
-	"\factors_count\x18\x02 \x01(\x04R\vactorsCount\"4\n" +
+	"\factors_count\x18\x02 \x01(\x04R\vactorsCount\x12!\n" +
+	"\fgrains_count\x18\x03 \x01(\x04R\vgrainsCount\x12\x1f\n" +
+	"\vmemory_size\x18\x04 \x01(\x04R\n" +
+	"memorySize\x12)\n" +
+	"\x10memory_available\x18\x05 \x01(\x04R\x0fmemoryAvailable\x12\x1f\n" +
+	"\vmemory_used\x18\x06 \x01(\x04R\n" +
+	"memoryUsed\"4\n" +
 	"\x0fGetKindsRequest\x12!\n" +
 	"\fnode_address\x18\x01 \x01(\tR\vnodeAddress\"(\n" +
 	"\x10GetKindsResponse\x12\x14\n" +
-	"\x05kinds\x18\x01 \x03(\tR\x05kinds\"c\n" +
+	"\x05kinds\x18\x01 \x03(\tR\x05kinds\"5\n" +
+	"\x10DrainNodeRequest\x12!\n" +
+	"\fnode_address\x18\x01 \x01(\tR\vnodeAddress\"\x13\n" +
+	"\x11DrainNodeResponse\"c\n" +
 	"\vDisseminate\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05topic\x18\x02 \x01(\tR\x05topic\x12.\n" +
-	"\amessage\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\amessage2\xad\x01\n" +
+	"\amessage\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\amessage2\xf7\x01\n" +
 	"\x0eClusterService\x12T\n" +
 	"\rGetNodeMetric\x12 .internalpb.GetNodeMetricRequest\x1a!.internalpb.GetNodeMetricResponse\x12E\n" +
-	"\bGetKinds\x12\x1b.internalpb.GetKindsRequest\x1a\x1c.internalpb.GetKindsResponseB\xa5\x01\n" +
+	"\bGetKinds\x12\x1b.internalpb.GetKindsRequest\x1a\x1c.internalpb.GetKindsResponse\x12H\n" +
+	"\tDrainNode\x12\x1c.internalpb.DrainNodeRequest\x1a\x1d.internalpb.DrainNodeResponseB\xa5\x01\n" +
 	"\x0ecom.internalpbB\fClusterProtoH\x02P\x01Z;github.com/tochemey/goakt/v3/internal/internalpb;internalpb\xa2\x02\x03IXX\xaa\x02\n" +
 	"Internalpb\xca\x02\n" +
 	"Internalpb\xe2\x02\x16Internalpb\\GPBMetadata\xea\x02\n" +
@@ -313,23 +440,27 @@ func file_internal_cluster_proto_rawDescGZIP() []byte {
 	return file_internal_cluster_proto_rawDescData
 }
 
-var file_internal_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_internal_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_internal_cluster_proto_goTypes = []any{
 	(*GetNodeMetricRequest)(nil),  // 0: internalpb.GetNodeMetricRequest
 	(*GetNodeMetricResponse)(nil), // 1: internalpb.GetNodeMetricResponse
 	(*GetKindsRequest)(nil),       // 2: internalpb.GetKindsRequest
 	(*GetKindsResponse)(nil),      // 3: internalpb.GetKindsResponse
-	(*Disseminate)(nil),           // 4: internalpb.Disseminate
-	(*anypb.Any)(nil),             // 5: google.protobuf.Any
+	(*DrainNodeRequest)(nil),      // 4: internalpb.DrainNodeRequest
+	(*DrainNodeResponse)(nil),     // 5: internalpb.DrainNodeResponse
+	(*Disseminate)(nil),           // 6: internalpb.Disseminate
+	(*anypb.Any)(nil),             // 7: google.protobuf.Any
 }
 var file_internal_cluster_proto_depIdxs = []int32{
-	5, // 0: internalpb.Disseminate.message:type_name -> google.protobuf.Any
+	7, // 0: internalpb.Disseminate.message:type_name -> google.protobuf.Any
 	0, // 1: internalpb.ClusterService.GetNodeMetric:input_type -> internalpb.GetNodeMetricRequest
 	2, // 2: internalpb.ClusterService.GetKinds:input_type -> internalpb.GetKindsRequest
-	1, // 3: internalpb.ClusterService.GetNodeMetric:output_type -> internalpb.GetNodeMetricResponse
-	3, // 4: internalpb.ClusterService.GetKinds:output_type -> internalpb.GetKindsResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
+	4, // 3: internalpb.ClusterService.DrainNode:input_type -> internalpb.DrainNodeRequest
+	1, // 4: internalpb.ClusterService.GetNodeMetric:output_type -> internalpb.GetNodeMetricResponse
+	3, // 5: internalpb.ClusterService.GetKinds:output_type -> internalpb.GetKindsResponse
+	5, // 6: internalpb.ClusterService.DrainNode:output_type -> internalpb.DrainNodeResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
 	1, // [1:1] is the sub-list for extension extendee
 	0, // [0:1] is the sub-list for field type_name
@@ -346,7 +477,7 @@ func file_internal_cluster_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_cluster_proto_rawDesc), len(file_internal_cluster_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
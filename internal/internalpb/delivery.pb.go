@@ -0,0 +1,299 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: internal/delivery.proto
+
+package internalpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Delivery wraps a message sent with at-least-once delivery
+type Delivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the producer ID
+	ProducerId string `protobuf:"bytes,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Specifies the sequence number of the delivery
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// Specifies the actual message serialized by one of the registered serializers
+	Message *Payload `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Specifies the producer incarnation.
+	// It is set when the producer does not use a delivery store so that
+	// the sequence numbers of a new instance of the producer are not
+	// mistaken for duplicates
+	Incarnation string `protobuf:"bytes,4,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+	// Specifies the sequence number up to which all the deliveries
+	// have been either confirmed or given up by the producer
+	ConfirmedSequenceNumber uint64 `protobuf:"varint,5,opt,name=confirmed_sequence_number,json=confirmedSequenceNumber,proto3" json:"confirmed_sequence_number,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Delivery) Reset() {
+	*x = Delivery{}
+	mi := &file_internal_delivery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delivery) ProtoMessage() {}
+
+func (x *Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Delivery) GetProducerId() string {
+	if x != nil {
+		return x.ProducerId
+	}
+	return ""
+}
+
+func (x *Delivery) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *Delivery) GetMessage() *Payload {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Delivery) GetIncarnation() string {
+	if x != nil {
+		return x.Incarnation
+	}
+	return ""
+}
+
+func (x *Delivery) GetConfirmedSequenceNumber() uint64 {
+	if x != nil {
+		return x.ConfirmedSequenceNumber
+	}
+	return 0
+}
+
+// DeliveryAck is sent back to the producer once
+// the recipient has received a delivery
+type DeliveryAck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the producer ID
+	ProducerId string `protobuf:"bytes,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Specifies the sequence number of the delivery
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// Specifies the producer incarnation
+	Incarnation   string `protobuf:"bytes,3,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryAck) Reset() {
+	*x = DeliveryAck{}
+	mi := &file_internal_delivery_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryAck) ProtoMessage() {}
+
+func (x *DeliveryAck) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryAck.ProtoReflect.Descriptor instead.
+func (*DeliveryAck) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeliveryAck) GetProducerId() string {
+	if x != nil {
+		return x.ProducerId
+	}
+	return ""
+}
+
+func (x *DeliveryAck) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *DeliveryAck) GetIncarnation() string {
+	if x != nil {
+		return x.Incarnation
+	}
+	return ""
+}
+
+// RedeliveryTick is scheduled by the producer
+// to redeliver the unconfirmed messages
+type RedeliveryTick struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the producer ID
+	ProducerId    string `protobuf:"bytes,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliveryTick) Reset() {
+	*x = RedeliveryTick{}
+	mi := &file_internal_delivery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliveryTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliveryTick) ProtoMessage() {}
+
+func (x *RedeliveryTick) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_delivery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliveryTick.ProtoReflect.Descriptor instead.
+func (*RedeliveryTick) Descriptor() ([]byte, []int) {
+	return file_internal_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RedeliveryTick) GetProducerId() string {
+	if x != nil {
+		return x.ProducerId
+	}
+	return ""
+}
+
+var File_internal_delivery_proto protoreflect.FileDescriptor
+
+const file_internal_delivery_proto_rawDesc = "" +
+	"\n" +
+	"\x17internal/delivery.proto\x12\n" +
+	"internalpb\x1a\x17internal/remoting.proto\"\xe1\x01\n" +
+	"\bDelivery\x12\x1f\n" +
+	"\vproducer_id\x18\x01 \x01(\tR\n" +
+	"producerId\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x04R\x0esequenceNumber\x12-\n" +
+	"\amessage\x18\x03 \x01(\v2\x13.internalpb.PayloadR\amessage\x12 \n" +
+	"\vincarnation\x18\x04 \x01(\tR\vincarnation\x12:\n" +
+	"\x19confirmed_sequence_number\x18\x05 \x01(\x04R\x17confirmedSequenceNumber\"y\n" +
+	"\vDeliveryAck\x12\x1f\n" +
+	"\vproducer_id\x18\x01 \x01(\tR\n" +
+	"producerId\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x04R\x0esequenceNumber\x12 \n" +
+	"\vincarnation\x18\x03 \x01(\tR\vincarnation\"1\n" +
+	"\x0eRedeliveryTick\x12\x1f\n" +
+	"\vproducer_id\x18\x01 \x01(\tR\n" +
+	"producerIdB\xa6\x01\n" +
+	"\x0ecom.internalpbB\rDeliveryProtoH\x02P\x01Z;github.com/tochemey/goakt/v3/internal/internalpb;internalpb\xa2\x02\x03IXX\xaa\x02\n" +
+	"Internalpb\xca\x02\n" +
+	"Internalpb\xe2\x02\x16Internalpb\\GPBMetadata\xea\x02\n" +
+	"Internalpbb\x06proto3"
+
+var (
+	file_internal_delivery_proto_rawDescOnce sync.Once
+	file_internal_delivery_proto_rawDescData []byte
+)
+
+func file_internal_delivery_proto_rawDescGZIP() []byte {
+	file_internal_delivery_proto_rawDescOnce.Do(func() {
+		file_internal_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_delivery_proto_rawDesc), len(file_internal_delivery_proto_rawDesc)))
+	})
+	return file_internal_delivery_proto_rawDescData
+}
+
+var file_internal_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_internal_delivery_proto_goTypes = []any{
+	(*Delivery)(nil),       // 0: internalpb.Delivery
+	(*DeliveryAck)(nil),    // 1: internalpb.DeliveryAck
+	(*RedeliveryTick)(nil), // 2: internalpb.RedeliveryTick
+	(*Payload)(nil),        // 3: internalpb.Payload
+}
+var file_internal_delivery_proto_depIdxs = []int32{
+	3, // 0: internalpb.Delivery.message:type_name -> internalpb.Payload
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_internal_delivery_proto_init() }
+func file_internal_delivery_proto_init() {
+	if File_internal_delivery_proto != nil {
+		return
+	}
+	file_internal_remoting_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_delivery_proto_rawDesc), len(file_internal_delivery_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_delivery_proto_goTypes,
+		DependencyIndexes: file_internal_delivery_proto_depIdxs,
+		MessageInfos:      file_internal_delivery_proto_msgTypes,
+	}.Build()
+	File_internal_delivery_proto = out.File
+	file_internal_delivery_proto_goTypes = nil
+	file_internal_delivery_proto_depIdxs = nil
+}
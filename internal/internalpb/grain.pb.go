@@ -91,8 +91,13 @@ type Grain struct {
 	Dependencies      []*Dependency          `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
 	ActivationTimeout *durationpb.Duration   `protobuf:"bytes,5,opt,name=activation_timeout,json=activationTimeout,proto3" json:"activation_timeout,omitempty"`
 	ActivationRetries int32                  `protobuf:"varint,6,opt,name=activation_retries,json=activationRetries,proto3" json:"activation_retries,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Specifies the duration of inactivity after which the grain is deactivated.
+	// A negative duration means the grain is long-lived
+	DeactivateAfter *durationpb.Duration `protobuf:"bytes,7,opt,name=deactivate_after,json=deactivateAfter,proto3" json:"deactivate_after,omitempty"`
+	// Specifies the role a cluster node must have to host the grain
+	Role          string `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Grain) Reset() {
@@ -167,6 +172,20 @@ func (x *Grain) GetActivationRetries() int32 {
 	return 0
 }
 
+func (x *Grain) GetDeactivateAfter() *durationpb.Duration {
+	if x != nil {
+		return x.DeactivateAfter
+	}
+	return nil
+}
+
+func (x *Grain) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 var File_internal_grain_proto protoreflect.FileDescriptor
 
 const file_internal_grain_proto_rawDesc = "" +
@@ -176,14 +195,16 @@ const file_internal_grain_proto_rawDesc = "" +
 	"\aGrainId\x12\x12\n" +
 	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05value\x18\x03 \x01(\tR\x05value\"\x94\x02\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xee\x02\n" +
 	"\x05Grain\x12.\n" +
 	"\bgrain_id\x18\x01 \x01(\v2\x13.internalpb.GrainIdR\agrainId\x12\x12\n" +
 	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x03 \x01(\x05R\x04port\x12:\n" +
 	"\fdependencies\x18\x04 \x03(\v2\x16.internalpb.DependencyR\fdependencies\x12H\n" +
 	"\x12activation_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x11activationTimeout\x12-\n" +
-	"\x12activation_retries\x18\x06 \x01(\x05R\x11activationRetriesB\xa3\x01\n" +
+	"\x12activation_retries\x18\x06 \x01(\x05R\x11activationRetries\x12D\n" +
+	"\x10deactivate_after\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0fdeactivateAfter\x12\x12\n" +
+	"\x04role\x18\b \x01(\tR\x04roleB\xa3\x01\n" +
 	"\x0ecom.internalpbB\n" +
 	"GrainProtoH\x02P\x01Z;github.com/tochemey/goakt/v3/internal/internalpb;internalpb\xa2\x02\x03IXX\xaa\x02\n" +
 	"Internalpb\xca\x02\n" +
@@ -213,11 +234,12 @@ var file_internal_grain_proto_depIdxs = []int32{
 	0, // 0: internalpb.Grain.grain_id:type_name -> internalpb.GrainId
 	2, // 1: internalpb.Grain.dependencies:type_name -> internalpb.Dependency
 	3, // 2: internalpb.Grain.activation_timeout:type_name -> google.protobuf.Duration
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 3: internalpb.Grain.deactivate_after:type_name -> google.protobuf.Duration
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_internal_grain_proto_init() }
@@ -38,6 +38,9 @@ const (
 	ClusterServiceGetNodeMetricProcedure = "/internalpb.ClusterService/GetNodeMetric"
 	// ClusterServiceGetKindsProcedure is the fully-qualified name of the ClusterService's GetKinds RPC.
 	ClusterServiceGetKindsProcedure = "/internalpb.ClusterService/GetKinds"
+	// ClusterServiceDrainNodeProcedure is the fully-qualified name of the ClusterService's DrainNode
+	// RPC.
+	ClusterServiceDrainNodeProcedure = "/internalpb.ClusterService/DrainNode"
 )
 
 // ClusterServiceClient is a client for the internalpb.ClusterService service.
@@ -46,6 +49,8 @@ type ClusterServiceClient interface {
 	GetNodeMetric(context.Context, *connect.Request[internalpb.GetNodeMetricRequest]) (*connect.Response[internalpb.GetNodeMetricResponse], error)
 	// GetKinds returns the list of cluster kinds
 	GetKinds(context.Context, *connect.Request[internalpb.GetKindsRequest]) (*connect.Response[internalpb.GetKindsResponse], error)
+	// DrainNode hands off the actors and grains of the node to the other cluster members before it leaves the cluster
+	DrainNode(context.Context, *connect.Request[internalpb.DrainNodeRequest]) (*connect.Response[internalpb.DrainNodeResponse], error)
 }
 
 // NewClusterServiceClient constructs a client for the internalpb.ClusterService service. By
@@ -71,6 +76,12 @@ func NewClusterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(clusterServiceMethods.ByName("GetKinds")),
 			connect.WithClientOptions(opts...),
 		),
+		drainNode: connect.NewClient[internalpb.DrainNodeRequest, internalpb.DrainNodeResponse](
+			httpClient,
+			baseURL+ClusterServiceDrainNodeProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("DrainNode")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -78,6 +89,7 @@ func NewClusterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type clusterServiceClient struct {
 	getNodeMetric *connect.Client[internalpb.GetNodeMetricRequest, internalpb.GetNodeMetricResponse]
 	getKinds      *connect.Client[internalpb.GetKindsRequest, internalpb.GetKindsResponse]
+	drainNode     *connect.Client[internalpb.DrainNodeRequest, internalpb.DrainNodeResponse]
 }
 
 // GetNodeMetric calls internalpb.ClusterService.GetNodeMetric.
@@ -90,12 +102,19 @@ func (c *clusterServiceClient) GetKinds(ctx context.Context, req *connect.Reques
 	return c.getKinds.CallUnary(ctx, req)
 }
 
+// DrainNode calls internalpb.ClusterService.DrainNode.
+func (c *clusterServiceClient) DrainNode(ctx context.Context, req *connect.Request[internalpb.DrainNodeRequest]) (*connect.Response[internalpb.DrainNodeResponse], error) {
+	return c.drainNode.CallUnary(ctx, req)
+}
+
 // ClusterServiceHandler is an implementation of the internalpb.ClusterService service.
 type ClusterServiceHandler interface {
 	// GetNodeMetric returns the node metric
 	GetNodeMetric(context.Context, *connect.Request[internalpb.GetNodeMetricRequest]) (*connect.Response[internalpb.GetNodeMetricResponse], error)
 	// GetKinds returns the list of cluster kinds
 	GetKinds(context.Context, *connect.Request[internalpb.GetKindsRequest]) (*connect.Response[internalpb.GetKindsResponse], error)
+	// DrainNode hands off the actors and grains of the node to the other cluster members before it leaves the cluster
+	DrainNode(context.Context, *connect.Request[internalpb.DrainNodeRequest]) (*connect.Response[internalpb.DrainNodeResponse], error)
 }
 
 // NewClusterServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -117,12 +136,20 @@ func NewClusterServiceHandler(svc ClusterServiceHandler, opts ...connect.Handler
 		connect.WithSchema(clusterServiceMethods.ByName("GetKinds")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clusterServiceDrainNodeHandler := connect.NewUnaryHandler(
+		ClusterServiceDrainNodeProcedure,
+		svc.DrainNode,
+		connect.WithSchema(clusterServiceMethods.ByName("DrainNode")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/internalpb.ClusterService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ClusterServiceGetNodeMetricProcedure:
 			clusterServiceGetNodeMetricHandler.ServeHTTP(w, r)
 		case ClusterServiceGetKindsProcedure:
 			clusterServiceGetKindsHandler.ServeHTTP(w, r)
+		case ClusterServiceDrainNodeProcedure:
+			clusterServiceDrainNodeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -139,3 +166,7 @@ func (UnimplementedClusterServiceHandler) GetNodeMetric(context.Context, *connec
 func (UnimplementedClusterServiceHandler) GetKinds(context.Context, *connect.Request[internalpb.GetKindsRequest]) (*connect.Response[internalpb.GetKindsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.ClusterService.GetKinds is not implemented"))
 }
+
+func (UnimplementedClusterServiceHandler) DrainNode(context.Context, *connect.Request[internalpb.DrainNodeRequest]) (*connect.Response[internalpb.DrainNodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.ClusterService.DrainNode is not implemented"))
+}
@@ -39,9 +39,18 @@ const (
 	// RemotingServiceRemoteTellProcedure is the fully-qualified name of the RemotingService's
 	// RemoteTell RPC.
 	RemotingServiceRemoteTellProcedure = "/internalpb.RemotingService/RemoteTell"
+	// RemotingServiceRemoteStreamTellProcedure is the fully-qualified name of the RemotingService's
+	// RemoteStreamTell RPC.
+	RemotingServiceRemoteStreamTellProcedure = "/internalpb.RemotingService/RemoteStreamTell"
 	// RemotingServiceRemoteLookupProcedure is the fully-qualified name of the RemotingService's
 	// RemoteLookup RPC.
 	RemotingServiceRemoteLookupProcedure = "/internalpb.RemotingService/RemoteLookup"
+	// RemotingServiceRemoteWatchProcedure is the fully-qualified name of the RemotingService's
+	// RemoteWatch RPC.
+	RemotingServiceRemoteWatchProcedure = "/internalpb.RemotingService/RemoteWatch"
+	// RemotingServiceRemoteUnWatchProcedure is the fully-qualified name of the RemotingService's
+	// RemoteUnWatch RPC.
+	RemotingServiceRemoteUnWatchProcedure = "/internalpb.RemotingService/RemoteUnWatch"
 	// RemotingServiceRemoteReSpawnProcedure is the fully-qualified name of the RemotingService's
 	// RemoteReSpawn RPC.
 	RemotingServiceRemoteReSpawnProcedure = "/internalpb.RemotingService/RemoteReSpawn"
@@ -63,6 +72,12 @@ const (
 	// RemotingServiceRemoteActivateGrainProcedure is the fully-qualified name of the RemotingService's
 	// RemoteActivateGrain RPC.
 	RemotingServiceRemoteActivateGrainProcedure = "/internalpb.RemotingService/RemoteActivateGrain"
+	// RemotingServiceRemoteChunkProcedure is the fully-qualified name of the RemotingService's
+	// RemoteChunk RPC.
+	RemotingServiceRemoteChunkProcedure = "/internalpb.RemotingService/RemoteChunk"
+	// RemotingServiceRemoteFetchChunkProcedure is the fully-qualified name of the RemotingService's
+	// RemoteFetchChunk RPC.
+	RemotingServiceRemoteFetchChunkProcedure = "/internalpb.RemotingService/RemoteFetchChunk"
 )
 
 // RemotingServiceClient is a client for the internalpb.RemotingService service.
@@ -72,8 +87,16 @@ type RemotingServiceClient interface {
 	// RemoteTell is used to send a message to a remote actor
 	// The actor on the other line can reply to the sender by using the Sender in the message
 	RemoteTell(context.Context, *connect.Request[internalpb.RemoteTellRequest]) (*connect.Response[internalpb.RemoteTellResponse], error)
+	// RemoteStreamTell opens a long-lived stream between two nodes to send messages to remote actors.
+	// The messages are processed in the order they are sent and the receiver grants the sender
+	// credits to control the number of in-flight batches.
+	RemoteStreamTell(context.Context) *connect.BidiStreamForClient[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse]
 	// Lookup for an actor on a remote host.
 	RemoteLookup(context.Context, *connect.Request[internalpb.RemoteLookupRequest]) (*connect.Response[internalpb.RemoteLookupResponse], error)
+	// RemoteWatch watches an actor on a remote node for a Terminated message when the actor stops
+	RemoteWatch(context.Context, *connect.Request[internalpb.RemoteWatchRequest]) (*connect.Response[internalpb.RemoteWatchResponse], error)
+	// RemoteUnWatch stops watching an actor on a remote node
+	RemoteUnWatch(context.Context, *connect.Request[internalpb.RemoteUnWatchRequest]) (*connect.Response[internalpb.RemoteUnWatchResponse], error)
 	// RemoteReSpawn restarts an actor on a remote machine
 	RemoteReSpawn(context.Context, *connect.Request[internalpb.RemoteReSpawnRequest]) (*connect.Response[internalpb.RemoteReSpawnResponse], error)
 	// RemoteStop stops an actor on a remote machine
@@ -88,6 +111,10 @@ type RemotingServiceClient interface {
 	RemoteTellGrain(context.Context, *connect.Request[internalpb.RemoteTellGrainRequest]) (*connect.Response[internalpb.RemoteTellGrainResponse], error)
 	// RemoteActivateGrain is used to activate a Grain on a remote node
 	RemoteActivateGrain(context.Context, *connect.Request[internalpb.RemoteActivateGrainRequest]) (*connect.Response[internalpb.RemoteActivateGrainResponse], error)
+	// RemoteChunk uploads a chunk of a message too large to be sent in a single request
+	RemoteChunk(context.Context, *connect.Request[internalpb.RemoteChunkRequest]) (*connect.Response[internalpb.RemoteChunkResponse], error)
+	// RemoteFetchChunk downloads a chunk of a reply too large to be sent in a single response
+	RemoteFetchChunk(context.Context, *connect.Request[internalpb.RemoteFetchChunkRequest]) (*connect.Response[internalpb.RemoteFetchChunkResponse], error)
 }
 
 // NewRemotingServiceClient constructs a client for the internalpb.RemotingService service. By
@@ -113,12 +140,30 @@ func NewRemotingServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(remotingServiceMethods.ByName("RemoteTell")),
 			connect.WithClientOptions(opts...),
 		),
+		remoteStreamTell: connect.NewClient[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse](
+			httpClient,
+			baseURL+RemotingServiceRemoteStreamTellProcedure,
+			connect.WithSchema(remotingServiceMethods.ByName("RemoteStreamTell")),
+			connect.WithClientOptions(opts...),
+		),
 		remoteLookup: connect.NewClient[internalpb.RemoteLookupRequest, internalpb.RemoteLookupResponse](
 			httpClient,
 			baseURL+RemotingServiceRemoteLookupProcedure,
 			connect.WithSchema(remotingServiceMethods.ByName("RemoteLookup")),
 			connect.WithClientOptions(opts...),
 		),
+		remoteWatch: connect.NewClient[internalpb.RemoteWatchRequest, internalpb.RemoteWatchResponse](
+			httpClient,
+			baseURL+RemotingServiceRemoteWatchProcedure,
+			connect.WithSchema(remotingServiceMethods.ByName("RemoteWatch")),
+			connect.WithClientOptions(opts...),
+		),
+		remoteUnWatch: connect.NewClient[internalpb.RemoteUnWatchRequest, internalpb.RemoteUnWatchResponse](
+			httpClient,
+			baseURL+RemotingServiceRemoteUnWatchProcedure,
+			connect.WithSchema(remotingServiceMethods.ByName("RemoteUnWatch")),
+			connect.WithClientOptions(opts...),
+		),
 		remoteReSpawn: connect.NewClient[internalpb.RemoteReSpawnRequest, internalpb.RemoteReSpawnResponse](
 			httpClient,
 			baseURL+RemotingServiceRemoteReSpawnProcedure,
@@ -161,6 +206,18 @@ func NewRemotingServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(remotingServiceMethods.ByName("RemoteActivateGrain")),
 			connect.WithClientOptions(opts...),
 		),
+		remoteChunk: connect.NewClient[internalpb.RemoteChunkRequest, internalpb.RemoteChunkResponse](
+			httpClient,
+			baseURL+RemotingServiceRemoteChunkProcedure,
+			connect.WithSchema(remotingServiceMethods.ByName("RemoteChunk")),
+			connect.WithClientOptions(opts...),
+		),
+		remoteFetchChunk: connect.NewClient[internalpb.RemoteFetchChunkRequest, internalpb.RemoteFetchChunkResponse](
+			httpClient,
+			baseURL+RemotingServiceRemoteFetchChunkProcedure,
+			connect.WithSchema(remotingServiceMethods.ByName("RemoteFetchChunk")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -168,7 +225,10 @@ func NewRemotingServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 type remotingServiceClient struct {
 	remoteAsk           *connect.Client[internalpb.RemoteAskRequest, internalpb.RemoteAskResponse]
 	remoteTell          *connect.Client[internalpb.RemoteTellRequest, internalpb.RemoteTellResponse]
+	remoteStreamTell    *connect.Client[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse]
 	remoteLookup        *connect.Client[internalpb.RemoteLookupRequest, internalpb.RemoteLookupResponse]
+	remoteWatch         *connect.Client[internalpb.RemoteWatchRequest, internalpb.RemoteWatchResponse]
+	remoteUnWatch       *connect.Client[internalpb.RemoteUnWatchRequest, internalpb.RemoteUnWatchResponse]
 	remoteReSpawn       *connect.Client[internalpb.RemoteReSpawnRequest, internalpb.RemoteReSpawnResponse]
 	remoteStop          *connect.Client[internalpb.RemoteStopRequest, internalpb.RemoteStopResponse]
 	remoteSpawn         *connect.Client[internalpb.RemoteSpawnRequest, internalpb.RemoteSpawnResponse]
@@ -176,6 +236,8 @@ type remotingServiceClient struct {
 	remoteAskGrain      *connect.Client[internalpb.RemoteAskGrainRequest, internalpb.RemoteAskGrainResponse]
 	remoteTellGrain     *connect.Client[internalpb.RemoteTellGrainRequest, internalpb.RemoteTellGrainResponse]
 	remoteActivateGrain *connect.Client[internalpb.RemoteActivateGrainRequest, internalpb.RemoteActivateGrainResponse]
+	remoteChunk         *connect.Client[internalpb.RemoteChunkRequest, internalpb.RemoteChunkResponse]
+	remoteFetchChunk    *connect.Client[internalpb.RemoteFetchChunkRequest, internalpb.RemoteFetchChunkResponse]
 }
 
 // RemoteAsk calls internalpb.RemotingService.RemoteAsk.
@@ -188,11 +250,26 @@ func (c *remotingServiceClient) RemoteTell(ctx context.Context, req *connect.Req
 	return c.remoteTell.CallUnary(ctx, req)
 }
 
+// RemoteStreamTell calls internalpb.RemotingService.RemoteStreamTell.
+func (c *remotingServiceClient) RemoteStreamTell(ctx context.Context) *connect.BidiStreamForClient[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse] {
+	return c.remoteStreamTell.CallBidiStream(ctx)
+}
+
 // RemoteLookup calls internalpb.RemotingService.RemoteLookup.
 func (c *remotingServiceClient) RemoteLookup(ctx context.Context, req *connect.Request[internalpb.RemoteLookupRequest]) (*connect.Response[internalpb.RemoteLookupResponse], error) {
 	return c.remoteLookup.CallUnary(ctx, req)
 }
 
+// RemoteWatch calls internalpb.RemotingService.RemoteWatch.
+func (c *remotingServiceClient) RemoteWatch(ctx context.Context, req *connect.Request[internalpb.RemoteWatchRequest]) (*connect.Response[internalpb.RemoteWatchResponse], error) {
+	return c.remoteWatch.CallUnary(ctx, req)
+}
+
+// RemoteUnWatch calls internalpb.RemotingService.RemoteUnWatch.
+func (c *remotingServiceClient) RemoteUnWatch(ctx context.Context, req *connect.Request[internalpb.RemoteUnWatchRequest]) (*connect.Response[internalpb.RemoteUnWatchResponse], error) {
+	return c.remoteUnWatch.CallUnary(ctx, req)
+}
+
 // RemoteReSpawn calls internalpb.RemotingService.RemoteReSpawn.
 func (c *remotingServiceClient) RemoteReSpawn(ctx context.Context, req *connect.Request[internalpb.RemoteReSpawnRequest]) (*connect.Response[internalpb.RemoteReSpawnResponse], error) {
 	return c.remoteReSpawn.CallUnary(ctx, req)
@@ -228,6 +305,16 @@ func (c *remotingServiceClient) RemoteActivateGrain(ctx context.Context, req *co
 	return c.remoteActivateGrain.CallUnary(ctx, req)
 }
 
+// RemoteChunk calls internalpb.RemotingService.RemoteChunk.
+func (c *remotingServiceClient) RemoteChunk(ctx context.Context, req *connect.Request[internalpb.RemoteChunkRequest]) (*connect.Response[internalpb.RemoteChunkResponse], error) {
+	return c.remoteChunk.CallUnary(ctx, req)
+}
+
+// RemoteFetchChunk calls internalpb.RemotingService.RemoteFetchChunk.
+func (c *remotingServiceClient) RemoteFetchChunk(ctx context.Context, req *connect.Request[internalpb.RemoteFetchChunkRequest]) (*connect.Response[internalpb.RemoteFetchChunkResponse], error) {
+	return c.remoteFetchChunk.CallUnary(ctx, req)
+}
+
 // RemotingServiceHandler is an implementation of the internalpb.RemotingService service.
 type RemotingServiceHandler interface {
 	// RemoteAsk is used to send a message to an actor remotely and expect a response immediately.
@@ -235,8 +322,16 @@ type RemotingServiceHandler interface {
 	// RemoteTell is used to send a message to a remote actor
 	// The actor on the other line can reply to the sender by using the Sender in the message
 	RemoteTell(context.Context, *connect.Request[internalpb.RemoteTellRequest]) (*connect.Response[internalpb.RemoteTellResponse], error)
+	// RemoteStreamTell opens a long-lived stream between two nodes to send messages to remote actors.
+	// The messages are processed in the order they are sent and the receiver grants the sender
+	// credits to control the number of in-flight batches.
+	RemoteStreamTell(context.Context, *connect.BidiStream[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse]) error
 	// Lookup for an actor on a remote host.
 	RemoteLookup(context.Context, *connect.Request[internalpb.RemoteLookupRequest]) (*connect.Response[internalpb.RemoteLookupResponse], error)
+	// RemoteWatch watches an actor on a remote node for a Terminated message when the actor stops
+	RemoteWatch(context.Context, *connect.Request[internalpb.RemoteWatchRequest]) (*connect.Response[internalpb.RemoteWatchResponse], error)
+	// RemoteUnWatch stops watching an actor on a remote node
+	RemoteUnWatch(context.Context, *connect.Request[internalpb.RemoteUnWatchRequest]) (*connect.Response[internalpb.RemoteUnWatchResponse], error)
 	// RemoteReSpawn restarts an actor on a remote machine
 	RemoteReSpawn(context.Context, *connect.Request[internalpb.RemoteReSpawnRequest]) (*connect.Response[internalpb.RemoteReSpawnResponse], error)
 	// RemoteStop stops an actor on a remote machine
@@ -251,6 +346,10 @@ type RemotingServiceHandler interface {
 	RemoteTellGrain(context.Context, *connect.Request[internalpb.RemoteTellGrainRequest]) (*connect.Response[internalpb.RemoteTellGrainResponse], error)
 	// RemoteActivateGrain is used to activate a Grain on a remote node
 	RemoteActivateGrain(context.Context, *connect.Request[internalpb.RemoteActivateGrainRequest]) (*connect.Response[internalpb.RemoteActivateGrainResponse], error)
+	// RemoteChunk uploads a chunk of a message too large to be sent in a single request
+	RemoteChunk(context.Context, *connect.Request[internalpb.RemoteChunkRequest]) (*connect.Response[internalpb.RemoteChunkResponse], error)
+	// RemoteFetchChunk downloads a chunk of a reply too large to be sent in a single response
+	RemoteFetchChunk(context.Context, *connect.Request[internalpb.RemoteFetchChunkRequest]) (*connect.Response[internalpb.RemoteFetchChunkResponse], error)
 }
 
 // NewRemotingServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -272,12 +371,30 @@ func NewRemotingServiceHandler(svc RemotingServiceHandler, opts ...connect.Handl
 		connect.WithSchema(remotingServiceMethods.ByName("RemoteTell")),
 		connect.WithHandlerOptions(opts...),
 	)
+	remotingServiceRemoteStreamTellHandler := connect.NewBidiStreamHandler(
+		RemotingServiceRemoteStreamTellProcedure,
+		svc.RemoteStreamTell,
+		connect.WithSchema(remotingServiceMethods.ByName("RemoteStreamTell")),
+		connect.WithHandlerOptions(opts...),
+	)
 	remotingServiceRemoteLookupHandler := connect.NewUnaryHandler(
 		RemotingServiceRemoteLookupProcedure,
 		svc.RemoteLookup,
 		connect.WithSchema(remotingServiceMethods.ByName("RemoteLookup")),
 		connect.WithHandlerOptions(opts...),
 	)
+	remotingServiceRemoteWatchHandler := connect.NewUnaryHandler(
+		RemotingServiceRemoteWatchProcedure,
+		svc.RemoteWatch,
+		connect.WithSchema(remotingServiceMethods.ByName("RemoteWatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	remotingServiceRemoteUnWatchHandler := connect.NewUnaryHandler(
+		RemotingServiceRemoteUnWatchProcedure,
+		svc.RemoteUnWatch,
+		connect.WithSchema(remotingServiceMethods.ByName("RemoteUnWatch")),
+		connect.WithHandlerOptions(opts...),
+	)
 	remotingServiceRemoteReSpawnHandler := connect.NewUnaryHandler(
 		RemotingServiceRemoteReSpawnProcedure,
 		svc.RemoteReSpawn,
@@ -320,14 +437,32 @@ func NewRemotingServiceHandler(svc RemotingServiceHandler, opts ...connect.Handl
 		connect.WithSchema(remotingServiceMethods.ByName("RemoteActivateGrain")),
 		connect.WithHandlerOptions(opts...),
 	)
+	remotingServiceRemoteChunkHandler := connect.NewUnaryHandler(
+		RemotingServiceRemoteChunkProcedure,
+		svc.RemoteChunk,
+		connect.WithSchema(remotingServiceMethods.ByName("RemoteChunk")),
+		connect.WithHandlerOptions(opts...),
+	)
+	remotingServiceRemoteFetchChunkHandler := connect.NewUnaryHandler(
+		RemotingServiceRemoteFetchChunkProcedure,
+		svc.RemoteFetchChunk,
+		connect.WithSchema(remotingServiceMethods.ByName("RemoteFetchChunk")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/internalpb.RemotingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RemotingServiceRemoteAskProcedure:
 			remotingServiceRemoteAskHandler.ServeHTTP(w, r)
 		case RemotingServiceRemoteTellProcedure:
 			remotingServiceRemoteTellHandler.ServeHTTP(w, r)
+		case RemotingServiceRemoteStreamTellProcedure:
+			remotingServiceRemoteStreamTellHandler.ServeHTTP(w, r)
 		case RemotingServiceRemoteLookupProcedure:
 			remotingServiceRemoteLookupHandler.ServeHTTP(w, r)
+		case RemotingServiceRemoteWatchProcedure:
+			remotingServiceRemoteWatchHandler.ServeHTTP(w, r)
+		case RemotingServiceRemoteUnWatchProcedure:
+			remotingServiceRemoteUnWatchHandler.ServeHTTP(w, r)
 		case RemotingServiceRemoteReSpawnProcedure:
 			remotingServiceRemoteReSpawnHandler.ServeHTTP(w, r)
 		case RemotingServiceRemoteStopProcedure:
@@ -342,6 +477,10 @@ func NewRemotingServiceHandler(svc RemotingServiceHandler, opts ...connect.Handl
 			remotingServiceRemoteTellGrainHandler.ServeHTTP(w, r)
 		case RemotingServiceRemoteActivateGrainProcedure:
 			remotingServiceRemoteActivateGrainHandler.ServeHTTP(w, r)
+		case RemotingServiceRemoteChunkProcedure:
+			remotingServiceRemoteChunkHandler.ServeHTTP(w, r)
+		case RemotingServiceRemoteFetchChunkProcedure:
+			remotingServiceRemoteFetchChunkHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -359,10 +498,22 @@ func (UnimplementedRemotingServiceHandler) RemoteTell(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteTell is not implemented"))
 }
 
+func (UnimplementedRemotingServiceHandler) RemoteStreamTell(context.Context, *connect.BidiStream[internalpb.RemoteStreamTellRequest, internalpb.RemoteStreamTellResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteStreamTell is not implemented"))
+}
+
 func (UnimplementedRemotingServiceHandler) RemoteLookup(context.Context, *connect.Request[internalpb.RemoteLookupRequest]) (*connect.Response[internalpb.RemoteLookupResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteLookup is not implemented"))
 }
 
+func (UnimplementedRemotingServiceHandler) RemoteWatch(context.Context, *connect.Request[internalpb.RemoteWatchRequest]) (*connect.Response[internalpb.RemoteWatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteWatch is not implemented"))
+}
+
+func (UnimplementedRemotingServiceHandler) RemoteUnWatch(context.Context, *connect.Request[internalpb.RemoteUnWatchRequest]) (*connect.Response[internalpb.RemoteUnWatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteUnWatch is not implemented"))
+}
+
 func (UnimplementedRemotingServiceHandler) RemoteReSpawn(context.Context, *connect.Request[internalpb.RemoteReSpawnRequest]) (*connect.Response[internalpb.RemoteReSpawnResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteReSpawn is not implemented"))
 }
@@ -390,3 +541,11 @@ func (UnimplementedRemotingServiceHandler) RemoteTellGrain(context.Context, *con
 func (UnimplementedRemotingServiceHandler) RemoteActivateGrain(context.Context, *connect.Request[internalpb.RemoteActivateGrainRequest]) (*connect.Response[internalpb.RemoteActivateGrainResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteActivateGrain is not implemented"))
 }
+
+func (UnimplementedRemotingServiceHandler) RemoteChunk(context.Context, *connect.Request[internalpb.RemoteChunkRequest]) (*connect.Response[internalpb.RemoteChunkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteChunk is not implemented"))
+}
+
+func (UnimplementedRemotingServiceHandler) RemoteFetchChunk(context.Context, *connect.Request[internalpb.RemoteFetchChunkRequest]) (*connect.Response[internalpb.RemoteFetchChunkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("internalpb.RemotingService.RemoteFetchChunk is not implemented"))
+}
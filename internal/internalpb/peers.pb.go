@@ -33,7 +33,9 @@ type PeerState struct {
 	// actorName -> Actor
 	Actors map[string]*Actor `protobuf:"bytes,4,rep,name=actors,proto3" json:"actors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// grainId -> Grain
-	Grains        map[string]*Grain `protobuf:"bytes,5,rep,name=grains,proto3" json:"grains,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Grains map[string]*Grain `protobuf:"bytes,5,rep,name=grains,proto3" json:"grains,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Specifies the peer roles
+	Roles         []string `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -103,6 +105,13 @@ func (x *PeerState) GetGrains() map[string]*Grain {
 	return nil
 }
 
+func (x *PeerState) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 type Rebalance struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Specifies the peer state
@@ -198,14 +207,15 @@ var File_internal_peers_proto protoreflect.FileDescriptor
 const file_internal_peers_proto_rawDesc = "" +
 	"\n" +
 	"\x14internal/peers.proto\x12\n" +
-	"internalpb\x1a\x14internal/actor.proto\x1a\x14internal/grain.proto\"\xf5\x02\n" +
+	"internalpb\x1a\x14internal/actor.proto\x1a\x14internal/grain.proto\"\x8b\x03\n" +
 	"\tPeerState\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12#\n" +
 	"\rremoting_port\x18\x02 \x01(\x05R\fremotingPort\x12\x1d\n" +
 	"\n" +
 	"peers_port\x18\x03 \x01(\x05R\tpeersPort\x129\n" +
 	"\x06actors\x18\x04 \x03(\v2!.internalpb.PeerState.ActorsEntryR\x06actors\x129\n" +
-	"\x06grains\x18\x05 \x03(\v2!.internalpb.PeerState.GrainsEntryR\x06grains\x1aL\n" +
+	"\x06grains\x18\x05 \x03(\v2!.internalpb.PeerState.GrainsEntryR\x06grains\x12\x14\n" +
+	"\x05roles\x18\x06 \x03(\tR\x05roles\x1aL\n" +
 	"\vActorsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
 	"\x05value\x18\x02 \x01(\v2\x11.internalpb.ActorR\x05value:\x028\x01\x1aL\n" +
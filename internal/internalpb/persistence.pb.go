@@ -0,0 +1,637 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: internal/persistence.proto
+
+package internalpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JournalEntry represents a single event persisted by a persistent actor
+// in the file-based journal store.
+type JournalEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the persistence ID of the actor that emitted the event
+	PersistenceId string `protobuf:"bytes,1,opt,name=persistence_id,json=persistenceId,proto3" json:"persistence_id,omitempty"`
+	// Specifies the sequence number of the event
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// Specifies the event payload
+	Payload *anypb.Any `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Specifies the time the event was persisted
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Specifies whether the entry only records the highest sequence number
+	// of the deleted events. A tombstone does not carry any event.
+	Tombstone     bool `protobuf:"varint,5,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	mi := &file_internal_persistence_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntry) ProtoMessage() {}
+
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_persistence_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_internal_persistence_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JournalEntry) GetPersistenceId() string {
+	if x != nil {
+		return x.PersistenceId
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *JournalEntry) GetPayload() *anypb.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetTombstone() bool {
+	if x != nil {
+		return x.Tombstone
+	}
+	return false
+}
+
+// SnapshotEntry represents the snapshot of a persistent actor state
+// in the file-based snapshot store.
+type SnapshotEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the persistence ID of the actor
+	PersistenceId string `protobuf:"bytes,1,opt,name=persistence_id,json=persistenceId,proto3" json:"persistence_id,omitempty"`
+	// Specifies the sequence number of the last event included in the snapshot
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// Specifies the actor state
+	State *anypb.Any `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// Specifies the time the snapshot was taken
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotEntry) Reset() {
+	*x = SnapshotEntry{}
+	mi := &file_internal_persistence_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotEntry) ProtoMessage() {}
+
+func (x *SnapshotEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_persistence_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotEntry.ProtoReflect.Descriptor instead.
+func (*SnapshotEntry) Descriptor() ([]byte, []int) {
+	return file_internal_persistence_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SnapshotEntry) GetPersistenceId() string {
+	if x != nil {
+		return x.PersistenceId
+	}
+	return ""
+}
+
+func (x *SnapshotEntry) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *SnapshotEntry) GetState() *anypb.Any {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *SnapshotEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// DurableStateEntry represents the durable state of an actor or a grain
+// in the file-based durable state store.
+type DurableStateEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the persistence ID of the actor or grain
+	PersistenceId string `protobuf:"bytes,1,opt,name=persistence_id,json=persistenceId,proto3" json:"persistence_id,omitempty"`
+	// Specifies the revision of the state
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Specifies the state
+	State *anypb.Any `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// Specifies the time the state was written
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DurableStateEntry) Reset() {
+	*x = DurableStateEntry{}
+	mi := &file_internal_persistence_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DurableStateEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurableStateEntry) ProtoMessage() {}
+
+func (x *DurableStateEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_persistence_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurableStateEntry.ProtoReflect.Descriptor instead.
+func (*DurableStateEntry) Descriptor() ([]byte, []int) {
+	return file_internal_persistence_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DurableStateEntry) GetPersistenceId() string {
+	if x != nil {
+		return x.PersistenceId
+	}
+	return ""
+}
+
+func (x *DurableStateEntry) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DurableStateEntry) GetState() *anypb.Any {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *DurableStateEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// DeliveryEntry represents a message sent with at-least-once delivery
+// that has not been confirmed yet.
+type DeliveryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the producer ID
+	ProducerId string `protobuf:"bytes,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Specifies the sequence number of the delivery
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// Specifies the recipient of the message
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Specifies the message serialized by one of the registered serializers
+	Message *Payload `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Specifies the time the message was first sent
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryEntry) Reset() {
+	*x = DeliveryEntry{}
+	mi := &file_internal_persistence_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryEntry) ProtoMessage() {}
+
+func (x *DeliveryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_persistence_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryEntry.ProtoReflect.Descriptor instead.
+func (*DeliveryEntry) Descriptor() ([]byte, []int) {
+	return file_internal_persistence_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeliveryEntry) GetProducerId() string {
+	if x != nil {
+		return x.ProducerId
+	}
+	return ""
+}
+
+func (x *DeliveryEntry) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *DeliveryEntry) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *DeliveryEntry) GetMessage() *Payload {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *DeliveryEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// DeliveryStoreEntry represents the unconfirmed deliveries of a producer
+// in the file-based delivery store.
+type DeliveryStoreEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the producer ID
+	ProducerId string `protobuf:"bytes,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Specifies the highest sequence number used by the producer
+	SequenceNumber uint64 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// Specifies the unconfirmed deliveries
+	Deliveries    []*DeliveryEntry `protobuf:"bytes,3,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryStoreEntry) Reset() {
+	*x = DeliveryStoreEntry{}
+	mi := &file_internal_persistence_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryStoreEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryStoreEntry) ProtoMessage() {}
+
+func (x *DeliveryStoreEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_persistence_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryStoreEntry.ProtoReflect.Descriptor instead.
+func (*DeliveryStoreEntry) Descriptor() ([]byte, []int) {
+	return file_internal_persistence_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeliveryStoreEntry) GetProducerId() string {
+	if x != nil {
+		return x.ProducerId
+	}
+	return ""
+}
+
+func (x *DeliveryStoreEntry) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *DeliveryStoreEntry) GetDeliveries() []*DeliveryEntry {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// RememberedGrainEntry represents a long-lived grain recorded
+// in the file-based remembered grain store.
+type RememberedGrainEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the grain identity
+	GrainId string `protobuf:"bytes,1,opt,name=grain_id,json=grainId,proto3" json:"grain_id,omitempty"`
+	// Specifies the grain kind
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Specifies the grain name
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Specifies the grain activation timeout
+	ActivationTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=activation_timeout,json=activationTimeout,proto3" json:"activation_timeout,omitempty"`
+	// Specifies the grain activation retries
+	ActivationRetries int32 `protobuf:"varint,5,opt,name=activation_retries,json=activationRetries,proto3" json:"activation_retries,omitempty"`
+	// Specifies the time the grain was recorded
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Specifies the cluster node role required to host the grain
+	Role string `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+	// Specifies the grain dependencies
+	Dependencies  []*Dependency `protobuf:"bytes,8,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RememberedGrainEntry) Reset() {
+	*x = RememberedGrainEntry{}
+	mi := &file_internal_persistence_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RememberedGrainEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RememberedGrainEntry) ProtoMessage() {}
+
+func (x *RememberedGrainEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_persistence_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RememberedGrainEntry.ProtoReflect.Descriptor instead.
+func (*RememberedGrainEntry) Descriptor() ([]byte, []int) {
+	return file_internal_persistence_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RememberedGrainEntry) GetGrainId() string {
+	if x != nil {
+		return x.GrainId
+	}
+	return ""
+}
+
+func (x *RememberedGrainEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RememberedGrainEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RememberedGrainEntry) GetActivationTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ActivationTimeout
+	}
+	return nil
+}
+
+func (x *RememberedGrainEntry) GetActivationRetries() int32 {
+	if x != nil {
+		return x.ActivationRetries
+	}
+	return 0
+}
+
+func (x *RememberedGrainEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *RememberedGrainEntry) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RememberedGrainEntry) GetDependencies() []*Dependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+var File_internal_persistence_proto protoreflect.FileDescriptor
+
+const file_internal_persistence_proto_rawDesc = "" +
+	"\n" +
+	"\x1ainternal/persistence.proto\x12\n" +
+	"internalpb\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19internal/dependency.proto\x1a\x17internal/remoting.proto\"\xe6\x01\n" +
+	"\fJournalEntry\x12%\n" +
+	"\x0epersistence_id\x18\x01 \x01(\tR\rpersistenceId\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x04R\x0esequenceNumber\x12.\n" +
+	"\apayload\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\apayload\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1c\n" +
+	"\ttombstone\x18\x05 \x01(\bR\ttombstone\"\xc5\x01\n" +
+	"\rSnapshotEntry\x12%\n" +
+	"\x0epersistence_id\x18\x01 \x01(\tR\rpersistenceId\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x04R\x0esequenceNumber\x12*\n" +
+	"\x05state\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\x05state\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xbc\x01\n" +
+	"\x11DurableStateEntry\x12%\n" +
+	"\x0epersistence_id\x18\x01 \x01(\tR\rpersistenceId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x04R\brevision\x12*\n" +
+	"\x05state\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\x05state\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xe0\x01\n" +
+	"\rDeliveryEntry\x12\x1f\n" +
+	"\vproducer_id\x18\x01 \x01(\tR\n" +
+	"producerId\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x04R\x0esequenceNumber\x12\x1c\n" +
+	"\trecipient\x18\x03 \x01(\tR\trecipient\x12-\n" +
+	"\amessage\x18\x04 \x01(\v2\x13.internalpb.PayloadR\amessage\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x99\x01\n" +
+	"\x12DeliveryStoreEntry\x12\x1f\n" +
+	"\vproducer_id\x18\x01 \x01(\tR\n" +
+	"producerId\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x04R\x0esequenceNumber\x129\n" +
+	"\n" +
+	"deliveries\x18\x03 \x03(\v2\x19.internalpb.DeliveryEntryR\n" +
+	"deliveries\"\xdc\x02\n" +
+	"\x14RememberedGrainEntry\x12\x19\n" +
+	"\bgrain_id\x18\x01 \x01(\tR\agrainId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12H\n" +
+	"\x12activation_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x11activationTimeout\x12-\n" +
+	"\x12activation_retries\x18\x05 \x01(\x05R\x11activationRetries\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
+	"\x04role\x18\a \x01(\tR\x04role\x12:\n" +
+	"\fdependencies\x18\b \x03(\v2\x16.internalpb.DependencyR\fdependenciesB\xa9\x01\n" +
+	"\x0ecom.internalpbB\x10PersistenceProtoH\x02P\x01Z;github.com/tochemey/goakt/v3/internal/internalpb;internalpb\xa2\x02\x03IXX\xaa\x02\n" +
+	"Internalpb\xca\x02\n" +
+	"Internalpb\xe2\x02\x16Internalpb\\GPBMetadata\xea\x02\n" +
+	"Internalpbb\x06proto3"
+
+var (
+	file_internal_persistence_proto_rawDescOnce sync.Once
+	file_internal_persistence_proto_rawDescData []byte
+)
+
+func file_internal_persistence_proto_rawDescGZIP() []byte {
+	file_internal_persistence_proto_rawDescOnce.Do(func() {
+		file_internal_persistence_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_persistence_proto_rawDesc), len(file_internal_persistence_proto_rawDesc)))
+	})
+	return file_internal_persistence_proto_rawDescData
+}
+
+var file_internal_persistence_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_internal_persistence_proto_goTypes = []any{
+	(*JournalEntry)(nil),          // 0: internalpb.JournalEntry
+	(*SnapshotEntry)(nil),         // 1: internalpb.SnapshotEntry
+	(*DurableStateEntry)(nil),     // 2: internalpb.DurableStateEntry
+	(*DeliveryEntry)(nil),         // 3: internalpb.DeliveryEntry
+	(*DeliveryStoreEntry)(nil),    // 4: internalpb.DeliveryStoreEntry
+	(*RememberedGrainEntry)(nil),  // 5: internalpb.RememberedGrainEntry
+	(*anypb.Any)(nil),             // 6: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*Payload)(nil),               // 8: internalpb.Payload
+	(*durationpb.Duration)(nil),   // 9: google.protobuf.Duration
+	(*Dependency)(nil),            // 10: internalpb.Dependency
+}
+var file_internal_persistence_proto_depIdxs = []int32{
+	6,  // 0: internalpb.JournalEntry.payload:type_name -> google.protobuf.Any
+	7,  // 1: internalpb.JournalEntry.timestamp:type_name -> google.protobuf.Timestamp
+	6,  // 2: internalpb.SnapshotEntry.state:type_name -> google.protobuf.Any
+	7,  // 3: internalpb.SnapshotEntry.timestamp:type_name -> google.protobuf.Timestamp
+	6,  // 4: internalpb.DurableStateEntry.state:type_name -> google.protobuf.Any
+	7,  // 5: internalpb.DurableStateEntry.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 6: internalpb.DeliveryEntry.message:type_name -> internalpb.Payload
+	7,  // 7: internalpb.DeliveryEntry.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 8: internalpb.DeliveryStoreEntry.deliveries:type_name -> internalpb.DeliveryEntry
+	9,  // 9: internalpb.RememberedGrainEntry.activation_timeout:type_name -> google.protobuf.Duration
+	7,  // 10: internalpb.RememberedGrainEntry.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 11: internalpb.RememberedGrainEntry.dependencies:type_name -> internalpb.Dependency
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_internal_persistence_proto_init() }
+func file_internal_persistence_proto_init() {
+	if File_internal_persistence_proto != nil {
+		return
+	}
+	file_internal_dependency_proto_init()
+	file_internal_remoting_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_persistence_proto_rawDesc), len(file_internal_persistence_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_persistence_proto_goTypes,
+		DependencyIndexes: file_internal_persistence_proto_depIdxs,
+		MessageInfos:      file_internal_persistence_proto_msgTypes,
+	}.Build()
+	File_internal_persistence_proto = out.File
+	file_internal_persistence_proto_goTypes = nil
+	file_internal_persistence_proto_depIdxs = nil
+}
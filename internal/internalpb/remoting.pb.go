@@ -82,9 +82,8 @@ func (x *RemoteAskRequest) GetTimeout() *durationpb.Duration {
 
 type RemoteAskResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Specifies the message to send to the actor
-	// Any proto message is allowed to be sent
-	Messages      []*anypb.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// Specifies the serialized replies
+	Payloads      []*Payload `protobuf:"bytes,2,rep,name=payloads,proto3" json:"payloads,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -119,9 +118,9 @@ func (*RemoteAskResponse) Descriptor() ([]byte, []int) {
 	return file_internal_remoting_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *RemoteAskResponse) GetMessages() []*anypb.Any {
+func (x *RemoteAskResponse) GetPayloads() []*Payload {
 	if x != nil {
-		return x.Messages
+		return x.Payloads
 	}
 	return nil
 }
@@ -208,6 +207,98 @@ func (*RemoteTellResponse) Descriptor() ([]byte, []int) {
 	return file_internal_remoting_proto_rawDescGZIP(), []int{3}
 }
 
+// RemoteStreamTellRequest is a batch of messages sent over the tell stream
+type RemoteStreamTellRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the remote messages to send in order
+	RemoteMessages []*RemoteMessage `protobuf:"bytes,1,rep,name=remote_messages,json=remoteMessages,proto3" json:"remote_messages,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoteStreamTellRequest) Reset() {
+	*x = RemoteStreamTellRequest{}
+	mi := &file_internal_remoting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteStreamTellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStreamTellRequest) ProtoMessage() {}
+
+func (x *RemoteStreamTellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStreamTellRequest.ProtoReflect.Descriptor instead.
+func (*RemoteStreamTellRequest) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoteStreamTellRequest) GetRemoteMessages() []*RemoteMessage {
+	if x != nil {
+		return x.RemoteMessages
+	}
+	return nil
+}
+
+// RemoteStreamTellResponse grants the sender credits over the tell stream
+type RemoteStreamTellResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the number of additional batches the sender is allowed to send
+	Credits       int32 `protobuf:"varint,1,opt,name=credits,proto3" json:"credits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteStreamTellResponse) Reset() {
+	*x = RemoteStreamTellResponse{}
+	mi := &file_internal_remoting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteStreamTellResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteStreamTellResponse) ProtoMessage() {}
+
+func (x *RemoteStreamTellResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteStreamTellResponse.ProtoReflect.Descriptor instead.
+func (*RemoteStreamTellResponse) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemoteStreamTellResponse) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
 // RemoteLookupRequest checks whether a given actor exists on a remote host
 type RemoteLookupRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -223,7 +314,7 @@ type RemoteLookupRequest struct {
 
 func (x *RemoteLookupRequest) Reset() {
 	*x = RemoteLookupRequest{}
-	mi := &file_internal_remoting_proto_msgTypes[4]
+	mi := &file_internal_remoting_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +326,7 @@ func (x *RemoteLookupRequest) String() string {
 func (*RemoteLookupRequest) ProtoMessage() {}
 
 func (x *RemoteLookupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[4]
+	mi := &file_internal_remoting_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +339,7 @@ func (x *RemoteLookupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteLookupRequest.ProtoReflect.Descriptor instead.
 func (*RemoteLookupRequest) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{4}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RemoteLookupRequest) GetHost() string {
@@ -282,7 +373,7 @@ type RemoteLookupResponse struct {
 
 func (x *RemoteLookupResponse) Reset() {
 	*x = RemoteLookupResponse{}
-	mi := &file_internal_remoting_proto_msgTypes[5]
+	mi := &file_internal_remoting_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -294,7 +385,7 @@ func (x *RemoteLookupResponse) String() string {
 func (*RemoteLookupResponse) ProtoMessage() {}
 
 func (x *RemoteLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[5]
+	mi := &file_internal_remoting_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,7 +398,7 @@ func (x *RemoteLookupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteLookupResponse.ProtoReflect.Descriptor instead.
 func (*RemoteLookupResponse) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{5}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RemoteLookupResponse) GetAddress() *goaktpb.Address {
@@ -317,6 +408,154 @@ func (x *RemoteLookupResponse) GetAddress() *goaktpb.Address {
 	return nil
 }
 
+// Payload defines a message serialized by one of the registered serializers
+type Payload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the identifier of the serializer used to serialize the message
+	SerializerId int32 `protobuf:"varint,1,opt,name=serializer_id,json=serializerId,proto3" json:"serializer_id,omitempty"`
+	// Specifies the type of the serialized message
+	// It is used to deserialize the message on the receiving node
+	Manifest string `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// Specifies the serialized message
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Specifies the description of a message sent in chunks.
+	// When set the data is empty and the message is reassembled from its chunks
+	Chunked       *ChunkedPayload `protobuf:"bytes,4,opt,name=chunked,proto3" json:"chunked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	mi := &file_internal_remoting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Payload) GetSerializerId() int32 {
+	if x != nil {
+		return x.SerializerId
+	}
+	return 0
+}
+
+func (x *Payload) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *Payload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Payload) GetChunked() *ChunkedPayload {
+	if x != nil {
+		return x.Chunked
+	}
+	return nil
+}
+
+// ChunkedPayload describes a serialized message sent in chunks
+type ChunkedPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the identifier of the chunks transfer
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	// Specifies the number of chunks
+	Chunks int32 `protobuf:"varint,2,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// Specifies the size in bytes of the serialized message
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Specifies the SHA-256 checksum of the serialized message
+	Checksum      []byte `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChunkedPayload) Reset() {
+	*x = ChunkedPayload{}
+	mi := &file_internal_remoting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkedPayload) ProtoMessage() {}
+
+func (x *ChunkedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkedPayload.ProtoReflect.Descriptor instead.
+func (*ChunkedPayload) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChunkedPayload) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *ChunkedPayload) GetChunks() int32 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
+}
+
+func (x *ChunkedPayload) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ChunkedPayload) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
 // RemoteMessage will be used by Actors to communicate remotely
 type RemoteMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -324,16 +563,20 @@ type RemoteMessage struct {
 	Sender *goaktpb.Address `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// Specifies the actor address
 	Receiver *goaktpb.Address `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	// Specifies the message to send to the actor
-	// Any proto message is allowed to be sent
-	Message       *anypb.Any `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Specifies the propagated context headers
+	// e.g. the W3C trace context of the sender
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Specifies the message metadata
+	Metadata map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Specifies the serialized message to send to the actor
+	Payload       *Payload `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RemoteMessage) Reset() {
 	*x = RemoteMessage{}
-	mi := &file_internal_remoting_proto_msgTypes[6]
+	mi := &file_internal_remoting_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -345,7 +588,7 @@ func (x *RemoteMessage) String() string {
 func (*RemoteMessage) ProtoMessage() {}
 
 func (x *RemoteMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[6]
+	mi := &file_internal_remoting_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +601,7 @@ func (x *RemoteMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteMessage.ProtoReflect.Descriptor instead.
 func (*RemoteMessage) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{6}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RemoteMessage) GetSender() *goaktpb.Address {
@@ -375,11 +618,207 @@ func (x *RemoteMessage) GetReceiver() *goaktpb.Address {
 	return nil
 }
 
-func (x *RemoteMessage) GetMessage() *anypb.Any {
+func (x *RemoteMessage) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RemoteMessage) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RemoteMessage) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// RemoteWatchRequest watches an actor on a remote node
+type RemoteWatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the address of the watching actor
+	Watcher *goaktpb.Address `protobuf:"bytes,1,opt,name=watcher,proto3" json:"watcher,omitempty"`
+	// Specifies the address of the watched actor
+	Watchee       *goaktpb.Address `protobuf:"bytes,2,opt,name=watchee,proto3" json:"watchee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteWatchRequest) Reset() {
+	*x = RemoteWatchRequest{}
+	mi := &file_internal_remoting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteWatchRequest) ProtoMessage() {}
+
+func (x *RemoteWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteWatchRequest.ProtoReflect.Descriptor instead.
+func (*RemoteWatchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoteWatchRequest) GetWatcher() *goaktpb.Address {
+	if x != nil {
+		return x.Watcher
+	}
+	return nil
+}
+
+func (x *RemoteWatchRequest) GetWatchee() *goaktpb.Address {
+	if x != nil {
+		return x.Watchee
+	}
+	return nil
+}
+
+type RemoteWatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteWatchResponse) Reset() {
+	*x = RemoteWatchResponse{}
+	mi := &file_internal_remoting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteWatchResponse) ProtoMessage() {}
+
+func (x *RemoteWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteWatchResponse.ProtoReflect.Descriptor instead.
+func (*RemoteWatchResponse) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{12}
+}
+
+// RemoteUnWatchRequest stops watching an actor on a remote node
+type RemoteUnWatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the address of the watching actor
+	Watcher *goaktpb.Address `protobuf:"bytes,1,opt,name=watcher,proto3" json:"watcher,omitempty"`
+	// Specifies the address of the watched actor
+	Watchee       *goaktpb.Address `protobuf:"bytes,2,opt,name=watchee,proto3" json:"watchee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteUnWatchRequest) Reset() {
+	*x = RemoteUnWatchRequest{}
+	mi := &file_internal_remoting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteUnWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteUnWatchRequest) ProtoMessage() {}
+
+func (x *RemoteUnWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteUnWatchRequest.ProtoReflect.Descriptor instead.
+func (*RemoteUnWatchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoteUnWatchRequest) GetWatcher() *goaktpb.Address {
+	if x != nil {
+		return x.Watcher
+	}
+	return nil
+}
+
+func (x *RemoteUnWatchRequest) GetWatchee() *goaktpb.Address {
+	if x != nil {
+		return x.Watchee
+	}
+	return nil
+}
+
+type RemoteUnWatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteUnWatchResponse) Reset() {
+	*x = RemoteUnWatchResponse{}
+	mi := &file_internal_remoting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteUnWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteUnWatchResponse) ProtoMessage() {}
+
+func (x *RemoteUnWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[14]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteUnWatchResponse.ProtoReflect.Descriptor instead.
+func (*RemoteUnWatchResponse) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{14}
 }
 
 type RemoteReSpawnRequest struct {
@@ -396,7 +835,7 @@ type RemoteReSpawnRequest struct {
 
 func (x *RemoteReSpawnRequest) Reset() {
 	*x = RemoteReSpawnRequest{}
-	mi := &file_internal_remoting_proto_msgTypes[7]
+	mi := &file_internal_remoting_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +847,7 @@ func (x *RemoteReSpawnRequest) String() string {
 func (*RemoteReSpawnRequest) ProtoMessage() {}
 
 func (x *RemoteReSpawnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[7]
+	mi := &file_internal_remoting_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +860,7 @@ func (x *RemoteReSpawnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteReSpawnRequest.ProtoReflect.Descriptor instead.
 func (*RemoteReSpawnRequest) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{7}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RemoteReSpawnRequest) GetHost() string {
@@ -453,7 +892,7 @@ type RemoteReSpawnResponse struct {
 
 func (x *RemoteReSpawnResponse) Reset() {
 	*x = RemoteReSpawnResponse{}
-	mi := &file_internal_remoting_proto_msgTypes[8]
+	mi := &file_internal_remoting_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -465,7 +904,7 @@ func (x *RemoteReSpawnResponse) String() string {
 func (*RemoteReSpawnResponse) ProtoMessage() {}
 
 func (x *RemoteReSpawnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[8]
+	mi := &file_internal_remoting_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +917,7 @@ func (x *RemoteReSpawnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteReSpawnResponse.ProtoReflect.Descriptor instead.
 func (*RemoteReSpawnResponse) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{8}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{16}
 }
 
 type RemoteStopRequest struct {
@@ -495,7 +934,7 @@ type RemoteStopRequest struct {
 
 func (x *RemoteStopRequest) Reset() {
 	*x = RemoteStopRequest{}
-	mi := &file_internal_remoting_proto_msgTypes[9]
+	mi := &file_internal_remoting_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -507,7 +946,7 @@ func (x *RemoteStopRequest) String() string {
 func (*RemoteStopRequest) ProtoMessage() {}
 
 func (x *RemoteStopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[9]
+	mi := &file_internal_remoting_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +959,7 @@ func (x *RemoteStopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteStopRequest.ProtoReflect.Descriptor instead.
 func (*RemoteStopRequest) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{9}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RemoteStopRequest) GetHost() string {
@@ -552,7 +991,7 @@ type RemoteStopResponse struct {
 
 func (x *RemoteStopResponse) Reset() {
 	*x = RemoteStopResponse{}
-	mi := &file_internal_remoting_proto_msgTypes[10]
+	mi := &file_internal_remoting_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -564,7 +1003,7 @@ func (x *RemoteStopResponse) String() string {
 func (*RemoteStopResponse) ProtoMessage() {}
 
 func (x *RemoteStopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[10]
+	mi := &file_internal_remoting_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +1016,7 @@ func (x *RemoteStopResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteStopResponse.ProtoReflect.Descriptor instead.
 func (*RemoteStopResponse) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{10}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{18}
 }
 
 type RemoteSpawnRequest struct {
@@ -598,15 +1037,31 @@ type RemoteSpawnRequest struct {
 	PassivationStrategy *PassivationStrategy `protobuf:"bytes,7,opt,name=passivation_strategy,json=passivationStrategy,proto3" json:"passivation_strategy,omitempty"`
 	// Specifies the dependencies
 	Dependencies []*Dependency `protobuf:"bytes,8,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
-	// States whether the actor will require a stash buffer
-	EnableStash   bool `protobuf:"varint,9,opt,name=enable_stash,json=enableStash,proto3" json:"enable_stash,omitempty"`
+	//  States whether the actor will require a stash buffer
+	EnableStash bool `protobuf:"varint,9,opt,name=enable_stash,json=enableStash,proto3" json:"enable_stash,omitempty"`
+	// Specifies the number of persisted events after which a snapshot is taken
+	// This is only relevant for persistent actors
+	SnapshotInterval uint64 `protobuf:"varint,10,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// Specifies the name of the registered factory used to create the actor.
+	// When set the actor type is ignored
+	Factory string `protobuf:"bytes,11,opt,name=factory,proto3" json:"factory,omitempty"`
+	// Specifies the arguments passed to the factory
+	Args *anypb.Any `protobuf:"bytes,12,opt,name=args,proto3" json:"args,omitempty"`
+	// Specifies the state handed off by the previous incarnation of the actor
+	// when it is moved from a draining node
+	HandOffState *anypb.Any `protobuf:"bytes,13,opt,name=hand_off_state,json=handOffState,proto3" json:"hand_off_state,omitempty"`
+	// Specifies the role a cluster node must have to host the actor
+	Role string `protobuf:"bytes,14,opt,name=role,proto3" json:"role,omitempty"`
+	// States whether the actor is moved from a draining node, which keeps
+	// the actor registered in the cluster until it is created
+	HandOff       bool `protobuf:"varint,15,opt,name=hand_off,json=handOff,proto3" json:"hand_off,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RemoteSpawnRequest) Reset() {
 	*x = RemoteSpawnRequest{}
-	mi := &file_internal_remoting_proto_msgTypes[11]
+	mi := &file_internal_remoting_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +1073,7 @@ func (x *RemoteSpawnRequest) String() string {
 func (*RemoteSpawnRequest) ProtoMessage() {}
 
 func (x *RemoteSpawnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[11]
+	mi := &file_internal_remoting_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +1086,7 @@ func (x *RemoteSpawnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteSpawnRequest.ProtoReflect.Descriptor instead.
 func (*RemoteSpawnRequest) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{11}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RemoteSpawnRequest) GetHost() string {
@@ -697,6 +1152,48 @@ func (x *RemoteSpawnRequest) GetEnableStash() bool {
 	return false
 }
 
+func (x *RemoteSpawnRequest) GetSnapshotInterval() uint64 {
+	if x != nil {
+		return x.SnapshotInterval
+	}
+	return 0
+}
+
+func (x *RemoteSpawnRequest) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
+}
+
+func (x *RemoteSpawnRequest) GetArgs() *anypb.Any {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *RemoteSpawnRequest) GetHandOffState() *anypb.Any {
+	if x != nil {
+		return x.HandOffState
+	}
+	return nil
+}
+
+func (x *RemoteSpawnRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RemoteSpawnRequest) GetHandOff() bool {
+	if x != nil {
+		return x.HandOff
+	}
+	return false
+}
+
 type RemoteSpawnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -705,7 +1202,7 @@ type RemoteSpawnResponse struct {
 
 func (x *RemoteSpawnResponse) Reset() {
 	*x = RemoteSpawnResponse{}
-	mi := &file_internal_remoting_proto_msgTypes[12]
+	mi := &file_internal_remoting_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -717,7 +1214,7 @@ func (x *RemoteSpawnResponse) String() string {
 func (*RemoteSpawnResponse) ProtoMessage() {}
 
 func (x *RemoteSpawnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[12]
+	mi := &file_internal_remoting_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,7 +1227,7 @@ func (x *RemoteSpawnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteSpawnResponse.ProtoReflect.Descriptor instead.
 func (*RemoteSpawnResponse) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{12}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{20}
 }
 
 type RemoteReinstateRequest struct {
@@ -747,7 +1244,7 @@ type RemoteReinstateRequest struct {
 
 func (x *RemoteReinstateRequest) Reset() {
 	*x = RemoteReinstateRequest{}
-	mi := &file_internal_remoting_proto_msgTypes[13]
+	mi := &file_internal_remoting_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -759,7 +1256,7 @@ func (x *RemoteReinstateRequest) String() string {
 func (*RemoteReinstateRequest) ProtoMessage() {}
 
 func (x *RemoteReinstateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[13]
+	mi := &file_internal_remoting_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,7 +1269,7 @@ func (x *RemoteReinstateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteReinstateRequest.ProtoReflect.Descriptor instead.
 func (*RemoteReinstateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{13}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RemoteReinstateRequest) GetHost() string {
@@ -804,7 +1301,7 @@ type RemoteReinstateResponse struct {
 
 func (x *RemoteReinstateResponse) Reset() {
 	*x = RemoteReinstateResponse{}
-	mi := &file_internal_remoting_proto_msgTypes[14]
+	mi := &file_internal_remoting_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +1313,7 @@ func (x *RemoteReinstateResponse) String() string {
 func (*RemoteReinstateResponse) ProtoMessage() {}
 
 func (x *RemoteReinstateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[14]
+	mi := &file_internal_remoting_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,21 +1326,23 @@ func (x *RemoteReinstateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteReinstateResponse.ProtoReflect.Descriptor instead.
 func (*RemoteReinstateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{14}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{22}
 }
 
 type RemoteAskGrainRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Grain          *Grain                 `protobuf:"bytes,1,opt,name=grain,proto3" json:"grain,omitempty"`
-	Message        *anypb.Any             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	RequestTimeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	Headers        map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata       map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Payload        *Payload               `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *RemoteAskGrainRequest) Reset() {
 	*x = RemoteAskGrainRequest{}
-	mi := &file_internal_remoting_proto_msgTypes[15]
+	mi := &file_internal_remoting_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -855,7 +1354,7 @@ func (x *RemoteAskGrainRequest) String() string {
 func (*RemoteAskGrainRequest) ProtoMessage() {}
 
 func (x *RemoteAskGrainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[15]
+	mi := &file_internal_remoting_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -868,7 +1367,7 @@ func (x *RemoteAskGrainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteAskGrainRequest.ProtoReflect.Descriptor instead.
 func (*RemoteAskGrainRequest) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{15}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RemoteAskGrainRequest) GetGrain() *Grain {
@@ -878,30 +1377,44 @@ func (x *RemoteAskGrainRequest) GetGrain() *Grain {
 	return nil
 }
 
-func (x *RemoteAskGrainRequest) GetMessage() *anypb.Any {
+func (x *RemoteAskGrainRequest) GetRequestTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+func (x *RemoteAskGrainRequest) GetHeaders() map[string]string {
 	if x != nil {
-		return x.Message
+		return x.Headers
 	}
 	return nil
 }
 
-func (x *RemoteAskGrainRequest) GetRequestTimeout() *durationpb.Duration {
+func (x *RemoteAskGrainRequest) GetMetadata() map[string]string {
 	if x != nil {
-		return x.RequestTimeout
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RemoteAskGrainRequest) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
 	}
 	return nil
 }
 
 type RemoteAskGrainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       *anypb.Any             `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Payload       *Payload               `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RemoteAskGrainResponse) Reset() {
 	*x = RemoteAskGrainResponse{}
-	mi := &file_internal_remoting_proto_msgTypes[16]
+	mi := &file_internal_remoting_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -913,7 +1426,7 @@ func (x *RemoteAskGrainResponse) String() string {
 func (*RemoteAskGrainResponse) ProtoMessage() {}
 
 func (x *RemoteAskGrainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[16]
+	mi := &file_internal_remoting_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,12 +1439,12 @@ func (x *RemoteAskGrainResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteAskGrainResponse.ProtoReflect.Descriptor instead.
 func (*RemoteAskGrainResponse) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{16}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RemoteAskGrainResponse) GetMessage() *anypb.Any {
+func (x *RemoteAskGrainResponse) GetPayload() *Payload {
 	if x != nil {
-		return x.Message
+		return x.Payload
 	}
 	return nil
 }
@@ -939,14 +1452,16 @@ func (x *RemoteAskGrainResponse) GetMessage() *anypb.Any {
 type RemoteTellGrainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Grain         *Grain                 `protobuf:"bytes,1,opt,name=grain,proto3" json:"grain,omitempty"`
-	Message       *anypb.Any             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Payload       *Payload               `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RemoteTellGrainRequest) Reset() {
 	*x = RemoteTellGrainRequest{}
-	mi := &file_internal_remoting_proto_msgTypes[17]
+	mi := &file_internal_remoting_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +1473,7 @@ func (x *RemoteTellGrainRequest) String() string {
 func (*RemoteTellGrainRequest) ProtoMessage() {}
 
 func (x *RemoteTellGrainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[17]
+	mi := &file_internal_remoting_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1486,7 @@ func (x *RemoteTellGrainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteTellGrainRequest.ProtoReflect.Descriptor instead.
 func (*RemoteTellGrainRequest) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{17}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RemoteTellGrainRequest) GetGrain() *Grain {
@@ -981,9 +1496,23 @@ func (x *RemoteTellGrainRequest) GetGrain() *Grain {
 	return nil
 }
 
-func (x *RemoteTellGrainRequest) GetMessage() *anypb.Any {
+func (x *RemoteTellGrainRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RemoteTellGrainRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RemoteTellGrainRequest) GetPayload() *Payload {
 	if x != nil {
-		return x.Message
+		return x.Payload
 	}
 	return nil
 }
@@ -996,7 +1525,7 @@ type RemoteTellGrainResponse struct {
 
 func (x *RemoteTellGrainResponse) Reset() {
 	*x = RemoteTellGrainResponse{}
-	mi := &file_internal_remoting_proto_msgTypes[18]
+	mi := &file_internal_remoting_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1008,7 +1537,7 @@ func (x *RemoteTellGrainResponse) String() string {
 func (*RemoteTellGrainResponse) ProtoMessage() {}
 
 func (x *RemoteTellGrainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[18]
+	mi := &file_internal_remoting_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,7 +1550,7 @@ func (x *RemoteTellGrainResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteTellGrainResponse.ProtoReflect.Descriptor instead.
 func (*RemoteTellGrainResponse) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{18}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{26}
 }
 
 type RemoteActivateGrainRequest struct {
@@ -1033,7 +1562,7 @@ type RemoteActivateGrainRequest struct {
 
 func (x *RemoteActivateGrainRequest) Reset() {
 	*x = RemoteActivateGrainRequest{}
-	mi := &file_internal_remoting_proto_msgTypes[19]
+	mi := &file_internal_remoting_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1045,7 +1574,7 @@ func (x *RemoteActivateGrainRequest) String() string {
 func (*RemoteActivateGrainRequest) ProtoMessage() {}
 
 func (x *RemoteActivateGrainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[19]
+	mi := &file_internal_remoting_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1587,7 @@ func (x *RemoteActivateGrainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteActivateGrainRequest.ProtoReflect.Descriptor instead.
 func (*RemoteActivateGrainRequest) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{19}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RemoteActivateGrainRequest) GetGrain() *Grain {
@@ -1076,7 +1605,7 @@ type RemoteActivateGrainResponse struct {
 
 func (x *RemoteActivateGrainResponse) Reset() {
 	*x = RemoteActivateGrainResponse{}
-	mi := &file_internal_remoting_proto_msgTypes[20]
+	mi := &file_internal_remoting_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1617,7 @@ func (x *RemoteActivateGrainResponse) String() string {
 func (*RemoteActivateGrainResponse) ProtoMessage() {}
 
 func (x *RemoteActivateGrainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_remoting_proto_msgTypes[20]
+	mi := &file_internal_remoting_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1630,205 @@ func (x *RemoteActivateGrainResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoteActivateGrainResponse.ProtoReflect.Descriptor instead.
 func (*RemoteActivateGrainResponse) Descriptor() ([]byte, []int) {
-	return file_internal_remoting_proto_rawDescGZIP(), []int{20}
+	return file_internal_remoting_proto_rawDescGZIP(), []int{28}
+}
+
+type RemoteChunkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the identifier of the chunks transfer
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	// Specifies the position of the chunk in the message
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Specifies the chunk data
+	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteChunkRequest) Reset() {
+	*x = RemoteChunkRequest{}
+	mi := &file_internal_remoting_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteChunkRequest) ProtoMessage() {}
+
+func (x *RemoteChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteChunkRequest.ProtoReflect.Descriptor instead.
+func (*RemoteChunkRequest) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RemoteChunkRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *RemoteChunkRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RemoteChunkRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RemoteChunkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteChunkResponse) Reset() {
+	*x = RemoteChunkResponse{}
+	mi := &file_internal_remoting_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteChunkResponse) ProtoMessage() {}
+
+func (x *RemoteChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteChunkResponse.ProtoReflect.Descriptor instead.
+func (*RemoteChunkResponse) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{30}
+}
+
+type RemoteFetchChunkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the identifier of the chunks transfer
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	// Specifies the position of the chunk in the message
+	Index         int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteFetchChunkRequest) Reset() {
+	*x = RemoteFetchChunkRequest{}
+	mi := &file_internal_remoting_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteFetchChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteFetchChunkRequest) ProtoMessage() {}
+
+func (x *RemoteFetchChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteFetchChunkRequest.ProtoReflect.Descriptor instead.
+func (*RemoteFetchChunkRequest) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoteFetchChunkRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *RemoteFetchChunkRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type RemoteFetchChunkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the chunk data
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteFetchChunkResponse) Reset() {
+	*x = RemoteFetchChunkResponse{}
+	mi := &file_internal_remoting_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteFetchChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteFetchChunkResponse) ProtoMessage() {}
+
+func (x *RemoteFetchChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_remoting_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteFetchChunkResponse.ProtoReflect.Descriptor instead.
+func (*RemoteFetchChunkResponse) Descriptor() ([]byte, []int) {
+	return file_internal_remoting_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RemoteFetchChunkResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
 var File_internal_remoting_proto protoreflect.FileDescriptor
@@ -1112,22 +1839,53 @@ const file_internal_remoting_proto_rawDesc = "" +
 	"internalpb\x1a\x11goakt/goakt.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19internal/dependency.proto\x1a\x14internal/grain.proto\x1a\x1ainternal/passivation.proto\"\x8b\x01\n" +
 	"\x10RemoteAskRequest\x12B\n" +
 	"\x0fremote_messages\x18\x01 \x03(\v2\x19.internalpb.RemoteMessageR\x0eremoteMessages\x123\n" +
-	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"E\n" +
-	"\x11RemoteAskResponse\x120\n" +
-	"\bmessages\x18\x01 \x03(\v2\x14.google.protobuf.AnyR\bmessages\"W\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"T\n" +
+	"\x11RemoteAskResponse\x12/\n" +
+	"\bpayloads\x18\x02 \x03(\v2\x13.internalpb.PayloadR\bpayloadsJ\x04\b\x01\x10\x02R\bmessages\"W\n" +
 	"\x11RemoteTellRequest\x12B\n" +
 	"\x0fremote_messages\x18\x01 \x03(\v2\x19.internalpb.RemoteMessageR\x0eremoteMessages\"\x14\n" +
-	"\x12RemoteTellResponse\"Q\n" +
+	"\x12RemoteTellResponse\"]\n" +
+	"\x17RemoteStreamTellRequest\x12B\n" +
+	"\x0fremote_messages\x18\x01 \x03(\v2\x19.internalpb.RemoteMessageR\x0eremoteMessages\"4\n" +
+	"\x18RemoteStreamTellResponse\x12\x18\n" +
+	"\acredits\x18\x01 \x01(\x05R\acredits\"Q\n" +
 	"\x13RemoteLookupRequest\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\"B\n" +
 	"\x14RemoteLookupResponse\x12*\n" +
-	"\aaddress\x18\x01 \x01(\v2\x10.goaktpb.AddressR\aaddress\"\x97\x01\n" +
+	"\aaddress\x18\x01 \x01(\v2\x10.goaktpb.AddressR\aaddress\"\x94\x01\n" +
+	"\aPayload\x12#\n" +
+	"\rserializer_id\x18\x01 \x01(\x05R\fserializerId\x12\x1a\n" +
+	"\bmanifest\x18\x02 \x01(\tR\bmanifest\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x124\n" +
+	"\achunked\x18\x04 \x01(\v2\x1a.internalpb.ChunkedPayloadR\achunked\"y\n" +
+	"\x0eChunkedPayload\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x16\n" +
+	"\x06chunks\x18\x02 \x01(\x05R\x06chunks\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\x04 \x01(\fR\bchecksum\"\xa5\x03\n" +
 	"\rRemoteMessage\x12(\n" +
 	"\x06sender\x18\x01 \x01(\v2\x10.goaktpb.AddressR\x06sender\x12,\n" +
-	"\breceiver\x18\x02 \x01(\v2\x10.goaktpb.AddressR\breceiver\x12.\n" +
-	"\amessage\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\amessage\"R\n" +
+	"\breceiver\x18\x02 \x01(\v2\x10.goaktpb.AddressR\breceiver\x12@\n" +
+	"\aheaders\x18\x04 \x03(\v2&.internalpb.RemoteMessage.HeadersEntryR\aheaders\x12C\n" +
+	"\bmetadata\x18\x05 \x03(\v2'.internalpb.RemoteMessage.MetadataEntryR\bmetadata\x12-\n" +
+	"\apayload\x18\x06 \x01(\v2\x13.internalpb.PayloadR\apayload\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x03\x10\x04R\amessage\"l\n" +
+	"\x12RemoteWatchRequest\x12*\n" +
+	"\awatcher\x18\x01 \x01(\v2\x10.goaktpb.AddressR\awatcher\x12*\n" +
+	"\awatchee\x18\x02 \x01(\v2\x10.goaktpb.AddressR\awatchee\"\x15\n" +
+	"\x13RemoteWatchResponse\"n\n" +
+	"\x14RemoteUnWatchRequest\x12*\n" +
+	"\awatcher\x18\x01 \x01(\v2\x10.goaktpb.AddressR\awatcher\x12*\n" +
+	"\awatchee\x18\x02 \x01(\v2\x10.goaktpb.AddressR\awatchee\"\x17\n" +
+	"\x15RemoteUnWatchResponse\"R\n" +
 	"\x14RemoteReSpawnRequest\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x12\n" +
@@ -1137,7 +1895,7 @@ const file_internal_remoting_proto_rawDesc = "" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\"\x14\n" +
-	"\x12RemoteStopResponse\"\xf2\x02\n" +
+	"\x12RemoteStopResponse\"\xce\x04\n" +
 	"\x12RemoteSpawnRequest\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1d\n" +
@@ -1149,31 +1907,70 @@ const file_internal_remoting_proto_rawDesc = "" +
 	"\vrelocatable\x18\x06 \x01(\bR\vrelocatable\x12R\n" +
 	"\x14passivation_strategy\x18\a \x01(\v2\x1f.internalpb.PassivationStrategyR\x13passivationStrategy\x12:\n" +
 	"\fdependencies\x18\b \x03(\v2\x16.internalpb.DependencyR\fdependencies\x12!\n" +
-	"\fenable_stash\x18\t \x01(\bR\venableStash\"\x15\n" +
+	"\fenable_stash\x18\t \x01(\bR\venableStash\x12+\n" +
+	"\x11snapshot_interval\x18\n" +
+	" \x01(\x04R\x10snapshotInterval\x12\x18\n" +
+	"\afactory\x18\v \x01(\tR\afactory\x12(\n" +
+	"\x04args\x18\f \x01(\v2\x14.google.protobuf.AnyR\x04args\x12:\n" +
+	"\x0ehand_off_state\x18\r \x01(\v2\x14.google.protobuf.AnyR\fhandOffState\x12\x12\n" +
+	"\x04role\x18\x0e \x01(\tR\x04role\x12\x19\n" +
+	"\bhand_off\x18\x0f \x01(\bR\ahandOff\"\x15\n" +
 	"\x13RemoteSpawnResponse\"T\n" +
 	"\x16RemoteReinstateRequest\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\"\x19\n" +
-	"\x17RemoteReinstateResponse\"\xb4\x01\n" +
+	"\x17RemoteReinstateResponse\"\xd2\x03\n" +
 	"\x15RemoteAskGrainRequest\x12'\n" +
-	"\x05grain\x18\x01 \x01(\v2\x11.internalpb.GrainR\x05grain\x12.\n" +
-	"\amessage\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\amessage\x12B\n" +
-	"\x0frequest_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0erequestTimeout\"H\n" +
-	"\x16RemoteAskGrainResponse\x12.\n" +
-	"\amessage\x18\x01 \x01(\v2\x14.google.protobuf.AnyR\amessage\"q\n" +
+	"\x05grain\x18\x01 \x01(\v2\x11.internalpb.GrainR\x05grain\x12B\n" +
+	"\x0frequest_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0erequestTimeout\x12H\n" +
+	"\aheaders\x18\x04 \x03(\v2..internalpb.RemoteAskGrainRequest.HeadersEntryR\aheaders\x12K\n" +
+	"\bmetadata\x18\x05 \x03(\v2/.internalpb.RemoteAskGrainRequest.MetadataEntryR\bmetadata\x12-\n" +
+	"\apayload\x18\x06 \x01(\v2\x13.internalpb.PayloadR\apayload\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x02\x10\x03R\amessage\"V\n" +
+	"\x16RemoteAskGrainResponse\x12-\n" +
+	"\apayload\x18\x02 \x01(\v2\x13.internalpb.PayloadR\apayloadJ\x04\b\x01\x10\x02R\amessage\"\x91\x03\n" +
 	"\x16RemoteTellGrainRequest\x12'\n" +
-	"\x05grain\x18\x01 \x01(\v2\x11.internalpb.GrainR\x05grain\x12.\n" +
-	"\amessage\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\amessage\"\x19\n" +
+	"\x05grain\x18\x01 \x01(\v2\x11.internalpb.GrainR\x05grain\x12I\n" +
+	"\aheaders\x18\x03 \x03(\v2/.internalpb.RemoteTellGrainRequest.HeadersEntryR\aheaders\x12L\n" +
+	"\bmetadata\x18\x04 \x03(\v20.internalpb.RemoteTellGrainRequest.MetadataEntryR\bmetadata\x12-\n" +
+	"\apayload\x18\x05 \x01(\v2\x13.internalpb.PayloadR\apayload\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x02\x10\x03R\amessage\"\x19\n" +
 	"\x17RemoteTellGrainResponse\"E\n" +
 	"\x1aRemoteActivateGrainRequest\x12'\n" +
 	"\x05grain\x18\x01 \x01(\v2\x11.internalpb.GrainR\x05grain\"\x1d\n" +
-	"\x1bRemoteActivateGrainResponse2\xe7\x06\n" +
+	"\x1bRemoteActivateGrainResponse\"_\n" +
+	"\x12RemoteChunkRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\x15\n" +
+	"\x13RemoteChunkResponse\"P\n" +
+	"\x17RemoteFetchChunkRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\".\n" +
+	"\x18RemoteFetchChunkResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data2\x9f\n" +
+	"\n" +
 	"\x0fRemotingService\x12H\n" +
 	"\tRemoteAsk\x12\x1c.internalpb.RemoteAskRequest\x1a\x1d.internalpb.RemoteAskResponse\x12K\n" +
 	"\n" +
-	"RemoteTell\x12\x1d.internalpb.RemoteTellRequest\x1a\x1e.internalpb.RemoteTellResponse\x12Q\n" +
-	"\fRemoteLookup\x12\x1f.internalpb.RemoteLookupRequest\x1a .internalpb.RemoteLookupResponse\x12T\n" +
+	"RemoteTell\x12\x1d.internalpb.RemoteTellRequest\x1a\x1e.internalpb.RemoteTellResponse\x12a\n" +
+	"\x10RemoteStreamTell\x12#.internalpb.RemoteStreamTellRequest\x1a$.internalpb.RemoteStreamTellResponse(\x010\x01\x12Q\n" +
+	"\fRemoteLookup\x12\x1f.internalpb.RemoteLookupRequest\x1a .internalpb.RemoteLookupResponse\x12N\n" +
+	"\vRemoteWatch\x12\x1e.internalpb.RemoteWatchRequest\x1a\x1f.internalpb.RemoteWatchResponse\x12T\n" +
+	"\rRemoteUnWatch\x12 .internalpb.RemoteUnWatchRequest\x1a!.internalpb.RemoteUnWatchResponse\x12T\n" +
 	"\rRemoteReSpawn\x12 .internalpb.RemoteReSpawnRequest\x1a!.internalpb.RemoteReSpawnResponse\x12K\n" +
 	"\n" +
 	"RemoteStop\x12\x1d.internalpb.RemoteStopRequest\x1a\x1e.internalpb.RemoteStopResponse\x12N\n" +
@@ -1181,7 +1978,9 @@ const file_internal_remoting_proto_rawDesc = "" +
 	"\x0fRemoteReinstate\x12\".internalpb.RemoteReinstateRequest\x1a#.internalpb.RemoteReinstateResponse\x12W\n" +
 	"\x0eRemoteAskGrain\x12!.internalpb.RemoteAskGrainRequest\x1a\".internalpb.RemoteAskGrainResponse\x12Z\n" +
 	"\x0fRemoteTellGrain\x12\".internalpb.RemoteTellGrainRequest\x1a#.internalpb.RemoteTellGrainResponse\x12f\n" +
-	"\x13RemoteActivateGrain\x12&.internalpb.RemoteActivateGrainRequest\x1a'.internalpb.RemoteActivateGrainResponseB\xa6\x01\n" +
+	"\x13RemoteActivateGrain\x12&.internalpb.RemoteActivateGrainRequest\x1a'.internalpb.RemoteActivateGrainResponse\x12N\n" +
+	"\vRemoteChunk\x12\x1e.internalpb.RemoteChunkRequest\x1a\x1f.internalpb.RemoteChunkResponse\x12]\n" +
+	"\x10RemoteFetchChunk\x12#.internalpb.RemoteFetchChunkRequest\x1a$.internalpb.RemoteFetchChunkResponseB\xa6\x01\n" +
 	"\x0ecom.internalpbB\rRemotingProtoH\x02P\x01Z;github.com/tochemey/goakt/v3/internal/internalpb;internalpb\xa2\x02\x03IXX\xaa\x02\n" +
 	"Internalpb\xca\x02\n" +
 	"Internalpb\xe2\x02\x16Internalpb\\GPBMetadata\xea\x02\n" +
@@ -1199,79 +1998,121 @@ func file_internal_remoting_proto_rawDescGZIP() []byte {
 	return file_internal_remoting_proto_rawDescData
 }
 
-var file_internal_remoting_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_internal_remoting_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_internal_remoting_proto_goTypes = []any{
 	(*RemoteAskRequest)(nil),            // 0: internalpb.RemoteAskRequest
 	(*RemoteAskResponse)(nil),           // 1: internalpb.RemoteAskResponse
 	(*RemoteTellRequest)(nil),           // 2: internalpb.RemoteTellRequest
 	(*RemoteTellResponse)(nil),          // 3: internalpb.RemoteTellResponse
-	(*RemoteLookupRequest)(nil),         // 4: internalpb.RemoteLookupRequest
-	(*RemoteLookupResponse)(nil),        // 5: internalpb.RemoteLookupResponse
-	(*RemoteMessage)(nil),               // 6: internalpb.RemoteMessage
-	(*RemoteReSpawnRequest)(nil),        // 7: internalpb.RemoteReSpawnRequest
-	(*RemoteReSpawnResponse)(nil),       // 8: internalpb.RemoteReSpawnResponse
-	(*RemoteStopRequest)(nil),           // 9: internalpb.RemoteStopRequest
-	(*RemoteStopResponse)(nil),          // 10: internalpb.RemoteStopResponse
-	(*RemoteSpawnRequest)(nil),          // 11: internalpb.RemoteSpawnRequest
-	(*RemoteSpawnResponse)(nil),         // 12: internalpb.RemoteSpawnResponse
-	(*RemoteReinstateRequest)(nil),      // 13: internalpb.RemoteReinstateRequest
-	(*RemoteReinstateResponse)(nil),     // 14: internalpb.RemoteReinstateResponse
-	(*RemoteAskGrainRequest)(nil),       // 15: internalpb.RemoteAskGrainRequest
-	(*RemoteAskGrainResponse)(nil),      // 16: internalpb.RemoteAskGrainResponse
-	(*RemoteTellGrainRequest)(nil),      // 17: internalpb.RemoteTellGrainRequest
-	(*RemoteTellGrainResponse)(nil),     // 18: internalpb.RemoteTellGrainResponse
-	(*RemoteActivateGrainRequest)(nil),  // 19: internalpb.RemoteActivateGrainRequest
-	(*RemoteActivateGrainResponse)(nil), // 20: internalpb.RemoteActivateGrainResponse
-	(*durationpb.Duration)(nil),         // 21: google.protobuf.Duration
-	(*anypb.Any)(nil),                   // 22: google.protobuf.Any
-	(*goaktpb.Address)(nil),             // 23: goaktpb.Address
-	(*PassivationStrategy)(nil),         // 24: internalpb.PassivationStrategy
-	(*Dependency)(nil),                  // 25: internalpb.Dependency
-	(*Grain)(nil),                       // 26: internalpb.Grain
+	(*RemoteStreamTellRequest)(nil),     // 4: internalpb.RemoteStreamTellRequest
+	(*RemoteStreamTellResponse)(nil),    // 5: internalpb.RemoteStreamTellResponse
+	(*RemoteLookupRequest)(nil),         // 6: internalpb.RemoteLookupRequest
+	(*RemoteLookupResponse)(nil),        // 7: internalpb.RemoteLookupResponse
+	(*Payload)(nil),                     // 8: internalpb.Payload
+	(*ChunkedPayload)(nil),              // 9: internalpb.ChunkedPayload
+	(*RemoteMessage)(nil),               // 10: internalpb.RemoteMessage
+	(*RemoteWatchRequest)(nil),          // 11: internalpb.RemoteWatchRequest
+	(*RemoteWatchResponse)(nil),         // 12: internalpb.RemoteWatchResponse
+	(*RemoteUnWatchRequest)(nil),        // 13: internalpb.RemoteUnWatchRequest
+	(*RemoteUnWatchResponse)(nil),       // 14: internalpb.RemoteUnWatchResponse
+	(*RemoteReSpawnRequest)(nil),        // 15: internalpb.RemoteReSpawnRequest
+	(*RemoteReSpawnResponse)(nil),       // 16: internalpb.RemoteReSpawnResponse
+	(*RemoteStopRequest)(nil),           // 17: internalpb.RemoteStopRequest
+	(*RemoteStopResponse)(nil),          // 18: internalpb.RemoteStopResponse
+	(*RemoteSpawnRequest)(nil),          // 19: internalpb.RemoteSpawnRequest
+	(*RemoteSpawnResponse)(nil),         // 20: internalpb.RemoteSpawnResponse
+	(*RemoteReinstateRequest)(nil),      // 21: internalpb.RemoteReinstateRequest
+	(*RemoteReinstateResponse)(nil),     // 22: internalpb.RemoteReinstateResponse
+	(*RemoteAskGrainRequest)(nil),       // 23: internalpb.RemoteAskGrainRequest
+	(*RemoteAskGrainResponse)(nil),      // 24: internalpb.RemoteAskGrainResponse
+	(*RemoteTellGrainRequest)(nil),      // 25: internalpb.RemoteTellGrainRequest
+	(*RemoteTellGrainResponse)(nil),     // 26: internalpb.RemoteTellGrainResponse
+	(*RemoteActivateGrainRequest)(nil),  // 27: internalpb.RemoteActivateGrainRequest
+	(*RemoteActivateGrainResponse)(nil), // 28: internalpb.RemoteActivateGrainResponse
+	(*RemoteChunkRequest)(nil),          // 29: internalpb.RemoteChunkRequest
+	(*RemoteChunkResponse)(nil),         // 30: internalpb.RemoteChunkResponse
+	(*RemoteFetchChunkRequest)(nil),     // 31: internalpb.RemoteFetchChunkRequest
+	(*RemoteFetchChunkResponse)(nil),    // 32: internalpb.RemoteFetchChunkResponse
+	nil,                                 // 33: internalpb.RemoteMessage.HeadersEntry
+	nil,                                 // 34: internalpb.RemoteMessage.MetadataEntry
+	nil,                                 // 35: internalpb.RemoteAskGrainRequest.HeadersEntry
+	nil,                                 // 36: internalpb.RemoteAskGrainRequest.MetadataEntry
+	nil,                                 // 37: internalpb.RemoteTellGrainRequest.HeadersEntry
+	nil,                                 // 38: internalpb.RemoteTellGrainRequest.MetadataEntry
+	(*durationpb.Duration)(nil),         // 39: google.protobuf.Duration
+	(*goaktpb.Address)(nil),             // 40: goaktpb.Address
+	(*PassivationStrategy)(nil),         // 41: internalpb.PassivationStrategy
+	(*Dependency)(nil),                  // 42: internalpb.Dependency
+	(*anypb.Any)(nil),                   // 43: google.protobuf.Any
+	(*Grain)(nil),                       // 44: internalpb.Grain
 }
 var file_internal_remoting_proto_depIdxs = []int32{
-	6,  // 0: internalpb.RemoteAskRequest.remote_messages:type_name -> internalpb.RemoteMessage
-	21, // 1: internalpb.RemoteAskRequest.timeout:type_name -> google.protobuf.Duration
-	22, // 2: internalpb.RemoteAskResponse.messages:type_name -> google.protobuf.Any
-	6,  // 3: internalpb.RemoteTellRequest.remote_messages:type_name -> internalpb.RemoteMessage
-	23, // 4: internalpb.RemoteLookupResponse.address:type_name -> goaktpb.Address
-	23, // 5: internalpb.RemoteMessage.sender:type_name -> goaktpb.Address
-	23, // 6: internalpb.RemoteMessage.receiver:type_name -> goaktpb.Address
-	22, // 7: internalpb.RemoteMessage.message:type_name -> google.protobuf.Any
-	24, // 8: internalpb.RemoteSpawnRequest.passivation_strategy:type_name -> internalpb.PassivationStrategy
-	25, // 9: internalpb.RemoteSpawnRequest.dependencies:type_name -> internalpb.Dependency
-	26, // 10: internalpb.RemoteAskGrainRequest.grain:type_name -> internalpb.Grain
-	22, // 11: internalpb.RemoteAskGrainRequest.message:type_name -> google.protobuf.Any
-	21, // 12: internalpb.RemoteAskGrainRequest.request_timeout:type_name -> google.protobuf.Duration
-	22, // 13: internalpb.RemoteAskGrainResponse.message:type_name -> google.protobuf.Any
-	26, // 14: internalpb.RemoteTellGrainRequest.grain:type_name -> internalpb.Grain
-	22, // 15: internalpb.RemoteTellGrainRequest.message:type_name -> google.protobuf.Any
-	26, // 16: internalpb.RemoteActivateGrainRequest.grain:type_name -> internalpb.Grain
-	0,  // 17: internalpb.RemotingService.RemoteAsk:input_type -> internalpb.RemoteAskRequest
-	2,  // 18: internalpb.RemotingService.RemoteTell:input_type -> internalpb.RemoteTellRequest
-	4,  // 19: internalpb.RemotingService.RemoteLookup:input_type -> internalpb.RemoteLookupRequest
-	7,  // 20: internalpb.RemotingService.RemoteReSpawn:input_type -> internalpb.RemoteReSpawnRequest
-	9,  // 21: internalpb.RemotingService.RemoteStop:input_type -> internalpb.RemoteStopRequest
-	11, // 22: internalpb.RemotingService.RemoteSpawn:input_type -> internalpb.RemoteSpawnRequest
-	13, // 23: internalpb.RemotingService.RemoteReinstate:input_type -> internalpb.RemoteReinstateRequest
-	15, // 24: internalpb.RemotingService.RemoteAskGrain:input_type -> internalpb.RemoteAskGrainRequest
-	17, // 25: internalpb.RemotingService.RemoteTellGrain:input_type -> internalpb.RemoteTellGrainRequest
-	19, // 26: internalpb.RemotingService.RemoteActivateGrain:input_type -> internalpb.RemoteActivateGrainRequest
-	1,  // 27: internalpb.RemotingService.RemoteAsk:output_type -> internalpb.RemoteAskResponse
-	3,  // 28: internalpb.RemotingService.RemoteTell:output_type -> internalpb.RemoteTellResponse
-	5,  // 29: internalpb.RemotingService.RemoteLookup:output_type -> internalpb.RemoteLookupResponse
-	8,  // 30: internalpb.RemotingService.RemoteReSpawn:output_type -> internalpb.RemoteReSpawnResponse
-	10, // 31: internalpb.RemotingService.RemoteStop:output_type -> internalpb.RemoteStopResponse
-	12, // 32: internalpb.RemotingService.RemoteSpawn:output_type -> internalpb.RemoteSpawnResponse
-	14, // 33: internalpb.RemotingService.RemoteReinstate:output_type -> internalpb.RemoteReinstateResponse
-	16, // 34: internalpb.RemotingService.RemoteAskGrain:output_type -> internalpb.RemoteAskGrainResponse
-	18, // 35: internalpb.RemotingService.RemoteTellGrain:output_type -> internalpb.RemoteTellGrainResponse
-	20, // 36: internalpb.RemotingService.RemoteActivateGrain:output_type -> internalpb.RemoteActivateGrainResponse
-	27, // [27:37] is the sub-list for method output_type
-	17, // [17:27] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	10, // 0: internalpb.RemoteAskRequest.remote_messages:type_name -> internalpb.RemoteMessage
+	39, // 1: internalpb.RemoteAskRequest.timeout:type_name -> google.protobuf.Duration
+	8,  // 2: internalpb.RemoteAskResponse.payloads:type_name -> internalpb.Payload
+	10, // 3: internalpb.RemoteTellRequest.remote_messages:type_name -> internalpb.RemoteMessage
+	10, // 4: internalpb.RemoteStreamTellRequest.remote_messages:type_name -> internalpb.RemoteMessage
+	40, // 5: internalpb.RemoteLookupResponse.address:type_name -> goaktpb.Address
+	9,  // 6: internalpb.Payload.chunked:type_name -> internalpb.ChunkedPayload
+	40, // 7: internalpb.RemoteMessage.sender:type_name -> goaktpb.Address
+	40, // 8: internalpb.RemoteMessage.receiver:type_name -> goaktpb.Address
+	33, // 9: internalpb.RemoteMessage.headers:type_name -> internalpb.RemoteMessage.HeadersEntry
+	34, // 10: internalpb.RemoteMessage.metadata:type_name -> internalpb.RemoteMessage.MetadataEntry
+	8,  // 11: internalpb.RemoteMessage.payload:type_name -> internalpb.Payload
+	40, // 12: internalpb.RemoteWatchRequest.watcher:type_name -> goaktpb.Address
+	40, // 13: internalpb.RemoteWatchRequest.watchee:type_name -> goaktpb.Address
+	40, // 14: internalpb.RemoteUnWatchRequest.watcher:type_name -> goaktpb.Address
+	40, // 15: internalpb.RemoteUnWatchRequest.watchee:type_name -> goaktpb.Address
+	41, // 16: internalpb.RemoteSpawnRequest.passivation_strategy:type_name -> internalpb.PassivationStrategy
+	42, // 17: internalpb.RemoteSpawnRequest.dependencies:type_name -> internalpb.Dependency
+	43, // 18: internalpb.RemoteSpawnRequest.args:type_name -> google.protobuf.Any
+	43, // 19: internalpb.RemoteSpawnRequest.hand_off_state:type_name -> google.protobuf.Any
+	44, // 20: internalpb.RemoteAskGrainRequest.grain:type_name -> internalpb.Grain
+	39, // 21: internalpb.RemoteAskGrainRequest.request_timeout:type_name -> google.protobuf.Duration
+	35, // 22: internalpb.RemoteAskGrainRequest.headers:type_name -> internalpb.RemoteAskGrainRequest.HeadersEntry
+	36, // 23: internalpb.RemoteAskGrainRequest.metadata:type_name -> internalpb.RemoteAskGrainRequest.MetadataEntry
+	8,  // 24: internalpb.RemoteAskGrainRequest.payload:type_name -> internalpb.Payload
+	8,  // 25: internalpb.RemoteAskGrainResponse.payload:type_name -> internalpb.Payload
+	44, // 26: internalpb.RemoteTellGrainRequest.grain:type_name -> internalpb.Grain
+	37, // 27: internalpb.RemoteTellGrainRequest.headers:type_name -> internalpb.RemoteTellGrainRequest.HeadersEntry
+	38, // 28: internalpb.RemoteTellGrainRequest.metadata:type_name -> internalpb.RemoteTellGrainRequest.MetadataEntry
+	8,  // 29: internalpb.RemoteTellGrainRequest.payload:type_name -> internalpb.Payload
+	44, // 30: internalpb.RemoteActivateGrainRequest.grain:type_name -> internalpb.Grain
+	0,  // 31: internalpb.RemotingService.RemoteAsk:input_type -> internalpb.RemoteAskRequest
+	2,  // 32: internalpb.RemotingService.RemoteTell:input_type -> internalpb.RemoteTellRequest
+	4,  // 33: internalpb.RemotingService.RemoteStreamTell:input_type -> internalpb.RemoteStreamTellRequest
+	6,  // 34: internalpb.RemotingService.RemoteLookup:input_type -> internalpb.RemoteLookupRequest
+	11, // 35: internalpb.RemotingService.RemoteWatch:input_type -> internalpb.RemoteWatchRequest
+	13, // 36: internalpb.RemotingService.RemoteUnWatch:input_type -> internalpb.RemoteUnWatchRequest
+	15, // 37: internalpb.RemotingService.RemoteReSpawn:input_type -> internalpb.RemoteReSpawnRequest
+	17, // 38: internalpb.RemotingService.RemoteStop:input_type -> internalpb.RemoteStopRequest
+	19, // 39: internalpb.RemotingService.RemoteSpawn:input_type -> internalpb.RemoteSpawnRequest
+	21, // 40: internalpb.RemotingService.RemoteReinstate:input_type -> internalpb.RemoteReinstateRequest
+	23, // 41: internalpb.RemotingService.RemoteAskGrain:input_type -> internalpb.RemoteAskGrainRequest
+	25, // 42: internalpb.RemotingService.RemoteTellGrain:input_type -> internalpb.RemoteTellGrainRequest
+	27, // 43: internalpb.RemotingService.RemoteActivateGrain:input_type -> internalpb.RemoteActivateGrainRequest
+	29, // 44: internalpb.RemotingService.RemoteChunk:input_type -> internalpb.RemoteChunkRequest
+	31, // 45: internalpb.RemotingService.RemoteFetchChunk:input_type -> internalpb.RemoteFetchChunkRequest
+	1,  // 46: internalpb.RemotingService.RemoteAsk:output_type -> internalpb.RemoteAskResponse
+	3,  // 47: internalpb.RemotingService.RemoteTell:output_type -> internalpb.RemoteTellResponse
+	5,  // 48: internalpb.RemotingService.RemoteStreamTell:output_type -> internalpb.RemoteStreamTellResponse
+	7,  // 49: internalpb.RemotingService.RemoteLookup:output_type -> internalpb.RemoteLookupResponse
+	12, // 50: internalpb.RemotingService.RemoteWatch:output_type -> internalpb.RemoteWatchResponse
+	14, // 51: internalpb.RemotingService.RemoteUnWatch:output_type -> internalpb.RemoteUnWatchResponse
+	16, // 52: internalpb.RemotingService.RemoteReSpawn:output_type -> internalpb.RemoteReSpawnResponse
+	18, // 53: internalpb.RemotingService.RemoteStop:output_type -> internalpb.RemoteStopResponse
+	20, // 54: internalpb.RemotingService.RemoteSpawn:output_type -> internalpb.RemoteSpawnResponse
+	22, // 55: internalpb.RemotingService.RemoteReinstate:output_type -> internalpb.RemoteReinstateResponse
+	24, // 56: internalpb.RemotingService.RemoteAskGrain:output_type -> internalpb.RemoteAskGrainResponse
+	26, // 57: internalpb.RemotingService.RemoteTellGrain:output_type -> internalpb.RemoteTellGrainResponse
+	28, // 58: internalpb.RemotingService.RemoteActivateGrain:output_type -> internalpb.RemoteActivateGrainResponse
+	30, // 59: internalpb.RemotingService.RemoteChunk:output_type -> internalpb.RemoteChunkResponse
+	32, // 60: internalpb.RemotingService.RemoteFetchChunk:output_type -> internalpb.RemoteFetchChunkResponse
+	46, // [46:61] is the sub-list for method output_type
+	31, // [31:46] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_internal_remoting_proto_init() }
@@ -1288,7 +2129,7 @@ func file_internal_remoting_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_remoting_proto_rawDesc), len(file_internal_remoting_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,120 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: internal/singleton.proto
+
+package internalpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SingletonProxyTick is scheduled by the singleton proxy
+// to deliver the buffered messages to the singleton actor
+type SingletonProxyTick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingletonProxyTick) Reset() {
+	*x = SingletonProxyTick{}
+	mi := &file_internal_singleton_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingletonProxyTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingletonProxyTick) ProtoMessage() {}
+
+func (x *SingletonProxyTick) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_singleton_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingletonProxyTick.ProtoReflect.Descriptor instead.
+func (*SingletonProxyTick) Descriptor() ([]byte, []int) {
+	return file_internal_singleton_proto_rawDescGZIP(), []int{0}
+}
+
+var File_internal_singleton_proto protoreflect.FileDescriptor
+
+const file_internal_singleton_proto_rawDesc = "" +
+	"\n" +
+	"\x18internal/singleton.proto\x12\n" +
+	"internalpb\"\x14\n" +
+	"\x12SingletonProxyTickB\xa7\x01\n" +
+	"\x0ecom.internalpbB\x0eSingletonProtoH\x02P\x01Z;github.com/tochemey/goakt/v3/internal/internalpb;internalpb\xa2\x02\x03IXX\xaa\x02\n" +
+	"Internalpb\xca\x02\n" +
+	"Internalpb\xe2\x02\x16Internalpb\\GPBMetadata\xea\x02\n" +
+	"Internalpbb\x06proto3"
+
+var (
+	file_internal_singleton_proto_rawDescOnce sync.Once
+	file_internal_singleton_proto_rawDescData []byte
+)
+
+func file_internal_singleton_proto_rawDescGZIP() []byte {
+	file_internal_singleton_proto_rawDescOnce.Do(func() {
+		file_internal_singleton_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_internal_singleton_proto_rawDesc), len(file_internal_singleton_proto_rawDesc)))
+	})
+	return file_internal_singleton_proto_rawDescData
+}
+
+var file_internal_singleton_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_internal_singleton_proto_goTypes = []any{
+	(*SingletonProxyTick)(nil), // 0: internalpb.SingletonProxyTick
+}
+var file_internal_singleton_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_internal_singleton_proto_init() }
+func file_internal_singleton_proto_init() {
+	if File_internal_singleton_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_singleton_proto_rawDesc), len(file_internal_singleton_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_singleton_proto_goTypes,
+		DependencyIndexes: file_internal_singleton_proto_depIdxs,
+		MessageInfos:      file_internal_singleton_proto_msgTypes,
+	}.Build()
+	File_internal_singleton_proto = out.File
+	file_internal_singleton_proto_goTypes = nil
+	file_internal_singleton_proto_depIdxs = nil
+}
@@ -9,7 +9,6 @@ package internalpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	anypb "google.golang.org/protobuf/types/known/anypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -89,8 +88,9 @@ type Down struct {
 	Directive isDown_Directive `protobuf_oneof:"directive"`
 	// Specifies the strategy
 	Strategy Strategy `protobuf:"varint,7,opt,name=strategy,proto3,enum=internalpb.Strategy" json:"strategy,omitempty"`
-	// Specifies the message that triggered the failure
-	Message *anypb.Any `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	// Specifies the message that triggered the failure.
+	// A message without any registered serializer only carries its type
+	Message *Payload `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
 	// Specifies when the error occurred
 	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -191,7 +191,7 @@ func (x *Down) GetStrategy() Strategy {
 	return Strategy_STRATEGY_ONE_FOR_ONE
 }
 
-func (x *Down) GetMessage() *anypb.Any {
+func (x *Down) GetMessage() *Payload {
 	if x != nil {
 		return x.Message
 	}
@@ -353,7 +353,10 @@ type RestartDirective struct {
 	// When reaching this number, the faulty actor is stopped
 	MaxRetries uint32 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
 	// Specifies the time range to restart the faulty actor
-	Timeout       int64 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Timeout int64 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Specifies the restart backoff
+	// When not set the faulty actor is restarted right away
+	Backoff       *RestartBackoff `protobuf:"bytes,3,opt,name=backoff,proto3" json:"backoff,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -402,12 +405,93 @@ func (x *RestartDirective) GetTimeout() int64 {
 	return 0
 }
 
+func (x *RestartDirective) GetBackoff() *RestartBackoff {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+// RestartBackoff defines the exponential backoff applied between restarts
+type RestartBackoff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Specifies the minimum delay before restarting
+	MinBackoff int64 `protobuf:"varint,1,opt,name=min_backoff,json=minBackoff,proto3" json:"min_backoff,omitempty"`
+	// Specifies the maximum delay before restarting
+	MaxBackoff int64 `protobuf:"varint,2,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	// Specifies the random factor added to the delay
+	RandomFactor float64 `protobuf:"fixed64,3,opt,name=random_factor,json=randomFactor,proto3" json:"random_factor,omitempty"`
+	// Specifies the time after which the backoff is reset
+	// when the actor has not failed again
+	ResetAfter    int64 `protobuf:"varint,4,opt,name=reset_after,json=resetAfter,proto3" json:"reset_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartBackoff) Reset() {
+	*x = RestartBackoff{}
+	mi := &file_internal_supervision_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartBackoff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartBackoff) ProtoMessage() {}
+
+func (x *RestartBackoff) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_supervision_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartBackoff.ProtoReflect.Descriptor instead.
+func (*RestartBackoff) Descriptor() ([]byte, []int) {
+	return file_internal_supervision_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RestartBackoff) GetMinBackoff() int64 {
+	if x != nil {
+		return x.MinBackoff
+	}
+	return 0
+}
+
+func (x *RestartBackoff) GetMaxBackoff() int64 {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return 0
+}
+
+func (x *RestartBackoff) GetRandomFactor() float64 {
+	if x != nil {
+		return x.RandomFactor
+	}
+	return 0
+}
+
+func (x *RestartBackoff) GetResetAfter() int64 {
+	if x != nil {
+		return x.ResetAfter
+	}
+	return 0
+}
+
 var File_internal_supervision_proto protoreflect.FileDescriptor
 
 const file_internal_supervision_proto_rawDesc = "" +
 	"\n" +
 	"\x1ainternal/supervision.proto\x12\n" +
-	"internalpb\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x03\n" +
+	"internalpb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17internal/remoting.proto\"\xcd\x03\n" +
 	"\x04Down\x12\x19\n" +
 	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12/\n" +
@@ -415,17 +499,26 @@ const file_internal_supervision_proto_rawDesc = "" +
 	"\x06resume\x18\x04 \x01(\v2\x1b.internalpb.ResumeDirectiveH\x00R\x06resume\x128\n" +
 	"\arestart\x18\x05 \x01(\v2\x1c.internalpb.RestartDirectiveH\x00R\arestart\x12;\n" +
 	"\bescalate\x18\x06 \x01(\v2\x1d.internalpb.EscalateDirectiveH\x00R\bescalate\x120\n" +
-	"\bstrategy\x18\a \x01(\x0e2\x14.internalpb.StrategyR\bstrategy\x12.\n" +
-	"\amessage\x18\b \x01(\v2\x14.google.protobuf.AnyR\amessage\x128\n" +
+	"\bstrategy\x18\a \x01(\x0e2\x14.internalpb.StrategyR\bstrategy\x12-\n" +
+	"\amessage\x18\b \x01(\v2\x13.internalpb.PayloadR\amessage\x128\n" +
 	"\ttimestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB\v\n" +
 	"\tdirective\"\x0f\n" +
 	"\rStopDirective\"\x11\n" +
 	"\x0fResumeDirective\"\x13\n" +
-	"\x11EscalateDirective\"M\n" +
+	"\x11EscalateDirective\"\x83\x01\n" +
 	"\x10RestartDirective\x12\x1f\n" +
 	"\vmax_retries\x18\x01 \x01(\rR\n" +
 	"maxRetries\x12\x18\n" +
-	"\atimeout\x18\x02 \x01(\x03R\atimeout*>\n" +
+	"\atimeout\x18\x02 \x01(\x03R\atimeout\x124\n" +
+	"\abackoff\x18\x03 \x01(\v2\x1a.internalpb.RestartBackoffR\abackoff\"\x98\x01\n" +
+	"\x0eRestartBackoff\x12\x1f\n" +
+	"\vmin_backoff\x18\x01 \x01(\x03R\n" +
+	"minBackoff\x12\x1f\n" +
+	"\vmax_backoff\x18\x02 \x01(\x03R\n" +
+	"maxBackoff\x12#\n" +
+	"\rrandom_factor\x18\x03 \x01(\x01R\frandomFactor\x12\x1f\n" +
+	"\vreset_after\x18\x04 \x01(\x03R\n" +
+	"resetAfter*>\n" +
 	"\bStrategy\x12\x18\n" +
 	"\x14STRATEGY_ONE_FOR_ONE\x10\x00\x12\x18\n" +
 	"\x14STRATEGY_ONE_FOR_ALL\x10\x01B\xa9\x01\n" +
@@ -447,7 +540,7 @@ func file_internal_supervision_proto_rawDescGZIP() []byte {
 }
 
 var file_internal_supervision_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_supervision_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_internal_supervision_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_internal_supervision_proto_goTypes = []any{
 	(Strategy)(0),                 // 0: internalpb.Strategy
 	(*Down)(nil),                  // 1: internalpb.Down
@@ -455,8 +548,9 @@ var file_internal_supervision_proto_goTypes = []any{
 	(*ResumeDirective)(nil),       // 3: internalpb.ResumeDirective
 	(*EscalateDirective)(nil),     // 4: internalpb.EscalateDirective
 	(*RestartDirective)(nil),      // 5: internalpb.RestartDirective
-	(*anypb.Any)(nil),             // 6: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*RestartBackoff)(nil),        // 6: internalpb.RestartBackoff
+	(*Payload)(nil),               // 7: internalpb.Payload
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
 }
 var file_internal_supervision_proto_depIdxs = []int32{
 	2, // 0: internalpb.Down.stop:type_name -> internalpb.StopDirective
@@ -464,13 +558,14 @@ var file_internal_supervision_proto_depIdxs = []int32{
 	5, // 2: internalpb.Down.restart:type_name -> internalpb.RestartDirective
 	4, // 3: internalpb.Down.escalate:type_name -> internalpb.EscalateDirective
 	0, // 4: internalpb.Down.strategy:type_name -> internalpb.Strategy
-	6, // 5: internalpb.Down.message:type_name -> google.protobuf.Any
-	7, // 6: internalpb.Down.timestamp:type_name -> google.protobuf.Timestamp
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	7, // 5: internalpb.Down.message:type_name -> internalpb.Payload
+	8, // 6: internalpb.Down.timestamp:type_name -> google.protobuf.Timestamp
+	6, // 7: internalpb.RestartDirective.backoff:type_name -> internalpb.RestartBackoff
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_internal_supervision_proto_init() }
@@ -478,6 +573,7 @@ func file_internal_supervision_proto_init() {
 	if File_internal_supervision_proto != nil {
 		return
 	}
+	file_internal_remoting_proto_init()
 	file_internal_supervision_proto_msgTypes[0].OneofWrappers = []any{
 		(*Down_Stop)(nil),
 		(*Down_Resume)(nil),
@@ -490,7 +586,7 @@ func file_internal_supervision_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_supervision_proto_rawDesc), len(file_internal_supervision_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,177 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package memberlist
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/memberlist"
+)
+
+// ErrPartitioned is returned when dialing a peer the local node is partitioned from
+var ErrPartitioned = errors.New("peer is unreachable due to a network partition")
+
+// partitionsEnabled states whether the cluster engines wrap their transport with a PartitionableTransport.
+// It is only enabled by the testkit, so that production nodes keep their transport untouched.
+var partitionsEnabled atomic.Bool
+
+// EnablePartitions makes the cluster engines started afterwards in the process use a PartitionableTransport,
+// allowing Partition to simulate network partitions between them.
+func EnablePartitions() {
+	partitionsEnabled.Store(true)
+}
+
+// PartitionsEnabled states whether the cluster engines use a PartitionableTransport
+func PartitionsEnabled() bool {
+	return partitionsEnabled.Load()
+}
+
+// partitions records the pairs of memberlist addresses that cannot reach each other.
+// It is shared by all the transports of the process so that tests can simulate
+// network partitions between nodes running in the same process.
+var partitions = &partitionTable{blocked: make(map[string]map[string]struct{})}
+
+type partitionTable struct {
+	mu      sync.RWMutex
+	blocked map[string]map[string]struct{}
+}
+
+// block prevents the given addresses from reaching each other
+func (p *partitionTable) block(from, to string) {
+	if p.blocked[from] == nil {
+		p.blocked[from] = make(map[string]struct{})
+	}
+	p.blocked[from][to] = struct{}{}
+}
+
+// isBlocked states whether the traffic between the given addresses is dropped
+func (p *partitionTable) isBlocked(from, to string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.blocked[from][to]
+	return ok
+}
+
+// Partition simulates a network partition between the given groups of memberlist addresses.
+// The members of a group can reach each other but cannot reach the members of the other groups.
+// The partition only affects the transports created with NewPartitionableTransport and lasts until Heal is called.
+func Partition(groups ...[]string) {
+	partitions.mu.Lock()
+	defer partitions.mu.Unlock()
+	for i, group := range groups {
+		for j, other := range groups {
+			if i == j {
+				continue
+			}
+			for _, from := range group {
+				for _, to := range other {
+					partitions.block(from, to)
+				}
+			}
+		}
+	}
+}
+
+// Heal removes all the simulated network partitions
+func Heal() {
+	partitions.mu.Lock()
+	partitions.blocked = make(map[string]map[string]struct{})
+	partitions.mu.Unlock()
+}
+
+// PartitionableTransport is a memberlist.NodeAwareTransport that drops the traffic
+// between the addresses separated by a simulated network partition.
+// The traffic is dropped on the sending side: packets are silently discarded and dialing fails.
+type PartitionableTransport struct {
+	memberlist.NodeAwareTransport
+
+	mu    sync.RWMutex
+	local string
+}
+
+var _ memberlist.NodeAwareTransport = (*PartitionableTransport)(nil)
+
+// NewPartitionableTransport wraps the given transport
+func NewPartitionableTransport(transport memberlist.NodeAwareTransport) *PartitionableTransport {
+	return &PartitionableTransport{NodeAwareTransport: transport}
+}
+
+// FinalAdvertiseAddr returns the address advertised by the underlying transport
+// and records it as the local address of the node
+func (t *PartitionableTransport) FinalAdvertiseAddr(ip string, port int) (net.IP, int, error) {
+	advertiseAddr, advertisePort, err := t.NodeAwareTransport.FinalAdvertiseAddr(ip, port)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	t.mu.Lock()
+	t.local = (&net.TCPAddr{IP: advertiseAddr, Port: advertisePort}).String()
+	t.mu.Unlock()
+	return advertiseAddr, advertisePort, nil
+}
+
+// WriteTo sends the given payload to the given address unless the address is partitioned
+func (t *PartitionableTransport) WriteTo(b []byte, addr string) (time.Time, error) {
+	if t.partitioned(addr) {
+		// packets are not guaranteed to be delivered, so they are silently dropped
+		return time.Now(), nil
+	}
+	return t.NodeAwareTransport.WriteTo(b, addr)
+}
+
+// WriteToAddress sends the given payload to the given address unless the address is partitioned
+func (t *PartitionableTransport) WriteToAddress(b []byte, addr memberlist.Address) (time.Time, error) {
+	if t.partitioned(addr.Addr) {
+		return time.Now(), nil
+	}
+	return t.NodeAwareTransport.WriteToAddress(b, addr)
+}
+
+// DialTimeout creates a connection to the given address unless the address is partitioned
+func (t *PartitionableTransport) DialTimeout(addr string, timeout time.Duration) (net.Conn, error) {
+	if t.partitioned(addr) {
+		return nil, ErrPartitioned
+	}
+	return t.NodeAwareTransport.DialTimeout(addr, timeout)
+}
+
+// DialAddressTimeout creates a connection to the given address unless the address is partitioned
+func (t *PartitionableTransport) DialAddressTimeout(addr memberlist.Address, timeout time.Duration) (net.Conn, error) {
+	if t.partitioned(addr.Addr) {
+		return nil, ErrPartitioned
+	}
+	return t.NodeAwareTransport.DialAddressTimeout(addr, timeout)
+}
+
+func (t *PartitionableTransport) partitioned(addr string) bool {
+	t.mu.RLock()
+	local := t.local
+	t.mu.RUnlock()
+	return partitions.isBlocked(local, addr)
+}
@@ -0,0 +1,87 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package memberlist
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/travisjeffery/go-dynaport"
+)
+
+func TestPartitionableTransport(t *testing.T) {
+	host := "127.0.0.1"
+	ports := dynaport.Get(2)
+
+	newTransport := func(port int) *PartitionableTransport {
+		transport, err := NewTransport(TransportConfig{
+			BindAddrs: []string{host},
+			BindPort:  port,
+		})
+		require.NoError(t, err)
+		partitionable := NewPartitionableTransport(transport)
+		_, _, err = partitionable.FinalAdvertiseAddr(host, port)
+		require.NoError(t, err)
+		return partitionable
+	}
+
+	transport1 := newTransport(ports[0])
+	transport2 := newTransport(ports[1])
+	t.Cleanup(Heal)
+
+	addr1 := net.JoinHostPort(host, strconv.Itoa(ports[0]))
+	addr2 := net.JoinHostPort(host, strconv.Itoa(ports[1]))
+
+	Partition([]string{addr1}, []string{addr2})
+
+	_, err := transport1.DialTimeout(addr2, time.Second)
+	require.ErrorIs(t, err, ErrPartitioned)
+	_, err = transport2.DialTimeout(addr1, time.Second)
+	require.ErrorIs(t, err, ErrPartitioned)
+
+	// packets are silently dropped
+	_, err = transport1.WriteTo([]byte("ping"), addr2)
+	require.NoError(t, err)
+
+	Heal()
+
+	conn, err := transport1.DialTimeout(addr2, time.Second)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	assert.NoError(t, transport1.Shutdown())
+	assert.NoError(t, transport2.Shutdown())
+}
+
+func TestEnablePartitions(t *testing.T) {
+	require.False(t, PartitionsEnabled())
+	EnablePartitions()
+	t.Cleanup(func() { partitionsEnabled.Store(false) })
+	assert.True(t, PartitionsEnabled())
+}
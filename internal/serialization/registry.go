@@ -0,0 +1,165 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package serialization
+
+import (
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/remote"
+)
+
+// Registry holds the serializers used to serialize the messages exchanged between nodes.
+// Protocol buffers messages without a registered serializer are serialized with the protobuf serializer.
+type Registry struct {
+	serializers map[reflect.Type]remote.Serializer
+	manifests   map[string]reflect.Type
+	proto       remote.Serializer
+}
+
+// NewRegistry creates an instance of Registry with the given serializers per message type
+func NewRegistry(serializers map[reflect.Type]remote.Serializer) *Registry {
+	registry := &Registry{
+		serializers: make(map[reflect.Type]remote.Serializer, len(serializers)),
+		manifests:   make(map[string]reflect.Type, len(serializers)),
+		proto:       remote.NewProtoSerializer(),
+	}
+
+	for messageType, serializer := range serializers {
+		if messageType == nil || serializer == nil {
+			continue
+		}
+		registry.serializers[messageType] = serializer
+		registry.manifests[manifest(messageType)] = messageType
+	}
+	return registry
+}
+
+// Serialize serializes the given message with the serializer registered for its type
+func (r *Registry) Serialize(message any) (*internalpb.Payload, error) {
+	messageType := reflect.TypeOf(message)
+	if serializer, ok := r.serializers[messageType]; ok {
+		data, err := serializer.Serialize(message)
+		if err != nil {
+			return nil, err
+		}
+
+		return &internalpb.Payload{
+			SerializerId: serializer.ID(),
+			Manifest:     manifest(messageType),
+			Data:         data,
+		}, nil
+	}
+
+	if msg, ok := message.(proto.Message); ok {
+		data, err := r.proto.Serialize(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		return &internalpb.Payload{
+			SerializerId: r.proto.ID(),
+			Manifest:     string(msg.ProtoReflect().Descriptor().FullName()),
+			Data:         data,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w for %v", remote.ErrSerializerNotFound, messageType)
+}
+
+// Deserialize deserializes the given payload with the serializer that serialized it
+func (r *Registry) Deserialize(payload *internalpb.Payload) (any, error) {
+	if messageType, ok := r.manifests[payload.GetManifest()]; ok {
+		serializer := r.serializers[messageType]
+		if serializer.ID() != payload.GetSerializerId() {
+			return nil, fmt.Errorf("%w: (%s) is not serialized with serializer (%d)", remote.ErrSerializerNotFound, payload.GetManifest(), payload.GetSerializerId())
+		}
+
+		pointer := messageType.Kind() == reflect.Pointer
+		elemType := messageType
+		if pointer {
+			elemType = messageType.Elem()
+		}
+
+		value := reflect.New(elemType)
+		if err := serializer.Deserialize(payload.GetData(), value.Interface()); err != nil {
+			return nil, err
+		}
+
+		if pointer {
+			return value.Interface(), nil
+		}
+		return value.Elem().Interface(), nil
+	}
+
+	if payload.GetSerializerId() == r.proto.ID() {
+		messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(payload.GetManifest()))
+		if err != nil {
+			return nil, err
+		}
+
+		message := messageType.New().Interface()
+		if err := r.proto.Deserialize(payload.GetData(), message); err != nil {
+			return nil, err
+		}
+		return message, nil
+	}
+
+	return nil, fmt.Errorf("%w for (%s) with serializer (%d)", remote.ErrSerializerNotFound, payload.GetManifest(), payload.GetSerializerId())
+}
+
+// Manifest returns the name identifying the type of the given message, as set in its serialized payload
+func (r *Registry) Manifest(message any) string {
+	messageType := reflect.TypeOf(message)
+	if messageType == nil {
+		return ""
+	}
+
+	if _, ok := r.serializers[messageType]; ok {
+		return manifest(messageType)
+	}
+
+	if msg, ok := message.(proto.Message); ok {
+		return string(msg.ProtoReflect().Descriptor().FullName())
+	}
+	return manifest(messageType)
+}
+
+// manifest returns the name identifying the given type across nodes
+func manifest(messageType reflect.Type) string {
+	if messageType.Kind() == reflect.Pointer {
+		return "*" + manifest(messageType.Elem())
+	}
+
+	if messageType.Name() != "" && messageType.PkgPath() != "" {
+		return messageType.PkgPath() + "." + messageType.Name()
+	}
+	return messageType.String()
+}
@@ -0,0 +1,158 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package serialization
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+	"github.com/tochemey/goakt/v3/remote"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+type account struct {
+	ID      string `json:"id"`
+	Balance int    `json:"balance"`
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("With protocol buffers message", func(t *testing.T) {
+		registry := NewRegistry(nil)
+		payload, err := registry.Serialize(&testpb.Reply{Content: "hello"})
+		require.NoError(t, err)
+		assert.Exactly(t, remote.ProtoSerializerID, payload.GetSerializerId())
+		assert.Exactly(t, "testpb.Reply", payload.GetManifest())
+
+		actual, err := registry.Deserialize(payload)
+		require.NoError(t, err)
+		require.IsType(t, new(testpb.Reply), actual)
+		assert.Equal(t, "hello", actual.(*testpb.Reply).GetContent())
+	})
+	t.Run("With registered value type", func(t *testing.T) {
+		registry := NewRegistry(map[reflect.Type]remote.Serializer{
+			reflect.TypeOf(account{}): remote.NewJSONSerializer(),
+		})
+
+		expected := account{ID: "id", Balance: 10}
+		payload, err := registry.Serialize(expected)
+		require.NoError(t, err)
+		assert.Exactly(t, remote.JSONSerializerID, payload.GetSerializerId())
+
+		actual, err := registry.Deserialize(payload)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+	t.Run("With registered pointer type", func(t *testing.T) {
+		registry := NewRegistry(map[reflect.Type]remote.Serializer{
+			reflect.TypeOf(new(account)): remote.NewJSONSerializer(),
+		})
+
+		expected := &account{ID: "id", Balance: 10}
+		payload, err := registry.Serialize(expected)
+		require.NoError(t, err)
+
+		actual, err := registry.Deserialize(payload)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+	t.Run("With protocol buffers message registered with another serializer", func(t *testing.T) {
+		registry := NewRegistry(map[reflect.Type]remote.Serializer{
+			reflect.TypeOf(new(testpb.Reply)): remote.NewJSONSerializer(),
+		})
+
+		payload, err := registry.Serialize(&testpb.Reply{Content: "hello"})
+		require.NoError(t, err)
+		assert.Exactly(t, remote.JSONSerializerID, payload.GetSerializerId())
+
+		actual, err := registry.Deserialize(payload)
+		require.NoError(t, err)
+		require.IsType(t, new(testpb.Reply), actual)
+		assert.Equal(t, "hello", actual.(*testpb.Reply).GetContent())
+	})
+	t.Run("With unregistered message type", func(t *testing.T) {
+		registry := NewRegistry(nil)
+		_, err := registry.Serialize(account{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, remote.ErrSerializerNotFound))
+	})
+	t.Run("With manifest", func(t *testing.T) {
+		registry := NewRegistry(map[reflect.Type]remote.Serializer{
+			reflect.TypeOf(account{}): remote.NewJSONSerializer(),
+		})
+
+		payload, err := registry.Serialize(account{})
+		require.NoError(t, err)
+		assert.Equal(t, payload.GetManifest(), registry.Manifest(account{}))
+
+		payload, err = registry.Serialize(new(testpb.Reply))
+		require.NoError(t, err)
+		assert.Equal(t, payload.GetManifest(), registry.Manifest(new(testpb.Reply)))
+
+		// the messages without any serializer are named after their type as well
+		assert.Equal(t, "*github.com/tochemey/goakt/v3/internal/serialization.account", registry.Manifest(new(account)))
+		assert.Empty(t, registry.Manifest(nil))
+	})
+	t.Run("With unknown manifest", func(t *testing.T) {
+		registry := NewRegistry(nil)
+		_, err := registry.Deserialize(&internalpb.Payload{SerializerId: remote.JSONSerializerID, Manifest: "unknown"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, remote.ErrSerializerNotFound))
+
+		_, err = registry.Deserialize(&internalpb.Payload{SerializerId: remote.ProtoSerializerID, Manifest: "unknown"})
+		require.Error(t, err)
+	})
+	t.Run("With serializer mismatch", func(t *testing.T) {
+		sender := NewRegistry(map[reflect.Type]remote.Serializer{
+			reflect.TypeOf(account{}): remote.NewJSONSerializer(),
+		})
+		receiver := NewRegistry(map[reflect.Type]remote.Serializer{
+			reflect.TypeOf(account{}): remote.NewProtoSerializer(),
+		})
+
+		payload, err := sender.Serialize(account{})
+		require.NoError(t, err)
+
+		_, err = receiver.Deserialize(payload)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, remote.ErrSerializerNotFound))
+	})
+	t.Run("With invalid data", func(t *testing.T) {
+		registry := NewRegistry(map[reflect.Type]remote.Serializer{
+			reflect.TypeOf(account{}): remote.NewJSONSerializer(),
+		})
+
+		_, err := registry.Deserialize(&internalpb.Payload{
+			SerializerId: remote.JSONSerializerID,
+			Manifest:     manifest(reflect.TypeOf(account{})),
+			Data:         []byte("{"),
+		})
+		require.Error(t, err)
+	})
+}
@@ -26,6 +26,67 @@ func (_m *Interface) EXPECT() *Interface_Expecter {
 	return &Interface_Expecter{mock: &_m.Mock}
 }
 
+// AcquireLease provides a mock function with given fields: ctx, key, ttl, wait
+func (_m *Interface) AcquireLease(ctx context.Context, key string, ttl time.Duration, wait time.Duration) (*internalcluster.Lease, error) {
+	ret := _m.Called(ctx, key, ttl, wait)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireLease")
+	}
+
+	var r0 *internalcluster.Lease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration, time.Duration) (*internalcluster.Lease, error)); ok {
+		return rf(ctx, key, ttl, wait)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration, time.Duration) *internalcluster.Lease); ok {
+		r0 = rf(ctx, key, ttl, wait)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalcluster.Lease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration, time.Duration) error); ok {
+		r1 = rf(ctx, key, ttl, wait)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Interface_AcquireLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireLease'
+type Interface_AcquireLease_Call struct {
+	*mock.Call
+}
+
+// AcquireLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - ttl time.Duration
+//   - wait time.Duration
+func (_e *Interface_Expecter) AcquireLease(ctx interface{}, key interface{}, ttl interface{}, wait interface{}) *Interface_AcquireLease_Call {
+	return &Interface_AcquireLease_Call{Call: _e.mock.On("AcquireLease", ctx, key, ttl, wait)}
+}
+
+func (_c *Interface_AcquireLease_Call) Run(run func(ctx context.Context, key string, ttl time.Duration, wait time.Duration)) *Interface_AcquireLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Interface_AcquireLease_Call) Return(_a0 *internalcluster.Lease, _a1 error) *Interface_AcquireLease_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Interface_AcquireLease_Call) RunAndReturn(run func(context.Context, string, time.Duration, time.Duration) (*internalcluster.Lease, error)) *Interface_AcquireLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ActorExists provides a mock function with given fields: ctx, actorName
 func (_m *Interface) ActorExists(ctx context.Context, actorName string) (bool, error) {
 	ret := _m.Called(ctx, actorName)
@@ -83,6 +144,55 @@ func (_c *Interface_ActorExists_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// ActorPartitions provides a mock function with given fields: actorName, kind
+func (_m *Interface) ActorPartitions(actorName string, kind string) []int {
+	ret := _m.Called(actorName, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActorPartitions")
+	}
+
+	var r0 []int
+	if rf, ok := ret.Get(0).(func(string, string) []int); ok {
+		r0 = rf(actorName, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	return r0
+}
+
+// Interface_ActorPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActorPartitions'
+type Interface_ActorPartitions_Call struct {
+	*mock.Call
+}
+
+// ActorPartitions is a helper method to define mock.On call
+//   - actorName string
+//   - kind string
+func (_e *Interface_Expecter) ActorPartitions(actorName interface{}, kind interface{}) *Interface_ActorPartitions_Call {
+	return &Interface_ActorPartitions_Call{Call: _e.mock.On("ActorPartitions", actorName, kind)}
+}
+
+func (_c *Interface_ActorPartitions_Call) Run(run func(actorName string, kind string)) *Interface_ActorPartitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Interface_ActorPartitions_Call) Return(_a0 []int) *Interface_ActorPartitions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Interface_ActorPartitions_Call) RunAndReturn(run func(string, string) []int) *Interface_ActorPartitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Actors provides a mock function with given fields: ctx, timeout
 func (_m *Interface) Actors(ctx context.Context, timeout time.Duration) ([]*internalpb.Actor, error) {
 	ret := _m.Called(ctx, timeout)
@@ -142,6 +252,98 @@ func (_c *Interface_Actors_Call) RunAndReturn(run func(context.Context, time.Dur
 	return _c
 }
 
+// AnnounceLeaving provides a mock function with given fields: ctx
+func (_m *Interface) AnnounceLeaving(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnnounceLeaving")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Interface_AnnounceLeaving_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnnounceLeaving'
+type Interface_AnnounceLeaving_Call struct {
+	*mock.Call
+}
+
+// AnnounceLeaving is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Interface_Expecter) AnnounceLeaving(ctx interface{}) *Interface_AnnounceLeaving_Call {
+	return &Interface_AnnounceLeaving_Call{Call: _e.mock.On("AnnounceLeaving", ctx)}
+}
+
+func (_c *Interface_AnnounceLeaving_Call) Run(run func(ctx context.Context)) *Interface_AnnounceLeaving_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Interface_AnnounceLeaving_Call) Return(_a0 error) *Interface_AnnounceLeaving_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Interface_AnnounceLeaving_Call) RunAndReturn(run func(context.Context) error) *Interface_AnnounceLeaving_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelLeaving provides a mock function with given fields: ctx
+func (_m *Interface) CancelLeaving(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelLeaving")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Interface_CancelLeaving_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelLeaving'
+type Interface_CancelLeaving_Call struct {
+	*mock.Call
+}
+
+// CancelLeaving is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Interface_Expecter) CancelLeaving(ctx interface{}) *Interface_CancelLeaving_Call {
+	return &Interface_CancelLeaving_Call{Call: _e.mock.On("CancelLeaving", ctx)}
+}
+
+func (_c *Interface_CancelLeaving_Call) Run(run func(ctx context.Context)) *Interface_CancelLeaving_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Interface_CancelLeaving_Call) Return(_a0 error) *Interface_CancelLeaving_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Interface_CancelLeaving_Call) RunAndReturn(run func(context.Context) error) *Interface_CancelLeaving_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Events provides a mock function with no fields
 func (_m *Interface) Events() <-chan *internalcluster.Event {
 	ret := _m.Called()
@@ -469,6 +671,101 @@ func (_c *Interface_GrainExists_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// GrainPartitions provides a mock function with given fields: grainID, kind
+func (_m *Interface) GrainPartitions(grainID string, kind string) []int {
+	ret := _m.Called(grainID, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrainPartitions")
+	}
+
+	var r0 []int
+	if rf, ok := ret.Get(0).(func(string, string) []int); ok {
+		r0 = rf(grainID, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	return r0
+}
+
+// Interface_GrainPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrainPartitions'
+type Interface_GrainPartitions_Call struct {
+	*mock.Call
+}
+
+// GrainPartitions is a helper method to define mock.On call
+//   - grainID string
+//   - kind string
+func (_e *Interface_Expecter) GrainPartitions(grainID interface{}, kind interface{}) *Interface_GrainPartitions_Call {
+	return &Interface_GrainPartitions_Call{Call: _e.mock.On("GrainPartitions", grainID, kind)}
+}
+
+func (_c *Interface_GrainPartitions_Call) Run(run func(grainID string, kind string)) *Interface_GrainPartitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Interface_GrainPartitions_Call) Return(_a0 []int) *Interface_GrainPartitions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Interface_GrainPartitions_Call) RunAndReturn(run func(string, string) []int) *Interface_GrainPartitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasLeft provides a mock function with given fields: peerAddress
+func (_m *Interface) HasLeft(peerAddress string) bool {
+	ret := _m.Called(peerAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasLeft")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(peerAddress)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Interface_HasLeft_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasLeft'
+type Interface_HasLeft_Call struct {
+	*mock.Call
+}
+
+// HasLeft is a helper method to define mock.On call
+//   - peerAddress string
+func (_e *Interface_Expecter) HasLeft(peerAddress interface{}) *Interface_HasLeft_Call {
+	return &Interface_HasLeft_Call{Call: _e.mock.On("HasLeft", peerAddress)}
+}
+
+func (_c *Interface_HasLeft_Call) Run(run func(peerAddress string)) *Interface_HasLeft_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Interface_HasLeft_Call) Return(_a0 bool) *Interface_HasLeft_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Interface_HasLeft_Call) RunAndReturn(run func(string) bool) *Interface_HasLeft_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsLeader provides a mock function with given fields: ctx
 func (_m *Interface) IsLeader(ctx context.Context) bool {
 	ret := _m.Called(ctx)
@@ -617,6 +914,122 @@ func (_c *Interface_LookupKind_Call) RunAndReturn(run func(context.Context, stri
 	return _c
 }
 
+// Members provides a mock function with given fields: ctx
+func (_m *Interface) Members(ctx context.Context) ([]*internalcluster.Peer, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Members")
+	}
+
+	var r0 []*internalcluster.Peer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*internalcluster.Peer, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*internalcluster.Peer); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalcluster.Peer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Interface_Members_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Members'
+type Interface_Members_Call struct {
+	*mock.Call
+}
+
+// Members is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Interface_Expecter) Members(ctx interface{}) *Interface_Members_Call {
+	return &Interface_Members_Call{Call: _e.mock.On("Members", ctx)}
+}
+
+func (_c *Interface_Members_Call) Run(run func(ctx context.Context)) *Interface_Members_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Interface_Members_Call) Return(_a0 []*internalcluster.Peer, _a1 error) *Interface_Members_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Interface_Members_Call) RunAndReturn(run func(context.Context) ([]*internalcluster.Peer, error)) *Interface_Members_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OwnedPartitions provides a mock function with given fields: ctx
+func (_m *Interface) OwnedPartitions(ctx context.Context) ([]int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OwnedPartitions")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []int); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Interface_OwnedPartitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OwnedPartitions'
+type Interface_OwnedPartitions_Call struct {
+	*mock.Call
+}
+
+// OwnedPartitions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Interface_Expecter) OwnedPartitions(ctx interface{}) *Interface_OwnedPartitions_Call {
+	return &Interface_OwnedPartitions_Call{Call: _e.mock.On("OwnedPartitions", ctx)}
+}
+
+func (_c *Interface_OwnedPartitions_Call) Run(run func(ctx context.Context)) *Interface_OwnedPartitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Interface_OwnedPartitions_Call) Return(_a0 []int, _a1 error) *Interface_OwnedPartitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Interface_OwnedPartitions_Call) RunAndReturn(run func(context.Context) ([]int, error)) *Interface_OwnedPartitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Peers provides a mock function with given fields: ctx
 func (_m *Interface) Peers(ctx context.Context) ([]*internalcluster.Peer, error) {
 	ret := _m.Called(ctx)
@@ -0,0 +1,74 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"time"
+)
+
+// Delivery defines a message sent with at-least-once delivery that has not been confirmed yet.
+// The message is stored serialized so that any message with a registered serializer can be delivered.
+type Delivery struct {
+	// ProducerID is the unique identifier of the actor that sent the message
+	ProducerID string
+	// SequenceNumber is the sequence number of the delivery.
+	// Sequence numbers start at 1 and are strictly increasing per producer.
+	SequenceNumber uint64
+	// Recipient is the recipient of the message
+	Recipient string
+	// Message is the binary representation of the actual message
+	Message []byte
+	// SerializerID is the identifier of the serializer the message is serialized with
+	SerializerID int32
+	// Manifest is the type name of the message the serializer identifies it with
+	Manifest string
+	// Timestamp is the time the message was first sent
+	Timestamp time.Time
+}
+
+// DeliveryStore defines the contract of the store used to keep track of the unconfirmed deliveries.
+//
+// Besides the unconfirmed deliveries, the store keeps the highest sequence number used by every producer
+// so that a restarted producer never reuses a sequence number the recipients have already seen.
+//
+// Implementations must be safe for concurrent use. In cluster mode all nodes must share the same store
+// so that relocated actors redeliver their unconfirmed messages from their new host.
+type DeliveryStore interface {
+	// Connect connects to the delivery store.
+	// It is called once when the actor system starts.
+	Connect(ctx context.Context) error
+	// Disconnect disconnects from the delivery store.
+	// It is called once when the actor system stops.
+	Disconnect(ctx context.Context) error
+	// WriteDelivery records the given delivery as unconfirmed. It returns ErrSequenceNumberConflict
+	// when the delivery sequence number is not greater than the highest sequence number of its producer.
+	WriteDelivery(ctx context.Context, delivery *Delivery) error
+	// ConfirmDelivery removes the given delivery. Confirming an unknown delivery is a no-op.
+	ConfirmDelivery(ctx context.Context, producerID string, sequenceNumber uint64) error
+	// GetDeliveries returns the highest sequence number used by the given producer together with
+	// its unconfirmed deliveries ordered by sequence number.
+	GetDeliveries(ctx context.Context, producerID string) (sequenceNumber uint64, deliveries []*Delivery, err error)
+}
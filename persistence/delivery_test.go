@@ -0,0 +1,135 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestDeliveryStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) DeliveryStore{
+		"memory": func(*testing.T) DeliveryStore { return NewMemoryDeliveryStore() },
+		"file":   func(t *testing.T) DeliveryStore { return NewFileDeliveryStore(t.TempDir()) },
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("With deliveries written and confirmed", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				sequenceNumber, deliveries, err := store.GetDeliveries(ctx, "producer")
+				require.NoError(t, err)
+				assert.Zero(t, sequenceNumber)
+				assert.Empty(t, deliveries)
+
+				for i := 1; i <= 3; i++ {
+					require.NoError(t, store.WriteDelivery(ctx, newDelivery("producer", uint64(i))))
+				}
+
+				require.NoError(t, store.ConfirmDelivery(ctx, "producer", 2))
+				// confirming an unknown delivery is a no-op
+				require.NoError(t, store.ConfirmDelivery(ctx, "producer", 10))
+				require.NoError(t, store.ConfirmDelivery(ctx, "unknown", 1))
+
+				sequenceNumber, deliveries, err = store.GetDeliveries(ctx, "producer")
+				require.NoError(t, err)
+				assert.EqualValues(t, 3, sequenceNumber)
+				require.Len(t, deliveries, 2)
+				assert.EqualValues(t, 1, deliveries[0].SequenceNumber)
+				assert.EqualValues(t, 3, deliveries[1].SequenceNumber)
+				assert.Equal(t, "recipient", deliveries[1].Recipient)
+				assert.Equal(t, newDelivery("producer", 3).Message, deliveries[1].Message)
+				assert.EqualValues(t, 1, deliveries[1].SerializerID)
+				assert.Equal(t, "testpb.Account", deliveries[1].Manifest)
+
+				// the sequence number is kept when all the deliveries are confirmed
+				require.NoError(t, store.ConfirmDelivery(ctx, "producer", 1))
+				require.NoError(t, store.ConfirmDelivery(ctx, "producer", 3))
+
+				sequenceNumber, deliveries, err = store.GetDeliveries(ctx, "producer")
+				require.NoError(t, err)
+				assert.EqualValues(t, 3, sequenceNumber)
+				assert.Empty(t, deliveries)
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+			t.Run("With sequence number conflict", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				require.NoError(t, store.WriteDelivery(ctx, newDelivery("producer", 2)))
+
+				err := store.WriteDelivery(ctx, newDelivery("producer", 2))
+				require.ErrorIs(t, err, ErrSequenceNumberConflict)
+				err = store.WriteDelivery(ctx, newDelivery("producer", 1))
+				require.ErrorIs(t, err, ErrSequenceNumberConflict)
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+		})
+	}
+
+	t.Run("With file delivery store not connected", func(t *testing.T) {
+		ctx := context.TODO()
+		store := NewFileDeliveryStore(t.TempDir())
+
+		err := store.WriteDelivery(ctx, newDelivery("producer", 1))
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		err = store.ConfirmDelivery(ctx, "producer", 1)
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		_, _, err = store.GetDeliveries(ctx, "producer")
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+	})
+}
+
+func newDelivery(producerID string, sequenceNumber uint64) *Delivery {
+	message, _ := proto.Marshal(&testpb.Account{
+		AccountId:      producerID,
+		AccountBalance: float64(sequenceNumber * 10),
+	})
+
+	return &Delivery{
+		ProducerID:     producerID,
+		SequenceNumber: sequenceNumber,
+		Recipient:      "recipient",
+		Message:        message,
+		SerializerID:   1,
+		Manifest:       "testpb.Account",
+		Timestamp:      time.Now().UTC(),
+	}
+}
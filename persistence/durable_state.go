@@ -0,0 +1,79 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tochemey/goakt/v3/extension"
+)
+
+// DurableStateStoreID is the extension ID of the durable state stores shipped with this package
+const DurableStateStoreID = "durable-state-store"
+
+// DurableState defines the latest state of an actor or a grain
+type DurableState struct {
+	// PersistenceID is the unique identifier of the actor or grain
+	PersistenceID string
+	// Revision is the version of the state.
+	// Revisions start at 1 and are incremented on every write.
+	Revision uint64
+	// State is the actual state
+	State proto.Message
+	// Timestamp is the time the state was written
+	Timestamp time.Time
+}
+
+// DurableStateStore defines the contract of a durable state store.
+//
+// Unlike the JournalStore, a durable state store only keeps the latest state of every persistence ID.
+// Writes are guarded by optimistic revision checks: a state can only be written when its revision
+// is exactly one more than the revision currently stored, or 1 when no state exists. This prevents
+// two instances of the same actor or grain from silently overwriting each other.
+//
+// A DurableStateStore is an extension of the actor system. Implementations must be safe for concurrent use.
+// In cluster mode all nodes must share the same store so that passivated or relocated actors and grains
+// get their state back on their new host.
+type DurableStateStore interface {
+	extension.Extension
+	// Connect connects to the durable state store.
+	// It is called once when the actor system starts.
+	Connect(ctx context.Context) error
+	// Disconnect disconnects from the durable state store.
+	// It is called once when the actor system stops.
+	Disconnect(ctx context.Context) error
+	// GetState returns the latest state of the given persistence ID.
+	// It returns nil when no state exists.
+	GetState(ctx context.Context, persistenceID string) (*DurableState, error)
+	// UpsertState writes the given state. It returns ErrRevisionConflict when
+	// the state revision does not follow the revision currently stored.
+	UpsertState(ctx context.Context, state *DurableState) error
+	// DeleteState removes the state of the given persistence ID. It returns ErrRevisionConflict
+	// when the given revision does not match the revision currently stored.
+	DeleteState(ctx context.Context, persistenceID string, revision uint64) error
+}
@@ -0,0 +1,139 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestDurableStateStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) DurableStateStore{
+		"memory": func(*testing.T) DurableStateStore { return NewMemoryDurableStateStore() },
+		"file":   func(t *testing.T) DurableStateStore { return NewFileDurableStateStore(t.TempDir()) },
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("With state written and read", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				assert.Equal(t, DurableStateStoreID, store.ID())
+				require.NoError(t, store.Connect(ctx))
+
+				state, err := store.GetState(ctx, "account")
+				require.NoError(t, err)
+				assert.Nil(t, state)
+
+				require.NoError(t, store.UpsertState(ctx, newDurableState("account", 1)))
+				require.NoError(t, store.UpsertState(ctx, newDurableState("account", 2)))
+
+				state, err = store.GetState(ctx, "account")
+				require.NoError(t, err)
+				require.NotNil(t, state)
+				assert.EqualValues(t, 2, state.Revision)
+				assert.True(t, proto.Equal(newDurableState("account", 2).State, state.State))
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+			t.Run("With revision conflict", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				err := store.UpsertState(ctx, newDurableState("account", 2))
+				require.ErrorIs(t, err, ErrRevisionConflict)
+
+				require.NoError(t, store.UpsertState(ctx, newDurableState("account", 1)))
+
+				// a stale writer cannot override the state
+				err = store.UpsertState(ctx, newDurableState("account", 1))
+				require.ErrorIs(t, err, ErrRevisionConflict)
+
+				err = store.DeleteState(ctx, "account", 2)
+				require.ErrorIs(t, err, ErrRevisionConflict)
+
+				state, err := store.GetState(ctx, "account")
+				require.NoError(t, err)
+				require.NotNil(t, state)
+				assert.EqualValues(t, 1, state.Revision)
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+			t.Run("With state deleted", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				require.NoError(t, store.UpsertState(ctx, newDurableState("account", 1)))
+				require.NoError(t, store.DeleteState(ctx, "account", 1))
+
+				state, err := store.GetState(ctx, "account")
+				require.NoError(t, err)
+				assert.Nil(t, state)
+
+				// deleting a missing state is a no-op
+				require.NoError(t, store.DeleteState(ctx, "account", 1))
+				// the revisions start over once the state is deleted
+				require.NoError(t, store.UpsertState(ctx, newDurableState("account", 1)))
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+		})
+	}
+
+	t.Run("With file durable state store not connected", func(t *testing.T) {
+		ctx := context.TODO()
+		store := NewFileDurableStateStore(t.TempDir())
+
+		err := store.UpsertState(ctx, newDurableState("account", 1))
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		_, err = store.GetState(ctx, "account")
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		err = store.DeleteState(ctx, "account", 1)
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+	})
+}
+
+func newDurableState(persistenceID string, revision uint64) *DurableState {
+	return &DurableState{
+		PersistenceID: persistenceID,
+		Revision:      revision,
+		State: &testpb.Account{
+			AccountId:      persistenceID,
+			AccountBalance: float64(revision * 10),
+		},
+		Timestamp: time.Now().UTC(),
+	}
+}
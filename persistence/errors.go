@@ -0,0 +1,51 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrSequenceNumberConflict is returned when an event is written with a sequence number
+	// that is not greater than the highest sequence number already persisted
+	ErrSequenceNumberConflict = errors.New("sequence number conflict")
+	// ErrStoreNotConnected is returned when the store is used before being connected
+	ErrStoreNotConnected = errors.New("store is not connected")
+	// ErrRevisionConflict is returned when a durable state is written or deleted
+	// with a revision that does not match the revision currently stored
+	ErrRevisionConflict = errors.New("revision conflict")
+)
+
+// NewErrSequenceNumberConflict formats an ErrSequenceNumberConflict for the given persistence ID and sequence number
+func NewErrSequenceNumberConflict(persistenceID string, sequenceNumber uint64) error {
+	return fmt.Errorf("(persistenceID=%s, sequenceNumber=%d) %w", persistenceID, sequenceNumber, ErrSequenceNumberConflict)
+}
+
+// NewErrRevisionConflict formats an ErrRevisionConflict for the given persistence ID and revision
+func NewErrRevisionConflict(persistenceID string, revision uint64) error {
+	return fmt.Errorf("(persistenceID=%s, revision=%d) %w", persistenceID, revision, ErrRevisionConflict)
+}
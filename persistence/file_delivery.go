@@ -0,0 +1,219 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+)
+
+// FileDeliveryStore is a local-file implementation of DeliveryStore.
+//
+// The deliveries of every producer are stored in their own file under the configured directory.
+// The file is rewritten atomically on every change so that a crash never leaves it partially written.
+type FileDeliveryStore struct {
+	dir       string
+	mu        sync.Mutex
+	connected *atomic.Bool
+}
+
+// enforce compilation error
+var _ DeliveryStore = (*FileDeliveryStore)(nil)
+
+// NewFileDeliveryStore creates an instance of FileDeliveryStore that stores
+// the delivery files under the given directory
+func NewFileDeliveryStore(dir string) *FileDeliveryStore {
+	return &FileDeliveryStore{
+		dir:       dir,
+		connected: atomic.NewBool(false),
+	}
+}
+
+// Connect creates the deliveries directory when it does not exist
+func (s *FileDeliveryStore) Connect(context.Context) error {
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return err
+	}
+	s.connected.Store(true)
+	return nil
+}
+
+// Disconnect disconnects from the delivery store
+func (s *FileDeliveryStore) Disconnect(context.Context) error {
+	s.connected.Store(false)
+	return nil
+}
+
+// WriteDelivery records the given delivery as unconfirmed
+func (s *FileDeliveryStore) WriteDelivery(_ context.Context, delivery *Delivery) error {
+	if !s.connected.Load() {
+		return ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.read(delivery.ProducerID)
+	if err != nil {
+		return err
+	}
+
+	if delivery.SequenceNumber <= entry.GetSequenceNumber() {
+		return NewErrSequenceNumberConflict(delivery.ProducerID, delivery.SequenceNumber)
+	}
+
+	entry.SequenceNumber = delivery.SequenceNumber
+	entry.Deliveries = append(entry.Deliveries, &internalpb.DeliveryEntry{
+		ProducerId:     delivery.ProducerID,
+		SequenceNumber: delivery.SequenceNumber,
+		Recipient:      delivery.Recipient,
+		Message: &internalpb.Payload{
+			SerializerId: delivery.SerializerID,
+			Manifest:     delivery.Manifest,
+			Data:         delivery.Message,
+		},
+		Timestamp: timestamppb.New(delivery.Timestamp),
+	})
+	return s.write(entry)
+}
+
+// ConfirmDelivery removes the given delivery
+func (s *FileDeliveryStore) ConfirmDelivery(_ context.Context, producerID string, sequenceNumber uint64) error {
+	if !s.connected.Load() {
+		return ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.read(producerID)
+	if err != nil {
+		return err
+	}
+
+	size := len(entry.GetDeliveries())
+	entry.Deliveries = slices.DeleteFunc(entry.GetDeliveries(), func(delivery *internalpb.DeliveryEntry) bool {
+		return delivery.GetSequenceNumber() == sequenceNumber
+	})
+
+	if len(entry.GetDeliveries()) == size {
+		return nil
+	}
+	return s.write(entry)
+}
+
+// GetDeliveries returns the highest sequence number and the unconfirmed deliveries of the given producer
+func (s *FileDeliveryStore) GetDeliveries(_ context.Context, producerID string) (uint64, []*Delivery, error) {
+	if !s.connected.Load() {
+		return 0, nil, ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	entry, err := s.read(producerID)
+	s.mu.Unlock()
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	deliveries := make([]*Delivery, 0, len(entry.GetDeliveries()))
+	for _, delivery := range entry.GetDeliveries() {
+		deliveries = append(deliveries, &Delivery{
+			ProducerID:     delivery.GetProducerId(),
+			SequenceNumber: delivery.GetSequenceNumber(),
+			Recipient:      delivery.GetRecipient(),
+			Message:        delivery.GetMessage().GetData(),
+			SerializerID:   delivery.GetMessage().GetSerializerId(),
+			Manifest:       delivery.GetMessage().GetManifest(),
+			Timestamp:      delivery.GetTimestamp().AsTime(),
+		})
+	}
+
+	return entry.GetSequenceNumber(), deliveries, nil
+}
+
+// read reads the deliveries file of the given producer.
+// It returns an empty entry when the file does not exist
+func (s *FileDeliveryStore) read(producerID string) (*internalpb.DeliveryStoreEntry, error) {
+	bytea, err := os.ReadFile(s.filename(producerID))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &internalpb.DeliveryStoreEntry{ProducerId: producerID}, nil
+		}
+		return nil, err
+	}
+
+	entry := new(internalpb.DeliveryStoreEntry)
+	if err := proto.Unmarshal(bytea, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// write atomically replaces the deliveries file of the given entry
+func (s *FileDeliveryStore) write(entry *internalpb.DeliveryStoreEntry) error {
+	bytea, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	filename := s.filename(entry.GetProducerId())
+	tmp := filename + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(bytea); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filename)
+}
+
+// filename returns the deliveries file name of the given producer
+func (s *FileDeliveryStore) filename(producerID string) string {
+	return filepath.Join(s.dir, encodeFilename(producerID)+".deliveries")
+}
@@ -0,0 +1,213 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+)
+
+// FileDurableStateStore is a local-file implementation of DurableStateStore.
+//
+// The state of every persistence ID is stored in its own file under the configured directory.
+// States are written atomically so that a crash never leaves a partially written state behind.
+type FileDurableStateStore struct {
+	dir       string
+	mu        sync.Mutex
+	connected *atomic.Bool
+}
+
+// enforce compilation error
+var _ DurableStateStore = (*FileDurableStateStore)(nil)
+
+// NewFileDurableStateStore creates an instance of FileDurableStateStore that stores
+// the state files under the given directory
+func NewFileDurableStateStore(dir string) *FileDurableStateStore {
+	return &FileDurableStateStore{
+		dir:       dir,
+		connected: atomic.NewBool(false),
+	}
+}
+
+// ID returns the extension ID of the durable state store
+func (s *FileDurableStateStore) ID() string {
+	return DurableStateStoreID
+}
+
+// Connect creates the states directory when it does not exist
+func (s *FileDurableStateStore) Connect(context.Context) error {
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return err
+	}
+	s.connected.Store(true)
+	return nil
+}
+
+// Disconnect disconnects from the durable state store
+func (s *FileDurableStateStore) Disconnect(context.Context) error {
+	s.connected.Store(false)
+	return nil
+}
+
+// GetState returns the latest state of the given persistence ID
+func (s *FileDurableStateStore) GetState(_ context.Context, persistenceID string) (*DurableState, error) {
+	if !s.connected.Load() {
+		return nil, ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	entry, err := s.read(persistenceID)
+	s.mu.Unlock()
+
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	state, err := entry.GetState().UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DurableState{
+		PersistenceID: entry.GetPersistenceId(),
+		Revision:      entry.GetRevision(),
+		State:         state,
+		Timestamp:     entry.GetTimestamp().AsTime(),
+	}, nil
+}
+
+// UpsertState writes the given state
+func (s *FileDurableStateStore) UpsertState(_ context.Context, state *DurableState) error {
+	if !s.connected.Load() {
+		return ErrStoreNotConnected
+	}
+
+	payload, err := anypb.New(state.State)
+	if err != nil {
+		return err
+	}
+
+	bytea, err := proto.Marshal(&internalpb.DurableStateEntry{
+		PersistenceId: state.PersistenceID,
+		Revision:      state.Revision,
+		State:         payload,
+		Timestamp:     timestamppb.New(state.Timestamp),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, err := s.read(state.PersistenceID)
+	if err != nil {
+		return err
+	}
+
+	if state.Revision != current.GetRevision()+1 {
+		return NewErrRevisionConflict(state.PersistenceID, state.Revision)
+	}
+
+	filename := s.filename(state.PersistenceID)
+	tmp := filename + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(bytea); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filename)
+}
+
+// DeleteState removes the state of the given persistence ID
+func (s *FileDurableStateStore) DeleteState(_ context.Context, persistenceID string, revision uint64) error {
+	if !s.connected.Load() {
+		return ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, err := s.read(persistenceID)
+	if err != nil || current == nil {
+		return err
+	}
+
+	if current.GetRevision() != revision {
+		return NewErrRevisionConflict(persistenceID, revision)
+	}
+
+	if err := os.Remove(s.filename(persistenceID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// read reads the state file of the given persistence ID.
+// It returns nil when the file does not exist
+func (s *FileDurableStateStore) read(persistenceID string) (*internalpb.DurableStateEntry, error) {
+	bytea, err := os.ReadFile(s.filename(persistenceID))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entry := new(internalpb.DurableStateEntry)
+	if err := proto.Unmarshal(bytea, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// filename returns the state file name of the given persistence ID
+func (s *FileDurableStateStore) filename(persistenceID string) string {
+	return filepath.Join(s.dir, encodeFilename(persistenceID)+".state")
+}
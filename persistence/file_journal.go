@@ -0,0 +1,346 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+)
+
+// FileJournalStore is a local-file implementation of JournalStore.
+//
+// Every persistence ID is stored in its own append-only file under the configured directory.
+// Event payloads are serialized as protocol buffers, hence the event types need to be
+// registered in the protobuf global registry, which is the case for any generated message.
+type FileJournalStore struct {
+	dir       string
+	mu        sync.Mutex
+	highest   map[string]uint64
+	connected *atomic.Bool
+}
+
+// enforce compilation error
+var _ JournalStore = (*FileJournalStore)(nil)
+
+// NewFileJournalStore creates an instance of FileJournalStore that stores
+// the journal files under the given directory
+func NewFileJournalStore(dir string) *FileJournalStore {
+	return &FileJournalStore{
+		dir:       dir,
+		highest:   make(map[string]uint64),
+		connected: atomic.NewBool(false),
+	}
+}
+
+// Connect creates the journal directory when it does not exist
+func (s *FileJournalStore) Connect(context.Context) error {
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return err
+	}
+	s.connected.Store(true)
+	return nil
+}
+
+// Disconnect disconnects from the journal store
+func (s *FileJournalStore) Disconnect(context.Context) error {
+	s.mu.Lock()
+	s.highest = make(map[string]uint64)
+	s.mu.Unlock()
+	s.connected.Store(false)
+	return nil
+}
+
+// WriteEvents appends the given events to the journal
+func (s *FileJournalStore) WriteEvents(_ context.Context, events []*Event) error {
+	if !s.connected.Load() {
+		return ErrStoreNotConnected
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// group the events per persistence ID and validate the sequence numbers
+	// before writing anything to disk
+	grouped := make(map[string][]*internalpb.JournalEntry)
+	order := make([]string, 0, 1)
+	next := make(map[string]uint64)
+	for _, event := range events {
+		highest, ok := next[event.PersistenceID]
+		if !ok {
+			var err error
+			if highest, err = s.highestLocked(event.PersistenceID); err != nil {
+				return err
+			}
+			order = append(order, event.PersistenceID)
+		}
+
+		if event.SequenceNumber <= highest {
+			return NewErrSequenceNumberConflict(event.PersistenceID, event.SequenceNumber)
+		}
+		next[event.PersistenceID] = event.SequenceNumber
+
+		payload, err := anypb.New(event.Payload)
+		if err != nil {
+			return err
+		}
+
+		grouped[event.PersistenceID] = append(grouped[event.PersistenceID], &internalpb.JournalEntry{
+			PersistenceId:  event.PersistenceID,
+			SequenceNumber: event.SequenceNumber,
+			Payload:        payload,
+			Timestamp:      timestamppb.New(event.Timestamp),
+		})
+	}
+
+	for _, persistenceID := range order {
+		if err := s.appendEntries(persistenceID, grouped[persistenceID]); err != nil {
+			return err
+		}
+		s.highest[persistenceID] = next[persistenceID]
+	}
+	return nil
+}
+
+// ReplayEvents returns the events of the given persistence ID within the given sequence numbers range
+func (s *FileJournalStore) ReplayEvents(_ context.Context, persistenceID string, fromSequenceNumber, toSequenceNumber, limit uint64) ([]*Event, error) {
+	if !s.connected.Load() {
+		return nil, ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	entries, err := s.readEntries(persistenceID)
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*Event, 0, len(entries))
+	for _, entry := range entries {
+		if entry.GetTombstone() || entry.GetSequenceNumber() < fromSequenceNumber {
+			continue
+		}
+
+		if entry.GetSequenceNumber() > toSequenceNumber {
+			break
+		}
+
+		if limit > 0 && uint64(len(events)) >= limit {
+			break
+		}
+
+		payload, err := entry.GetPayload().UnmarshalNew()
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, &Event{
+			PersistenceID:  entry.GetPersistenceId(),
+			SequenceNumber: entry.GetSequenceNumber(),
+			Payload:        payload,
+			Timestamp:      entry.GetTimestamp().AsTime(),
+		})
+	}
+	return events, nil
+}
+
+// HighestSequenceNumber returns the highest sequence number persisted for the given persistence ID
+func (s *FileJournalStore) HighestSequenceNumber(_ context.Context, persistenceID string) (uint64, error) {
+	if !s.connected.Load() {
+		return 0, ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.highestLocked(persistenceID)
+}
+
+// DeleteEvents removes the events of the given persistence ID up to and including toSequenceNumber
+func (s *FileJournalStore) DeleteEvents(_ context.Context, persistenceID string, toSequenceNumber uint64) error {
+	if !s.connected.Load() {
+		return ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	highest, err := s.highestLocked(persistenceID)
+	if err != nil {
+		return err
+	}
+
+	entries, err := s.readEntries(persistenceID)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]*internalpb.JournalEntry, 0, len(entries)+1)
+	for _, entry := range entries {
+		if !entry.GetTombstone() && entry.GetSequenceNumber() > toSequenceNumber {
+			kept = append(kept, entry)
+		}
+	}
+
+	// keep track of the highest sequence number on disk when the last events are deleted
+	// so that the sequence numbers are not reused after a restart
+	if highest > 0 && (len(kept) == 0 || kept[len(kept)-1].GetSequenceNumber() < highest) {
+		kept = append(kept, &internalpb.JournalEntry{
+			PersistenceId:  persistenceID,
+			SequenceNumber: highest,
+			Timestamp:      timestamppb.Now(),
+			Tombstone:      true,
+		})
+	}
+
+	// rewrite the journal file atomically
+	filename := s.filename(persistenceID)
+	tmp := filename + ".tmp"
+	if err := writeEntries(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, kept); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, filename); err != nil {
+		return err
+	}
+
+	s.highest[persistenceID] = highest
+	return nil
+}
+
+// highestLocked returns the highest sequence number of the given persistence ID.
+// The caller must hold the lock
+func (s *FileJournalStore) highestLocked(persistenceID string) (uint64, error) {
+	if highest, ok := s.highest[persistenceID]; ok {
+		return highest, nil
+	}
+
+	entries, err := s.readEntries(persistenceID)
+	if err != nil {
+		return 0, err
+	}
+
+	var highest uint64
+	if len(entries) > 0 {
+		highest = entries[len(entries)-1].GetSequenceNumber()
+	}
+
+	s.highest[persistenceID] = highest
+	return highest, nil
+}
+
+// appendEntries appends the given entries to the journal file of the given persistence ID
+func (s *FileJournalStore) appendEntries(persistenceID string, entries []*internalpb.JournalEntry) error {
+	return writeEntries(s.filename(persistenceID), os.O_CREATE|os.O_APPEND|os.O_WRONLY, entries)
+}
+
+// readEntries reads all the entries of the journal file of the given persistence ID.
+// A record torn by a crash in the middle of a write is dropped from the end of the file.
+// The caller must hold the lock
+func (s *FileJournalStore) readEntries(persistenceID string) ([]*internalpb.JournalEntry, error) {
+	filename := s.filename(persistenceID)
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	reader := bytes.NewReader(data)
+	var entries []*internalpb.JournalEntry
+	for {
+		offset := reader.Size() - int64(reader.Len())
+		entry := new(internalpb.JournalEntry)
+		if err := protodelim.UnmarshalFrom(reader, entry); err != nil {
+			switch {
+			case errors.Is(err, io.EOF):
+				return entries, nil
+			case errors.Is(err, io.ErrUnexpectedEOF):
+				// truncate the torn record so that the next writes are appended after the last complete entry
+				if err := os.Truncate(filename, offset); err != nil {
+					return nil, err
+				}
+				return entries, nil
+			default:
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// filename returns the journal file name of the given persistence ID
+func (s *FileJournalStore) filename(persistenceID string) string {
+	return filepath.Join(s.dir, encodeFilename(persistenceID)+".journal")
+}
+
+// writeEntries writes the given entries into the named file and flushes them to disk
+func writeEntries(filename string, flag int, entries []*internalpb.JournalEntry) error {
+	file, err := os.OpenFile(filename, flag, 0o640)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	for _, entry := range entries {
+		if _, err := protodelim.MarshalTo(writer, entry); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// encodeFilename encodes the persistence ID into a file system safe name
+func encodeFilename(persistenceID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(persistenceID))
+}
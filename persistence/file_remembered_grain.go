@@ -0,0 +1,216 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tochemey/goakt/v3/internal/internalpb"
+)
+
+// rememberedGrainExt is the extension of the remembered grain files
+const rememberedGrainExt = ".grain"
+
+// FileRememberedGrainStore is a local-file implementation of RememberedGrainStore.
+//
+// Every recorded grain is stored in its own file under the configured directory.
+// The file is written atomically so that a crash never leaves it partially written.
+// The directory must be shared by all the nodes of the cluster, for instance using a network file system.
+type FileRememberedGrainStore struct {
+	dir       string
+	mu        sync.Mutex
+	connected *atomic.Bool
+}
+
+// enforce compilation error
+var _ RememberedGrainStore = (*FileRememberedGrainStore)(nil)
+
+// NewFileRememberedGrainStore creates an instance of FileRememberedGrainStore that stores
+// the grain files under the given directory
+func NewFileRememberedGrainStore(dir string) *FileRememberedGrainStore {
+	return &FileRememberedGrainStore{
+		dir:       dir,
+		connected: atomic.NewBool(false),
+	}
+}
+
+// Connect creates the grains directory when it does not exist
+func (s *FileRememberedGrainStore) Connect(context.Context) error {
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return err
+	}
+	s.connected.Store(true)
+	return nil
+}
+
+// Disconnect disconnects from the remembered grain store
+func (s *FileRememberedGrainStore) Disconnect(context.Context) error {
+	s.connected.Store(false)
+	return nil
+}
+
+// RememberGrain records the given grain
+func (s *FileRememberedGrainStore) RememberGrain(_ context.Context, grain *RememberedGrain) error {
+	if !s.connected.Load() {
+		return ErrStoreNotConnected
+	}
+
+	dependencies := make([]*internalpb.Dependency, 0, len(grain.Dependencies))
+	for _, dependency := range grain.Dependencies {
+		dependencies = append(dependencies, &internalpb.Dependency{
+			Id:       dependency.ID,
+			TypeName: dependency.TypeName,
+			Bytea:    dependency.Bytea,
+		})
+	}
+
+	bytea, err := proto.Marshal(&internalpb.RememberedGrainEntry{
+		GrainId:           grain.ID,
+		Kind:              grain.Kind,
+		Name:              grain.Name,
+		ActivationTimeout: durationpb.New(grain.ActivationTimeout),
+		ActivationRetries: grain.ActivationRetries,
+		Timestamp:         timestamppb.New(grain.Timestamp),
+		Role:              grain.Role,
+		Dependencies:      dependencies,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	filename := s.filename(grain.ID)
+	tmp := filename + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(bytea); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filename)
+}
+
+// ForgetGrain removes the given grain
+func (s *FileRememberedGrainStore) ForgetGrain(_ context.Context, grainID string) error {
+	if !s.connected.Load() {
+		return ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.filename(grainID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// RememberedGrains returns all the recorded grains
+func (s *FileRememberedGrainStore) RememberedGrains(context.Context) ([]*RememberedGrain, error) {
+	if !s.connected.Load() {
+		return nil, ErrStoreNotConnected
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	grains := make([]*RememberedGrain, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != rememberedGrainExt {
+			continue
+		}
+
+		bytea, err := os.ReadFile(filepath.Join(s.dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		entry := new(internalpb.RememberedGrainEntry)
+		if err := proto.Unmarshal(bytea, entry); err != nil {
+			return nil, err
+		}
+
+		dependencies := make([]*GrainDependency, 0, len(entry.GetDependencies()))
+		for _, dependency := range entry.GetDependencies() {
+			dependencies = append(dependencies, &GrainDependency{
+				ID:       dependency.GetId(),
+				TypeName: dependency.GetTypeName(),
+				Bytea:    dependency.GetBytea(),
+			})
+		}
+
+		grains = append(grains, &RememberedGrain{
+			ID:                entry.GetGrainId(),
+			Kind:              entry.GetKind(),
+			Name:              entry.GetName(),
+			ActivationTimeout: entry.GetActivationTimeout().AsDuration(),
+			ActivationRetries: entry.GetActivationRetries(),
+			Role:              entry.GetRole(),
+			Dependencies:      dependencies,
+			Timestamp:         entry.GetTimestamp().AsTime(),
+		})
+	}
+
+	slices.SortFunc(grains, func(a, b *RememberedGrain) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return grains, nil
+}
+
+// filename returns the file name of the given grain
+func (s *FileRememberedGrainStore) filename(grainID string) string {
+	return filepath.Join(s.dir, encodeFilename(grainID)+rememberedGrainExt)
+}
@@ -0,0 +1,87 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package persistence defines the storage contracts used by persistent actors
+// together with in-memory and local-file implementations.
+//
+// A persistent actor records every state change as an event in a JournalStore.
+// When the actor starts, restarts or is relocated to another node, the events are
+// replayed to rebuild its state. A SnapshotStore can be used to periodically save
+// the actor state so that recovery does not need to replay the whole journal.
+//
+// Actors and grains that do not need event sourcing can use a DurableStateStore
+// to load their latest state on activation and save it on change.
+//
+// Actors sending messages with at-least-once delivery can use a DeliveryStore to keep track
+// of the messages that have not been confirmed yet, so that they are redelivered after a restart
+// or a relocation.
+//
+// Clusters can use a RememberedGrainStore to record their long-lived grains, so that
+// the grains are reactivated when the whole cluster is restarted.
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Event defines a single event emitted by a persistent actor
+type Event struct {
+	// PersistenceID is the unique identifier of the actor that emitted the event
+	PersistenceID string
+	// SequenceNumber is the position of the event in the actor's journal.
+	// Sequence numbers start at 1 and are strictly increasing.
+	SequenceNumber uint64
+	// Payload is the actual event
+	Payload proto.Message
+	// Timestamp is the time the event was persisted
+	Timestamp time.Time
+}
+
+// JournalStore defines the contract of an event journal.
+//
+// The journal is an append-only log of events keyed by persistence ID.
+// Implementations must be safe for concurrent use. In cluster mode all nodes
+// must share the same journal so that relocated actors can rebuild their state.
+type JournalStore interface {
+	// Connect connects to the journal store.
+	// It is called once when the actor system starts.
+	Connect(ctx context.Context) error
+	// Disconnect disconnects from the journal store.
+	// It is called once when the actor system stops.
+	Disconnect(ctx context.Context) error
+	// WriteEvents atomically appends the given events to the journal
+	WriteEvents(ctx context.Context, events []*Event) error
+	// ReplayEvents returns the events of the given persistence ID whose sequence numbers
+	// are within [fromSequenceNumber, toSequenceNumber], ordered by sequence number.
+	// When limit is greater than zero, at most limit events are returned.
+	ReplayEvents(ctx context.Context, persistenceID string, fromSequenceNumber, toSequenceNumber, limit uint64) ([]*Event, error)
+	// HighestSequenceNumber returns the highest sequence number persisted for the given persistence ID.
+	// It returns zero when no event has been persisted.
+	HighestSequenceNumber(ctx context.Context, persistenceID string) (uint64, error)
+	// DeleteEvents removes all the events of the given persistence ID up to and including toSequenceNumber
+	DeleteEvents(ctx context.Context, persistenceID string, toSequenceNumber uint64) error
+}
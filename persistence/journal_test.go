@@ -0,0 +1,248 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestJournalStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) JournalStore{
+		"memory": func(*testing.T) JournalStore { return NewMemoryJournalStore() },
+		"file":   func(t *testing.T) JournalStore { return NewFileJournalStore(t.TempDir()) },
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("With events written and replayed", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				events := newEvents("account", 1, 5)
+				require.NoError(t, store.WriteEvents(ctx, events[:2]))
+				require.NoError(t, store.WriteEvents(ctx, events[2:]))
+
+				highest, err := store.HighestSequenceNumber(ctx, "account")
+				require.NoError(t, err)
+				assert.EqualValues(t, 5, highest)
+
+				replayed, err := store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+				require.NoError(t, err)
+				require.Len(t, replayed, 5)
+				for index, event := range replayed {
+					assert.EqualValues(t, index+1, event.SequenceNumber)
+					assert.True(t, proto.Equal(events[index].Payload, event.Payload))
+				}
+
+				replayed, err = store.ReplayEvents(ctx, "account", 2, 4, 0)
+				require.NoError(t, err)
+				require.Len(t, replayed, 3)
+				assert.EqualValues(t, 2, replayed[0].SequenceNumber)
+				assert.EqualValues(t, 4, replayed[2].SequenceNumber)
+
+				replayed, err = store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 2)
+				require.NoError(t, err)
+				assert.Len(t, replayed, 2)
+
+				replayed, err = store.ReplayEvents(ctx, "unknown", 1, math.MaxUint64, 0)
+				require.NoError(t, err)
+				assert.Empty(t, replayed)
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+			t.Run("With sequence number conflict", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				require.NoError(t, store.WriteEvents(ctx, newEvents("account", 1, 2)))
+
+				// the whole batch is rejected
+				batch := append(newEvents("account", 3, 3), newEvents("account", 2, 2)...)
+				err := store.WriteEvents(ctx, batch)
+				require.ErrorIs(t, err, ErrSequenceNumberConflict)
+
+				highest, err := store.HighestSequenceNumber(ctx, "account")
+				require.NoError(t, err)
+				assert.EqualValues(t, 2, highest)
+
+				replayed, err := store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+				require.NoError(t, err)
+				assert.Len(t, replayed, 2)
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+			t.Run("With events deleted", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				require.NoError(t, store.WriteEvents(ctx, newEvents("account", 1, 4)))
+				require.NoError(t, store.DeleteEvents(ctx, "account", 3))
+
+				replayed, err := store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+				require.NoError(t, err)
+				require.Len(t, replayed, 1)
+				assert.EqualValues(t, 4, replayed[0].SequenceNumber)
+
+				require.NoError(t, store.DeleteEvents(ctx, "account", 4))
+				replayed, err = store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+				require.NoError(t, err)
+				assert.Empty(t, replayed)
+
+				// the highest sequence number is kept
+				highest, err := store.HighestSequenceNumber(ctx, "account")
+				require.NoError(t, err)
+				assert.EqualValues(t, 4, highest)
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+		})
+	}
+
+	t.Run("With file journal reopened", func(t *testing.T) {
+		ctx := context.TODO()
+		dir := t.TempDir()
+		store := NewFileJournalStore(dir)
+		require.NoError(t, store.Connect(ctx))
+		require.NoError(t, store.WriteEvents(ctx, newEvents("account", 1, 3)))
+		require.NoError(t, store.Disconnect(ctx))
+
+		store = NewFileJournalStore(dir)
+		require.NoError(t, store.Connect(ctx))
+
+		highest, err := store.HighestSequenceNumber(ctx, "account")
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, highest)
+
+		replayed, err := store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+		require.NoError(t, err)
+		assert.Len(t, replayed, 3)
+
+		require.NoError(t, store.Disconnect(ctx))
+	})
+	t.Run("With file journal reopened after the events are deleted", func(t *testing.T) {
+		ctx := context.TODO()
+		dir := t.TempDir()
+		store := NewFileJournalStore(dir)
+		require.NoError(t, store.Connect(ctx))
+		require.NoError(t, store.WriteEvents(ctx, newEvents("account", 1, 3)))
+		require.NoError(t, store.DeleteEvents(ctx, "account", 3))
+		require.NoError(t, store.Disconnect(ctx))
+
+		store = NewFileJournalStore(dir)
+		require.NoError(t, store.Connect(ctx))
+
+		highest, err := store.HighestSequenceNumber(ctx, "account")
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, highest)
+
+		replayed, err := store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+		require.NoError(t, err)
+		assert.Empty(t, replayed)
+
+		err = store.WriteEvents(ctx, newEvents("account", 3, 3))
+		require.Error(t, err)
+		require.NoError(t, store.WriteEvents(ctx, newEvents("account", 4, 4)))
+
+		replayed, err = store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+		require.NoError(t, err)
+		require.Len(t, replayed, 1)
+		assert.EqualValues(t, 4, replayed[0].SequenceNumber)
+
+		require.NoError(t, store.Disconnect(ctx))
+	})
+	t.Run("With file journal having a torn trailing record", func(t *testing.T) {
+		ctx := context.TODO()
+		dir := t.TempDir()
+		store := NewFileJournalStore(dir)
+		require.NoError(t, store.Connect(ctx))
+		require.NoError(t, store.WriteEvents(ctx, newEvents("account", 1, 3)))
+		require.NoError(t, store.Disconnect(ctx))
+
+		// simulate a crash in the middle of the last write
+		filename := store.filename("account")
+		info, err := os.Stat(filename)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(filename, info.Size()-2))
+
+		store = NewFileJournalStore(dir)
+		require.NoError(t, store.Connect(ctx))
+
+		highest, err := store.HighestSequenceNumber(ctx, "account")
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, highest)
+
+		require.NoError(t, store.WriteEvents(ctx, newEvents("account", 3, 3)))
+		replayed, err := store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+		require.NoError(t, err)
+		assert.Len(t, replayed, 3)
+
+		require.NoError(t, store.Disconnect(ctx))
+	})
+	t.Run("With file journal not connected", func(t *testing.T) {
+		ctx := context.TODO()
+		store := NewFileJournalStore(t.TempDir())
+
+		err := store.WriteEvents(ctx, newEvents("account", 1, 1))
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		_, err = store.ReplayEvents(ctx, "account", 1, math.MaxUint64, 0)
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		_, err = store.HighestSequenceNumber(ctx, "account")
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		err = store.DeleteEvents(ctx, "account", 1)
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+	})
+}
+
+func newEvents(persistenceID string, from, to uint64) []*Event {
+	events := make([]*Event, 0, to-from+1)
+	for sequenceNumber := from; sequenceNumber <= to; sequenceNumber++ {
+		events = append(events, &Event{
+			PersistenceID:  persistenceID,
+			SequenceNumber: sequenceNumber,
+			Payload: &testpb.AccountCredited{
+				AccountId:      persistenceID,
+				AccountBalance: float64(sequenceNumber),
+			},
+			Timestamp: time.Now().UTC(),
+		})
+	}
+	return events
+}
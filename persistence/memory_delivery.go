@@ -0,0 +1,111 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// MemoryDeliveryStore is an in-memory implementation of DeliveryStore.
+//
+// It is meant for testing and single-node deployments where losing the unconfirmed
+// deliveries on process exit is acceptable.
+type MemoryDeliveryStore struct {
+	mu        sync.RWMutex
+	producers map[string]*memoryDeliveries
+}
+
+// memoryDeliveries holds the deliveries of a given producer
+type memoryDeliveries struct {
+	sequenceNumber uint64
+	deliveries     []*Delivery
+}
+
+// enforce compilation error
+var _ DeliveryStore = (*MemoryDeliveryStore)(nil)
+
+// NewMemoryDeliveryStore creates an instance of MemoryDeliveryStore
+func NewMemoryDeliveryStore() *MemoryDeliveryStore {
+	return &MemoryDeliveryStore{
+		producers: make(map[string]*memoryDeliveries),
+	}
+}
+
+// Connect connects to the delivery store
+func (s *MemoryDeliveryStore) Connect(context.Context) error {
+	return nil
+}
+
+// Disconnect disconnects from the delivery store
+func (s *MemoryDeliveryStore) Disconnect(context.Context) error {
+	return nil
+}
+
+// WriteDelivery records the given delivery as unconfirmed
+func (s *MemoryDeliveryStore) WriteDelivery(_ context.Context, delivery *Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	producer, ok := s.producers[delivery.ProducerID]
+	if !ok {
+		producer = new(memoryDeliveries)
+		s.producers[delivery.ProducerID] = producer
+	}
+
+	if delivery.SequenceNumber <= producer.sequenceNumber {
+		return NewErrSequenceNumberConflict(delivery.ProducerID, delivery.SequenceNumber)
+	}
+
+	producer.sequenceNumber = delivery.SequenceNumber
+	producer.deliveries = append(producer.deliveries, delivery)
+	return nil
+}
+
+// ConfirmDelivery removes the given delivery
+func (s *MemoryDeliveryStore) ConfirmDelivery(_ context.Context, producerID string, sequenceNumber uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if producer, ok := s.producers[producerID]; ok {
+		producer.deliveries = slices.DeleteFunc(producer.deliveries, func(delivery *Delivery) bool {
+			return delivery.SequenceNumber == sequenceNumber
+		})
+	}
+	return nil
+}
+
+// GetDeliveries returns the highest sequence number and the unconfirmed deliveries of the given producer
+func (s *MemoryDeliveryStore) GetDeliveries(_ context.Context, producerID string) (uint64, []*Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	producer, ok := s.producers[producerID]
+	if !ok {
+		return 0, nil, nil
+	}
+	return producer.sequenceNumber, slices.Clone(producer.deliveries), nil
+}
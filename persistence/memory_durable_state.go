@@ -0,0 +1,108 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryDurableStateStore is an in-memory implementation of DurableStateStore.
+//
+// It is meant for testing and single-node deployments where losing the states
+// on process exit is acceptable.
+type MemoryDurableStateStore struct {
+	mu     sync.RWMutex
+	states map[string]*DurableState
+}
+
+// enforce compilation error
+var _ DurableStateStore = (*MemoryDurableStateStore)(nil)
+
+// NewMemoryDurableStateStore creates an instance of MemoryDurableStateStore
+func NewMemoryDurableStateStore() *MemoryDurableStateStore {
+	return &MemoryDurableStateStore{
+		states: make(map[string]*DurableState),
+	}
+}
+
+// ID returns the extension ID of the durable state store
+func (s *MemoryDurableStateStore) ID() string {
+	return DurableStateStoreID
+}
+
+// Connect connects to the durable state store
+func (s *MemoryDurableStateStore) Connect(context.Context) error {
+	return nil
+}
+
+// Disconnect disconnects from the durable state store
+func (s *MemoryDurableStateStore) Disconnect(context.Context) error {
+	return nil
+}
+
+// GetState returns the latest state of the given persistence ID
+func (s *MemoryDurableStateStore) GetState(_ context.Context, persistenceID string) (*DurableState, error) {
+	s.mu.RLock()
+	state := s.states[persistenceID]
+	s.mu.RUnlock()
+	return state, nil
+}
+
+// UpsertState writes the given state
+func (s *MemoryDurableStateStore) UpsertState(_ context.Context, state *DurableState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var revision uint64
+	if current, ok := s.states[state.PersistenceID]; ok {
+		revision = current.Revision
+	}
+
+	if state.Revision != revision+1 {
+		return NewErrRevisionConflict(state.PersistenceID, state.Revision)
+	}
+
+	s.states[state.PersistenceID] = state
+	return nil
+}
+
+// DeleteState removes the state of the given persistence ID
+func (s *MemoryDurableStateStore) DeleteState(_ context.Context, persistenceID string, revision uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.states[persistenceID]
+	if !ok {
+		return nil
+	}
+
+	if current.Revision != revision {
+		return NewErrRevisionConflict(persistenceID, revision)
+	}
+
+	delete(s.states, persistenceID)
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// MemoryJournalStore is an in-memory implementation of JournalStore.
+//
+// It is meant for testing and single-node deployments where losing the events
+// on process exit is acceptable.
+type MemoryJournalStore struct {
+	mu     sync.RWMutex
+	events map[string][]*Event
+	// highest keeps track of the highest sequence number per persistence ID
+	// even when the events have been deleted
+	highest map[string]uint64
+}
+
+// enforce compilation error
+var _ JournalStore = (*MemoryJournalStore)(nil)
+
+// NewMemoryJournalStore creates an instance of MemoryJournalStore
+func NewMemoryJournalStore() *MemoryJournalStore {
+	return &MemoryJournalStore{
+		events:  make(map[string][]*Event),
+		highest: make(map[string]uint64),
+	}
+}
+
+// Connect connects to the journal store
+func (s *MemoryJournalStore) Connect(context.Context) error {
+	return nil
+}
+
+// Disconnect disconnects from the journal store
+func (s *MemoryJournalStore) Disconnect(context.Context) error {
+	return nil
+}
+
+// WriteEvents appends the given events to the journal
+func (s *MemoryJournalStore) WriteEvents(_ context.Context, events []*Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// validate the whole batch before writing anything
+	next := make(map[string]uint64)
+	for _, event := range events {
+		highest, ok := next[event.PersistenceID]
+		if !ok {
+			highest = s.highest[event.PersistenceID]
+		}
+
+		if event.SequenceNumber <= highest {
+			return NewErrSequenceNumberConflict(event.PersistenceID, event.SequenceNumber)
+		}
+		next[event.PersistenceID] = event.SequenceNumber
+	}
+
+	for _, event := range events {
+		s.events[event.PersistenceID] = append(s.events[event.PersistenceID], event)
+	}
+
+	for persistenceID, highest := range next {
+		s.highest[persistenceID] = highest
+	}
+	return nil
+}
+
+// ReplayEvents returns the events of the given persistence ID within the given sequence numbers range
+func (s *MemoryJournalStore) ReplayEvents(_ context.Context, persistenceID string, fromSequenceNumber, toSequenceNumber, limit uint64) ([]*Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	events := s.events[persistenceID]
+	start := sort.Search(len(events), func(i int) bool {
+		return events[i].SequenceNumber >= fromSequenceNumber
+	})
+
+	result := make([]*Event, 0, len(events)-start)
+	for _, event := range events[start:] {
+		if event.SequenceNumber > toSequenceNumber {
+			break
+		}
+		if limit > 0 && uint64(len(result)) >= limit {
+			break
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+// HighestSequenceNumber returns the highest sequence number persisted for the given persistence ID
+func (s *MemoryJournalStore) HighestSequenceNumber(_ context.Context, persistenceID string) (uint64, error) {
+	s.mu.RLock()
+	highest := s.highest[persistenceID]
+	s.mu.RUnlock()
+	return highest, nil
+}
+
+// DeleteEvents removes the events of the given persistence ID up to and including toSequenceNumber
+func (s *MemoryJournalStore) DeleteEvents(_ context.Context, persistenceID string, toSequenceNumber uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := s.events[persistenceID]
+	start := sort.Search(len(events), func(i int) bool {
+		return events[i].SequenceNumber > toSequenceNumber
+	})
+
+	if start >= len(events) {
+		delete(s.events, persistenceID)
+		return nil
+	}
+
+	s.events[persistenceID] = append([]*Event(nil), events[start:]...)
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// MemoryRememberedGrainStore is an in-memory implementation of RememberedGrainStore.
+//
+// It is meant for testing since the recorded grains do not survive the process exit.
+type MemoryRememberedGrainStore struct {
+	mu     sync.RWMutex
+	grains map[string]*RememberedGrain
+}
+
+// enforce compilation error
+var _ RememberedGrainStore = (*MemoryRememberedGrainStore)(nil)
+
+// NewMemoryRememberedGrainStore creates an instance of MemoryRememberedGrainStore
+func NewMemoryRememberedGrainStore() *MemoryRememberedGrainStore {
+	return &MemoryRememberedGrainStore{
+		grains: make(map[string]*RememberedGrain),
+	}
+}
+
+// Connect connects to the remembered grain store
+func (s *MemoryRememberedGrainStore) Connect(context.Context) error {
+	return nil
+}
+
+// Disconnect disconnects from the remembered grain store
+func (s *MemoryRememberedGrainStore) Disconnect(context.Context) error {
+	return nil
+}
+
+// RememberGrain records the given grain
+func (s *MemoryRememberedGrainStore) RememberGrain(_ context.Context, grain *RememberedGrain) error {
+	s.mu.Lock()
+	s.grains[grain.ID] = grain
+	s.mu.Unlock()
+	return nil
+}
+
+// ForgetGrain removes the given grain
+func (s *MemoryRememberedGrainStore) ForgetGrain(_ context.Context, grainID string) error {
+	s.mu.Lock()
+	delete(s.grains, grainID)
+	s.mu.Unlock()
+	return nil
+}
+
+// RememberedGrains returns all the recorded grains
+func (s *MemoryRememberedGrainStore) RememberedGrains(context.Context) ([]*RememberedGrain, error) {
+	s.mu.RLock()
+	grains := make([]*RememberedGrain, 0, len(s.grains))
+	for _, grain := range s.grains {
+		grains = append(grains, grain)
+	}
+	s.mu.RUnlock()
+
+	slices.SortFunc(grains, func(a, b *RememberedGrain) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return grains, nil
+}
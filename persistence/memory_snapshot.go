@@ -0,0 +1,90 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"sync"
+)
+
+// MemorySnapshotStore is an in-memory implementation of SnapshotStore.
+//
+// Only the latest snapshot of every persistence ID is kept.
+type MemorySnapshotStore struct {
+	mu        sync.RWMutex
+	snapshots map[string]*Snapshot
+}
+
+// enforce compilation error
+var _ SnapshotStore = (*MemorySnapshotStore)(nil)
+
+// NewMemorySnapshotStore creates an instance of MemorySnapshotStore
+func NewMemorySnapshotStore() *MemorySnapshotStore {
+	return &MemorySnapshotStore{
+		snapshots: make(map[string]*Snapshot),
+	}
+}
+
+// Connect connects to the snapshot store
+func (s *MemorySnapshotStore) Connect(context.Context) error {
+	return nil
+}
+
+// Disconnect disconnects from the snapshot store
+func (s *MemorySnapshotStore) Disconnect(context.Context) error {
+	return nil
+}
+
+// WriteSnapshot persists the given snapshot
+func (s *MemorySnapshotStore) WriteSnapshot(_ context.Context, snapshot *Snapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if current, ok := s.snapshots[snapshot.PersistenceID]; ok && current.SequenceNumber > snapshot.SequenceNumber {
+		return nil
+	}
+
+	s.snapshots[snapshot.PersistenceID] = snapshot
+	return nil
+}
+
+// LatestSnapshot returns the most recent snapshot of the given persistence ID
+func (s *MemorySnapshotStore) LatestSnapshot(_ context.Context, persistenceID string) (*Snapshot, error) {
+	s.mu.RLock()
+	snapshot := s.snapshots[persistenceID]
+	s.mu.RUnlock()
+	return snapshot, nil
+}
+
+// DeleteSnapshots removes the snapshots of the given persistence ID up to and including toSequenceNumber
+func (s *MemorySnapshotStore) DeleteSnapshots(_ context.Context, persistenceID string, toSequenceNumber uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if current, ok := s.snapshots[persistenceID]; ok && current.SequenceNumber <= toSequenceNumber {
+		delete(s.snapshots, persistenceID)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"time"
+)
+
+// RememberedGrain defines a long-lived grain that must be reactivated when the cluster restarts
+type RememberedGrain struct {
+	// ID is the unique identifier of the grain in the form kind/name
+	ID string
+	// Kind is the grain kind
+	Kind string
+	// Name is the grain name
+	Name string
+	// ActivationTimeout is the timeout used when reactivating the grain
+	ActivationTimeout time.Duration
+	// ActivationRetries is the number of retries used when reactivating the grain
+	ActivationRetries int32
+	// Role is the cluster node role required to host the grain. It is empty when any node can host it
+	Role string
+	// Dependencies are the serialized dependencies of the grain
+	Dependencies []*GrainDependency
+	// Timestamp is the time the grain was recorded
+	Timestamp time.Time
+}
+
+// GrainDependency defines a serialized dependency of a remembered grain
+type GrainDependency struct {
+	// ID is the dependency unique identifier
+	ID string
+	// TypeName is the registered type name of the dependency
+	TypeName string
+	// Bytea is the binary representation of the dependency
+	Bytea []byte
+}
+
+// RememberedGrainStore defines the contract of the store used to record the long-lived grains
+// that are active in the cluster.
+//
+// A grain is recorded when it is activated and removed when it is deactivated, except when
+// the deactivation is caused by its node shutting down. Every node reactivates the recorded grains
+// whose partition it owns once it has started and whenever a node joins the cluster.
+//
+// Implementations must be safe for concurrent use. All nodes of the cluster must share the same store.
+type RememberedGrainStore interface {
+	// Connect connects to the remembered grain store.
+	// It is called once when the actor system starts.
+	Connect(ctx context.Context) error
+	// Disconnect disconnects from the remembered grain store.
+	// It is called once when the actor system stops.
+	Disconnect(ctx context.Context) error
+	// RememberGrain records the given grain. Recording a grain that is already recorded replaces it.
+	RememberGrain(ctx context.Context, grain *RememberedGrain) error
+	// ForgetGrain removes the given grain. Forgetting an unknown grain is a no-op.
+	ForgetGrain(ctx context.Context, grainID string) error
+	// RememberedGrains returns all the recorded grains ordered by ID.
+	RememberedGrains(ctx context.Context) ([]*RememberedGrain, error)
+}
@@ -0,0 +1,128 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRememberedGrainStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) RememberedGrainStore{
+		"memory": func(*testing.T) RememberedGrainStore { return NewMemoryRememberedGrainStore() },
+		"file":   func(t *testing.T) RememberedGrainStore { return NewFileRememberedGrainStore(t.TempDir()) },
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("With grains remembered and forgotten", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				grains, err := store.RememberedGrains(ctx)
+				require.NoError(t, err)
+				assert.Empty(t, grains)
+
+				require.NoError(t, store.RememberGrain(ctx, newRememberedGrain("session", "device-2")))
+				require.NoError(t, store.RememberGrain(ctx, newRememberedGrain("session", "device-1")))
+				require.NoError(t, store.RememberGrain(ctx, newRememberedGrain("session", "device-3")))
+				// remembering a grain twice replaces it
+				grain := newRememberedGrain("session", "device-3")
+				grain.ActivationRetries = 10
+				grain.Role = "edge"
+				grain.Dependencies = []*GrainDependency{{ID: "dep", TypeName: "dependency", Bytea: []byte("state")}}
+				require.NoError(t, store.RememberGrain(ctx, grain))
+
+				require.NoError(t, store.ForgetGrain(ctx, "session/device-2"))
+				// forgetting an unknown grain is a no-op
+				require.NoError(t, store.ForgetGrain(ctx, "session/unknown"))
+
+				grains, err = store.RememberedGrains(ctx)
+				require.NoError(t, err)
+				require.Len(t, grains, 2)
+				assert.Equal(t, "session/device-1", grains[0].ID)
+				assert.Equal(t, "session/device-3", grains[1].ID)
+				assert.Equal(t, "session", grains[1].Kind)
+				assert.Equal(t, "device-3", grains[1].Name)
+				assert.Equal(t, time.Second, grains[1].ActivationTimeout)
+				assert.EqualValues(t, 10, grains[1].ActivationRetries)
+				assert.Equal(t, "edge", grains[1].Role)
+				assert.Equal(t, grain.Dependencies, grains[1].Dependencies)
+				assert.Empty(t, grains[0].Role)
+				assert.Empty(t, grains[0].Dependencies)
+				assert.True(t, grain.Timestamp.Equal(grains[1].Timestamp))
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+		})
+	}
+
+	t.Run("With file remembered grain store reconnected", func(t *testing.T) {
+		ctx := context.TODO()
+		dir := t.TempDir()
+		store := NewFileRememberedGrainStore(dir)
+		require.NoError(t, store.Connect(ctx))
+		require.NoError(t, store.RememberGrain(ctx, newRememberedGrain("session", "device-1")))
+		require.NoError(t, store.Disconnect(ctx))
+
+		store = NewFileRememberedGrainStore(dir)
+		require.NoError(t, store.Connect(ctx))
+		grains, err := store.RememberedGrains(ctx)
+		require.NoError(t, err)
+		require.Len(t, grains, 1)
+		assert.Equal(t, "session/device-1", grains[0].ID)
+		require.NoError(t, store.Disconnect(ctx))
+	})
+
+	t.Run("With file remembered grain store not connected", func(t *testing.T) {
+		ctx := context.TODO()
+		store := NewFileRememberedGrainStore(t.TempDir())
+
+		err := store.RememberGrain(ctx, newRememberedGrain("session", "device-1"))
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		err = store.ForgetGrain(ctx, "session/device-1")
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		_, err = store.RememberedGrains(ctx)
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+	})
+}
+
+func newRememberedGrain(kind, name string) *RememberedGrain {
+	return &RememberedGrain{
+		ID:                kind + "/" + name,
+		Kind:              kind,
+		Name:              name,
+		ActivationTimeout: time.Second,
+		ActivationRetries: 5,
+		Timestamp:         time.Now().UTC(),
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Snapshot defines the state of a persistent actor at a given sequence number
+type Snapshot struct {
+	// PersistenceID is the unique identifier of the actor
+	PersistenceID string
+	// SequenceNumber is the sequence number of the last event included in the snapshot
+	SequenceNumber uint64
+	// State is the actor state
+	State proto.Message
+	// Timestamp is the time the snapshot was taken
+	Timestamp time.Time
+}
+
+// SnapshotStore defines the contract of a snapshot store.
+//
+// Implementations must be safe for concurrent use. In cluster mode all nodes
+// must share the same snapshot store so that relocated actors can rebuild their state.
+type SnapshotStore interface {
+	// Connect connects to the snapshot store.
+	// It is called once when the actor system starts.
+	Connect(ctx context.Context) error
+	// Disconnect disconnects from the snapshot store.
+	// It is called once when the actor system stops.
+	Disconnect(ctx context.Context) error
+	// WriteSnapshot persists the given snapshot
+	WriteSnapshot(ctx context.Context, snapshot *Snapshot) error
+	// LatestSnapshot returns the most recent snapshot of the given persistence ID.
+	// It returns nil when no snapshot exists.
+	LatestSnapshot(ctx context.Context, persistenceID string) (*Snapshot, error)
+	// DeleteSnapshots removes all the snapshots of the given persistence ID
+	// whose sequence numbers are less than or equal to toSequenceNumber
+	DeleteSnapshots(ctx context.Context, persistenceID string, toSequenceNumber uint64) error
+}
@@ -0,0 +1,116 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+func TestSnapshotStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) SnapshotStore{
+		"memory": func(*testing.T) SnapshotStore { return NewMemorySnapshotStore() },
+		"file":   func(t *testing.T) SnapshotStore { return NewFileSnapshotStore(t.TempDir()) },
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("With latest snapshot", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				snapshot, err := store.LatestSnapshot(ctx, "account")
+				require.NoError(t, err)
+				assert.Nil(t, snapshot)
+
+				require.NoError(t, store.WriteSnapshot(ctx, newSnapshot("account", 2)))
+				require.NoError(t, store.WriteSnapshot(ctx, newSnapshot("account", 4)))
+				// an older snapshot does not override the latest one
+				require.NoError(t, store.WriteSnapshot(ctx, newSnapshot("account", 3)))
+
+				snapshot, err = store.LatestSnapshot(ctx, "account")
+				require.NoError(t, err)
+				require.NotNil(t, snapshot)
+				assert.EqualValues(t, 4, snapshot.SequenceNumber)
+				assert.True(t, proto.Equal(newSnapshot("account", 4).State, snapshot.State))
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+			t.Run("With snapshots deleted", func(t *testing.T) {
+				ctx := context.TODO()
+				store := newStore(t)
+				require.NoError(t, store.Connect(ctx))
+
+				require.NoError(t, store.WriteSnapshot(ctx, newSnapshot("account", 4)))
+
+				require.NoError(t, store.DeleteSnapshots(ctx, "account", 3))
+				snapshot, err := store.LatestSnapshot(ctx, "account")
+				require.NoError(t, err)
+				assert.NotNil(t, snapshot)
+
+				require.NoError(t, store.DeleteSnapshots(ctx, "account", 4))
+				snapshot, err = store.LatestSnapshot(ctx, "account")
+				require.NoError(t, err)
+				assert.Nil(t, snapshot)
+
+				require.NoError(t, store.Disconnect(ctx))
+			})
+		})
+	}
+
+	t.Run("With file snapshot store not connected", func(t *testing.T) {
+		ctx := context.TODO()
+		store := NewFileSnapshotStore(t.TempDir())
+
+		err := store.WriteSnapshot(ctx, newSnapshot("account", 1))
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		_, err = store.LatestSnapshot(ctx, "account")
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+
+		err = store.DeleteSnapshots(ctx, "account", 1)
+		require.ErrorIs(t, err, ErrStoreNotConnected)
+	})
+}
+
+func newSnapshot(persistenceID string, sequenceNumber uint64) *Snapshot {
+	return &Snapshot{
+		PersistenceID:  persistenceID,
+		SequenceNumber: sequenceNumber,
+		State: &testpb.Account{
+			AccountId:      persistenceID,
+			AccountBalance: float64(sequenceNumber * 10),
+		},
+		Timestamp: time.Now().UTC(),
+	}
+}
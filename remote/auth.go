@@ -0,0 +1,401 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package remote
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidToken is returned when the bearer token of a remoting request cannot be verified
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTokenExpired is returned when the bearer token of a remoting request has expired
+	ErrTokenExpired = errors.New("token expired")
+	// ErrInvalidKey is returned when the key given to the JWTAuthenticator cannot safely verify tokens
+	ErrInvalidKey = errors.New("invalid key")
+)
+
+// minHMACKeySize is the minimum size of an HMAC key, the size of the SHA-256 hash as required by RFC 7518
+const minHMACKeySize = 32
+
+// esCurveBitSizes are the bit sizes of the curves the ECDSA signing algorithms are bound to
+var esCurveBitSizes = map[string]int{
+	"ES256": 256,
+	"ES384": 384,
+	"ES512": 521,
+}
+
+// Principal defines the authenticated caller of a remoting request
+type Principal struct {
+	// Subject identifies the caller, e.g. the subject of a JWT
+	Subject string
+	// Claims holds the claims carried by the caller's token, if any
+	Claims map[string]any
+}
+
+// Authenticator verifies the bearer token attached to the incoming remoting requests.
+// Requests without a token or with a token that cannot be verified are rejected
+// with an unauthenticated error.
+type Authenticator interface {
+	// Authenticate verifies the given token and returns the authenticated caller
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
+
+// RemoteCall describes a remoting request to authorize
+type RemoteCall struct {
+	// Procedure is the name of the remoting method called, e.g. RemoteSpawn, RemoteStop or RemoteTell
+	Procedure string
+	// Actors are the names of the actors or the identities of the grains targeted by the request.
+	// It is empty for the requests that do not target an actor.
+	Actors []string
+}
+
+// Authorizer decides whether the authenticated caller is allowed to perform the given remoting call.
+// Returning an error rejects the call with a permission denied error. Streamed messages are authorized one batch at a time.
+type Authorizer func(ctx context.Context, principal *Principal, call *RemoteCall) error
+
+// Credentials provides the credentials attached to the outgoing remoting requests
+type Credentials interface {
+	// Token returns the bearer token sent with a remoting request
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticCredentials sends the same bearer token with every remoting request
+type StaticCredentials struct {
+	token string
+}
+
+// enforce compilation error
+var _ Credentials = (*StaticCredentials)(nil)
+
+// NewStaticCredentials creates an instance of StaticCredentials with the given token
+func NewStaticCredentials(token string) *StaticCredentials {
+	return &StaticCredentials{token: token}
+}
+
+// Token returns the static token
+func (x *StaticCredentials) Token(context.Context) (string, error) {
+	return x.token, nil
+}
+
+// TokenAuthenticator authenticates the callers presenting one of the shared tokens.
+// Several tokens can be accepted at once to rotate them without downtime.
+type TokenAuthenticator struct {
+	tokens [][]byte
+}
+
+// enforce compilation error
+var _ Authenticator = (*TokenAuthenticator)(nil)
+
+// NewTokenAuthenticator creates an instance of TokenAuthenticator accepting the given shared tokens
+func NewTokenAuthenticator(tokens ...string) *TokenAuthenticator {
+	authenticator := &TokenAuthenticator{tokens: make([][]byte, 0, len(tokens))}
+	for _, token := range tokens {
+		if token != "" {
+			authenticator.tokens = append(authenticator.tokens, []byte(token))
+		}
+	}
+	return authenticator
+}
+
+// Authenticate checks the given token against the shared tokens in constant time
+func (x *TokenAuthenticator) Authenticate(_ context.Context, token string) (*Principal, error) {
+	matched := 0
+	for _, expected := range x.tokens {
+		matched |= subtle.ConstantTimeCompare(expected, []byte(token))
+	}
+
+	if matched != 1 {
+		return nil, ErrInvalidToken
+	}
+	return &Principal{}, nil
+}
+
+// JWTOption configures the JWTAuthenticator
+type JWTOption func(*JWTAuthenticator)
+
+// WithJWTIssuer requires the tokens to be issued by the given issuer
+func WithJWTIssuer(issuer string) JWTOption {
+	return func(x *JWTAuthenticator) {
+		x.issuer = issuer
+	}
+}
+
+// WithJWTAudience requires the tokens to be intended for the given audience
+func WithJWTAudience(audience string) JWTOption {
+	return func(x *JWTAuthenticator) {
+		x.audience = audience
+	}
+}
+
+// WithJWTLeeway sets the clock skew tolerated when checking the expiry and not before times of the tokens
+func WithJWTLeeway(leeway time.Duration) JWTOption {
+	return func(x *JWTAuthenticator) {
+		x.leeway = leeway
+	}
+}
+
+// JWTAuthenticator authenticates the callers presenting a signed JSON Web Token.
+//
+// The key determines the accepted signing algorithms:
+//   - []byte: HS256, HS384 and HS512, the key being at least as long as the hash
+//   - *rsa.PublicKey: RS256, RS384 and RS512
+//   - *ecdsa.PublicKey: ES256, ES384 and ES512
+//
+// The token must have an expiry time, and its not before time is checked when present.
+// The subject and the claims of the token are returned as the Principal.
+type JWTAuthenticator struct {
+	key      any
+	issuer   string
+	audience string
+	leeway   time.Duration
+}
+
+// enforce compilation error
+var _ Authenticator = (*JWTAuthenticator)(nil)
+
+// NewJWTAuthenticator creates an instance of JWTAuthenticator verifying the tokens with the given key.
+// It returns an error when the key type is not supported or when an HMAC key is shorter than 32 bytes.
+func NewJWTAuthenticator(key any, opts ...JWTOption) (*JWTAuthenticator, error) {
+	switch k := key.(type) {
+	case []byte:
+		if len(k) < minHMACKeySize {
+			return nil, fmt.Errorf("%w: HMAC key must be at least %d bytes", ErrInvalidKey, minHMACKeySize)
+		}
+	case *rsa.PublicKey:
+		if k == nil {
+			return nil, fmt.Errorf("%w: nil RSA key", ErrInvalidKey)
+		}
+	case *ecdsa.PublicKey:
+		if k == nil {
+			return nil, fmt.Errorf("%w: nil ECDSA key", ErrInvalidKey)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported key type %T", ErrInvalidKey, key)
+	}
+
+	authenticator := &JWTAuthenticator{key: key}
+	for _, opt := range opts {
+		opt(authenticator)
+	}
+	return authenticator, nil
+}
+
+// Authenticate verifies the signature and the claims of the given token
+func (x *JWTAuthenticator) Authenticate(_ context.Context, token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if err := x.verify(header.Alg, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	claims := make(map[string]any)
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	if err := x.validate(claims); err != nil {
+		return nil, err
+	}
+
+	subject, _ := claims["sub"].(string)
+	return &Principal{
+		Subject: subject,
+		Claims:  claims,
+	}, nil
+}
+
+// verify checks the signature of the given signed content
+func (x *JWTAuthenticator) verify(alg, signed string, signature []byte) error {
+	if len(alg) != 5 {
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, alg)
+	}
+
+	var hashFunc crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hashFunc = crypto.SHA256
+	case "384":
+		hashFunc = crypto.SHA384
+	case "512":
+		hashFunc = crypto.SHA512
+	default:
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, alg)
+	}
+
+	switch key := x.key.(type) {
+	case []byte:
+		if alg[:2] != "HS" {
+			return fmt.Errorf("%w: unexpected algorithm %q", ErrInvalidToken, alg)
+		}
+
+		if len(key) < hashFunc.Size() {
+			return fmt.Errorf("%w: key too short for algorithm %q", ErrInvalidToken, alg)
+		}
+
+		mac := hmac.New(newHash(hashFunc), key)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return ErrInvalidToken
+		}
+		return nil
+	case *rsa.PublicKey:
+		if alg[:2] != "RS" {
+			return fmt.Errorf("%w: unexpected algorithm %q", ErrInvalidToken, alg)
+		}
+
+		if err := rsa.VerifyPKCS1v15(key, hashFunc, digest(hashFunc, signed), signature); err != nil {
+			return ErrInvalidToken
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		// each algorithm is bound to a curve: ES256 to P-256, ES384 to P-384 and ES512 to P-521
+		bitSize := key.Curve.Params().BitSize
+		if alg[:2] != "ES" || bitSize != esCurveBitSizes[alg] {
+			return fmt.Errorf("%w: unexpected algorithm %q", ErrInvalidToken, alg)
+		}
+
+		// the signature is the concatenation of r and s, each the size of the curve
+		keySize := (bitSize + 7) / 8
+		if len(signature) != 2*keySize {
+			return ErrInvalidToken
+		}
+
+		r := new(big.Int).SetBytes(signature[:keySize])
+		s := new(big.Int).SetBytes(signature[keySize:])
+		if !ecdsa.Verify(key, digest(hashFunc, signed), r, s) {
+			return ErrInvalidToken
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported key type %T", ErrInvalidToken, x.key)
+	}
+}
+
+// validate checks the registered claims of a token
+func (x *JWTAuthenticator) validate(claims map[string]any) error {
+	now := time.Now()
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("%w: missing expiry", ErrInvalidToken)
+	}
+
+	if now.After(time.Unix(int64(exp), 0).Add(x.leeway)) {
+		return ErrTokenExpired
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Before(time.Unix(int64(nbf), 0).Add(-x.leeway)) {
+		return fmt.Errorf("%w: token not valid yet", ErrInvalidToken)
+	}
+
+	if x.issuer != "" {
+		if issuer, _ := claims["iss"].(string); issuer != x.issuer {
+			return fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+		}
+	}
+
+	if x.audience != "" && !hasAudience(claims["aud"], x.audience) {
+		return fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+	return nil
+}
+
+// hasAudience checks whether the audience claim, a string or a list of strings, contains the given audience
+func hasAudience(claim any, audience string) bool {
+	switch aud := claim.(type) {
+	case string:
+		return aud == audience
+	case []any:
+		return slices.ContainsFunc(aud, func(value any) bool {
+			return value == audience
+		})
+	default:
+		return false
+	}
+}
+
+// decodeJWTSegment decodes a base64url encoded JSON segment of a token
+func decodeJWTSegment(segment string, value any) error {
+	bytea, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	if err := json.Unmarshal(bytea, value); err != nil {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// newHash returns the constructor of the given hash function
+func newHash(hashFunc crypto.Hash) func() hash.Hash {
+	switch hashFunc {
+	case crypto.SHA384:
+		return sha512.New384
+	case crypto.SHA512:
+		return sha512.New
+	default:
+		return sha256.New
+	}
+}
+
+// digest hashes the given content
+func digest(hashFunc crypto.Hash, content string) []byte {
+	h := newHash(hashFunc)()
+	h.Write([]byte(content))
+	return h.Sum(nil)
+}
@@ -0,0 +1,261 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package remote
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signJWT creates a token with the given algorithm, claims and signing function
+func signJWT(t *testing.T, alg string, claims map[string]any, sign func(signed string) []byte) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sign(signed))
+}
+
+func hs256(key []byte) func(string) []byte {
+	return func(signed string) []byte {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(signed))
+		return mac.Sum(nil)
+	}
+}
+
+// newJWTAuthenticator creates a JWTAuthenticator with a valid key
+func newJWTAuthenticator(t *testing.T, key any, opts ...JWTOption) *JWTAuthenticator {
+	authenticator, err := NewJWTAuthenticator(key, opts...)
+	require.NoError(t, err)
+	return authenticator
+}
+
+func TestStaticCredentials(t *testing.T) {
+	token, err := NewStaticCredentials("token").Token(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
+}
+
+func TestTokenAuthenticator(t *testing.T) {
+	ctx := context.TODO()
+	authenticator := NewTokenAuthenticator("current", "previous", "")
+
+	principal, err := authenticator.Authenticate(ctx, "current")
+	require.NoError(t, err)
+	require.NotNil(t, principal)
+
+	_, err = authenticator.Authenticate(ctx, "previous")
+	require.NoError(t, err)
+
+	_, err = authenticator.Authenticate(ctx, "other")
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = authenticator.Authenticate(ctx, "")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	ctx := context.TODO()
+	key := []byte("a-shared-secret-of-at-least-32-bytes")
+	claims := func() map[string]any {
+		return map[string]any{
+			"sub": "orders-service",
+			"iss": "issuer",
+			"aud": []string{"goakt"},
+			"exp": time.Now().Add(time.Minute).Unix(),
+			"nbf": time.Now().Add(-time.Minute).Unix(),
+		}
+	}
+
+	t.Run("With HMAC signed token", func(t *testing.T) {
+		authenticator := newJWTAuthenticator(t, key, WithJWTIssuer("issuer"), WithJWTAudience("goakt"))
+		principal, err := authenticator.Authenticate(ctx, signJWT(t, "HS256", claims(), hs256(key)))
+		require.NoError(t, err)
+		assert.Equal(t, "orders-service", principal.Subject)
+		assert.Equal(t, "issuer", principal.Claims["iss"])
+	})
+	t.Run("With RSA signed token", func(t *testing.T) {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		token := signJWT(t, "RS256", claims(), func(signed string) []byte {
+			digest := sha256.Sum256([]byte(signed))
+			signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+			require.NoError(t, err)
+			return signature
+		})
+
+		principal, err := newJWTAuthenticator(t, &privateKey.PublicKey).Authenticate(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, "orders-service", principal.Subject)
+	})
+	t.Run("With ECDSA signed token", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		token := signJWT(t, "ES256", claims(), func(signed string) []byte {
+			digest := sha256.Sum256([]byte(signed))
+			r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest[:])
+			require.NoError(t, err)
+			signature := make([]byte, 64)
+			r.FillBytes(signature[:32])
+			s.FillBytes(signature[32:])
+			return signature
+		})
+
+		principal, err := newJWTAuthenticator(t, &privateKey.PublicKey).Authenticate(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, "orders-service", principal.Subject)
+	})
+	t.Run("With ECDSA algorithm not matching the key curve", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		token := signJWT(t, "ES512", claims(), func(signed string) []byte {
+			digest := sha512.Sum512([]byte(signed))
+			r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest[:])
+			require.NoError(t, err)
+			signature := make([]byte, 64)
+			r.FillBytes(signature[:32])
+			s.FillBytes(signature[32:])
+			return signature
+		})
+
+		_, err = newJWTAuthenticator(t, &privateKey.PublicKey).Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+	t.Run("With malformed ECDSA signature", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		token := signJWT(t, "ES256", claims(), func(signed string) []byte {
+			digest := sha256.Sum256([]byte(signed))
+			r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest[:])
+			require.NoError(t, err)
+			// r and s padded to 33 bytes each
+			signature := make([]byte, 66)
+			r.FillBytes(signature[:33])
+			s.FillBytes(signature[33:])
+			return signature
+		})
+
+		_, err = newJWTAuthenticator(t, &privateKey.PublicKey).Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+	t.Run("With invalid signature", func(t *testing.T) {
+		token := signJWT(t, "HS256", claims(), hs256([]byte("other")))
+		_, err := newJWTAuthenticator(t, key).Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+	t.Run("With unexpected algorithm", func(t *testing.T) {
+		token := signJWT(t, "none", claims(), func(string) []byte { return nil })
+		_, err := newJWTAuthenticator(t, key).Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		token = signJWT(t, "HS256", claims(), hs256(key))
+		_, err = newJWTAuthenticator(t, &privateKey.PublicKey).Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+	t.Run("With expired token", func(t *testing.T) {
+		expired := claims()
+		expired["exp"] = time.Now().Add(-time.Minute).Unix()
+		_, err := newJWTAuthenticator(t, key).Authenticate(ctx, signJWT(t, "HS256", expired, hs256(key)))
+		require.ErrorIs(t, err, ErrTokenExpired)
+
+		_, err = newJWTAuthenticator(t, key, WithJWTLeeway(2*time.Minute)).Authenticate(ctx, signJWT(t, "HS256", expired, hs256(key)))
+		require.NoError(t, err)
+	})
+	t.Run("With token not valid yet", func(t *testing.T) {
+		early := claims()
+		early["nbf"] = time.Now().Add(time.Minute).Unix()
+		_, err := newJWTAuthenticator(t, key).Authenticate(ctx, signJWT(t, "HS256", early, hs256(key)))
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+	t.Run("With unexpected issuer and audience", func(t *testing.T) {
+		token := signJWT(t, "HS256", claims(), hs256(key))
+		_, err := newJWTAuthenticator(t, key, WithJWTIssuer("other")).Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+
+		_, err = newJWTAuthenticator(t, key, WithJWTAudience("other")).Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+	t.Run("With invalid key", func(t *testing.T) {
+		_, err := NewJWTAuthenticator([]byte{})
+		require.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = NewJWTAuthenticator([]byte("secret"))
+		require.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = NewJWTAuthenticator(nil)
+		require.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = NewJWTAuthenticator("secret")
+		require.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = NewJWTAuthenticator((*rsa.PublicKey)(nil))
+		require.ErrorIs(t, err, ErrInvalidKey)
+	})
+	t.Run("With key shorter than the algorithm hash", func(t *testing.T) {
+		token := signJWT(t, "HS512", claims(), func(signed string) []byte {
+			mac := hmac.New(sha512.New, key)
+			mac.Write([]byte(signed))
+			return mac.Sum(nil)
+		})
+		_, err := newJWTAuthenticator(t, key).Authenticate(ctx, token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+	t.Run("With token without expiry", func(t *testing.T) {
+		noExpiry := claims()
+		delete(noExpiry, "exp")
+		_, err := newJWTAuthenticator(t, key).Authenticate(ctx, signJWT(t, "HS256", noExpiry, hs256(key)))
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+	t.Run("With malformed token", func(t *testing.T) {
+		_, err := newJWTAuthenticator(t, key).Authenticate(ctx, "malformed")
+		require.ErrorIs(t, err, ErrInvalidToken)
+
+		_, err = newJWTAuthenticator(t, key).Authenticate(ctx, "a.b.c")
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
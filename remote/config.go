@@ -26,6 +26,7 @@ package remote
 
 import (
 	"net"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -42,6 +43,22 @@ type Config struct {
 	idleTimeout     time.Duration
 	bindAddr        string
 	bindPort        int
+	compression     Compression
+	compressMinSize int
+	serializers     map[reflect.Type]Serializer
+	streaming       bool
+	streamBatchSize int
+	streamWindow    int
+	streamTimeout   time.Duration
+	authenticator   Authenticator
+	authorizer      Authorizer
+	credentials     Credentials
+	outboundQueue   int
+	overflowPolicy  OverflowPolicy
+	chunkSize       int
+	chunkTimeout    time.Duration
+	chunkBuffer     int
+	chunkTransfers  int
 }
 
 var _ validation.Validator = (*Config)(nil)
@@ -55,6 +72,14 @@ func NewConfig(host string, port int, opts ...Option) *Config {
 		idleTimeout:     1200 * time.Second,
 		bindAddr:        host,
 		bindPort:        port,
+		compression:     NoCompression,
+		compressMinSize: size.KB,
+		streamBatchSize: 256,
+		streamWindow:    32,
+		streamTimeout:   10 * time.Second,
+		chunkTimeout:    30 * time.Second,
+		chunkBuffer:     256 * size.MB,
+		chunkTransfers:  1024,
 	}
 
 	// apply the options
@@ -74,6 +99,14 @@ func DefaultConfig() *Config {
 		idleTimeout:     1200 * time.Second,
 		bindAddr:        "127.0.0.1",
 		bindPort:        0,
+		compression:     NoCompression,
+		compressMinSize: size.KB,
+		streamBatchSize: 256,
+		streamWindow:    32,
+		streamTimeout:   10 * time.Second,
+		chunkTimeout:    30 * time.Second,
+		chunkBuffer:     256 * size.MB,
+		chunkTransfers:  1024,
 	}
 }
 
@@ -117,6 +150,96 @@ func (x *Config) BindPort() int {
 	return x.bindPort
 }
 
+// Compression returns the compression algorithm used to compress the outgoing remoting traffic
+func (x *Config) Compression() Compression {
+	return x.compression
+}
+
+// CompressMinSize returns the minimum size in bytes of a message to be compressed.
+// Smaller messages are sent uncompressed.
+func (x *Config) CompressMinSize() int {
+	return x.compressMinSize
+}
+
+// Serializers returns the serializers registered per message type.
+// Protocol buffers messages without a registered serializer are serialized with the ProtoSerializer.
+func (x *Config) Serializers() map[reflect.Type]Serializer {
+	return x.serializers
+}
+
+// Streaming returns true when the messages sent to remote actors without expecting a reply
+// are sent over a long-lived stream per remote node
+func (x *Config) Streaming() bool {
+	return x.streaming
+}
+
+// StreamBatchSize returns the maximum number of messages sent in a single batch over a stream
+func (x *Config) StreamBatchSize() int {
+	return x.streamBatchSize
+}
+
+// StreamWindow returns the number of batches a remote node is allowed to send to this node over a stream
+// before waiting for this node to process them
+func (x *Config) StreamWindow() int {
+	return x.streamWindow
+}
+
+// StreamTimeout returns how long the sender waits for a remote node to grant credits or
+// to acknowledge the end of a stream before canceling the stream
+func (x *Config) StreamTimeout() time.Duration {
+	return x.streamTimeout
+}
+
+// Authenticator returns the authenticator verifying the credentials of the incoming remoting requests
+func (x *Config) Authenticator() Authenticator {
+	return x.authenticator
+}
+
+// Authorizer returns the policy deciding whether an authenticated caller can perform a remoting call
+func (x *Config) Authorizer() Authorizer {
+	return x.authorizer
+}
+
+// Credentials returns the credentials attached to the outgoing remoting requests
+func (x *Config) Credentials() Credentials {
+	return x.credentials
+}
+
+// OutboundQueueSize returns the maximum number of messages queued per remote node when the messages
+// sent to remote actors without expecting a reply are sent asynchronously. Zero means the messages are sent synchronously.
+func (x *Config) OutboundQueueSize() int {
+	return x.outboundQueue
+}
+
+// OverflowPolicy returns what happens to a message sent to a remote node whose outbound queue is full
+func (x *Config) OverflowPolicy() OverflowPolicy {
+	return x.overflowPolicy
+}
+
+// ChunkSize returns the size in bytes above which the messages sent to remote actors are split into chunks
+// of that size. Zero means the messages are never split.
+func (x *Config) ChunkSize() int {
+	return x.chunkSize
+}
+
+// ChunkTimeout returns how long the chunks of a message are kept waiting for the rest of the message
+// before they are discarded
+func (x *Config) ChunkTimeout() time.Duration {
+	return x.chunkTimeout
+}
+
+// ChunkBufferSize returns the maximum number of bytes of the received chunks held while waiting for the rest of their messages,
+// and of the chunks of the replies held while waiting to be fetched
+func (x *Config) ChunkBufferSize() int {
+	return x.chunkBuffer
+}
+
+// ChunkTransfers returns the maximum number of messages whose chunks are being received at the same time,
+// and of replies whose chunks are waiting to be fetched
+func (x *Config) ChunkTransfers() int {
+	return x.chunkTransfers
+}
+
 // Sanitize the configuration
 func (x *Config) Sanitize() error {
 	var err error
@@ -137,5 +260,43 @@ func (x *Config) Validate() error {
 		AddAssertion(x.bindPort >= 0 && x.bindPort <= 65535, "invalid bindPort").
 		AddAssertion(x.readIdleTimeout >= 0, "invalid server read idle timeout").
 		AddAssertion(x.writeTimeout >= 0, "invalid server write timeout").
+		AddAssertion(x.compression >= NoCompression && x.compression <= ZstdCompression, "invalid compression").
+		AddAssertion(x.compressMinSize >= 0, "invalid compression minimum size").
+		AddAssertion(validSerializers(x.serializers), "invalid serializers").
+		AddAssertion(x.streamBatchSize > 0, "invalid stream batch size").
+		AddAssertion(x.streamWindow > 0, "invalid stream window").
+		AddAssertion(x.streamTimeout > 0, "invalid stream timeout").
+		AddAssertion(x.authorizer == nil || x.authenticator != nil, "authorizer requires an authenticator").
+		AddAssertion(x.outboundQueue >= 0, "invalid outbound queue size").
+		AddAssertion(x.overflowPolicy >= DropToDeadletters && x.overflowPolicy <= FailFast, "invalid overflow policy").
+		AddAssertion(x.chunkSize >= 0 && x.chunkSize+size.KB <= int(x.maxFrameSize), "invalid chunk size").
+		AddAssertion(x.chunkTimeout > 0, "invalid chunk timeout").
+		AddAssertion(x.chunkBuffer > 0 && x.chunkTransfers > 0, "invalid chunk buffer").
 		Validate()
 }
+
+// validSerializers checks that the custom serializers do not use a reserved identifier
+// and that a given identifier is not shared by different serializers
+func validSerializers(serializers map[reflect.Type]Serializer) bool {
+	identifiers := make(map[int32]reflect.Type, len(serializers))
+	for messageType, serializer := range serializers {
+		if messageType == nil || serializer == nil {
+			return false
+		}
+
+		serializerType := reflect.TypeOf(serializer)
+		switch serializer.(type) {
+		case *ProtoSerializer, *JSONSerializer:
+		default:
+			if serializer.ID() <= MaxReservedSerializerID {
+				return false
+			}
+		}
+
+		if existing, ok := identifiers[serializer.ID()]; ok && existing != serializerType {
+			return false
+		}
+		identifiers[serializer.ID()] = serializerType
+	}
+	return true
+}
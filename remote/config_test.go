@@ -25,6 +25,8 @@
 package remote
 
 import (
+	"context"
+	"reflect"
 	"testing"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tochemey/goakt/v3/internal/size"
+	"github.com/tochemey/goakt/v3/test/data/testpb"
 )
 
 func TestConfig(t *testing.T) {
@@ -45,6 +48,13 @@ func TestConfig(t *testing.T) {
 		assert.Exactly(t, 1200*time.Second, config.IdleTimeout())
 		assert.Exactly(t, "127.0.0.1", config.BindAddr())
 		assert.Exactly(t, 0, config.BindPort())
+		assert.Exactly(t, NoCompression, config.Compression())
+		assert.Exactly(t, size.KB, config.CompressMinSize())
+		assert.False(t, config.Streaming())
+		assert.Exactly(t, 256, config.StreamBatchSize())
+		assert.Exactly(t, 32, config.StreamWindow())
+		assert.Zero(t, config.ChunkSize())
+		assert.Exactly(t, 30*time.Second, config.ChunkTimeout())
 	})
 	t.Run("With config", func(t *testing.T) {
 		config := NewConfig("127.0.0.1", 8080, WithReadIdleTimeout(10*time.Second), WithWriteTimeout(10*time.Second))
@@ -57,6 +67,140 @@ func TestConfig(t *testing.T) {
 		assert.Exactly(t, "127.0.0.1", config.BindAddr())
 		assert.Exactly(t, 8080, config.BindPort())
 	})
+	t.Run("With compression", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithCompression(GzipCompression), WithCompressMinSize(512))
+		require.NoError(t, config.Validate())
+		assert.Exactly(t, GzipCompression, config.Compression())
+		assert.Exactly(t, "gzip", config.Compression().String())
+		assert.Exactly(t, 512, config.CompressMinSize())
+		assert.Exactly(t, "zstd", ZstdCompression.String())
+		assert.Exactly(t, "identity", NoCompression.String())
+	})
+	t.Run("With invalid compression", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithCompression(Compression(10)))
+		err := config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid compression")
+
+		config = NewConfig("127.0.0.1", 8080, WithCompressMinSize(-1))
+		err = config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid compression minimum size")
+	})
+	t.Run("With serializers", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080,
+			WithSerializer(new(testpb.Reply), NewJSONSerializer()),
+			WithSerializer(serializerMessage{}, NewJSONSerializer()))
+		require.NoError(t, config.Validate())
+		assert.Len(t, config.Serializers(), 2)
+		assert.IsType(t, new(JSONSerializer), config.Serializers()[reflect.TypeOf(serializerMessage{})])
+	})
+	t.Run("With invalid serializers", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithSerializer(serializerMessage{}, &customSerializer{id: 10}))
+		err := config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid serializers")
+
+		config = NewConfig("127.0.0.1", 8080, WithSerializer(serializerMessage{}, nil))
+		err = config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid serializers")
+
+		config = NewConfig("127.0.0.1", 8080,
+			WithSerializer(serializerMessage{}, &customSerializer{id: 200}),
+			WithSerializer(new(testpb.Reply), &otherSerializer{customSerializer{id: 200}}))
+		err = config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid serializers")
+
+		config = NewConfig("127.0.0.1", 8080,
+			WithSerializer(serializerMessage{}, &customSerializer{id: 200}),
+			WithSerializer(new(testpb.Reply), &customSerializer{id: 200}))
+		require.NoError(t, config.Validate())
+	})
+	t.Run("With streaming", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithStreaming(), WithStreamBatchSize(10), WithStreamWindow(2), WithStreamTimeout(time.Second))
+		require.NoError(t, config.Validate())
+		assert.True(t, config.Streaming())
+		assert.Exactly(t, 10, config.StreamBatchSize())
+		assert.Exactly(t, 2, config.StreamWindow())
+		assert.Exactly(t, time.Second, config.StreamTimeout())
+	})
+	t.Run("With invalid streaming", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithStreaming(), WithStreamBatchSize(0))
+		err := config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid stream batch size")
+
+		config = NewConfig("127.0.0.1", 8080, WithStreaming(), WithStreamWindow(-1))
+		err = config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid stream window")
+
+		config = NewConfig("127.0.0.1", 8080, WithStreaming(), WithStreamTimeout(0))
+		err = config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid stream timeout")
+	})
+	t.Run("With authentication", func(t *testing.T) {
+		authenticator := NewTokenAuthenticator("token")
+		credentials := NewStaticCredentials("token")
+		config := NewConfig("127.0.0.1", 8080,
+			WithAuthenticator(authenticator),
+			WithAuthorizer(func(context.Context, *Principal, *RemoteCall) error { return nil }),
+			WithCredentials(credentials))
+		require.NoError(t, config.Validate())
+		assert.Equal(t, authenticator, config.Authenticator())
+		assert.NotNil(t, config.Authorizer())
+		assert.Equal(t, credentials, config.Credentials())
+	})
+	t.Run("With authorizer without authenticator", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithAuthorizer(func(context.Context, *Principal, *RemoteCall) error { return nil }))
+		err := config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "authorizer requires an authenticator")
+	})
+	t.Run("With outbound queue", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithOutboundQueue(100, BlockOnOverflow))
+		require.NoError(t, config.Validate())
+		assert.Exactly(t, 100, config.OutboundQueueSize())
+		assert.Equal(t, BlockOnOverflow, config.OverflowPolicy())
+	})
+	t.Run("With invalid outbound queue", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithOutboundQueue(-1, BlockOnOverflow))
+		err := config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid outbound queue size")
+
+		config = NewConfig("127.0.0.1", 8080, WithOutboundQueue(10, OverflowPolicy(10)))
+		err = config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid overflow policy")
+	})
+	t.Run("With chunking", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithChunkSize(size.MB), WithChunkTimeout(time.Minute), WithChunkBuffer(64*size.MB, 10))
+		require.NoError(t, config.Validate())
+		assert.Exactly(t, size.MB, config.ChunkSize())
+		assert.Exactly(t, time.Minute, config.ChunkTimeout())
+		assert.Exactly(t, 64*size.MB, config.ChunkBufferSize())
+		assert.Exactly(t, 10, config.ChunkTransfers())
+	})
+	t.Run("With invalid chunking", func(t *testing.T) {
+		config := NewConfig("127.0.0.1", 8080, WithChunkSize(16*size.MB))
+		err := config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid chunk size")
+
+		config = NewConfig("127.0.0.1", 8080, WithChunkTimeout(0))
+		err = config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid chunk timeout")
+
+		config = NewConfig("127.0.0.1", 8080, WithChunkBuffer(size.MB, 0))
+		err = config.Validate()
+		require.Error(t, err)
+		assert.EqualError(t, err, "invalid chunk buffer")
+	})
 	t.Run("With invalid framesize", func(t *testing.T) {
 		config := NewConfig("127.0.0.1", 8080, WithMaxFrameSize(20*size.MB))
 		err := config.Validate()
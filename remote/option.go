@@ -24,7 +24,10 @@
 
 package remote
 
-import "time"
+import (
+	"reflect"
+	"time"
+)
 
 // Option is the interface that applies a configuration option.
 type Option interface {
@@ -67,3 +70,149 @@ func WithMaxFrameSize(size uint32) Option {
 		config.maxFrameSize = size
 	})
 }
+
+// WithCompression sets the compression algorithm used to compress the outgoing remoting traffic.
+//
+// Compression is negotiated: every node accepts gzip and zstd compressed requests regardless of its own setting,
+// and responses are compressed with the algorithm used by the request. Messages smaller than the minimum size set
+// with WithCompressMinSize are sent uncompressed.
+func WithCompression(compression Compression) Option {
+	return OptionFunc(func(config *Config) {
+		config.compression = compression
+	})
+}
+
+// WithCompressMinSize sets the minimum size in bytes of a message to be compressed.
+// Compressing small messages costs more CPU than it saves bandwidth. The default value is 1KB.
+func WithCompressMinSize(size int) Option {
+	return OptionFunc(func(config *Config) {
+		config.compressMinSize = size
+	})
+}
+
+// WithSerializer registers the serializer used to serialize the messages of the same type as the given message
+// when they are sent to remote actors and grains. The message type is matched exactly, hence a value
+// and a pointer of the same type are registered separately.
+//
+// Protocol buffers messages are serialized with the ProtoSerializer unless a serializer is registered for their type.
+// Messages of any other type require a registered serializer. All the nodes exchanging a given message
+// type must register the same serializer for that type.
+func WithSerializer(message any, serializer Serializer) Option {
+	return OptionFunc(func(config *Config) {
+		if config.serializers == nil {
+			config.serializers = make(map[reflect.Type]Serializer)
+		}
+		config.serializers[reflect.TypeOf(message)] = serializer
+	})
+}
+
+// WithStreaming sends the messages to remote actors without expecting a reply over a long-lived
+// bidirectional stream per remote node instead of one request per message.
+//
+// Messages sent over the stream are batched on the wire and delivered in the order they are sent.
+// The receiving node grants the sender a window of in-flight batches set with WithStreamWindow,
+// and the sender waits when the window is exhausted or its queue is full. When the remote node does not support streaming
+// or the stream fails, messages are sent one request at a time.
+//
+// Delivery failures on the receiving node, e.g. an actor not found, are logged by that node and are not
+// reported to the sender.
+func WithStreaming() Option {
+	return OptionFunc(func(config *Config) {
+		config.streaming = true
+	})
+}
+
+// WithStreamBatchSize sets the maximum number of messages sent in a single batch over a stream.
+// The default value is 256.
+func WithStreamBatchSize(size int) Option {
+	return OptionFunc(func(config *Config) {
+		config.streamBatchSize = size
+	})
+}
+
+// WithStreamWindow sets the number of batches a remote node is allowed to send to this node over a stream
+// before waiting for this node to process them. It also bounds the number of messages queued by this node
+// for a given remote node to the window times the batch size. The default value is 32.
+func WithStreamWindow(window int) Option {
+	return OptionFunc(func(config *Config) {
+		config.streamWindow = window
+	})
+}
+
+// WithStreamTimeout sets how long the sender waits for a remote node to grant credits or to acknowledge
+// the end of a stream. The stream is canceled when the timeout elapses and the pending messages are sent
+// one request at a time. The default value is 10s.
+func WithStreamTimeout(timeout time.Duration) Option {
+	return OptionFunc(func(config *Config) {
+		config.streamTimeout = timeout
+	})
+}
+
+// WithAuthenticator sets the authenticator verifying the bearer token of the incoming remoting requests.
+// Requests without a valid token are rejected. All the nodes of a cluster and the clients
+// must then send their credentials with WithCredentials.
+func WithAuthenticator(authenticator Authenticator) Option {
+	return OptionFunc(func(config *Config) {
+		config.authenticator = authenticator
+	})
+}
+
+// WithAuthorizer sets the policy deciding whether an authenticated caller can perform a remoting call,
+// e.g. spawning or stopping a given actor. It requires an authenticator set with WithAuthenticator.
+func WithAuthorizer(authorizer Authorizer) Option {
+	return OptionFunc(func(config *Config) {
+		config.authorizer = authorizer
+	})
+}
+
+// WithCredentials sets the credentials attached to the outgoing remoting requests
+func WithCredentials(credentials Credentials) Option {
+	return OptionFunc(func(config *Config) {
+		config.credentials = credentials
+	})
+}
+
+// WithOutboundQueue sends the messages to remote actors without expecting a reply asynchronously.
+//
+// The messages are queued per remote node, up to the given size, and sent in the background in the order they are queued.
+// A slow remote node then only fills its own queue instead of blocking the sending actors. The given policy decides
+// what happens to a message sent to a remote node whose queue is full. The messages that cannot be delivered
+// are sent to the deadletter. The queue of a remote node no message has been sent to for a while is released.
+func WithOutboundQueue(size int, policy OverflowPolicy) Option {
+	return OptionFunc(func(config *Config) {
+		config.outboundQueue = size
+		config.overflowPolicy = policy
+	})
+}
+
+// WithChunkSize splits the messages whose serialized size exceeds the given size into chunks of that size.
+//
+// The chunks are sent to the remote node one by one and the message is reassembled and checked against its
+// checksum before it reaches the mailbox of the remote actor. This allows sending messages larger than the
+// maximum frame size of the remote node. Replies exceeding the given size are returned in chunks as well.
+// The size must leave room for the framing overhead, that is be at most the maximum frame size minus 1KB.
+// Zero, the default, disables chunking.
+func WithChunkSize(size int) Option {
+	return OptionFunc(func(config *Config) {
+		config.chunkSize = size
+	})
+}
+
+// WithChunkTimeout sets how long the chunks of a message are kept waiting for the rest of the message
+// before they are discarded. Defaults to 30 seconds.
+func WithChunkTimeout(timeout time.Duration) Option {
+	return OptionFunc(func(config *Config) {
+		config.chunkTimeout = timeout
+	})
+}
+
+// WithChunkBuffer bounds the chunks received from the remote nodes and held while waiting for the rest of their messages
+// to the given number of bytes and of messages. The chunks exceeding these limits are rejected and the sending of
+// their messages fails. The same limits apply to the chunks of the replies held until the requesters fetch them.
+// The default values are 256MB and 1024 messages.
+func WithChunkBuffer(size, transfers int) Option {
+	return OptionFunc(func(config *Config) {
+		config.chunkBuffer = size
+		config.chunkTransfers = transfers
+	})
+}
@@ -25,6 +25,8 @@
 package remote
 
 import (
+	"context"
+	"reflect"
 	"testing"
 	"time"
 
@@ -52,6 +54,71 @@ func TestOption(t *testing.T) {
 			option:   WithMaxFrameSize(1024),
 			expected: Config{maxFrameSize: 1024},
 		},
+		{
+			name:     "WithCompression",
+			option:   WithCompression(ZstdCompression),
+			expected: Config{compression: ZstdCompression},
+		},
+		{
+			name:     "WithCompressMinSize",
+			option:   WithCompressMinSize(2048),
+			expected: Config{compressMinSize: 2048},
+		},
+		{
+			name:     "WithStreaming",
+			option:   WithStreaming(),
+			expected: Config{streaming: true},
+		},
+		{
+			name:     "WithStreamBatchSize",
+			option:   WithStreamBatchSize(512),
+			expected: Config{streamBatchSize: 512},
+		},
+		{
+			name:     "WithStreamWindow",
+			option:   WithStreamWindow(64),
+			expected: Config{streamWindow: 64},
+		},
+		{
+			name:     "WithStreamTimeout",
+			option:   WithStreamTimeout(time.Second),
+			expected: Config{streamTimeout: time.Second},
+		},
+		{
+			name:     "WithSerializer",
+			option:   WithSerializer(serializerMessage{}, NewJSONSerializer()),
+			expected: Config{serializers: map[reflect.Type]Serializer{reflect.TypeOf(serializerMessage{}): NewJSONSerializer()}},
+		},
+		{
+			name:     "WithAuthenticator",
+			option:   WithAuthenticator(NewTokenAuthenticator("token")),
+			expected: Config{authenticator: NewTokenAuthenticator("token")},
+		},
+		{
+			name:     "WithOutboundQueue",
+			option:   WithOutboundQueue(100, FailFast),
+			expected: Config{outboundQueue: 100, overflowPolicy: FailFast},
+		},
+		{
+			name:     "WithChunkSize",
+			option:   WithChunkSize(1024),
+			expected: Config{chunkSize: 1024},
+		},
+		{
+			name:     "WithChunkTimeout",
+			option:   WithChunkTimeout(time.Minute),
+			expected: Config{chunkTimeout: time.Minute},
+		},
+		{
+			name:     "WithChunkBuffer",
+			option:   WithChunkBuffer(1024, 10),
+			expected: Config{chunkBuffer: 1024, chunkTransfers: 10},
+		},
+		{
+			name:     "WithCredentials",
+			option:   WithCredentials(NewStaticCredentials("token")),
+			expected: Config{credentials: NewStaticCredentials("token")},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -61,4 +128,9 @@ func TestOption(t *testing.T) {
 			assert.Equal(t, tc.expected, config)
 		})
 	}
+	t.Run("WithAuthorizer", func(t *testing.T) {
+		var config Config
+		WithAuthorizer(func(context.Context, *Principal, *RemoteCall) error { return nil }).Apply(&config)
+		assert.NotNil(t, config.authorizer)
+	})
 }
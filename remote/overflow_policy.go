@@ -0,0 +1,50 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package remote
+
+// OverflowPolicy defines what happens to a message sent to a remote node whose outbound queue is full
+type OverflowPolicy int
+
+const (
+	// DropToDeadletters drops the message and sends it to the deadletter of the sending actor system
+	DropToDeadletters OverflowPolicy = iota
+	// BlockOnOverflow blocks the sender until there is room in the queue or its context is done
+	BlockOnOverflow
+	// FailFast drops the messages and returns an error to the sender. The messages sent together are either
+	// all queued or all dropped
+	FailFast
+)
+
+// String returns the name of the overflow policy
+func (p OverflowPolicy) String() string {
+	switch p {
+	case BlockOnOverflow:
+		return "block"
+	case FailFast:
+		return "fail-fast"
+	default:
+		return "drop-to-deadletters"
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package remote
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ProtoSerializerID is the identifier of the protobuf serializer
+	ProtoSerializerID int32 = 1
+	// JSONSerializerID is the identifier of the JSON serializer
+	JSONSerializerID int32 = 2
+	// MaxReservedSerializerID is the highest serializer identifier reserved for the built-in serializers.
+	// Custom serializers must use identifiers greater than this value.
+	MaxReservedSerializerID int32 = 100
+)
+
+// ErrSerializerNotFound is returned when no serializer is registered for a given message type or serializer identifier
+var ErrSerializerNotFound = errors.New("serializer not found")
+
+// Serializer converts the messages sent to remote actors and grains to and from bytes.
+//
+// The serializer identifier is sent along with every serialized message so that the receiving node
+// picks the same serializer. All the nodes exchanging a given message type must register the
+// same serializer for that type.
+type Serializer interface {
+	// ID returns the unique identifier of the serializer.
+	// Identifiers up to MaxReservedSerializerID are reserved for the built-in serializers.
+	ID() int32
+	// Serialize converts the given message into bytes
+	Serialize(message any) ([]byte, error)
+	// Deserialize decodes the given bytes into message, a pointer to a new value of the message type
+	Deserialize(data []byte, message any) error
+}
+
+// ProtoSerializer serializes protocol buffers messages.
+// It is the default serializer of protocol buffers messages.
+type ProtoSerializer struct{}
+
+// enforce compilation error
+var _ Serializer = (*ProtoSerializer)(nil)
+
+// NewProtoSerializer creates an instance of ProtoSerializer
+func NewProtoSerializer() *ProtoSerializer {
+	return &ProtoSerializer{}
+}
+
+// ID returns the serializer identifier
+func (s *ProtoSerializer) ID() int32 {
+	return ProtoSerializerID
+}
+
+// Serialize converts the given protocol buffers message into bytes
+func (s *ProtoSerializer) Serialize(message any) ([]byte, error) {
+	msg, ok := message.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protocol buffers message", message)
+	}
+	return proto.Marshal(msg)
+}
+
+// Deserialize decodes the given bytes into the given protocol buffers message
+func (s *ProtoSerializer) Deserialize(data []byte, message any) error {
+	msg, ok := message.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%T is not a protocol buffers message", message)
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+// JSONSerializer serializes messages using the encoding/json package.
+// It suits plain Go structs exchanged between nodes.
+type JSONSerializer struct{}
+
+// enforce compilation error
+var _ Serializer = (*JSONSerializer)(nil)
+
+// NewJSONSerializer creates an instance of JSONSerializer
+func NewJSONSerializer() *JSONSerializer {
+	return &JSONSerializer{}
+}
+
+// ID returns the serializer identifier
+func (s *JSONSerializer) ID() int32 {
+	return JSONSerializerID
+}
+
+// Serialize converts the given message into JSON
+func (s *JSONSerializer) Serialize(message any) ([]byte, error) {
+	return json.Marshal(message)
+}
+
+// Deserialize decodes the given JSON into the given message
+func (s *JSONSerializer) Deserialize(data []byte, message any) error {
+	return json.Unmarshal(data, message)
+}
@@ -0,0 +1,84 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package remote
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tochemey/goakt/v3/test/data/testpb"
+)
+
+type serializerMessage struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+}
+
+type customSerializer struct {
+	id int32
+}
+
+var _ Serializer = (*customSerializer)(nil)
+
+func (s *customSerializer) ID() int32                         { return s.id }
+func (s *customSerializer) Serialize(any) ([]byte, error)     { return nil, nil }
+func (s *customSerializer) Deserialize(_ []byte, _ any) error { return nil }
+
+type otherSerializer struct {
+	customSerializer
+}
+
+func TestProtoSerializer(t *testing.T) {
+	serializer := NewProtoSerializer()
+	assert.Exactly(t, ProtoSerializerID, serializer.ID())
+
+	bytea, err := serializer.Serialize(&testpb.Reply{Content: "hello"})
+	require.NoError(t, err)
+
+	actual := new(testpb.Reply)
+	require.NoError(t, serializer.Deserialize(bytea, actual))
+	assert.Equal(t, "hello", actual.GetContent())
+
+	_, err = serializer.Serialize(serializerMessage{})
+	require.Error(t, err)
+	require.Error(t, serializer.Deserialize(bytea, new(serializerMessage)))
+}
+
+func TestJSONSerializer(t *testing.T) {
+	serializer := NewJSONSerializer()
+	assert.Exactly(t, JSONSerializerID, serializer.ID())
+
+	expected := serializerMessage{Text: "hello", Count: 2}
+	bytea, err := serializer.Serialize(expected)
+	require.NoError(t, err)
+
+	actual := new(serializerMessage)
+	require.NoError(t, serializer.Deserialize(bytea, actual))
+	assert.Equal(t, expected, *actual)
+
+	require.Error(t, serializer.Deserialize([]byte("{"), new(serializerMessage)))
+}
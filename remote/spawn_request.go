@@ -27,6 +27,8 @@ package remote
 import (
 	"strings"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/internal/validation"
 	"github.com/tochemey/goakt/v3/passivation"
@@ -40,9 +42,20 @@ type SpawnRequest struct {
 	Name string
 
 	// Kind represents the type of the actor.
-	// It typically corresponds to the actor’s implementation within the system
+	// It typically corresponds to the actor’s implementation within the system.
+	// It is not required when the actor is created with a Factory.
 	Kind string
 
+	// Factory is the name of the actor factory creating the actor on the remote node.
+	// The factory must be registered on the remote node, and on every node of the cluster
+	// for the actor to be relocated with the same factory.
+	// When set, the actor is created by the factory with Args instead of from its Kind.
+	Factory string
+
+	// Args defines the arguments passed to the Factory when creating the actor.
+	// They are kept by the cluster and passed again to the Factory when the actor is relocated.
+	Args proto.Message
+
 	// Singleton specifies whether the actor is a singleton, meaning only one instance of the actor
 	// can exist across the entire cluster at any given time.
 	// This option is useful for actors responsible for global coordination or shared state.
@@ -93,6 +106,16 @@ type SpawnRequest struct {
 	// When used correctly, the stash buffer is a powerful tool for managing transient states
 	// and preserving actor responsiveness while maintaining orderly message handling.
 	EnableStashing bool
+
+	// SnapshotInterval defines the number of persisted events after which the state of a
+	// persistent actor is saved in the snapshot store. It is ignored for non-persistent actors.
+	// A zero value disables snapshots.
+	SnapshotInterval uint64
+
+	// Role specifies the role a cluster node must have to host the actor.
+	// The remote node rejects the request when it does not have the role, and the actor is only
+	// relocated to nodes having the role. An empty role means that any node can host the actor.
+	Role string
 }
 
 // _ ensures that SpawnRequest implements the validation.Validator interface at compile time.
@@ -100,11 +123,15 @@ var _ validation.Validator = (*SpawnRequest)(nil)
 
 // Validate validates the SpawnRequest
 func (s *SpawnRequest) Validate() error {
-	if err := validation.
+	chain := validation.
 		New(validation.FailFast()).
-		AddValidator(validation.NewEmptyStringValidator("Name", s.Name)).
-		AddValidator(validation.NewEmptyStringValidator("Kind", s.Kind)).
-		Validate(); err != nil {
+		AddValidator(validation.NewEmptyStringValidator("Name", s.Name))
+
+	if s.Factory == "" {
+		chain.AddValidator(validation.NewEmptyStringValidator("Kind", s.Kind))
+	}
+
+	if err := chain.Validate(); err != nil {
 		return err
 	}
 
@@ -122,6 +149,7 @@ func (s *SpawnRequest) Validate() error {
 func (s *SpawnRequest) Sanitize() {
 	s.Name = strings.TrimSpace(s.Name)
 	s.Kind = strings.TrimSpace(s.Kind)
+	s.Factory = strings.TrimSpace(s.Factory)
 	if s.Singleton {
 		s.Relocatable = true
 	}
@@ -27,6 +27,8 @@ package testkit
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
 	"testing"
 	"time"
 
@@ -40,20 +42,22 @@ import (
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/internal/collection"
 	"github.com/tochemey/goakt/v3/internal/errorschain"
+	"github.com/tochemey/goakt/v3/internal/memberlist"
 	"github.com/tochemey/goakt/v3/internal/pause"
 	"github.com/tochemey/goakt/v3/log"
 	"github.com/tochemey/goakt/v3/remote"
 )
 
 type MultiNodes struct {
-	extensions []extension.Extension
-	gt         *testing.T
-	logger     log.Logger
-	nodes      *collection.Map[string, *TestNode]
-	kinds      []actor.Actor
-	started    *atomic.Bool
-	server     *natsserver.Server
-	host       string
+	extensions         []extension.Extension
+	gt                 *testing.T
+	logger             log.Logger
+	nodes              *collection.Map[string, *TestNode]
+	kinds              []actor.Actor
+	started            *atomic.Bool
+	server             *natsserver.Server
+	host               string
+	splitBrainResolver *actor.SplitBrainResolver
 }
 
 // NewMultiNodes creates a new instance of MultiNodes for testing purposes.
@@ -61,7 +65,7 @@ type MultiNodes struct {
 // logger, actor kinds, and extensions. This setup is essential for running
 // multi-node tests in a controlled environment, allowing for the simulation of
 // distributed actor systems.
-func NewMultiNodes(t *testing.T, logger log.Logger, kinds []actor.Actor, extensions []extension.Extension) *MultiNodes {
+func NewMultiNodes(t *testing.T, logger log.Logger, kinds []actor.Actor, extensions []extension.Extension, opts ...MultiNodesOption) *MultiNodes {
 	instance := &MultiNodes{
 		gt:         t,
 		logger:     logger,
@@ -71,6 +75,12 @@ func NewMultiNodes(t *testing.T, logger log.Logger, kinds []actor.Actor, extensi
 		started:    atomic.NewBool(false),
 	}
 
+	for _, opt := range opts {
+		opt(instance)
+	}
+
+	// the nodes need a partitionable transport for Partition to take effect
+	memberlist.EnablePartitions()
 	return instance
 }
 
@@ -173,6 +183,9 @@ func (m *MultiNodes) Stop() {
 		}
 	}
 
+	// remove any simulated network partition
+	memberlist.Heal()
+
 	if m.server != nil {
 		m.server.Shutdown()
 	}
@@ -225,22 +238,27 @@ func (m *MultiNodes) StartNode(ctx context.Context, name string) *TestNode {
 
 	provider := nats.NewDiscovery(&config, nats.WithLogger(m.logger))
 
+	clusterConfig := actor.NewClusterConfig().
+		WithKinds(m.kinds...).
+		WithPartitionCount(7).
+		WithReplicaCount(1).
+		WithPeersPort(clusterPort).
+		WithMinimumPeersQuorum(1).
+		WithDiscoveryPort(discoveryPort).
+		WithClusterStateSyncInterval(300 * time.Millisecond).
+		WithPeersStateSyncInterval(500 * time.Millisecond).
+		WithDiscovery(provider)
+
+	if m.splitBrainResolver != nil {
+		clusterConfig.WithSplitBrainResolver(m.splitBrainResolver)
+	}
+
 	options := []actor.Option{
 		actor.WithLogger(m.logger),
 		actor.WithRemote(remote.NewConfig(m.host, remotingPort)),
 		actor.WithExtensions(m.extensions...),
 		actor.WithShutdownTimeout(3 * time.Minute),
-		actor.WithCluster(
-			actor.NewClusterConfig().
-				WithKinds(m.kinds...).
-				WithPartitionCount(7).
-				WithReplicaCount(1).
-				WithPeersPort(clusterPort).
-				WithMinimumPeersQuorum(1).
-				WithDiscoveryPort(discoveryPort).
-				WithClusterStateSyncInterval(300 * time.Millisecond).
-				WithPeersStateSyncInterval(500 * time.Millisecond).
-				WithDiscovery(provider)),
+		actor.WithCluster(clusterConfig),
 	}
 
 	actorSystem, err := actor.NewActorSystem("testSystem", options...)
@@ -251,14 +269,52 @@ func (m *MultiNodes) StartNode(ctx context.Context, name string) *TestNode {
 	pause.For(2 * time.Second)
 
 	node := &TestNode{
-		actorSystem: actorSystem,
-		discovery:   provider,
-		nodeName:    name,
-		testingT:    m.gt,
-		created:     atomic.NewBool(true),
-		testCtx:     ctx,
+		actorSystem:   actorSystem,
+		discovery:     provider,
+		nodeName:      name,
+		testingT:      m.gt,
+		created:       atomic.NewBool(true),
+		testCtx:       ctx,
+		discoveryAddr: net.JoinHostPort(m.host, strconv.Itoa(discoveryPort)),
 	}
 
 	m.nodes.Set(name, node)
 	return node
 }
+
+// Partition simulates a network partition between the given groups of nodes.
+//
+// The nodes of a group can reach each other but can no longer reach the nodes of the other groups.
+// The partition only affects the cluster membership protocol: each side of the partition sees the nodes
+// of the other side leave the cluster, which is what the split brain resolver reacts to.
+// Nodes that are not part of any group are not affected.
+//
+// Parameters:
+//   - groups: the names of the nodes on each side of the partition.
+//
+// Example:
+//
+//	multiNodes.Partition([]string{"node-1", "node-2"}, []string{"node-3"})
+//
+// Notes:
+//   - The test fails immediately when a node name is unknown.
+//   - Call Heal to remove the partition. Stop heals all the partitions.
+func (m *MultiNodes) Partition(groups ...[]string) {
+	addresses := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		addrs := make([]string, 0, len(group))
+		for _, name := range group {
+			node, ok := m.nodes.Get(name)
+			require.True(m.gt, ok, "node %s not found", name)
+			addrs = append(addrs, node.discoveryAddr)
+		}
+		addresses = append(addresses, addrs)
+	}
+	memberlist.Partition(addresses...)
+}
+
+// Heal removes all the simulated network partitions.
+// Nodes that have already left the cluster because of a partition do not rejoin it.
+func (m *MultiNodes) Heal() {
+	memberlist.Heal()
+}
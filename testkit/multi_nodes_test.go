@@ -0,0 +1,108 @@
+/*
+ * MIT License
+ *
+ * Copyright (c) 2022-2025  Arsene Tochemey Gandote
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package testkit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	actors "github.com/tochemey/goakt/v3/actor"
+	"github.com/tochemey/goakt/v3/goaktpb"
+	"github.com/tochemey/goakt/v3/internal/eventstream"
+	"github.com/tochemey/goakt/v3/log"
+)
+
+func TestMultiNodes(t *testing.T) {
+	t.Run("With split brain resolver", func(t *testing.T) {
+		ctx := context.TODO()
+		resolver := actors.NewSplitBrainResolver(actors.KeepMajority).
+			WithStableAfter(2 * time.Second).
+			WithAction(actors.ReadOnlyAction)
+
+		multiNodes := NewMultiNodes(t, log.DiscardLogger, []actors.Actor{&pinger{}}, nil, WithSplitBrainResolver(resolver))
+		multiNodes.Start()
+		t.Cleanup(multiNodes.Stop)
+
+		node1 := multiNodes.StartNode(ctx, "node1")
+		node2 := multiNodes.StartNode(ctx, "node2")
+		node3 := multiNodes.StartNode(ctx, "node3")
+
+		node3.Spawn(ctx, "pinger", &pinger{})
+
+		subscriber1 := node1.Subscribe()
+		subscriber3 := node3.Subscribe()
+
+		// isolate node3 from the rest of the cluster
+		multiNodes.Partition([]string{node1.NodeName(), node2.NodeName()}, []string{node3.NodeName()})
+
+		var kept, readOnly *goaktpb.SplitBrainResolved
+		require.Eventually(t, func() bool {
+			if kept == nil {
+				kept = splitBrainResolved(subscriber1)
+			}
+			if readOnly == nil {
+				readOnly = splitBrainResolved(subscriber3)
+			}
+			return kept != nil && readOnly != nil
+		}, time.Minute, 500*time.Millisecond)
+
+		// the majority side survives
+		assert.Equal(t, goaktpb.SplitBrainDecision_SPLIT_BRAIN_DECISION_KEEP, kept.GetDecision())
+		assert.Equal(t, actors.KeepMajority.String(), kept.GetStrategy())
+		assert.Len(t, kept.GetReachable(), 2)
+		assert.Len(t, kept.GetUnreachable(), 1)
+
+		// the minority side switches to read-only
+		assert.Equal(t, goaktpb.SplitBrainDecision_SPLIT_BRAIN_DECISION_READ_ONLY, readOnly.GetDecision())
+		assert.Len(t, readOnly.GetReachable(), 1)
+		assert.Len(t, readOnly.GetUnreachable(), 2)
+
+		_, err := node3.actorSystem.Spawn(ctx, "other", &pinger{})
+		require.ErrorIs(t, err, actors.ErrActorSystemReadOnly)
+
+		// the read-only node still serves its actors
+		pid, err := node3.actorSystem.LocalActor("pinger")
+		require.NoError(t, err)
+		assert.True(t, pid.IsRunning())
+
+		// the surviving side still creates actors
+		node1.Spawn(ctx, "other", &pinger{})
+	})
+}
+
+// splitBrainResolved returns the split brain resolution received by the given subscriber if any
+func splitBrainResolved(subscriber eventstream.Subscriber) *goaktpb.SplitBrainResolved {
+	var resolved *goaktpb.SplitBrainResolved
+	for message := range subscriber.Iterator() {
+		if event, ok := message.Payload().(*goaktpb.SplitBrainResolved); ok {
+			resolved = event
+		}
+	}
+	return resolved
+}
@@ -27,6 +27,7 @@ package testkit
 import (
 	"os"
 
+	"github.com/tochemey/goakt/v3/actor"
 	"github.com/tochemey/goakt/v3/extension"
 	"github.com/tochemey/goakt/v3/log"
 )
@@ -60,3 +61,13 @@ func WithExtensions(extensions ...extension.Extension) Option {
 		kit.extensions = append(kit.extensions, extensions...)
 	})
 }
+
+// MultiNodesOption configures the MultiNodes test environment
+type MultiNodesOption func(m *MultiNodes)
+
+// WithSplitBrainResolver sets the split brain resolver of the nodes started by MultiNodes
+func WithSplitBrainResolver(resolver *actor.SplitBrainResolver) MultiNodesOption {
+	return func(m *MultiNodes) {
+		m.splitBrainResolver = resolver
+	}
+}
@@ -51,13 +51,13 @@ const (
 // useful for verifying the behavior of other actors in controlled scenarios.
 type Probe interface {
 	// ExpectMessage asserts that the next message received by the probe
-	// exactly matches the given protobuf message.
+	// exactly matches the given message.
 	// It fails the test if no message is received or the message does not match.
-	ExpectMessage(message proto.Message)
+	ExpectMessage(message any)
 
 	// ExpectMessageWithin asserts that the expected message is received within the given duration.
 	// It fails the test if the timeout is reached or the message differs.
-	ExpectMessageWithin(duration time.Duration, message proto.Message)
+	ExpectMessageWithin(duration time.Duration, message any)
 
 	// ExpectNoMessage asserts that no message is received within a short, default time window.
 	// This is useful for asserting inactivity or idle actors.
@@ -65,11 +65,11 @@ type Probe interface {
 
 	// ExpectAnyMessage waits for and returns the next message received by the probe.
 	// It fails the test if no message is received in a reasonable default timeout.
-	ExpectAnyMessage() proto.Message
+	ExpectAnyMessage() any
 
 	// ExpectAnyMessageWithin waits for and returns the next message received within the specified duration.
 	// It fails the test if no message is received in the given time window.
-	ExpectAnyMessageWithin(duration time.Duration) proto.Message
+	ExpectAnyMessageWithin(duration time.Duration) any
 
 	// ExpectMessageOfType asserts that the next received message matches the given message type.
 	// It fails the test if no message is received or the type does not match.
@@ -92,7 +92,7 @@ type Probe interface {
 	//
 	// Parameters:
 	//   - actorName: the name of the target actor registered within the actor system.
-	//   - message: the protocol buffer message to send to the actor.
+	//   - message: the message to send to the actor.
 	//
 	// Behavior:
 	//   - If the target actor does not exist, is unregistered, or is unreachable, the test will immediately fail.
@@ -102,7 +102,7 @@ type Probe interface {
 	// Notes:
 	//   - This is a convenience method for testing fire-and-forget scenarios.
 	//   - For request-response testing, consider using SendSync or SendSyncContext instead.
-	Send(actorName string, message proto.Message)
+	Send(actorName string, message any)
 
 	// SendSync sends a message to the specified actor and waits for a synchronous response within the given timeout duration.
 	// This method simulates the "Ask" pattern (request-response) and is primarily used in test scenarios to
@@ -111,11 +111,11 @@ type Probe interface {
 	//
 	// Parameters:
 	//   - actorName: the registered name of the target actor to which the message should be sent.
-	//   - msg: the protocol buffer message to send to the actor.
+	//   - msg: the message to send to the actor.
 	//   - timeout: the duration to wait for a response before failing the test.
 	//
 	// Returns:
-	//   - The response as a proto.Message if the actor responds within the timeout period.
+	//   - The response message if the actor responds within the timeout period.
 	//   - The test will fail immediately if:
 	//       - The actor does not exist or is not reachable.
 	//       - The actor fails to respond within the timeout.
@@ -124,7 +124,7 @@ type Probe interface {
 	// Notes:
 	//   - This method is intended for use within testing frameworks via a test probe.
 	//   - Should not be used in production code as it couples testing and actor system internals.
-	SendSync(actorName string, message proto.Message, timeout time.Duration)
+	SendSync(actorName string, message any, timeout time.Duration)
 
 	// Sender returns the PID (Process Identifier) of the sender of the last received message.
 	// This is useful when testing interactions involving message origins.
@@ -172,7 +172,7 @@ type Probe interface {
 type message struct {
 	sender        *actors.PID
 	senderAddress *address.Address
-	payload       proto.Message
+	payload       any
 }
 
 type probeActor struct {
@@ -214,7 +214,7 @@ type probe struct {
 
 	testCtx           context.Context
 	pid               *actors.PID
-	lastMessage       proto.Message
+	lastMessage       any
 	lastSender        *actors.PID
 	lastSenderAddress *address.Address
 	messageQueue      chan message
@@ -271,15 +271,15 @@ func (x *probe) ExpectTerminated(actorName string) {
 }
 
 // ExpectMessage asserts that the next message received by the probe
-// exactly matches the given protobuf message.
+// exactly matches the given message.
 // It fails the test if no message is received or the message does not match.
-func (x *probe) ExpectMessage(message proto.Message) {
+func (x *probe) ExpectMessage(message any) {
 	x.expectMessage(x.defaultTimeout, message)
 }
 
 // ExpectMessageWithin asserts that the expected message is received within the given duration.
 // It fails the test if the timeout is reached or the message differs.
-func (x *probe) ExpectMessageWithin(duration time.Duration, message proto.Message) {
+func (x *probe) ExpectMessageWithin(duration time.Duration, message any) {
 	x.expectMessage(duration, message)
 }
 
@@ -291,13 +291,13 @@ func (x *probe) ExpectNoMessage() {
 
 // ExpectAnyMessage waits for and returns the next message received by the probe.
 // It fails the test if no message is received in a reasonable default timeout.
-func (x *probe) ExpectAnyMessage() proto.Message {
+func (x *probe) ExpectAnyMessage() any {
 	return x.expectAnyMessage(x.defaultTimeout)
 }
 
 // ExpectAnyMessageWithin waits for and returns the next message received within the specified duration.
 // It fails the test if no message is received in the given time window.
-func (x *probe) ExpectAnyMessageWithin(duration time.Duration) proto.Message {
+func (x *probe) ExpectAnyMessageWithin(duration time.Duration) any {
 	return x.expectAnyMessage(duration)
 }
 
@@ -310,7 +310,7 @@ func (x *probe) ExpectAnyMessageWithin(duration time.Duration) proto.Message {
 //
 // Parameters:
 //   - actorName: the name of the target actor registered within the actor system.
-//   - message: the protocol buffer message to send to the actor.
+//   - message: the message to send to the actor.
 //
 // Behavior:
 //   - If the target actor does not exist, is unregistered, or is unreachable, the test will immediately fail.
@@ -320,7 +320,7 @@ func (x *probe) ExpectAnyMessageWithin(duration time.Duration) proto.Message {
 // Notes:
 //   - This is a convenience method for testing fire-and-forget scenarios.
 //   - For request-response testing, consider using SendSync or SendSyncContext instead.
-func (x *probe) Send(actorName string, message proto.Message) {
+func (x *probe) Send(actorName string, message any) {
 	if x.pid.ActorSystem().InCluster() {
 		err := x.pid.SendAsync(x.testCtx, actorName, message)
 		require.NoError(x.testingT, err)
@@ -341,11 +341,11 @@ func (x *probe) Send(actorName string, message proto.Message) {
 //
 // Parameters:
 //   - actorName: the registered name of the target actor to which the message should be sent.
-//   - msg: the protocol buffer message to send to the actor.
+//   - msg: the message to send to the actor.
 //   - timeout: the duration to wait for a response before failing the test.
 //
 // Returns:
-//   - The response as a proto.Message if the actor responds within the timeout period.
+//   - The response message if the actor responds within the timeout period.
 //   - The test will fail immediately if:
 //   - The actor does not exist or is not reachable.
 //   - The actor fails to respond within the timeout.
@@ -354,9 +354,9 @@ func (x *probe) Send(actorName string, message proto.Message) {
 // Notes:
 //   - This method is intended for use within testing frameworks via a test probe.
 //   - Should not be used in production code as it couples testing and actor system internals.
-func (x *probe) SendSync(actorName string, msg proto.Message, timeout time.Duration) {
+func (x *probe) SendSync(actorName string, msg any, timeout time.Duration) {
 	var (
-		received  proto.Message
+		received  any
 		err       error
 		to        *actors.PID
 		toAddress *address.Address
@@ -444,7 +444,7 @@ func (x *probe) Stop() {
 }
 
 // receiveOne receives one message within a maximum time duration
-func (x *probe) receiveOne(duration time.Duration) proto.Message {
+func (x *probe) receiveOne(duration time.Duration) any {
 	t := x.timers.Get(duration)
 
 	select {
@@ -470,12 +470,19 @@ func (x *probe) receiveOne(duration time.Duration) proto.Message {
 }
 
 // expectMessage assert the expectation of a message within a maximum time duration
-func (x *probe) expectMessage(duration time.Duration, message proto.Message) {
+func (x *probe) expectMessage(duration time.Duration, message any) {
 	// receive one message
 	received := x.receiveOne(duration)
 	// let us assert the received message
-	require.NotNil(x.testingT, received, fmt.Sprintf("timeout (%v) during expectMessage while waiting for %v", duration, message.ProtoReflect().Descriptor().FullName()))
-	require.Equal(x.testingT, prototext.Format(message), prototext.Format(received), fmt.Sprintf("expected %v, found %v", message, received))
+	require.NotNil(x.testingT, received, fmt.Sprintf("timeout (%v) during expectMessage while waiting for %T", duration, message))
+
+	expected, isProto := message.(proto.Message)
+	actual, receivedProto := received.(proto.Message)
+	if isProto && receivedProto {
+		require.Equal(x.testingT, prototext.Format(expected), prototext.Format(actual), fmt.Sprintf("expected %v, found %v", message, received))
+		return
+	}
+	require.Equal(x.testingT, message, received, fmt.Sprintf("expected %v, found %v", message, received))
 }
 
 // expectNoMessage asserts that no message is expected
@@ -486,7 +493,7 @@ func (x *probe) expectNoMessage(duration time.Duration) {
 }
 
 // expectedAnyMessage asserts that any message is expected
-func (x *probe) expectAnyMessage(duration time.Duration) proto.Message {
+func (x *probe) expectAnyMessage(duration time.Duration) any {
 	// receive one message
 	received := x.receiveOne(duration)
 	require.NotNil(x.testingT, received, fmt.Sprintf("timeout (%v) during expectAnyMessage while waiting", duration))
@@ -500,7 +507,9 @@ func (x *probe) expectMessageOfType(duration time.Duration, messageType protoref
 	require.NotNil(x.testingT, received, fmt.Sprintf("timeout (%v) , during expectAnyMessage while waiting", duration))
 
 	// assert the message type
-	expectedType := received.ProtoReflect().Type() == messageType
-	require.True(x.testingT, expectedType, fmt.Sprintf("expected %v, found %v", messageType, received.ProtoReflect().Type()))
-	return received
+	actual, ok := received.(proto.Message)
+	require.True(x.testingT, ok, fmt.Sprintf("expected %v, found %T", messageType.Descriptor().FullName(), received))
+	expectedType := actual.ProtoReflect().Type() == messageType
+	require.True(x.testingT, expectedType, fmt.Sprintf("expected %v, found %v", messageType, actual.ProtoReflect().Type()))
+	return actual
 }
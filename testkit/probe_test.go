@@ -32,6 +32,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 
 	actors "github.com/tochemey/goakt/v3/actor"
 	"github.com/tochemey/goakt/v3/goaktpb"
@@ -80,7 +81,7 @@ func TestTestProbe(t *testing.T) {
 		probe.Send("pinger", new(testpb.TestPing))
 
 		actual := probe.ExpectAnyMessage()
-		require.Equal(t, prototext.Format(msg), prototext.Format(actual))
+		require.Equal(t, prototext.Format(msg), prototext.Format(actual.(proto.Message)))
 		probe.ExpectNoMessage()
 
 		probe.Stop()
@@ -47,6 +47,8 @@ type TestNode struct {
 	testingT    *testing.T         // The testing context for reporting errors and assertions.
 	created     *atomic.Bool
 	testCtx     context.Context
+	// discoveryAddr is the address used by the cluster membership protocol
+	discoveryAddr string
 }
 
 // NodeName returns the name of the test node, which can be used for identification